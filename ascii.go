@@ -0,0 +1,46 @@
+package easychars
+
+import "encoding/binary"
+
+const (
+	// the highest bit of every byte in a word, which is set only for non-ASCII bytes
+	highBits = 0x8080808080808080
+	// the low byte of every 16-bit lane in a word
+	lowBytes = 0x00FF00FF00FF00FF
+	// added to a byte in the low half of a 16-bit lane, it carries into bit 8 if the byte is no less than 0xD8
+	surrogateCarry = 0x0028002800280028
+	// bit 8 of every 16-bit lane in a word
+	laneBit8 = 0x0100010001000100
+)
+
+// asciiPrefixLenGeneric returns the number of leading ASCII bytes in content, testing 8 bytes at a time.
+//
+// It's the pure-Go asciiPrefixLen, which amd64 and arm64 replace by assembly unless built with the purego tag.
+func asciiPrefixLenGeneric(content []byte) int {
+	i := 0
+	for ; i+8 <= len(content); i += 8 {
+		if binary.LittleEndian.Uint64(content[i:])&highBits != 0 {
+			break
+		}
+	}
+	for ; i < len(content) && content[i] < 0x80; i++ {
+	}
+	return i
+}
+
+// utf16PrefixLen returns the number of leading bytes in content, a multiple of 8, whose UTF-16 code units are all below 0xD800,
+// i.e. they can't start or end a surrogate pair. It tests 4 code units at a time.
+func utf16PrefixLen(content []byte, bigEndian bool) int {
+	i := 0
+	for ; i+8 <= len(content); i += 8 {
+		w := binary.LittleEndian.Uint64(content[i:])
+		// move the high byte of every code unit to the low half of its lane
+		if !bigEndian {
+			w >>= 8
+		}
+		if (w&lowBytes+surrogateCarry)&laneBit8 != 0 {
+			break
+		}
+	}
+	return i
+}
@@ -0,0 +1,40 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func asciiPrefixLen(content []byte) int
+//
+// PMOVMSKB gathers the highest bit of 16 bytes, which is set only for non-ASCII bytes.
+TEXT ·asciiPrefixLen(SB), NOSPLIT, $0-32
+	MOVQ content_base+0(FP), SI
+	MOVQ content_len+8(FP), BX
+	XORQ AX, AX
+
+loop16:
+	LEAQ     16(AX), CX
+	CMPQ     CX, BX
+	JA       tail
+	MOVOU    (SI)(AX*1), X0
+	PMOVMSKB X0, DX
+	TESTL    DX, DX
+	JNZ      found
+	MOVQ     CX, AX
+	JMP      loop16
+
+found:
+	BSFL DX, DX
+	ADDQ DX, AX
+	JMP  done
+
+tail:
+	CMPQ    AX, BX
+	JAE     done
+	MOVBLZX (SI)(AX*1), DX
+	CMPL    DX, $0x80
+	JAE     done
+	INCQ    AX
+	JMP     tail
+
+done:
+	MOVQ AX, ret+24(FP)
+	RET
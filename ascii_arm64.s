@@ -0,0 +1,37 @@
+//go:build !purego
+
+#include "textflag.h"
+
+// func asciiPrefixLen(content []byte) int
+//
+// LDP loads 16 bytes into two registers, which are tested against the highest bit of every byte together.
+TEXT ·asciiPrefixLen(SB), NOSPLIT, $0-32
+	MOVD content_base+0(FP), R0
+	MOVD content_len+8(FP), R1
+	MOVD $0, R2
+	MOVD $0x8080808080808080, R5
+
+loop16:
+	ADD  $16, R2, R3
+	CMP  R1, R3
+	BHI  tail
+	ADD  R0, R2, R4
+	LDP  (R4), (R6, R7)
+	ORR  R6, R7, R8
+	TST  R5, R8
+	BNE  tail
+	MOVD R3, R2
+	B    loop16
+
+// the non-ASCII byte, if any, is in the next 16 bytes
+tail:
+	CMP   R1, R2
+	BHS   done
+	MOVBU (R0)(R2), R6
+	TBNZ  $7, R6, done
+	ADD   $1, R2
+	B     tail
+
+done:
+	MOVD R2, ret+24(FP)
+	RET
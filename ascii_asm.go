@@ -0,0 +1,10 @@
+//go:build (amd64 || arm64) && !purego
+
+package easychars
+
+// asciiPrefixLen returns the number of leading ASCII bytes in content.
+//
+// It's implemented in ascii_amd64.s and ascii_arm64.s, testing 16 bytes at a time.
+//
+//go:noescape
+func asciiPrefixLen(content []byte) int
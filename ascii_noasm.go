@@ -0,0 +1,8 @@
+//go:build !(amd64 || arm64) || purego
+
+package easychars
+
+// asciiPrefixLen returns the number of leading ASCII bytes in content.
+func asciiPrefixLen(content []byte) int {
+	return asciiPrefixLenGeneric(content)
+}
@@ -0,0 +1,205 @@
+package easychars
+
+import (
+	"bytes"
+	"fmt"
+	"golang.org/x/text/transform"
+	"unicode/utf8"
+)
+
+// ErrorMode tells how invalid sequences in content are handled when converting to UTF-8.
+type ErrorMode int
+
+const (
+	// Write U+FFFD for every invalid sequence, it's the default.
+	ErrorReplace ErrorMode = iota
+	// Fail at the first invalid sequence with an *InvalidSequenceError.
+	ErrorStrict
+	// Drop invalid sequences.
+	ErrorSkip
+	// Write every byte of invalid sequences as \xNN, e.g. "\x81".
+	ErrorEscape
+)
+
+// InvalidSequenceError is returned with ErrorStrict for the first invalid sequence in content.
+type InvalidSequenceError struct {
+	// Byte offset of the sequence in content.
+	Offset int
+	// Bytes of the sequence.
+	Bytes []byte
+}
+
+func (e *InvalidSequenceError) Error() string {
+	return fmt.Sprintf("easychars: invalid sequence % x at offset %d", e.Bytes, e.Offset)
+}
+
+// MaxInvalidOffsets is the number of invalid sequences whose offsets are kept in ConvertReport.
+const MaxInvalidOffsets = 16
+
+// ConvertReport describes how well content was converted to UTF-8, so that bad conversions can be told apart.
+type ConvertReport struct {
+	// Number of U+FFFD replacement characters the decoder wrote for invalid or unmappable sequences.
+	// A U+FFFD decoded from content itself is text, it's not counted.
+	Replacements int
+	// Byte offsets in content of the first MaxInvalidOffsets invalid sequences, in ascending order.
+	InvalidOffsets []int
+	// Whether no sequence was replaced, i.e. Replacements is 0.
+	Lossless bool
+	// Ratio of non-ASCII bytes (0x80 - 0xFF) in content, 0 for empty content.
+	NonASCIIRatio float64
+}
+
+// ToUtf8WithReport converts content to UTF-8 with Decoder like ToUtf8WithDecoder, and reports the invalid sequences.
+//
+// It decodes one character at a time to locate them, so it's slower than ToUtf8WithDecoder.
+func ToUtf8WithReport(content []byte, d Decoder) ([]byte, *ConvertReport, error) {
+	report := &ConvertReport{}
+	decoded := make([]byte, 0, len(content))
+	err := decodeEach(content, d, func(out []byte, src []byte, offset int, replaced int) error {
+		for n := replaced; n > 0; n-- {
+			report.Replacements++
+			if len(report.InvalidOffsets) < MaxInvalidOffsets {
+				report.InvalidOffsets = append(report.InvalidOffsets, offset)
+			}
+		}
+		decoded = append(decoded, out...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	report.Lossless = report.Replacements == 0
+	report.NonASCIIRatio = nonASCIIRatio(content)
+	return decoded, report, nil
+}
+
+// decodeWithMode converts content to UTF-8 with d, handling invalid sequences by mode.
+// A U+FFFD decoded from content itself is text, it's kept as it is.
+func decodeWithMode(content []byte, d Decoder, mode ErrorMode) ([]byte, error) {
+	decoded := make([]byte, 0, len(content))
+	err := decodeEach(content, d, func(out []byte, src []byte, offset int, replaced int) error {
+		out, err := applyErrorMode(out, src, offset, replaced, mode)
+		decoded = append(decoded, out...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// applyErrorMode handles the UTF-8 output of a single character decoded from src at offset by mode,
+// where replaced is the number of U+FFFD in out which replace invalid sequences, see replacements.
+func applyErrorMode(out []byte, src []byte, offset int, replaced int, mode ErrorMode) ([]byte, error) {
+	if replaced == 0 {
+		return out, nil
+	}
+	switch mode {
+	case ErrorStrict:
+		return nil, &InvalidSequenceError{Offset: offset, Bytes: append([]byte(nil), src...)}
+	case ErrorSkip:
+		out = bytes.ReplaceAll(out, replacementChar, nil)
+	case ErrorEscape:
+		escaped := make([]byte, 0, 4*len(src))
+		for _, b := range src {
+			escaped = append(escaped, fmt.Sprintf("\\x%02x", b)...)
+		}
+		out = bytes.ReplaceAll(out, replacementChar, escaped)
+	}
+	return out, nil
+}
+
+// replacementChar is U+FFFD in UTF-8, which decoders write for invalid sequences.
+var replacementChar = []byte(string(utf8.RuneError))
+
+// encodedReplacementChars are U+FFFD encoded by the charsets which can represent it, with U+FFFC encoded the same way:
+// UTF-8, UTF-16BE, UTF-16LE, UTF-32BE, UTF-32LE and GB18030.
+var encodedReplacementChars = []struct{ fffd, fffc []byte }{
+	{[]byte{0xEF, 0xBF, 0xBD}, []byte{0xEF, 0xBF, 0xBC}},
+	{[]byte{0xFF, 0xFD}, []byte{0xFF, 0xFC}},
+	{[]byte{0xFD, 0xFF}, []byte{0xFC, 0xFF}},
+	{[]byte{0x00, 0x00, 0xFF, 0xFD}, []byte{0x00, 0x00, 0xFF, 0xFC}},
+	{[]byte{0xFD, 0xFF, 0x00, 0x00}, []byte{0xFC, 0xFF, 0x00, 0x00}},
+	{[]byte{0x84, 0x31, 0xA4, 0x37}, []byte{0x84, 0x31, 0xA4, 0x36}},
+}
+
+// encodedReplacementChar returns U+FFFD encoded in the charset of d, nil if the charset can't represent it.
+//
+// Other charsets may decode the same bytes to U+FFFD as an invalid sequence, e.g. FD FF in Big5,
+// so the charset is told by decoding U+FFFC. d is Reset.
+func encodedReplacementChar(d Decoder) []byte {
+	var buf [8]byte
+	defer d.Reset()
+	for _, encoded := range encodedReplacementChars {
+		d.Reset()
+		out, nSrc, err := decodeOne(d, buf[:], encoded.fffc, true)
+		if err == nil && nSrc == len(encoded.fffc) && string(out) == "\uFFFC" {
+			return encoded.fffd
+		}
+	}
+	return nil
+}
+
+// replacements returns the number of U+FFFD in out, a single character decoded from src, which replace invalid sequences.
+// fffd is U+FFFD encoded in the source charset, see encodedReplacementChar, and a U+FFFD decoded from it is not counted.
+func replacements(out []byte, src []byte, fffd []byte) int {
+	if fffd != nil && bytes.Equal(src, fffd) && bytes.Equal(out, replacementChar) {
+		return 0
+	}
+	return bytes.Count(out, replacementChar)
+}
+
+// decodeEach decodes content with d one character at a time, and calls fn with the UTF-8 output of each character,
+// the source bytes it's decoded from, their offset in content and the number of replacements in the output.
+// It stops at the first error fn returns, or a *DecodeError if d fails.
+//
+// The destination given to d grows byte by byte until d writes a character, so the output of each call is a single character.
+// Escape sequences and byte order marks are consumed with empty output.
+func decodeEach(content []byte, d Decoder, fn func(out []byte, src []byte, offset int, replaced int) error) error {
+	fffd := encodedReplacementChar(d)
+	var buf [64]byte
+	for pos := 0; pos < len(content); {
+		out, nSrc, err := decodeOne(d, buf[:], content[pos:], true)
+		if err != nil {
+			return &DecodeError{Offset: pos, Err: err}
+		}
+		src := content[pos : pos+nSrc]
+		if err := fn(out, src, pos, replacements(out, src, fffd)); err != nil {
+			return err
+		}
+		pos += nSrc
+	}
+	return nil
+}
+
+// decodeOne decodes the first character of src with d into buf, see decodeEach.
+// It returns transform.ErrShortSrc if the character is incomplete.
+func decodeOne(d Decoder, buf []byte, src []byte, atEOF bool) (out []byte, nSrc int, err error) {
+	for size := 1; ; size++ {
+		nDst, nSrc, err := d.Transform(buf[:size], src, atEOF)
+		if nSrc > 0 {
+			return buf[:nDst], nSrc, nil
+		}
+		if err == transform.ErrShortDst && size < len(buf) {
+			continue
+		}
+		if err == nil {
+			err = transform.ErrShortSrc
+		}
+		return nil, 0, err
+	}
+}
+
+// nonASCIIRatio returns the ratio of bytes 0x80 - 0xFF in content, 0 for empty content.
+func nonASCIIRatio(content []byte) float64 {
+	if len(content) == 0 {
+		return 0
+	}
+	n := 0
+	for _, b := range content {
+		if b >= utf8.RuneSelf {
+			n++
+		}
+	}
+	return float64(n) / float64(len(content))
+}
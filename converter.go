@@ -0,0 +1,55 @@
+package easychars
+
+import (
+	"golang.org/x/text/encoding"
+)
+
+// Converter converts content of a charset to UTF-8 again and again.
+//
+// The charset is resolved and its Decoder is built once, then reset for every conversion,
+// so that AppendUtf8 with a reused buffer allocates nothing in steady state.
+// A Converter is not safe for concurrent use, use one for each goroutine.
+type Converter struct {
+	charset  string
+	encoding encoding.Encoding
+	decoder  Decoder
+}
+
+// NewConverter returns a Converter of the charset of charsetName (case insensitive),
+// and an *InvalidNameError if charset name is not valid.
+func NewConverter(charsetName string) (*Converter, error) {
+	e, err := GetEncodingFromCharsetName(charsetName)
+	if err != nil {
+		return nil, err
+	}
+	c := NewConverterWithEncoding(e)
+	c.charset = charsetName
+	return c, nil
+}
+
+// NewConverterWithEncoding returns a Converter of e.
+func NewConverterWithEncoding(e encoding.Encoding) *Converter {
+	return &Converter{encoding: e, decoder: e.NewDecoder()}
+}
+
+// Encoding returns the encoding.Encoding the Converter decodes.
+func (c *Converter) Encoding() encoding.Encoding {
+	return c.encoding
+}
+
+// AppendUtf8 appends content decoded to UTF-8 to dst, and returns the extended buffer, see AppendUtf8 for dst.
+//
+// If the Decoder fails, the error is a *DecodeError with the charset name given to NewConverter,
+// and dst is returned as it was given, see AppendUtf8.
+func (c *Converter) AppendUtf8(dst, content []byte) ([]byte, error) {
+	decoded, err := appendDecoded(dst, content, c.decoder)
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.Charset = c.charset
+	}
+	return decoded, err
+}
+
+// ToUtf8 returns content decoded to UTF-8 in a new slice, see AppendUtf8.
+func (c *Converter) ToUtf8(content []byte) ([]byte, error) {
+	return c.AppendUtf8(nil, content)
+}
@@ -0,0 +1,99 @@
+package easychars
+
+// Detector detects the charset of data which arrives piece by piece, in the style of uchardet and Python's UniversalDetector.
+//
+// Feed data by Write until Done reports true or the data ends, then get the Result by Close:
+//
+//	d := easychars.NewDetector()
+//	for !d.Done() {
+//		n, err := conn.Read(buf)
+//		d.Write(buf[:n])
+//		if err != nil {
+//			break
+//		}
+//	}
+//	res, err := d.Close()
+//
+// At most DefaultSampleSize bytes are kept for detection, the data written after that is ignored.
+type Detector struct {
+	buf       []byte
+	nextCheck int     // length of buf to run the next early detection
+	result    *Result // the Result once detection is finished, nil if it failed
+	err       error   // the error once detection is finished, nil if it succeeded
+	finished  bool
+	closed    bool
+}
+
+const (
+	// length of buf to run the first early detection, doubled after every check
+	detectorFirstCheck = 1024
+	// Confidence of the best Result to finish detection early
+	detectorDoneConfidence = 100
+)
+
+// NewDetector returns a Detector ready to be fed.
+func NewDetector() *Detector {
+	return &Detector{nextCheck: detectorFirstCheck}
+}
+
+// Write feeds p to the Detector. It always consumes the whole p,
+// and returns ErrDetectorClosed only if the Detector has been closed.
+func (d *Detector) Write(p []byte) (n int, err error) {
+	if d.closed {
+		return 0, ErrDetectorClosed
+	}
+	n = len(p)
+	if d.finished {
+		return
+	}
+	if room := DefaultSampleSize - len(d.buf); len(p) > room {
+		p = p[:room]
+	}
+	d.buf = append(d.buf, p...)
+
+	if len(d.buf) >= DefaultSampleSize {
+		// more data won't be used, finish detection even if it fails
+		d.result, d.err = d.detect()
+		d.finished = true
+		return
+	}
+	if len(d.buf) >= d.nextCheck {
+		d.nextCheck *= 2
+		if res, err := d.detect(); err == nil && res.Confidence >= detectorDoneConfidence {
+			d.result, d.finished = res, true
+		}
+	}
+	return
+}
+
+// Done reports whether the Detector is confident enough or has DefaultSampleSize bytes, so that feeding more data is useless.
+func (d *Detector) Done() bool {
+	return d.finished
+}
+
+// Close finishes detection and returns the Result with highest Confidence for the fed data.
+//
+// It can be called more than once, and Write fails after it.
+func (d *Detector) Close() (*Result, error) {
+	d.closed = true
+	if !d.finished {
+		d.result, d.err = d.detect()
+		d.finished = true
+	}
+	return d.result, d.err
+}
+
+// Reset clears the fed data and Result, so the Detector can be reused for another stream.
+func (d *Detector) Reset() {
+	d.buf = d.buf[:0]
+	d.nextCheck = detectorFirstCheck
+	d.result = nil
+	d.err = nil
+	d.finished = false
+	d.closed = false
+}
+
+// detect runs DetectAll on the fed data and returns the highest ranked Result that the data is valid under.
+func (d *Detector) detect() (*Result, error) {
+	return detectValid(d.buf, nil, true)
+}
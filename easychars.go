@@ -1,16 +1,16 @@
 package easychars
 
 import (
-	"bytes"
 	"errors"
+	"fmt"
 	"github.com/saintfish/chardet"
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/htmlindex"
 	"golang.org/x/text/encoding/ianaindex"
-	"golang.org/x/text/encoding/unicode/utf32"
 	"golang.org/x/text/transform"
-	"io"
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // Result contains all the information that charset detecfr gives.
@@ -23,65 +23,436 @@ type Result struct {
 	Confidence int
 	// a Decoder which can convert the Result.Charset to utf-8, default encoding.Nop.NewDecoder() which won't try to convert the charset.
 	Decoder transform.Transformer
+	// the encoding.Encoding of Result.Charset, which can encode utf-8 back to the charset. nil if the charset is not Convertible.
+	Encoding encoding.Encoding
 	// Whether the charset can be converted by this package
 	Convertible bool
+	// Number of byte order mark bytes at the beginning of content, 0 if there is no BOM.
+	BOMLength int
+	// Where the charset came from: statistics, a byte order mark or a declaration in content.
+	Source Source
+	// Not nil if the charset declared in content disagrees with the detected one.
+	Conflict *Conflict
 }
 
+// Conflict describes a charset declared in content which disagrees with the charset detected by statistics.
+type Conflict struct {
+	// The charset declared by XML declaration or HTML <meta>.
+	Declared *Result
+	// The charset with highest Confidence detected by statistics.
+	Detected *Result
+	// Source of the charset which wins, SourceDeclaration or SourceStatistics.
+	Winner Source
+	// Why the winner is chosen.
+	Reason string
+}
+
+// Source tells where the charset of a Result came from.
+type Source int
+
+const (
+	// The charset is guessed by statistics of content, it's the default Source.
+	SourceStatistics Source = iota
+	// The charset is given by a byte order mark at the beginning of content.
+	SourceBOM
+	// The charset is declared by content itself, with XML declaration or HTML <meta>.
+	SourceDeclaration
+	// The charset is given by the charset parameter of a Content-Type header.
+	SourceContentType
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceStatistics:
+		return "statistics"
+	case SourceBOM:
+		return "BOM"
+	case SourceDeclaration:
+		return "declaration"
+	case SourceContentType:
+		return "Content-Type"
+	}
+	return "unknown"
+}
+
+// DetectOptions controls how DetectAllWithOptions and DetectEncodingWithOptions detect the charset.
+// The zero value is the default behavior of DetectAll.
+//
+// Preferred, Locale and TLD are hints which raise the Confidence of statistical Results before the declaration is applied,
+// see reweight for the bonuses. Charset names are compared as GetEncodingFromCharsetName resolves them,
+// so "GB-18030" and "gb18030" are the same.
+type DetectOptions struct {
+	// How the charset declared by XML declaration or HTML <meta> in content is used.
+	Declaration DeclarationPolicy
+	// Charsets preferred by the caller, e.g. the charsets of former documents from the same source.
+	Preferred []string
+	// If not empty, Results of other charsets are dropped, except the one from a byte order mark.
+	Allowed []string
+	// Locale of content such as "ru-RU", "zh_TW" or "ja", the charsets commonly used for its language are preferred.
+	Locale string
+	// Top-level domain or host name where content comes from such as "ru" or "www.example.co.jp",
+	// the charset browsers use by default for the domain is preferred.
+	TLD string
+}
+
+// DeclarationPolicy tells how the charset declared in content is used in detection.
+// A byte order mark always takes precedence over the declaration.
+type DeclarationPolicy int
+
+const (
+	// The declared charset is a candidate with Confidence at least declarationHintConfidence,
+	// so it wins only if statistics are not confident.
+	DeclarationHint DeclarationPolicy = iota
+	// The declared charset is the first Result with Confidence 100, statistics are only kept as other candidates.
+	DeclarationOverride
+	// The declaration is not looked for.
+	DeclarationIgnore
+	// Same as DeclarationOverride if content is valid under the declared charset,
+	// otherwise the declared charset is the last candidate.
+	DeclarationOverrideIfValid
+	// Detection always wins, the declared charset is only kept as a candidate behind the detected one.
+	DeclarationFallback
+)
+
+// Confidence of the declared charset with DeclarationHint.
+const declarationHintConfidence = 50
+
 // alias for transform.Transformer
 type Decoder interface {
 	transform.Transformer
 }
 
+// Errors of this package, compare them by errors.Is, because most are wrapped in the error types below.
 var (
-	errInvalidName  = errors.New("easychars: invalid encoding name")
-	errUnknown      = errors.New("easychars: unknown Encoding")
-	errUnsupported  = errors.New("easychars: this encoding is not supported")
-	errWrongDecoder = errors.New("easychars: wrong decoder")
+	// A charset name is not valid, wrapped in an *InvalidNameError.
+	ErrInvalidName = errors.New("easychars: invalid encoding name")
+	// An encoding.Encoding is not associated with a known encoding scheme.
+	ErrUnknown = errors.New("easychars: unknown Encoding")
+	// An encoding.Encoding is not listed in the mibMap of htmlindex and ianaindex.
+	ErrUnsupported = errors.New("easychars: this encoding is not supported")
+	// Content can't be decoded by the Decoder, matched by every *DecodeError.
+	ErrWrongDecoder = errors.New("easychars: wrong decoder")
+
+	// Write to a closed Detector.
+	ErrDetectorClosed = errors.New("easychars: write to closed detector")
+	// No charset is detected, or content is invalid under every detected charset.
+	ErrNotDetected = errors.New("easychars: charset not detected")
+
+	errUnsupportedRune = repertoireError{}
 )
 
+// InvalidNameError is returned when no encoding.Encoding is found for a charset name, it wraps ErrInvalidName.
+type InvalidNameError struct {
+	// The charset name as given.
+	Label string
+}
+
+func (e *InvalidNameError) Error() string {
+	return fmt.Sprintf("easychars: invalid encoding name %q", e.Label)
+}
+
+func (e *InvalidNameError) Unwrap() error {
+	return ErrInvalidName
+}
+
+// DecodeError is returned when the Decoder fails to convert content to UTF-8, it wraps the error of the Decoder.
+// errors.Is(err, ErrWrongDecoder) reports true for it too.
+type DecodeError struct {
+	// Name of the charset content is decoded from, empty if only the Decoder is known.
+	Charset string
+	// Byte offset in content where the Decoder stopped.
+	Offset int
+	// The error of the Decoder.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	charset := e.Charset
+	if charset == "" {
+		charset = "content"
+	}
+	return fmt.Sprintf("easychars: decode %s at offset %d: %v", charset, e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrWrongDecoder
+}
+
+// repertoireError is returned by the encoders of this package for a rune the charset can't represent.
+//
+// It has the Replacement method which encoding.ReplaceUnsupported and encoding.HTMLEscapeUnsupported look for.
+type repertoireError struct{}
+
+func (repertoireError) Error() string {
+	return "easychars: rune not supported by encoding"
+}
+
+func (repertoireError) Replacement() byte {
+	return encoding.ASCIISub
+}
+
+// newResult returns a Result for charset with matched Decoder and Encoding saved, Convertible is false if there is no Decoder for charset.
+func newResult(charset string, language string, confidence int) *Result {
+	result := &Result{
+		Charset:     charset,
+		Language:    language,
+		Confidence:  confidence,
+		Decoder:     encoding.Nop.NewDecoder(),
+		Convertible: false,
+	}
+	if e, err := GetEncodingFromCharsetName(charset); err == nil {
+		result.Decoder = e.NewDecoder()
+		result.Encoding = e
+		result.Convertible = true
+	}
+	return result
+}
+
 // DetectAll returns all chardet.Results which have non-zero Confidence. The Results are sorted by Confidence in descending order.
 //
-// Same as saintfish/chardet - chardet.NewTextDetector().DetectAll()
-// but save matched Decoder in result
+// If content starts with a UTF-8, UTF-16 or UTF-32 byte order mark,
+// the only Result is the correspond Unicode charset with Confidence 100.
+//
+// Otherwise same as saintfish/chardet - chardet.NewTextDetector().DetectAll()
+// but save matched Decoder in result, and merge the Results of this package's probers (BOM-less UTF-16 and UTF-32, EUC-TW, Johab).
+// A Result whose charset can't have the byte structure of content is downgraded to Confidence 1,
+// and Shift_JIS or EUC-KR using the extensions of Microsoft code pages is reported as CP932 or CP949.
+// The charset declared by XML declaration or HTML <meta> is used as a hint, see DeclarationHint.
 func DetectAll(content []byte) (results []*Result, err error) {
+	return DetectAllWithOptions(content, nil)
+}
+
+// DetectAllWithOptions is DetectAll with options, nil opts is same as DetectAll.
+//
+// If the declared charset disagrees with the detected one, the first Result records the Conflict.
+func DetectAllWithOptions(content []byte, opts *DetectOptions) (results []*Result, err error) {
+	results, conflict, err := detectAll(content, opts)
+	if len(results) > 0 {
+		conflict.resolve(results[0], results[0])
+	}
+	return
+}
+
+// detectAll ranks the Results like DetectAllWithOptions, and returns the disagreement of the declared charset, if any,
+// to be resolved on the Result finally chosen.
+func detectAll(content []byte, opts *DetectOptions) (results []*Result, conflict *declarationConflict, err error) {
+	if opts == nil {
+		opts = &DetectOptions{}
+	}
+	if result := detectBOM(content); result != nil {
+		results = append(results, result)
+		return
+	}
+	results, err = detectStatistics(content)
+	results = reweight(results, opts)
+	if opts.Declaration != DeclarationIgnore {
+		if declared := detectDeclaration(content); declared != nil {
+			results, conflict = applyDeclaration(results, declared, content, opts.Declaration)
+			err = nil
+		}
+	}
+	if len(opts.Allowed) > 0 {
+		results = filterAllowed(results, opts.Allowed)
+		if len(results) == 0 && err == nil {
+			err = ErrNotDetected
+		}
+	}
+	return
+}
+
+// detectStatistics returns the Results of chardet and this package's probers.
+func detectStatistics(content []byte) (results []*Result, err error) {
 	ress, err := chardet.NewTextDetector().DetectAll(content)
 	for _, res := range ress {
-		result := &Result{
-			Charset:     res.Charset,
-			Language:    res.Language,
-			Confidence:  res.Confidence,
-			Decoder:     encoding.Nop.NewDecoder(),
-			Convertible: false,
-		}
-		charset := res.Charset
-		if decoder, err := GetDecoderFromCharsetName(charset); err == nil {
-			result.Decoder = decoder
-			result.Convertible = true
+		results = append(results, newResult(res.Charset, res.Language, res.Confidence))
+	}
+	results = checkStructure(results, content)
+	for _, probe := range probers {
+		if probed := probe(content); probed != nil {
+			results = mergeResult(results, probed)
+			err = nil
 		}
-		results = append(results, result)
 	}
 	return
 }
 
+// applyDeclaration adds the declared Result to results by policy, replacing the statistical Result of same charset.
+//
+// If the declared charset disagrees with the best statistical one, the disagreement is returned,
+// which isn't a Conflict until a Result is chosen, because the ranking may be changed by validation.
+func applyDeclaration(results []*Result, declared *Result, content []byte, policy DeclarationPolicy) ([]*Result, *declarationConflict) {
+	var detected *Result
+	if len(results) > 0 {
+		detected = results[0]
+	}
+	confidence := 0
+	for i, res := range results {
+		if SameCharset(res.Charset, declared.Charset) {
+			confidence = res.Confidence
+			results = append(results[:i], results[i+1:]...)
+			break
+		}
+	}
+
+	reason := ""
+	switch policy {
+	case DeclarationOverride:
+		declared.Confidence = 100
+		results = append([]*Result{declared}, results...)
+		reason = "declaration overrides detection"
+	case DeclarationOverrideIfValid:
+		if isValidUnder(declared.Charset, content) {
+			declared.Confidence = 100
+			results = append([]*Result{declared}, results...)
+			reason = "content is valid under the declared charset"
+		} else {
+			declared.Confidence = 1
+			results = append(results, declared)
+			reason = "content is invalid under the declared charset"
+		}
+	case DeclarationFallback:
+		if detected != nil && confidence > detected.Confidence {
+			confidence = detected.Confidence
+		}
+		declared.Confidence = confidence
+		if declared.Confidence == 0 {
+			declared.Confidence = 1
+		}
+		results = mergeResult(results, declared)
+		reason = "detection wins over declaration"
+	default:
+		if confidence < declarationHintConfidence {
+			confidence = declarationHintConfidence
+		}
+		declared.Confidence = confidence
+		results = mergeResult(results, declared)
+		reason = "declaration is a hint, the more confident charset wins"
+	}
+
+	if detected == nil || SameCharset(detected.Charset, declared.Charset) {
+		return results, nil
+	}
+	return results, &declarationConflict{declared: declared, detected: detected, reason: reason}
+}
+
+// declarationConflict is a declared charset which disagrees with the best statistical one.
+type declarationConflict struct {
+	declared *Result
+	detected *Result
+	// why the policy ranks the declared charset as it does
+	reason string
+}
+
+// resolve records the Conflict on chosen, the Result finally used, whose Source is the Winner. Nil c does nothing.
+//
+// first is the Result ranked first by the policy, which is not chosen if content is invalid under its charset.
+func (c *declarationConflict) resolve(chosen, first *Result) {
+	if c == nil {
+		return
+	}
+	winner, reason := SourceStatistics, c.reason
+	if chosen == c.declared {
+		winner = SourceDeclaration
+	} else if first == c.declared {
+		reason = "the declared charset ranks first but fails validation"
+	}
+	chosen.Conflict = &Conflict{
+		Declared: c.declared,
+		Detected: c.detected,
+		Winner:   winner,
+		Reason:   reason,
+	}
+}
+
+// isValidUnder checks whether content is valid under the byte structure of charset, by its Validator.
+//
+// For charsets without a Validator, content is invalid if decoding produces
+// U+FFFD replacement characters or C1 control characters, which are seldom in text.
+func isValidUnder(charset string, content []byte) bool {
+	if validate, ok := LookupValidator(charset); ok {
+		return validate(content)
+	}
+	decoded, err := ToUtf8WithCharsetName(content, charset)
+	if err != nil {
+		return false
+	}
+	for _, r := range string(decoded) {
+		if r == utf8.RuneError || r >= 0x80 && r <= 0x9F {
+			return false
+		}
+	}
+	return true
+}
+
+// probers guess the charsets which chardet can't detect well, each returns nil if content doesn't look like its charset.
+var probers = []func(content []byte) *Result{
+	probeUTF16,
+	probeUTF32,
+	probeEUCTW,
+	probeJohab,
+}
+
+// mergeResult adds probed to results, or replaces the Result with same Charset if probed is more confident.
+// The Results are kept sorted by Confidence in descending order.
+func mergeResult(results []*Result, probed *Result) []*Result {
+	for i, res := range results {
+		if res.Charset == probed.Charset {
+			if res.Confidence >= probed.Confidence {
+				return results
+			}
+			results = append(results[:i], results[i+1:]...)
+			break
+		}
+	}
+	i := sort.Search(len(results), func(i int) bool { return results[i].Confidence < probed.Confidence })
+	results = append(results, nil)
+	copy(results[i+1:], results[i:])
+	results[i] = probed
+	return results
+}
+
 // DetectEncoding return the Result with highest Confidence.
 func DetectEncoding(content []byte) (result *Result, err error) {
-	if res, err := DetectAll(content); err == nil {
+	return DetectEncodingWithOptions(content, nil)
+}
+
+// DetectEncodingWithOptions is DetectEncoding with options, nil opts is same as DetectEncoding.
+func DetectEncodingWithOptions(content []byte, opts *DetectOptions) (result *Result, err error) {
+	res, err := DetectAllWithOptions(content, opts)
+	if err == nil {
 		result = res[0]
 	}
 	return
 }
 
-// Detect and convert content to UTF-8 encoded.
+// Detect and convert content to UTF-8 encoded. The byte order mark, if any, is removed from convertedContent.
+//
+// The highest ranked Result of DetectAll that content is valid under is used, see RegisterValidator.
+// It returns ErrNotDetected if content is invalid under every detected charset.
 func DetectAndConvertToUtf8(content []byte) (convertedContent []byte, res *Result, err error) {
+	return DetectAndConvertToUtf8WithOptions(content, nil)
+}
+
+// DetectAndConvertToUtf8WithOptions is DetectAndConvertToUtf8 with options, nil opts is same as DetectAndConvertToUtf8.
+//
+// opts.Declaration decides which charset is used when the charset declared in content disagrees with the detected one,
+// and res.Conflict records both of them.
+func DetectAndConvertToUtf8WithOptions(content []byte, opts *DetectOptions) (convertedContent []byte, res *Result, err error) {
 	convertedContent = content
-	err = nil
-	res, err = DetectEncoding(content)
+	res, err = detectValid(content, opts, false)
 	if err != nil {
 		return
 	}
 	if !res.Convertible {
 		return
 	}
+	// BOM is not part of the text, drop it before converting
+	content = content[res.BOMLength:]
+	convertedContent = content
 
 	charsetLower := strings.ToLower(res.Charset)
 	switch charsetLower {
@@ -89,11 +460,6 @@ func DetectAndConvertToUtf8(content []byte) (convertedContent []byte, res *Resul
 		return
 	case "utf-8", "utf8":
 		return
-	case "gb18030", "gb-18030", "gb 18030", "gbk", "gb2312":
-		// Check whether it's valid under GBK rule
-		if !isValidGBK(content) {
-			res.Confidence = 20
-		}
 	}
 
 	convertedContent, err = ToUtf8WithDecoder(content, res.Decoder)
@@ -103,81 +469,137 @@ func DetectAndConvertToUtf8(content []byte) (convertedContent []byte, res *Resul
 	return
 }
 
-// Get UTF-8 encoded []byte with encoding.Encoding.
-func ToUtf8WithEncoding(content []byte, e encoding.Encoding) ([]byte, error) {
-	return ToUtf8WithDecoder(content, e.NewDecoder())
+// Get UTF-8 encoded []byte with encoding.Encoding, see ToUtf8WithDecoder for mode.
+func ToUtf8WithEncoding(content []byte, e encoding.Encoding, mode ...ErrorMode) ([]byte, error) {
+	return ToUtf8WithDecoder(content, e.NewDecoder(), mode...)
+}
+
+// AppendUtf8 appends content decoded by e to dst, and returns the extended buffer.
+//
+// It writes into the spare capacity of dst, so that reusing a buffer, e.g. AppendUtf8(buf[:0], content, e),
+// doesn't allocate once it's large enough. It builds a new Decoder of e for every call, use a Converter to reuse one.
+// If the Decoder fails, the error is a *DecodeError wrapping the error of it, and dst is returned as it was given,
+// though it may have been grown, so that the buffer can still be reused.
+func AppendUtf8(dst, content []byte, e encoding.Encoding) ([]byte, error) {
+	if c, ok := e.(*SingleByteCharset); ok {
+		return c.appendUtf8(dst, content), nil
+	}
+	return appendDecoded(dst, content, e.NewDecoder())
 }
 
 // Get UTF-8 encoded []byte with Decoder.
-func ToUtf8WithDecoder(content []byte, d Decoder) ([]byte, error) {
-	reader := transform.NewReader(bytes.NewReader(content), d)
-	decoded, err := io.ReadAll(reader)
+//
+// mode tells how invalid sequences are handled, ErrorReplace if it's not given.
+// With ErrorStrict, the error is an *InvalidSequenceError if content has an invalid sequence.
+// If d fails, the error is a *DecodeError wrapping the error of d.
+func ToUtf8WithDecoder(content []byte, d Decoder, mode ...ErrorMode) ([]byte, error) {
+	if len(mode) > 0 && mode[0] != ErrorReplace {
+		return decodeWithMode(content, d, mode[0])
+	}
+	decoded, err := appendDecoded(nil, content, d)
 	if err != nil {
-		// seems that it seldom happens even if the decoder is not correspond to content
-		err = errWrongDecoder
 		return nil, err
 	}
 	return decoded, nil
 }
 
-// Get UTF-8 encoded []byte with charset name.
+// appendDecoded appends content decoded by d to dst, and returns dst truncated to its original length
+// with a *DecodeError if d fails.
 //
-// # It will return errInvalidName if there is charset name is not valid
+// d writes into the spare capacity of dst directly, which only grows when d runs short of it,
+// and the decoders of SingleByteCharset don't run d at all, see SingleByteCharset.appendUtf8.
+func appendDecoded(dst, content []byte, d Decoder) ([]byte, error) {
+	if c := singleByteCharsetOf(d); c != nil {
+		return c.appendUtf8(dst, content), nil
+	}
+	d.Reset()
+	start := len(dst)
+	// room for content if it's mostly ASCII, it's doubled when d needs more
+	dst = growBytes(dst, len(content)+utf8.UTFMax)
+	nSrc := 0
+	for {
+		nDst, n, err := d.Transform(dst[len(dst):cap(dst)], content[nSrc:], true)
+		dst = dst[:len(dst)+nDst]
+		nSrc += n
+		if err == transform.ErrShortDst {
+			dst = growBytes(dst, cap(dst))
+			continue
+		}
+		if err != nil {
+			// seems that it seldom happens even if the decoder is not correspond to content
+			return dst[:start], &DecodeError{Offset: nSrc, Err: err}
+		}
+		return dst, nil
+	}
+}
+
+// growBytes returns dst with room for at least n more bytes, a new slice if dst is nil.
+func growBytes(dst []byte, n int) []byte {
+	if dst != nil && cap(dst)-len(dst) >= n {
+		return dst
+	}
+	grown := make([]byte, len(dst), len(dst)+n)
+	copy(grown, dst)
+	return grown
+}
+
+// Get UTF-8 encoded []byte with charset name, see ToUtf8WithDecoder for mode.
 //
-// or errWrongDecoder if content can't decoded by the correspond Decoder
+// # It will return an *InvalidNameError if there is charset name is not valid
+//
+// or a *DecodeError with the charset name if content can't decoded by the correspond Decoder
 //
 // Charset name reference:
 //
 // https://encoding.spec.whatwg.org/#names-and-labels
 //
 // http://www.iana.org/assignments/character-sets/character-sets.xhtml
-func ToUtf8WithCharsetName(content []byte, charsetName string) ([]byte, error) {
+func ToUtf8WithCharsetName(content []byte, charsetName string, mode ...ErrorMode) ([]byte, error) {
 	decoder, err := GetDecoderFromCharsetName(charsetName)
 	if err != nil {
 		return content, err
 	}
-	return ToUtf8WithDecoder(content, decoder)
+	decoded, err := ToUtf8WithDecoder(content, decoder, mode...)
+	if decodeErr, ok := err.(*DecodeError); ok {
+		decodeErr.Charset = charsetName
+	}
+	return decoded, err
 }
 
 // GetEncodingFromCharsetName return encoding.Encoding for given charset name (case insensitive).
+// The charsets registered by RegisterEncoding are looked up first, then htmlindex and ianaindex.
 //
-// It will return errInvalidName if the package can't find correspond encoding.Encoding.
+// It will return an *InvalidNameError if the package can't find correspond encoding.Encoding.
 //
 // Charset name reference:
 //
 // https://encoding.spec.whatwg.org/#names-and-labels
 //
 // http://www.iana.org/assignments/character-sets/character-sets.xhtml
-func GetEncodingFromCharsetName(name string) (e encoding.Encoding, err error) {
-	name = strings.TrimSpace(name)
-	name = strings.ToLower(name)
-	switch name {
-	// only gb18030 is valid name in htmlindex and ianaindex
-	case "gb-18030", "gb_18030", "gb 18030":
-		name = "gb18030"
-
-	// UTF-32 is not listed in ianaindex and html encodings,
-	// so manually return correspond encoding.Encoding
-	case "utf-32-le", "utf_32_le", "utf-32_le", "utf_32-le", "utf32le", "utf-32le", "utf32-le", "utf_32le", "utf32_le":
-		return utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), nil
-
-	case "utf-32-be", "utf_32_be", "utf-32_be", "utf_32-be", "utf32be", "utf-32be", "utf32-be", "utf_32be", "utf32_be":
-		return utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), nil
-
+func GetEncodingFromCharsetName(label string) (e encoding.Encoding, err error) {
+	name := strings.ToLower(strings.TrimSpace(label))
+	if _, e, ok := lookupRegistered(name); ok {
+		return e, nil
+	}
+	// the charsets golang.org/x/text can't decode may be registered by their IANA names
+	if iana, ok := unsupportedNames[name]; ok {
+		if _, e, ok := lookupRegistered(iana); ok {
+			return e, nil
+		}
 	}
 	e, err = htmlindex.Get(name)
 	if err != nil || e == nil {
 		e, err = ianaindex.IANA.Encoding(name)
 	}
 	if err != nil || e == nil {
-		err = errInvalidName
+		e, err = nil, &InvalidNameError{Label: label}
 	}
 	return
 }
 
 // GetDecoderFromCharsetName return Decoder for given charset name (case insensitive).
 //
-// It will return errInvalidName if the package can't find correspond Decoder.
+// It will return an *InvalidNameError if the package can't find correspond Decoder.
 //
 // Reference: http://www.iana.org/assignments/character-sets/character-sets.xhtml
 //
@@ -193,12 +615,16 @@ func GetDecoderFromCharsetName(charsetName string) (decoder Decoder, err error)
 
 // GetCharsetNameFromEncoding reports the canonical name of the given Encoding.
 //
-// # It will return errUnknown if e is not associated with a known encoding scheme
+// # It will return ErrUnknown if e is not associated with a known encoding scheme
 //
-// or errUnsupported if e is not listed in the mibMap of htmlindex and ianaindex.
+// or ErrUnsupported if e is not listed in the mibMap of htmlindex and ianaindex.
 //
 // Reference: http://www.iana.org/assignments/character-sets/character-sets.xhtml.
 func getCharsetNameFromEncoding(e encoding.Encoding) (name string, err error) {
+	// encodings of this package and registered ones are not listed in htmlindex and ianaindex
+	if name, ok := registeredName(e); ok {
+		return name, nil
+	}
 	// in golang.org/x/text/encoding v0.6.0
 	// htmlindex and iana index name return "", errUnknown for utf-32
 	name, err = ianaindex.IANA.Name(e)
@@ -207,9 +633,9 @@ func getCharsetNameFromEncoding(e encoding.Encoding) (name string, err error) {
 	}
 	if err != nil {
 		if strings.Contains(err.Error(), "not supported") {
-			err = errUnsupported
+			err = ErrUnsupported
 		} else {
-			err = errUnknown
+			err = ErrUnknown
 		}
 	}
 	return
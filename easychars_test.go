@@ -1,10 +1,25 @@
 package easychars
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/transform"
+	"io"
 	"io/fs"
+	"math/rand"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
+	"unicode/utf8"
 )
 
 // Check whether file content is valid under UTF-16 rule, reference: https://zh.wikipedia.org/wiki/UTF-16
@@ -136,6 +151,64 @@ func TestIsValidUTF16LE(t *testing.T) {
 	}
 }
 
+func TestIsValidUTF32BE(t *testing.T) {
+	cases := GetTestCases("./tests/UTF-32BE", true)
+	cases2 := GetTestCases("./tests/UTF-16BE", false)
+	cases = append(cases, cases2...)
+	cases2 = GetTestCases("./tests/UTF-32LE", false)
+	cases = append(cases, cases2...)
+	for _, c := range cases {
+		got, _ := CheckFileIs(c.in, IsValidUTF32BE)
+		filename := filepath.Base(c.in)
+		if got != c.want {
+			t.Errorf("CheckFileIsUTF32BE(%q) == %t, want %t\n", filename, got, c.want)
+		} else {
+			t.Logf("PASS: CheckFileIsUTF32BE(%q) == %t", filename, got)
+		}
+	}
+}
+
+func TestIsValidUTF32LE(t *testing.T) {
+	cases := GetTestCases("./tests/UTF-32LE", true)
+	cases2 := GetTestCases("./tests/UTF-16LE", false)
+	cases = append(cases, cases2...)
+	cases2 = GetTestCases("./tests/UTF-32BE", false)
+	cases = append(cases, cases2...)
+	for _, c := range cases {
+		got, _ := CheckFileIs(c.in, IsValidUTF32LE)
+		filename := filepath.Base(c.in)
+		if got != c.want {
+			t.Errorf("CheckFileIsUTF32LE(%q) == %t, want %t\n", filename, got, c.want)
+		} else {
+			t.Logf("PASS: CheckFileIsUTF32LE(%q) == %t", filename, got)
+		}
+	}
+}
+
+func TestProbeUTF32(t *testing.T) {
+	cases := map[string]string{
+		"./tests/UTF-32BE": "UTF-32BE",
+		"./tests/UTF-32LE": "UTF-32LE",
+		"./tests/UTF-16BE": "",
+		"./tests/UTF-16LE": "",
+		"./tests/GB2312":   "",
+	}
+	for dir, charsetName := range cases {
+		for _, c := range GetTestCases(dir, true) {
+			content, _ := os.ReadFile(c.in)
+			filename := filepath.Base(c.in)
+			res := probeUTF32(content)
+			got := ""
+			if res != nil {
+				got = res.Charset
+			}
+			if got != charsetName {
+				t.Errorf("probeUTF32(%q) == %q, want %q", filename, got, charsetName)
+			}
+		}
+	}
+}
+
 func TestIsValidBig5(t *testing.T) {
 	cases := GetTestCases("./tests/Big5", true)
 	cases2 := GetTestCases("./tests/UTF-16", false)
@@ -151,6 +224,414 @@ func TestIsValidBig5(t *testing.T) {
 	}
 }
 
+func TestIsValidEUCTW(t *testing.T) {
+	cases := GetTestCases("./tests/EUC-TW", true)
+	cases2 := GetTestCases("./tests/UTF-16", false)
+	cases = append(cases, cases2...)
+	for _, c := range cases {
+		got, _ := CheckFileIs(c.in, isValidEUCTW)
+		filename := filepath.Base(c.in)
+		if got != c.want {
+			t.Errorf("CheckFileIsEUCTW(%q) == %t, want %t\n", filename, got, c.want)
+		} else {
+			t.Logf("PASS: CheckFileIsEUCTW(%q) == %t", filename, got)
+		}
+	}
+}
+
+func TestIsValidJohab(t *testing.T) {
+	cases := GetTestCases("./tests/Johab", true)
+	cases2 := GetTestCases("./tests/UTF-16", false)
+	cases = append(cases, cases2...)
+	for _, c := range cases {
+		got, _ := CheckFileIs(c.in, isValidJohab)
+		filename := filepath.Base(c.in)
+		if got != c.want {
+			t.Errorf("CheckFileIsJohab(%q) == %t, want %t\n", filename, got, c.want)
+		} else {
+			t.Logf("PASS: CheckFileIsJohab(%q) == %t", filename, got)
+		}
+	}
+}
+
+func TestIsValidJapaneseKorean(t *testing.T) {
+	validators := map[string]func([]byte) bool{
+		"ShiftJIS":  isValidShiftJIS,
+		"CP932":     isValidCP932,
+		"EUCJP":     isValidEUCJP,
+		"EUCKR":     isValidEUCKR,
+		"CP949":     isValidCP949,
+		"ISO2022JP": isValidISO2022JP,
+		"ISO2022KR": isValidISO2022KR,
+	}
+	cases := []struct {
+		dir   string
+		valid []string // the validators which content is valid under, invalid under the others
+	}{
+		{"./tests/SHIFT_JIS", []string{"ShiftJIS", "CP932"}},
+		{"./tests/CP932", []string{"CP932"}},
+		{"./tests/CP949", []string{"CP949"}},
+		{"./tests/iso-2022-jp", []string{"ShiftJIS", "CP932", "EUCJP", "EUCKR", "CP949", "ISO2022JP"}},
+		{"./tests/iso-2022-kr", []string{"ShiftJIS", "CP932", "EUCJP", "EUCKR", "CP949", "ISO2022KR"}},
+		{"./tests/UTF-16", nil},
+	}
+	for _, c := range cases {
+		for _, p := range GetTestCases(c.dir, true) {
+			filename := filepath.Base(p.in)
+			if c.dir == "./tests/CP932" && filename == "y-moto.com.xml" {
+				continue // no extension characters in it
+			}
+			for name, fn := range validators {
+				want := false
+				for _, v := range c.valid {
+					want = want || v == name
+				}
+				if got, _ := CheckFileIs(p.in, fn); got != want {
+					t.Errorf("CheckFileIs%s(%q) == %t, want %t\n", name, filename, got, want)
+				}
+			}
+		}
+	}
+
+	// EUC-JP and EUC-KR are valid under each other's rule, but the 3 byte JIS X 0212 is only in EUC-JP
+	for _, p := range GetTestCases("./tests/EUC-JP", true) {
+		if got, _ := CheckFileIs(p.in, isValidEUCJP); !got {
+			t.Errorf("CheckFileIsEUCJP(%q) == false, want true", filepath.Base(p.in))
+		}
+	}
+	for _, p := range GetTestCases("./tests/EUC-KR", true) {
+		if got, _ := CheckFileIs(p.in, isValidEUCKR); !got {
+			t.Errorf("CheckFileIsEUCKR(%q) == false, want true", filepath.Base(p.in))
+		}
+	}
+	if isValidEUCKR([]byte("\x8f\xb0\xa1")) || !isValidEUCJP([]byte("\x8f\xb0\xa1")) {
+		t.Errorf("JIS X 0212 character should be only valid in EUC-JP")
+	}
+
+	// escape sequences and shift states
+	iso2022 := []struct {
+		in   string
+		fn   func([]byte) bool
+		want bool
+	}{
+		{"\x1b$B$3$s\x1b(B abc", isValidISO2022JP, true},
+		{"\x1b$B$3$\x1b(B", isValidISO2022JP, false}, // half of a 2 byte character
+		{"\x1b$B$3\n", isValidISO2022JP, true},       // line feed resets to ASCII
+		{"\x1b$Z$3$s", isValidISO2022JP, false},      // unknown escape sequence
+		{"\x1b$)C\x0e$3$s\x0f abc", isValidISO2022KR, true},
+		{"\x0e$3$s\x0f", isValidISO2022KR, false}, // SO before designation
+		{"\x1b$)C\x0e$3$\x0f", isValidISO2022KR, false},
+	}
+	for _, c := range iso2022 {
+		if got := c.fn([]byte(c.in)); got != c.want {
+			t.Errorf("validate(%q) == %t, want %t", c.in, got, c.want)
+		}
+	}
+}
+
+func TestAsciiPrefixLen(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 70)
+	for start := 0; start < 8; start++ {
+		for end := start; end <= len(content); end++ {
+			for at := start; at <= end; at++ {
+				if at < end {
+					content[at] = 0x80
+				}
+				want := at - start
+				if n := asciiPrefixLen(content[start:end]); n != want {
+					t.Errorf("asciiPrefixLen of %d bytes with 0x80 at %d == %d, want %d", end-start, at-start, n, want)
+				}
+				if n := asciiPrefixLenGeneric(content[start:end]); n != want {
+					t.Errorf("asciiPrefixLenGeneric of %d bytes with 0x80 at %d == %d, want %d", end-start, at-start, n, want)
+				}
+				if at < end {
+					content[at] = 'a'
+				}
+			}
+		}
+	}
+}
+
+// The validators skipping runs of ASCII and code units several bytes at a time give the same results as the ones walking byte by byte,
+// on the test files and random content around the boundaries of their fast paths.
+func TestValidatorFastPaths(t *testing.T) {
+	validators := []struct {
+		name      string
+		fast, ref func([]byte) bool
+	}{
+		{"UTF-8", IsValidUTF8, utf8.Valid},
+		{"GBK", isValidGBK, isValidGBKByteAtATime},
+		{"GB18030", isValidGB18030, isValidGB18030ByteAtATime},
+		{"Big5", isValidBig5, isValidBig5ByteAtATime},
+		{"UTF-16BE", isValidUTF16BE, isValidUTF16BEByteAtATime},
+		{"UTF-16LE", isValidUTF16LE, isValidUTF16LEByteAtATime},
+	}
+	var contents [][]byte
+	for _, c := range GetTestCases("./tests", true) {
+		content, _ := os.ReadFile(c.in)
+		contents = append(contents, content)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	// special bytes of the charsets: lead and trail bytes, surrogates, and the bytes next to them
+	special := []byte{0x00, 0x30, 0x39, 0x40, 0x7E, 0x7F, 0x80, 0x81, 0xA1, 0xD7, 0xD8, 0xDB, 0xDC, 0xDF, 0xE0, 0xFE, 0xFF}
+	for i := 0; i < 20000; i++ {
+		content := make([]byte, rnd.Intn(48))
+		for j := range content {
+			content[j] = byte('a' + rnd.Intn(26))
+			if rnd.Intn(4) == 0 {
+				content[j] = special[rnd.Intn(len(special))]
+			}
+		}
+		contents = append(contents, content)
+	}
+	for _, v := range validators {
+		for _, content := range contents {
+			if got, want := v.fast(content), v.ref(content); got != want {
+				t.Errorf("%s validator of % x == %t, want %t", v.name, content, got, want)
+			}
+		}
+	}
+}
+
+// isValidGBKByteAtATime is isValidGBK walking content byte by byte, the reference of its fast path.
+func isValidGBKByteAtATime(content []byte) bool {
+	nByte := 1 // the number of bytes that current character use, max 2 bytes in GBK
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F { // character is ascii
+				continue
+			}
+			if b >= 0x81 && b <= 0xFE { // may be a GBK encoded character, depending on second byte
+				nByte = 2
+			} else { // not a valid GBK encoded character
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b < 0x40 || b > 0xFE || b == 0x7F { // not a valid GBK encoded character under these circumustance
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// isValidGB18030ByteAtATime is isValidGB18030 walking content byte by byte, the reference of its fast path.
+func isValidGB18030ByteAtATime(content []byte) bool {
+	nByte := 1 // the number of bytes that current character use, max 4 bytes in GB18030
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F { // character is ascii
+				continue
+			}
+			if b >= 0x81 && b <= 0xFE { // may be a GB18030 encoded character, depending on second byte
+				nByte = 2
+			} else { // not a valid GBK encoded character
+				return false
+			}
+		case 2:
+			if b >= 0x40 && b <= 0xFE && b != 0x7F { // is a valid 2 byte GB18030(GBK) encoded character
+				nByte = 1
+				continue
+			}
+			if b >= 0x30 && b <= 0x39 { // may be a valid 4 byte GB18030 encoded character, depending on the third and fourth byte
+				nByte = 3
+				continue
+			} else {
+				return false
+			}
+		case 3:
+			if b >= 0x81 && b <= 0xFE { // may be a valid 4 byte GB18030 encoded character, depending on the fourth byte
+				nByte = 4
+				continue
+			} else {
+				return false
+			}
+		case 4:
+			if b >= 0x30 && b <= 0x39 { // a valid 4 byte GB18030 encoded character
+				nByte = 1
+				continue
+			} else {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// isValidBig5ByteAtATime is isValidBig5 walking content byte by byte, the reference of its fast path.
+func isValidBig5ByteAtATime(content []byte) bool {
+	nByte := 1 // Big5 use ascii && 2 byte encoded character
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0x81 && b <= 0xFE {
+				nByte = 2
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if !(b >= 0x40 && b <= 0x7E || b >= 0xA1 && b <= 0xFE) {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// isValidUTF16BEByteAtATime is isValidUTF16BE walking content byte by byte, the reference of its fast path.
+func isValidUTF16BEByteAtATime(content []byte) bool {
+	if len(content) == 0 {
+		return false
+	}
+	if len(content)&0x1 != 0 {
+		return false
+	}
+	// UTF-16 BE BOM: FE FF
+	BOM := uint16(content[0])<<8 ^ uint16(content[1])
+	if BOM == 0xFEFF {
+		return true
+	}
+
+	// If no BOM in content, assume it's encoded by UTF-16-BE and check it
+	//
+	// UTF-16 is valid in the range 0x0000 - 0xFFFF excluding 0xD800 - 0xFFFF
+	// with an exception for surrogate pairs, which must be in the range
+	// 0xD800-0xDBFF followed by 0xDC00-0xDFFF
+	//
+	// https://en.wikipedia.org/wiki/UTF-16
+	is_surrogate_pairs := false
+	for i := 0; i < len(content); i += 2 {
+		c := uint16(content[i])<<8 ^ uint16(content[i+1])
+		switch is_surrogate_pairs {
+		case true:
+			is_surrogate_pairs = false
+			if c < 0xDC00 || c > 0xDFFF {
+				return false
+			}
+		case false:
+			if c >= 0xD800 && c <= 0xFFFF {
+				is_surrogate_pairs = true
+			}
+		}
+	}
+	return !is_surrogate_pairs
+}
+
+// isValidUTF16LEByteAtATime is isValidUTF16LE walking content byte by byte, the reference of its fast path.
+func isValidUTF16LEByteAtATime(content []byte) bool {
+	if len(content) == 0 {
+		return false
+	}
+	if len(content)&0x1 != 0 {
+		return false
+	}
+
+	// UTF-16 LE BOM: FE FF
+	BOM := uint16(content[1])<<8 ^ uint16(content[0])
+	if BOM == 0xFEFF {
+		return true
+	}
+
+	// If no BOM in content, assume it's encoded by UTF-16-BE and check it
+	//
+	// UTF-16 is valid in the range 0x0000 - 0xFFFF excluding 0xD800 - 0xFFFF
+	// with an exception for surrogate pairs, which must be in the range
+	// 0xD800-0xDBFF followed by 0xDC00-0xDFFF
+	//
+	// https://en.wikipedia.org/wiki/UTF-16
+	is_surrogate_pairs := false
+	for i := 0; i < len(content); i += 2 {
+		c := uint16(content[i+1])<<8 ^ uint16(content[i])
+		switch is_surrogate_pairs {
+		case true:
+			is_surrogate_pairs = false
+			if c < 0xDC00 || c > 0xDFFF {
+				return false
+			}
+		case false:
+			if c >= 0xD800 && c <= 0xFFFF {
+				is_surrogate_pairs = true
+			}
+		}
+	}
+	return !is_surrogate_pairs
+}
+
+func TestCheckStructure(t *testing.T) {
+	// chardet reports Shift_JIS and EUC-KR, but the content uses extensions of Microsoft code pages
+	opts := &DetectOptions{Declaration: DeclarationIgnore}
+	for dir, charsetName := range map[string]string{"./tests/CP949": "CP949", "./tests/CP932/hardsoft.at.webry.info.xml": "CP932"} {
+		for _, c := range GetTestCases(dir, true) {
+			content, _ := os.ReadFile(c.in)
+			results, err := DetectAllWithOptions(content, opts)
+			if err != nil || results[0].Charset != charsetName {
+				t.Errorf("%s: got charset %s != %s", filepath.Base(c.in), results[0].Charset, charsetName)
+			}
+		}
+	}
+
+	// impossible charsets are downgraded
+	for _, c := range GetTestCases("./tests/GB2312", true) {
+		content, _ := os.ReadFile(c.in)
+		results, _ := DetectAllWithOptions(content, opts)
+		for _, res := range results {
+			if validate, ok := LookupValidator(res.Charset); ok && !validate(content) && res.Confidence != 1 {
+				t.Errorf("%s: invalid charset %s has Confidence %d", filepath.Base(c.in), res.Charset, res.Confidence)
+			}
+		}
+	}
+
+	// a character cut at the end of a sample is not an error
+	results := checkStructure([]*Result{newResult("Shift_JIS", "ja", 80)}, []byte("abc\x82\xa0\x82"))
+	if results[0].Confidence != 80 {
+		t.Errorf("cut character downgrades Shift_JIS to %d", results[0].Confidence)
+	}
+}
+
+func TestValidatorRegistry(t *testing.T) {
+	for _, name := range []string{"GB-18030", "gb18030", "csGB2312", "Shift_JIS", "UTF-32LE", "windows-1252", "latin1", "KOI8-R"} {
+		if _, ok := LookupValidator(name); !ok {
+			t.Errorf("LookupValidator(%q) not found", name)
+		}
+	}
+	if _, ok := LookupValidator("windows-1253"); ok {
+		t.Errorf("LookupValidator(windows-1253) should not be found")
+	}
+
+	// a registered Validator re-ranks the Results
+	content, _ := os.ReadFile("./tests/GB2312/cnblog.org.xml")
+	_, res, _ := DetectAndConvertToUtf8(content)
+	if res.Charset != "GB-18030" {
+		t.Fatalf("cnblog.org.xml: got charset %s != GB-18030", res.Charset)
+	}
+	gb18030, _ := LookupValidator("GB-18030")
+	RegisterValidator("gb-18030", func([]byte) bool { return false })
+	t.Cleanup(func() { RegisterValidator("GB-18030", gb18030) })
+	_, res, err := DetectAndConvertToUtf8WithOptions(content, &DetectOptions{Declaration: DeclarationIgnore})
+	if err != nil || res.Charset == "GB-18030" {
+		t.Errorf("cnblog.org.xml: got charset %s, err %v, rejected GB-18030 is used", res.Charset, err)
+	}
+
+	// the highest ranked valid Result is selected, ErrNotDetected if none is valid
+	results := []*Result{newResult("UTF-8", "", 90), newResult("ISO-8859-1", "", 50), newResult("Big5", "", 40)}
+	res, err = selectValid(results, []byte("caf\xe9"), nil, false)
+	if err != nil || res.Charset != "ISO-8859-1" || results[1].Charset != "UTF-8" || results[2].Charset != "Big5" {
+		t.Errorf("selectValid gives %v, %v", res, err)
+	}
+	RegisterValidator("custom", func([]byte) bool { return false })
+	defer RegisterValidator("custom", nil)
+	if _, err = selectValid([]*Result{newResult("custom", "", 90)}, []byte("abc"), nil, false); err != ErrNotDetected {
+		t.Errorf("selectValid of invalid Results gives err %v", err)
+	}
+}
+
 func Test_UTF_8_Detect(t *testing.T) {
 	cases := GetTestCases("./tests/utf-8", true)
 	charsetName := "UTF-8"
@@ -167,39 +648,67 @@ func Test_UTF_8_Detect(t *testing.T) {
 	}
 }
 
-// // UTF-16BE_Detect can't pass tests
-// func Test_UTF_16BE_Detect(t *testing.T) {
-// 	cases := GetTestCases("./tests/UTF-16BE", true)
-// 	charsetName := "UTF-16BE"
-// 	for _, c := range cases {
-// 		content, _ := os.ReadFile(c.in)
-// 		content, res, err := DetectAndConvertToUtf8(content)
-// 		filename := filepath.Base(c.in)
-// 		if err != nil {
-// 			t.Errorf("%s: can't convert to utf8", filename)
-// 		} else if res.Charset != charsetName {
-// 			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
-// 		}
-// 		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
-// 	}
-// }
+func Test_BOM_Detect(t *testing.T) {
+	charsetNames := map[string]string{
+		"bom-utf-8.srt":     "UTF-8",
+		"_ude_4.txt":        "UTF-8",
+		"bom-utf-16-be.srt": "UTF-16BE",
+		"bom-utf-16-le.srt": "UTF-16LE",
+		"bom-utf-32-be.srt": "UTF-32BE",
+		"bom-utf-32-le.srt": "UTF-32LE",
+	}
+	cases := GetTestCases("./tests/utf-8-sig", true)
+	cases = append(cases, GetTestCases("./tests/UTF-16", true)...)
+	cases = append(cases, GetTestCases("./tests/UTF-32", true)...)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		charsetName := charsetNames[filename]
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		} else if res.Confidence != 100 || res.BOMLength == 0 || res.Source != SourceBOM {
+			t.Errorf("%s: got confidence %d, BOM length %d, source %s", filename, res.Confidence, res.BOMLength, res.Source)
+		} else if bytes.HasPrefix(content, []byte("\uFEFF")) {
+			t.Errorf("%s: BOM is not removed from converted content", filename)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
 
-// // UTF-16LE_Detect can't pass tests
-// func Test_UTF_16LE_Detect(t *testing.T) {
-// 	cases := GetTestCases("./tests/UTF-16LE", true)
-// 	charsetName := "UTF-16LE"
-// 	for _, c := range cases {
-// 		content, _ := os.ReadFile(c.in)
-// 		content, res, err := DetectAndConvertToUtf8(content)
-// 		filename := filepath.Base(c.in)
-// 		if err != nil {
-// 			t.Errorf("%s: can't convert to utf8", filename)
-// 		} else if res.Charset != charsetName {
-// 			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
-// 		}
-// 		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
-// 	}
-// }
+func Test_UTF_16BE_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/UTF-16BE", true)
+	charsetName := "UTF-16BE"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
+
+func Test_UTF_16LE_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/UTF-16LE", true)
+	charsetName := "UTF-16LE"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
 
 func Test_UTF_32BE_Detect(t *testing.T) {
 	cases := GetTestCases("./tests/UTF-32BE", true)
@@ -265,9 +774,9 @@ func Test_Big5_Detect(t *testing.T) {
 	}
 }
 
-func Test_EUC_JP_Detect(t *testing.T) {
-	cases := GetTestCases("./tests/EUC-JP", true)
-	charsetName := "EUC-JP"
+func Test_EUC_TW_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/EUC-TW", true)
+	charsetName := "EUC-TW"
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
 		content, res, err := DetectAndConvertToUtf8(content)
@@ -279,27 +788,56 @@ func Test_EUC_JP_Detect(t *testing.T) {
 		}
 		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
 	}
+
+	// samples cut in the middle of a character are detected too
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		if sample := cutSample(content, isValidEUCTW); probeEUCTW(sample) == nil {
+			t.Errorf("%s: sample of %d bytes is not detected as EUC-TW", filepath.Base(c.in), len(sample))
+		}
+	}
 }
 
-func Test_Shift_JIS_Detect(t *testing.T) {
-	cases := GetTestCases("./tests/SHIFT_JIS", true)
-	charsetName := "Shift_JIS"
+// cutSample returns about the first half of content, cut in the middle of a character so that it's not valid by validate.
+func cutSample(content []byte, validate func(content []byte) bool) []byte {
+	for n := len(content) / 2; n < len(content); n++ {
+		if !validate(content[:n]) {
+			return content[:n]
+		}
+	}
+	return content[:len(content)/2]
+}
+
+func Test_EUC_TW_WithCharsetName(t *testing.T) {
+	cases := GetTestCases("./tests/EUC-TW", true)
+	charsetName := "EUC-TW"
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
-		content, res, err := DetectAndConvertToUtf8(content)
 		filename := filepath.Base(c.in)
+		decoded, err := ToUtf8WithCharsetName(content, charsetName)
 		if err != nil {
 			t.Errorf("%s: can't convert to utf8", filename)
-		} else if res.Charset != charsetName {
-			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+			continue
+		}
+		if bytes.ContainsRune(decoded, utf8.RuneError) {
+			t.Errorf("%s: converted content contains U+FFFD", filename)
+		}
+		encoded, err := EUCTW.NewEncoder().Bytes(decoded)
+		if err != nil || !bytes.Equal(encoded, content) {
+			t.Errorf("%s: can't encode back to EUC-TW: %v", filename, err)
 		}
-		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+
+	// plane 2 in 4 bytes form, and incomplete code at EOF
+	decoded, _ := ToUtf8WithEncoding([]byte("\x8e\xa2\xa1\xa1\xc4\xa1\xc4"), EUCTW)
+	if string(decoded) != "\u4e42\u4e00\ufffd" {
+		t.Errorf("EUC-TW decode gives %q", decoded)
 	}
 }
 
-func Test_ISO_2022_JP_Detect(t *testing.T) {
-	cases := GetTestCases("./tests/iso-2022-jp", true)
-	charsetName := "ISO-2022-JP"
+func Test_Johab_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/Johab", true)
+	charsetName := "Johab"
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
 		content, res, err := DetectAndConvertToUtf8(content)
@@ -311,11 +849,59 @@ func Test_ISO_2022_JP_Detect(t *testing.T) {
 		}
 		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
 	}
+
+	// samples cut in the middle of a character are detected too
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		if sample := cutSample(content, isValidJohab); isValidJohab(sample) || probeJohab(sample) == nil {
+			t.Errorf("%s: sample of %d bytes cut in a character is not detected as Johab", filepath.Base(c.in), len(sample))
+		}
+	}
+
+	// EUC-KR and CP949 are valid Johab in most cases, but must not be detected as Johab
+	for _, dir := range []string{"./tests/EUC-KR", "./tests/CP949"} {
+		for _, c := range GetTestCases(dir, false) {
+			content, _ := os.ReadFile(c.in)
+			if res := probeJohab(content); res != nil {
+				t.Errorf("%s: detected as Johab", filepath.Base(c.in))
+			}
+		}
+	}
 }
 
-func Test_EUC_KR_Detect(t *testing.T) {
-	cases := GetTestCases("./tests/EUC-KR", true)
-	charsetName := "EUC-KR"
+func Test_Johab_WithCharsetName(t *testing.T) {
+	cases := GetTestCases("./tests/Johab", true)
+	charsetName := "Johab"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		decoded, err := ToUtf8WithCharsetName(content, charsetName)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+			continue
+		}
+		if bytes.ContainsRune(decoded, utf8.RuneError) {
+			t.Errorf("%s: converted content contains U+FFFD", filename)
+		}
+		encoded, err := Johab.NewEncoder().Bytes(decoded)
+		if err != nil || !bytes.Equal(encoded, content) {
+			t.Errorf("%s: can't encode back to Johab: %v", filename, err)
+		}
+	}
+
+	// syllable, compatibility jamo, symbol, Hanja, invalid jamo combination and incomplete code at EOF
+	decoded, _ := ToUtf8WithEncoding([]byte("\x88\x61\x88\x41\xd9\x31\xe0\x31\x84\x40\xd0"), Johab)
+	if string(decoded) != "\uac00\u3131\u3000\u4f3d\ufffd@\ufffd" {
+		t.Errorf("Johab decode gives %q", decoded)
+	}
+	if _, err := Johab.NewEncoder().Bytes([]byte("\u00e9")); err == nil {
+		t.Errorf("Johab encode of U+00E9 should fail")
+	}
+}
+
+func Test_EUC_JP_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/EUC-JP", true)
+	charsetName := "EUC-JP"
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
 		content, res, err := DetectAndConvertToUtf8(content)
@@ -329,9 +915,9 @@ func Test_EUC_KR_Detect(t *testing.T) {
 	}
 }
 
-func Test_ISO_2022_KR_Detect(t *testing.T) {
-	cases := GetTestCases("./tests/ISO-2022-KR", true)
-	charsetName := "ISO-2022-KR"
+func Test_Shift_JIS_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/SHIFT_JIS", true)
+	charsetName := "Shift_JIS"
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
 		content, res, err := DetectAndConvertToUtf8(content)
@@ -345,11 +931,59 @@ func Test_ISO_2022_KR_Detect(t *testing.T) {
 	}
 }
 
-// // Windows_1250_Detect can't pass tests
-// func Test_Windows_1250_Detect(t *testing.T) {
-// 	cases := GetTestCases("./tests/windows-1250-croatian", true)
-// 	charsetName := "windows-1250"
-// 	for _, c := range cases {
+func Test_ISO_2022_JP_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/iso-2022-jp", true)
+	charsetName := "ISO-2022-JP"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
+
+func Test_EUC_KR_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/EUC-KR", true)
+	charsetName := "EUC-KR"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
+
+func Test_ISO_2022_KR_Detect(t *testing.T) {
+	cases := GetTestCases("./tests/ISO-2022-KR", true)
+	charsetName := "ISO-2022-KR"
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		content, res, err := DetectAndConvertToUtf8(content)
+		filename := filepath.Base(c.in)
+		if err != nil {
+			t.Errorf("%s: can't convert to utf8", filename)
+		} else if res.Charset != charsetName {
+			t.Errorf("%s: got charset %s != %s (real charset)", filename, res.Charset, charsetName)
+		}
+		t.Logf("\nfilename: %s\ncharset: %s\nconfidence: %d\ncontent: \n%s\n\n", filename, res.Charset, res.Confidence, content)
+	}
+}
+
+// // Windows_1250_Detect can't pass tests
+// func Test_Windows_1250_Detect(t *testing.T) {
+// 	cases := GetTestCases("./tests/windows-1250-croatian", true)
+// 	charsetName := "windows-1250"
+// 	for _, c := range cases {
 // 		content, _ := os.ReadFile(c.in)
 // 		content, res, err := DetectAndConvertToUtf8(content)
 // 		filename := filepath.Base(c.in)
@@ -733,14 +1367,17 @@ func Test_8859_9_Detect(t *testing.T) {
 func Test_Windows_1251_WithDecoder(t *testing.T) {
 	cases := GetTestCases("./tests/windows-1251-russian", true)
 	charsetName := "windows-1251"
-	decoder := windows_1251_Decoder{}
+	decoder := Windows1251.NewDecoder()
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
+		want, _ := ToUtf8WithEncoding(content, charmap.Windows1251)
 
 		content, err := ToUtf8WithDecoder(content, decoder)
 		filename := filepath.Base(c.in)
 		if err != nil {
 			t.Errorf("can't convert %s to utf8", filename)
+		} else if !bytes.Equal(content, want) {
+			t.Errorf("%s: converted content is different from golang.org/x/text", filename)
 		}
 		t.Logf("\nfilename: %s\ncharset: %s\ncontent: \n%s\n\n", filename, charsetName, content)
 	}
@@ -749,7 +1386,7 @@ func Test_Windows_1251_WithDecoder(t *testing.T) {
 func Test_ISO_88959_1_WithDecoder(t *testing.T) {
 	cases := GetTestCases("./tests/iso-8859-1", true)
 	charsetName := "ISO-8859-1"
-	decoder := iso_8859_1_Decoder{}
+	decoder := ISO8859_1.NewDecoder()
 	for _, c := range cases {
 		content, _ := os.ReadFile(c.in)
 
@@ -762,10 +1399,471 @@ func Test_ISO_88959_1_WithDecoder(t *testing.T) {
 	}
 }
 
-func TestUnicodeRuneToUtf8(t *testing.T) {
-	for i, c := range charMap_windows_1251 {
-		codes := unicodeRuneToUtf8(c)
-		t.Logf("idx: %d, codes == %v, %#X\n", i+128, string(codes), codes)
+func TestSingleByteCharset(t *testing.T) {
+	cases := []struct {
+		dirs    string
+		charset *SingleByteCharset
+		xtext   encoding.Encoding
+	}{
+		{"ascii", ASCII, nil},
+		{"iso-8859-1", ISO8859_1, nil},
+		{"iso-8859-2-*", ISO8859_2, charmap.ISO8859_2},
+		{"iso-8859-5-*", ISO8859_5, charmap.ISO8859_5},
+		{"iso-8859-6-*", ISO8859_6, charmap.ISO8859_6},
+		{"iso-8859-7-*", ISO8859_7, charmap.ISO8859_7},
+		{"iso-8859-9-*", ISO8859_9, charmap.ISO8859_9},
+		{"windows-1250-*", Windows1250, charmap.Windows1250},
+		{"windows-1251-*", Windows1251, charmap.Windows1251},
+		{"windows-1252", Windows1252, charmap.Windows1252},
+		{"windows-1254-*", Windows1254, charmap.Windows1254},
+		{"windows-1255-*", Windows1255, charmap.Windows1255},
+		{"windows-1256-*", Windows1256, charmap.Windows1256},
+		{"KOI8-R", KOI8R, charmap.KOI8R},
+		{"IBM866", IBM866, charmap.CodePage866},
+		{"IBM855", IBM855, charmap.CodePage855},
+		{"MacCyrillic", MacCyrillic, charmap.MacintoshCyrillic},
+		{"TIS-620", TIS620, charmap.Windows874},
+		{"MacRoman", MacRoman, charmap.Macintosh},
+	}
+	for _, c := range cases {
+		// every byte defined by both is the same as golang.org/x/text,
+		// which leaves C1 controls of ISO-8859 undefined, and extends TIS-620 as Windows-874
+		if xtext, ok := c.xtext.(*charmap.Charmap); ok {
+			for b := 0; b < 256; b++ {
+				r, want := c.charset.DecodeByte(byte(b)), xtext.DecodeByte(byte(b))
+				isC1 := r >= 0x80 && r < 0xA0
+				if r != utf8.RuneError && want != utf8.RuneError && !isC1 && r != want {
+					t.Errorf("%s: byte %#X is %U, want %U", c.charset, b, r, want)
+				}
+			}
+		}
+
+		dirs, _ := filepath.Glob(filepath.Join("./tests", c.dirs))
+		for _, dir := range dirs {
+			for _, tc := range GetTestCases(dir, true) {
+				content, _ := os.ReadFile(tc.in)
+				filename := filepath.Base(tc.in)
+				if !c.charset.IsValid(content) {
+					t.Errorf("%s: invalid under %s", filename, c.charset)
+				}
+				decoded, err := ToUtf8WithEncoding(content, c.charset, ErrorStrict)
+				if err != nil {
+					t.Errorf("%s: %s decode fail: %v", filename, c.charset, err)
+					continue
+				}
+				// golang.org/x/text doesn't decode C1 controls
+				if c.xtext != nil && c.charset.DecodeByte(0x80) != 0x80 {
+					if want, _ := ToUtf8WithEncoding(content, c.xtext); !bytes.Equal(decoded, want) {
+						t.Errorf("%s: %s decoded content is different from golang.org/x/text", filename, c.charset)
+					}
+				}
+				if encoded, err := FromUtf8WithEncoding(decoded, c.charset); err != nil || !bytes.Equal(encoded, content) {
+					t.Errorf("%s: %s round trip changes content, err %v", filename, c.charset, err)
+				}
+			}
+		}
+	}
+
+	// 0x98 is undefined in Windows-1251
+	if decoded, _ := ToUtf8WithEncoding([]byte("a\x98\xc0"), Windows1251); string(decoded) != "a\ufffd\u0410" {
+		t.Errorf("Windows-1251 decodes %q", decoded)
+	}
+	if _, err := ToUtf8WithEncoding([]byte("a\x98"), Windows1251, ErrorStrict); err == nil {
+		t.Errorf("undefined byte should fail with ErrorStrict")
+	}
+	if mask := Windows1251.ValidMask(); mask[0x98] || !mask[0x97] {
+		t.Errorf("ValidMask of Windows-1251 is wrong at 0x97 - 0x98")
+	}
+	if _, err := FromUtf8WithEncoding([]byte("€ 中"), KOI8R); err == nil {
+		t.Errorf("unsupported rune should fail")
+	}
+
+	// a custom table which swaps '$' and '€'
+	var table [256]rune
+	for b := range table {
+		table[b] = rune(b)
+	}
+	table['$'], table[0x80] = '€', '$'
+	custom := NewSingleByteCharset("x-custom", table)
+	if encoded, err := FromUtf8WithEncoding([]byte("€5 $"), custom); err != nil || string(encoded) != "$5 \x80" {
+		t.Errorf("custom encode: got %q, err %v", encoded, err)
+	}
+
+	// the charsets are registered by their names and WHATWG labels, with IsValid as Validator
+	for name, want := range map[string]encoding.Encoding{"koi8": KOI8R, "CP866": IBM866, "cp855": IBM855, "x-mac-ukrainian": MacCyrillic, "latin1": Windows1252, "iso-8859-9": Windows1254} {
+		if e, err := GetEncodingFromCharsetName(name); err != nil || e != want {
+			t.Errorf("GetEncodingFromCharsetName(%s) == %v, %v, want %v", name, e, err, want)
+		}
+	}
+	if validate, ok := LookupValidator("cp1252"); !ok || validate([]byte("a\x81")) || !validate([]byte("a\x80")) {
+		t.Errorf("Validator of windows-1252 is not Windows1252.IsValid")
+	}
+	// the registered ISO-8859 charsets decode every byte as golang.org/x/text, C1 controls are undefined
+	var all [256]byte
+	for b := range all {
+		all[b] = byte(b)
+	}
+	for name, xtext := range map[string]*charmap.Charmap{"iso-8859-2": charmap.ISO8859_2, "iso-8859-5": charmap.ISO8859_5, "iso-8859-6": charmap.ISO8859_6, "iso-8859-7": charmap.ISO8859_7} {
+		want, _ := xtext.NewDecoder().Bytes(all[:])
+		if got, err := ToUtf8WithCharsetName(all[:], name); err != nil || !bytes.Equal(got, want) {
+			t.Errorf("ToUtf8WithCharsetName(%s) differs from golang.org/x/text, err %v", name, err)
+		}
+		if validate, ok := LookupValidator(name); !ok || validate([]byte("a\x85")) || !validate([]byte("a\xa0")) {
+			t.Errorf("Validator of %s accepts C1 controls", name)
+		}
+	}
+
+	// TIS-620 is registered by its own names, other labels of windows-874 are kept
+	for _, name := range []string{"TIS-620", "tis620", "csTIS620"} {
+		if e, err := GetEncodingFromCharsetName(name); err != nil || e != TIS620 {
+			t.Errorf("GetEncodingFromCharsetName(%s) == %v, %v, want TIS-620", name, e, err)
+		}
+	}
+	if e, _ := GetEncodingFromCharsetName("iso-8859-11"); e == TIS620 {
+		t.Errorf("GetEncodingFromCharsetName(iso-8859-11) should be windows-874")
+	}
+	if validate, ok := LookupValidator("tis620"); !ok || validate([]byte("a\xff")) || !validate([]byte("a\xa1")) {
+		t.Errorf("Validator of TIS-620 is not TIS620.IsValid")
+	}
+	// the strict charsets are only Validators, WHATWG decodes them by the supersets
+	if decoded, _ := ToUtf8WithCharsetName([]byte("\x93ok\x94"), "ISO-8859-1"); string(decoded) != "\u201cok\u201d" {
+		t.Errorf("ISO-8859-1 decodes %q, want it as windows-1252", decoded)
+	}
+	// aliases have the Validator of their canonical name
+	for _, name := range []string{"US-ASCII", "ascii", "ISO-8859-1", "latin1"} {
+		if validate, ok := LookupValidator(name); !ok || validate([]byte("a\x81")) || !validate([]byte("a\x80")) {
+			t.Errorf("Validator of %s is not Windows1252.IsValid", name)
+		}
+	}
+
+	// decoding a single-byte charset allocates the output only, whatever the length of content
+	content := bytes.Repeat([]byte("\xcf\xf0\xe8\xe2\xe5\xf2 world "), 1000)
+	decoder := Windows1251.NewDecoder()
+	if allocs := testing.AllocsPerRun(10, func() { ToUtf8WithDecoder(content, decoder) }); allocs != 1 {
+		t.Errorf("ToUtf8WithDecoder allocates %v times, want 1", allocs)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	cases := []struct {
+		dirs    string
+		charset string
+	}{
+		{"windows-1251-*", "windows-1251"},
+		{"GB2312", "GB18030"},
+		{"SHIFT_JIS", "Shift_JIS"},
+		{"EUC-TW", "EUC-TW"},
+		{"UTF-16LE", "UTF-16LE"},
+	}
+	for _, c := range cases {
+		conv, err := NewConverter(c.charset)
+		if err != nil {
+			t.Fatalf("NewConverter(%s) gives err %v", c.charset, err)
+		}
+		dirs, _ := filepath.Glob(filepath.Join("./tests", c.dirs))
+		var buf []byte
+		for _, dir := range dirs {
+			for _, tc := range GetTestCases(dir, true) {
+				content, _ := os.ReadFile(tc.in)
+				filename := filepath.Base(tc.in)
+				want, _ := ToUtf8WithCharsetName(content, c.charset)
+				if buf, err = conv.AppendUtf8(buf[:0], content); err != nil || !bytes.Equal(buf, want) {
+					t.Errorf("%s: Converter(%s).AppendUtf8 differs from ToUtf8WithCharsetName, err %v", filename, c.charset, err)
+				}
+				if got, err := AppendUtf8([]byte("prefix"), content, conv.Encoding()); err != nil || !bytes.Equal(got, append([]byte("prefix"), want...)) {
+					t.Errorf("%s: AppendUtf8(%s) differs from ToUtf8WithCharsetName, err %v", filename, c.charset, err)
+				}
+				// the decoder and the buffer are reused, nothing is allocated
+				if allocs := testing.AllocsPerRun(10, func() { buf, _ = conv.AppendUtf8(buf[:0], content) }); allocs != 0 {
+					t.Errorf("%s: Converter(%s).AppendUtf8 allocates %v times", filename, c.charset, allocs)
+				}
+			}
+		}
+	}
+
+	if _, err := NewConverter("not-a-charset"); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("NewConverter(not-a-charset) gives err %v", err)
+	}
+	conv := NewConverterWithEncoding(KOI8R)
+	if got, err := conv.ToUtf8([]byte("\xf0\xd2\xc9\xd7\xc5\xd4")); err != nil || string(got) != "Привет" {
+		t.Errorf("Converter(KOI8-R).ToUtf8 == %q, %v", got, err)
+	}
+	conv, _ = NewConverter("gbk")
+	if _, err := conv.ToUtf8([]byte("ok")); err != nil {
+		t.Errorf("Converter(gbk).ToUtf8 gives err %v", err)
+	}
+	conv.decoder = failingDecoder{}
+	var decodeErr *DecodeError
+	if _, err := conv.ToUtf8([]byte("ok\xff")); !errors.As(err, &decodeErr) || decodeErr.Charset != "gbk" {
+		t.Errorf("Converter(gbk) with failing decoder gives err %v", err)
+	}
+	// the buffer is kept on failure, with what it had before
+	buf := append(make([]byte, 0, 64), "prefix"...)
+	if got, err := conv.AppendUtf8(buf, []byte("ok\xff")); err == nil || string(got) != "prefix" || cap(got) != 64 || &got[0] != &buf[0] {
+		t.Errorf("Converter(gbk).AppendUtf8 with failing decoder == %q, %v, want the given buffer", got, err)
+	}
+	if got, err := ToUtf8WithDecoder([]byte("ok\xff"), failingDecoder{}); err == nil || got != nil {
+		t.Errorf("ToUtf8WithDecoder with failing decoder == %q, %v, want nil", got, err)
+	}
+}
+
+func TestToUtf8WithReport(t *testing.T) {
+	for _, c := range GetTestCases("./tests/GB2312", true) {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		decoder, _ := GetDecoderFromCharsetName("GB18030")
+		want, _ := ToUtf8WithDecoder(content, decoder)
+		decoded, report, err := ToUtf8WithReport(content, decoder)
+		if err != nil || !bytes.Equal(decoded, want) {
+			t.Errorf("%s: ToUtf8WithReport differs from ToUtf8WithDecoder, err %v", filename, err)
+		}
+		if !report.Lossless || report.Replacements != 0 || report.NonASCIIRatio <= 0 {
+			t.Errorf("%s: GB18030 report %+v", filename, report)
+		}
+
+		// the wrong charset gives replacements
+		decoder, _ = GetDecoderFromCharsetName("Shift_JIS")
+		_, report, _ = ToUtf8WithReport(content, decoder)
+		if report.Lossless || report.Replacements == 0 || len(report.InvalidOffsets) > MaxInvalidOffsets {
+			t.Errorf("%s: Shift_JIS report %+v", filename, report)
+		}
+	}
+
+	decoder, _ := GetDecoderFromCharsetName("GBK")
+	decoded, report, _ := ToUtf8WithReport([]byte("ab\x81 c\xd6\xd0\xff"), decoder)
+	if string(decoded) != "ab\ufffd c\u4e2d\ufffd" || report.Replacements != 2 ||
+		len(report.InvalidOffsets) != 2 || report.InvalidOffsets[0] != 2 || report.InvalidOffsets[1] != 7 || report.NonASCIIRatio != 0.5 {
+		t.Errorf("GBK report %q %+v", decoded, report)
+	}
+
+	// a U+FFFD in valid content is text, not a replacement
+	for name, content := range map[string]string{
+		"UTF-8":    "a\xef\xbf\xbd",
+		"UTF-16LE": "a\x00\xfd\xff",
+		"GB18030":  "a\x84\x31\xa4\x37",
+	} {
+		decoder, _ := GetDecoderFromCharsetName(name)
+		decoded, report, err := ToUtf8WithReport([]byte(content), decoder)
+		if err != nil || string(decoded) != "a\ufffd" || report.Replacements != 0 || !report.Lossless {
+			t.Errorf("%s report of U+FFFD %q %+v, err %v", name, decoded, report, err)
+		}
+		decoded, report, err = ToUtf8WithReport([]byte(content+"\xff"), decoder)
+		if err != nil || !strings.HasPrefix(string(decoded), "a\ufffd\ufffd") || report.Replacements != 1 || len(report.InvalidOffsets) != 1 || report.InvalidOffsets[0] != len(content) {
+			t.Errorf("%s report of U+FFFD and an invalid byte %q %+v, err %v", name, decoded, report, err)
+		}
+	}
+	if decoded, err := ToUtf8WithCharsetName([]byte("a\xef\xbf\xbd"), "UTF-8", ErrorStrict); err != nil || string(decoded) != "a\ufffd" {
+		t.Errorf("ErrorStrict rejects U+FFFD in valid UTF-8: %q, %v", decoded, err)
+	}
+	// FD FF is U+FFFD in UTF-16LE, but an invalid sequence in Big5
+	big5, _ := GetDecoderFromCharsetName("Big5")
+	if decoded, report, err := ToUtf8WithReport([]byte("a\xfd\xff"), big5); err != nil || report.Replacements != 1 || report.Lossless {
+		t.Errorf("Big5 report of FD FF %q %+v, err %v", decoded, report, err)
+	}
+	if transcoded, err := Transcode([]byte("a\xef\xbf\xbd"), "UTF-8", "GB18030", &TranscodeOptions{Invalid: ErrorStrict}); err != nil || string(transcoded) != "a\x84\x31\xa4\x37" {
+		t.Errorf("Transcode of U+FFFD %x, %v", transcoded, err)
+	}
+}
+
+func TestErrorMode(t *testing.T) {
+	content := []byte("ab\x81 c\xd6\xd0\xff")
+	cases := []struct {
+		mode ErrorMode
+		want string
+	}{
+		{ErrorReplace, "ab\ufffd c\u4e2d\ufffd"},
+		{ErrorSkip, "ab c\u4e2d"},
+		{ErrorEscape, `ab\x81 c` + "\u4e2d" + `\xff`},
+	}
+	for _, c := range cases {
+		decoded, err := ToUtf8WithCharsetName(content, "GBK", c.mode)
+		if err != nil || string(decoded) != c.want {
+			t.Errorf("mode %d: got %q, err %v, want %q", c.mode, decoded, err, c.want)
+		}
+	}
+
+	_, err := ToUtf8WithCharsetName(content, "GBK", ErrorStrict)
+	var invalid *InvalidSequenceError
+	if !errors.As(err, &invalid) || invalid.Offset != 2 || !bytes.Equal(invalid.Bytes, []byte{0x81}) {
+		t.Errorf("ErrorStrict gives err %v", err)
+	}
+
+	// valid content is converted same as ErrorReplace
+	for _, c := range GetTestCases("./tests/SHIFT_JIS", true) {
+		content, _ := os.ReadFile(c.in)
+		want, _ := ToUtf8WithCharsetName(content, "Shift_JIS")
+		decoded, err := ToUtf8WithEncoding(content, japanese.ShiftJIS, ErrorStrict)
+		if err != nil || !bytes.Equal(decoded, want) {
+			t.Errorf("%s: ErrorStrict gives err %v", filepath.Base(c.in), err)
+		}
+	}
+}
+
+func TestFromUtf8(t *testing.T) {
+	cases := GetTestCases("./tests/GB2312", true)
+	cases = append(cases, GetTestCases("./tests/SHIFT_JIS", true)...)
+	cases = append(cases, GetTestCases("./tests/iso-2022-jp", true)...)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		decoded, res, err := DetectAndConvertToUtf8(content)
+		if err != nil || res.Encoding == nil {
+			t.Errorf("%s: detect fail: %v", filename, err)
+			continue
+		}
+		encoded, err := FromUtf8WithCharsetName(decoded, res.Charset)
+		if err != nil {
+			t.Errorf("%s: FromUtf8WithCharsetName(%s) fail: %v", filename, res.Charset, err)
+			continue
+		}
+		if again, _ := ToUtf8WithEncoding(encoded, res.Encoding); !bytes.Equal(again, decoded) {
+			t.Errorf("%s: %s round trip changes content", filename, res.Charset)
+		}
+	}
+
+	content := []byte("a€b中")
+	_, err := FromUtf8WithCharsetName(content, "KOI8-R")
+	var unsupported *UnsupportedRuneError
+	if !errors.As(err, &unsupported) || unsupported.Offset != 1 || unsupported.Rune != '€' {
+		t.Errorf("unsupported rune gives err %v", err)
+	}
+	policies := []struct {
+		name   string
+		policy UnsupportedPolicy
+		want   string
+	}{
+		{"UnsupportedQuestionMark", UnsupportedQuestionMark, "a?b?"},
+		{"UnsupportedHTMLEscape", UnsupportedHTMLEscape, "a&#8364;b&#20013;"},
+		{"callback", func(r rune) string { return map[rune]string{'€': "EUR"}[r] }, "aEURb"},
+	}
+	for _, p := range policies {
+		if got, err := FromUtf8WithCharsetName(content, "KOI8-R", p.policy); err != nil || string(got) != p.want {
+			t.Errorf("%s: got %q, err %v, want %q", p.name, got, err, p.want)
+		}
+	}
+	// the replacement must be representable too
+	if _, err := FromUtf8WithCharsetName(content, "KOI8-R", func(rune) string { return "中" }); !errors.As(err, &unsupported) {
+		t.Errorf("unsupported replacement gives err %v", err)
+	}
+
+	// the state of ISO-2022-JP is kept around a replacement, and switched back to ASCII at the end
+	got, err := FromUtf8WithCharsetName([]byte("日€本"), "ISO-2022-JP", UnsupportedQuestionMark)
+	if want := "\x1b$BF|\x1b(B?\x1b$BK\\\x1b(B"; err != nil || string(got) != want {
+		t.Errorf("ISO-2022-JP: got %q, err %v", got, err)
+	}
+}
+
+func TestTranscode(t *testing.T) {
+	cases := []struct {
+		dir, from, to string
+	}{
+		{"./tests/SHIFT_JIS", "Shift_JIS", "EUC-JP"},
+		{"./tests/windows-1251-russian", "windows-1251", "KOI8-R"},
+		{"./tests/EUC-JP", "EUC-JP", "ISO-2022-JP"},
+	}
+	opts := &TranscodeOptions{Unsupported: UnsupportedQuestionMark}
+	for _, c := range cases {
+		for _, tc := range GetTestCases(c.dir, true) {
+			content, _ := os.ReadFile(tc.in)
+			filename := filepath.Base(tc.in)
+			decoded, _ := ToUtf8WithCharsetName(content, c.from)
+			want, _ := FromUtf8WithCharsetName(decoded, c.to, UnsupportedQuestionMark)
+
+			got, err := Transcode(content, c.from, c.to, opts)
+			if err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%s: Transcode from %s to %s is different from converting through UTF-8, err %v", filename, c.from, c.to, err)
+			}
+			// read one byte at a time to split multi-byte characters between reads
+			reader, _ := NewTranscodingReader(iotest.OneByteReader(bytes.NewReader(content)), c.from, c.to, opts)
+			if got, err := io.ReadAll(reader); err != nil || !bytes.Equal(got, want) {
+				t.Errorf("%s: NewTranscodingReader from %s to %s is different from converting through UTF-8, err %v", filename, c.from, c.to, err)
+			}
+		}
+	}
+
+	// "a日本" in Shift_JIS, KOI8-R can't represent 日
+	content := []byte("a\x93\xfa\x96\x7b")
+	_, err := Transcode(content, "Shift_JIS", "KOI8-R", nil)
+	var unmappable *UnmappableError
+	if !errors.As(err, &unmappable) || unmappable.Offset != 1 || unmappable.Rune != '日' || !bytes.Equal(unmappable.Bytes, content[1:3]) {
+		t.Errorf("unmappable character gives err %v", err)
+	}
+	reader, _ := NewTranscodingReader(bytes.NewReader(content), "Shift_JIS", "KOI8-R", nil)
+	if _, err := io.ReadAll(reader); !errors.As(err, &unmappable) || unmappable.Offset != 1 {
+		t.Errorf("unmappable character gives err %v from reader", err)
+	}
+	if got, err := Transcode(content, "Shift_JIS", "KOI8-R", opts); err != nil || string(got) != "a??" {
+		t.Errorf("UnsupportedQuestionMark: got %q, err %v", got, err)
+	}
+
+	content = []byte("ab\x81 c")
+	_, err = Transcode(content, "GBK", "Big5", &TranscodeOptions{Invalid: ErrorStrict})
+	var invalid *InvalidSequenceError
+	if !errors.As(err, &invalid) || invalid.Offset != 2 {
+		t.Errorf("ErrorStrict gives err %v", err)
+	}
+	if got, err := Transcode(content, "GBK", "Big5", &TranscodeOptions{Invalid: ErrorSkip}); err != nil || string(got) != "ab c" {
+		t.Errorf("ErrorSkip: got %q, err %v", got, err)
+	}
+	if _, err := Transcode(content, "GBK", "no-such-charset", nil); err == nil {
+		t.Errorf("invalid charset name should fail")
+	}
+}
+
+// failingDecoder copies content until a 0xFF byte, where it fails with errBadByte.
+type failingDecoder struct{ transform.NopResetter }
+
+var errBadByte = errors.New("bad byte")
+
+func (failingDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for ; nSrc < len(src); nSrc++ {
+		if src[nSrc] == 0xff {
+			return nDst, nSrc, errBadByte
+		}
+		if nDst == len(dst) {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		dst[nDst] = src[nSrc]
+		nDst++
+	}
+	return nDst, nSrc, nil
+}
+
+func TestErrors(t *testing.T) {
+	_, err := GetEncodingFromCharsetName(" no-such-charset")
+	var invalidName *InvalidNameError
+	if !errors.Is(err, ErrInvalidName) || !errors.As(err, &invalidName) || invalidName.Label != " no-such-charset" {
+		t.Errorf("invalid charset name gives err %v", err)
+	}
+	for _, err := range []error{
+		func() error { _, err := ToUtf8WithCharsetName([]byte("abc"), "no-such-charset"); return err }(),
+		func() error { _, err := FromUtf8WithCharsetName([]byte("abc"), "no-such-charset"); return err }(),
+		func() error { _, err := Transcode([]byte("abc"), "GBK", "no-such-charset", nil); return err }(),
+	} {
+		if !errors.Is(err, ErrInvalidName) {
+			t.Errorf("invalid charset name gives err %v", err)
+		}
+	}
+
+	content := []byte("abc\xffdef")
+	var decodeErr *DecodeError
+	for mode := ErrorReplace; mode <= ErrorEscape; mode++ {
+		_, err = ToUtf8WithDecoder(content, failingDecoder{}, mode)
+		if !errors.As(err, &decodeErr) || decodeErr.Offset != 3 || !errors.Is(err, errBadByte) || !errors.Is(err, ErrWrongDecoder) {
+			t.Errorf("mode %d: failed decoder gives err %v", mode, err)
+		}
+	}
+	if _, _, err = ToUtf8WithReport(content, failingDecoder{}); !errors.As(err, &decodeErr) || decodeErr.Offset != 3 {
+		t.Errorf("ToUtf8WithReport: failed decoder gives err %v", err)
+	}
+	if errors.Is(&InvalidSequenceError{}, ErrWrongDecoder) || errors.Is(errBadByte, ErrWrongDecoder) {
+		t.Errorf("only DecodeError matches ErrWrongDecoder")
+	}
+
+	d := NewDetector()
+	d.Close()
+	if _, err := d.Write(content); !errors.Is(err, ErrDetectorClosed) {
+		t.Errorf("Write after Close gives err %v", err)
 	}
 }
 
@@ -788,3 +1886,634 @@ func TestGetCharsetName(t *testing.T) {
 		}
 	}
 }
+
+func TestCanonicalName(t *testing.T) {
+	cases := []struct {
+		label string
+		want  string
+	}{
+		{"GB-18030", "GB18030"},
+		{" gb18030 ", "GB18030"},
+		{"gb2312", "GBK"},
+		{"ISO-8859-8-I", "ISO-8859-8-I"},
+		{"iso-8859-8", "ISO-8859-8"},
+		{"ISO-8859-1", "windows-1252"},
+		{"utf32le", "UTF-32LE"},
+		{"UTF_32-BE", "UTF-32BE"},
+		{"cp932", "Shift_JIS"},
+		{"euctw", "EUC-TW"},
+		{"x-johab", "Johab"},
+		{"EUC-JP", "EUC-JP"},
+		{"IBM424_rtl", "IBM424"},
+		{"csISO2022KR", "ISO-2022-KR"},
+	}
+	for _, c := range cases {
+		if got, err := CanonicalName(c.label); err != nil || got != c.want {
+			t.Errorf("CanonicalName(%q) == %q, err %v, want %q", c.label, got, err, c.want)
+		}
+	}
+	if _, err := CanonicalName("no-such-charset"); !errors.Is(err, ErrInvalidName) {
+		t.Errorf("CanonicalName of invalid name gives err %v", err)
+	}
+
+	aliases := Aliases("utf-32le")
+	if len(aliases) < 2 || aliases[0] != "UTF-32LE" {
+		t.Errorf("Aliases(utf-32le) == %v", aliases)
+	}
+	for _, alias := range Aliases("Shift_JIS") {
+		if !SameCharset(alias, "Shift_JIS") {
+			t.Errorf("alias %s of Shift_JIS is not the same charset", alias)
+		}
+	}
+	aliases = Aliases("latin1")
+	found := false
+	for _, alias := range aliases {
+		found = found || alias == "iso-8859-1"
+	}
+	if aliases[0] != "windows-1252" || !found {
+		t.Errorf("Aliases(latin1) == %v", aliases)
+	}
+	if Aliases("no-such-charset") != nil {
+		t.Errorf("Aliases of invalid name should be nil")
+	}
+
+	same := [][2]string{{"GB-18030", "gb18030"}, {"UTF-32BE", "utf_32_be"}, {"IBM424_rtl", "IBM424_ltr"}, {"ks_c_5601-1987", "EUC-KR"}}
+	for _, c := range same {
+		if !SameCharset(c[0], c[1]) {
+			t.Errorf("SameCharset(%q, %q) == false", c[0], c[1])
+		}
+	}
+	different := [][2]string{{"ISO-2022-KR", "ISO-2022-CN"}, {"ISO-8859-8", "ISO-8859-8-I"}, {"GBK", "GB18030"}, {"no-such-charset", "GBK"}}
+	for _, c := range different {
+		if SameCharset(c[0], c[1]) {
+			t.Errorf("SameCharset(%q, %q) == true", c[0], c[1])
+		}
+	}
+}
+
+func TestRegisterEncoding(t *testing.T) {
+	// an EBCDIC code page of mainframes
+	RegisterEncoding("X-Mainframe", []string{"mf037", " X-MF "}, charmap.CodePage037)
+	defer RegisterEncoding("X-Mainframe", []string{"mf037", "x-mf"}, nil)
+
+	if e, err := GetEncodingFromCharsetName("MF037"); err != nil || e != charmap.CodePage037 {
+		t.Errorf("registered alias is not resolved, err %v", err)
+	}
+	if decoded, err := ToUtf8WithCharsetName([]byte("\xc8\x89"), "x-mf"); err != nil || string(decoded) != "Hi" {
+		t.Errorf("ToUtf8WithCharsetName with registered alias: got %q, err %v", decoded, err)
+	}
+	if name, err := CanonicalName("mf037"); err != nil || name != "X-Mainframe" {
+		t.Errorf("CanonicalName(mf037) == %q, err %v", name, err)
+	}
+	if aliases := Aliases("x-mf"); len(aliases) != 3 || aliases[0] != "X-Mainframe" || aliases[1] != "mf037" || aliases[2] != "x-mf" {
+		t.Errorf("Aliases(x-mf) == %v", aliases)
+	}
+	if name, _ := getCharsetNameFromEncoding(charmap.CodePage037); name != "X-Mainframe" {
+		t.Errorf("registered encoding is named %q", name)
+	}
+	if res := newResult("X-MF", "", 10); !res.Convertible || res.Encoding != charmap.CodePage037 {
+		t.Errorf("Result of registered charset is not convertible")
+	}
+
+	// the charsets chardet reports but golang.org/x/text can't decode are resolved by their IANA names
+	if res := newResult("IBM424_rtl", "he", 10); res.Convertible {
+		t.Errorf("IBM424_rtl should not be convertible before registered")
+	}
+	RegisterEncoding("IBM424", nil, charmap.CodePage037)
+	if res := newResult("IBM424_rtl", "he", 10); !res.Convertible {
+		t.Errorf("IBM424_rtl is not convertible after IBM424 is registered")
+	}
+	if name, _ := getCharsetNameFromEncoding(charmap.CodePage037); name != "X-Mainframe" {
+		t.Errorf("encoding registered twice is named %q, want the first name", name)
+	}
+	RegisterEncoding("IBM424", nil, nil)
+	if _, err := GetEncodingFromCharsetName("IBM424_rtl"); err == nil {
+		t.Errorf("removed charset is still resolved")
+	}
+
+	// a registered name replaces the built-in one
+	RegisterEncoding("Big5-Patched", []string{"big5"}, charmap.CodePage037)
+	if e, _ := GetEncodingFromCharsetName("Big5"); e != charmap.CodePage037 {
+		t.Errorf("registered big5 doesn't replace the built-in one")
+	}
+	RegisterEncoding("Big5-Patched", []string{"big5"}, nil)
+	if e, _ := GetEncodingFromCharsetName("Big5"); e != traditionalchinese.Big5 {
+		t.Errorf("built-in big5 is not restored")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("x-concurrent-%d", i)
+			RegisterEncoding(name, nil, charmap.CodePage037)
+			if _, err := GetEncodingFromCharsetName(name); err != nil {
+				t.Errorf("%s is not registered", name)
+			}
+			Aliases(name)
+			RegisterEncoding(name, nil, nil)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDetectReader(t *testing.T) {
+	cases := GetTestCases("./tests/GB2312", true)
+	cases = append(cases, GetTestCases("./tests/UTF-16LE", true)...)
+	cases = append(cases, GetTestCases("./tests/UTF-32BE", true)...)
+	opts := &SampleOptions{HeadSize: 4096, MiddleSize: 1024, TailSize: 1024}
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		want, _ := DetectEncoding(content)
+
+		// not an io.ReaderAt, only the head window is read
+		res, sample, err := DetectReader(io.LimitReader(bytes.NewReader(content), int64(len(content))), opts)
+		if err != nil {
+			t.Errorf("%s: DetectReader fail: %v", filename, err)
+			continue
+		}
+		if !bytes.HasPrefix(content, sample) || len(sample) > 4096 {
+			t.Errorf("%s: sample is not the head of content", filename)
+		}
+		if res.Charset != want.Charset {
+			t.Errorf("%s: got charset %s != %s (DetectEncoding)", filename, res.Charset, want.Charset)
+		}
+
+		// io.ReaderAt, the middle and tail windows are read too
+		res, _, err = DetectReader(bytes.NewReader(content), opts)
+		if err != nil {
+			t.Errorf("%s: DetectReader fail: %v", filename, err)
+		} else if res.Charset != want.Charset {
+			t.Errorf("%s: got charset %s != %s (DetectEncoding)", filename, res.Charset, want.Charset)
+		}
+	}
+}
+
+func TestLineBoundary(t *testing.T) {
+	cases := []struct {
+		w          string
+		start, end int
+	}{
+		{"ab\ncdefg\nhij", -1, -1},
+		{"abc\ndefg\nhij", 4, 4},
+		{"abc\ndef\nghij\nkl", 4, 8},
+		{"a\x00\n\x00bcde", 4, 4},             // UTF-16LE
+		{"\x00a\x00\nbcde", 4, 4},             // UTF-16BE
+		{"\n\x00\x00\x00a\x00\x00\x00", 4, 4}, // UTF-32LE
+		{"abcdefg\n", 8, 8},
+	}
+	for _, c := range cases {
+		if start := firstLineStart([]byte(c.w)); start != c.start {
+			t.Errorf("firstLineStart(%q) == %d, want %d", c.w, start, c.start)
+		}
+		if end := lastLineEnd([]byte(c.w)); end != c.end {
+			t.Errorf("lastLineEnd(%q) == %d, want %d", c.w, end, c.end)
+		}
+	}
+
+	// joined windows of UTF-8 text keep every character whole
+	var text []byte
+	for i := 0; len(text) < 64*1024; i++ {
+		text = append(text, bytes.Repeat([]byte("汉字"), i%7+1)...)
+		text = append(text, '\n')
+	}
+	opts := &SampleOptions{HeadSize: 4094, MiddleSize: 1022, TailSize: 1022}
+	if res, _, err := DetectReader(bytes.NewReader(text), opts); err != nil || res.Charset != "UTF-8" {
+		t.Errorf("DetectReader of UTF-8 lines == %v, %v", res, err)
+	}
+}
+
+func TestReadSample(t *testing.T) {
+	opts := &SampleOptions{HeadSize: 1024, MiddleSize: 512, TailSize: 512}
+
+	// a head window without line feed can't be cut, so the windows with lines are not joined to it
+	text := bytes.Repeat([]byte("\u6c49\u5b57"), 400)
+	text = append(text, bytes.Repeat([]byte("\u6c49\u5b57\n"), 1000)...)
+	sample, content, err := readSample(bytes.NewReader(text), opts)
+	if err != nil || !bytes.Equal(content, sample) || len(sample) != 1024 {
+		t.Errorf("readSample of head without line feed gives %d bytes of content, %d of sample, err %v", len(content), len(sample), err)
+	}
+
+	// the windows are taken from the current position of the reader, not from offset 0
+	var prefix, body []byte
+	for i := 0; i < 500; i++ {
+		prefix = append(prefix, "prefix\n"...)
+		body = append(body, fmt.Sprintf("line %04d\n", i)...)
+	}
+	r := bytes.NewReader(append(prefix, body...))
+	r.Seek(int64(len(prefix)), io.SeekStart)
+	sample, content, err = readSample(r, opts)
+	if err != nil || !bytes.HasPrefix(body, sample) {
+		t.Fatalf("readSample from offset %d gives sample %q, err %v", len(prefix), sample, err)
+	}
+	if bytes.Contains(content, []byte("prefix")) || !bytes.HasSuffix(content, []byte("line 0499\n")) || len(content) <= len(sample) {
+		t.Errorf("readSample from offset %d reads the wrong windows: %q", len(prefix), content)
+	}
+	middle := []byte(fmt.Sprintf("line %04d\n", 250))
+	if !bytes.Contains(content, middle) {
+		t.Errorf("readSample from offset %d doesn't read the middle of the stream", len(prefix))
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	cases := GetTestCases("./tests/utf-8-sig", true)
+	cases = append(cases, GetTestCases("./tests/UTF-16", true)...)
+	cases = append(cases, GetTestCases("./tests/UTF-16LE", true)...)
+	cases = append(cases, GetTestCases("./tests/GB2312", true)...)
+	cases = append(cases, GetTestCases("./tests/EUC-JP", true)...)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		want, wantRes, _ := DetectAndConvertToUtf8(content)
+
+		// read one byte at a time to split multi-byte characters between reads
+		reader, res, err := NewReader(iotest.OneByteReader(bytes.NewReader(content)))
+		if err != nil {
+			t.Errorf("%s: NewReader fail: %v", filename, err)
+			continue
+		}
+		got, err := io.ReadAll(reader)
+		if err != nil {
+			t.Errorf("%s: read fail: %v", filename, err)
+		} else if res.Charset != wantRes.Charset {
+			t.Errorf("%s: got charset %s != %s (DetectAndConvertToUtf8)", filename, res.Charset, wantRes.Charset)
+		} else if !bytes.Equal(got, want) {
+			t.Errorf("%s: converted content is different from DetectAndConvertToUtf8", filename)
+		}
+	}
+
+	reader, _, err := NewReader(bytes.NewReader(nil))
+	if err != nil {
+		t.Errorf("NewReader of empty stream fail: %v", err)
+	} else if got, _ := io.ReadAll(reader); len(got) != 0 {
+		t.Errorf("NewReader of empty stream gives %q", got)
+	}
+}
+
+func TestDetector(t *testing.T) {
+	cases := GetTestCases("./tests/GB2312", true)
+	cases = append(cases, GetTestCases("./tests/utf-8", true)...)
+	cases = append(cases, GetTestCases("./tests/UTF-16", true)...)
+	cases = append(cases, GetTestCases("./tests/UTF-32BE", true)...)
+	cases = append(cases, GetTestCases("./tests/Big5", true)...)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		want, _ := DetectEncoding(content)
+
+		d := NewDetector()
+		for rest := content; len(rest) > 0 && !d.Done(); {
+			n := 100
+			if n > len(rest) {
+				n = len(rest)
+			}
+			d.Write(rest[:n])
+			rest = rest[n:]
+		}
+		res, err := d.Close()
+		if err != nil {
+			t.Errorf("%s: Close fail: %v", filename, err)
+		} else if res.Charset != want.Charset {
+			t.Errorf("%s: got charset %s != %s (DetectEncoding)", filename, res.Charset, want.Charset)
+		}
+		if _, err := d.Write(content); err == nil {
+			t.Errorf("%s: Write after Close should fail", filename)
+		}
+	}
+}
+
+func TestDetectorFailure(t *testing.T) {
+	// detection fails on a full sample, the Detector is done and keeps the error
+	d := NewDetector()
+	for i := 0; i < 70000; i++ {
+		d.Write([]byte{0xff})
+		if done := i+1 >= DefaultSampleSize; d.Done() != done {
+			t.Fatalf("Done() == %t after %d bytes", d.Done(), i+1)
+		}
+	}
+	res, err := d.Close()
+	if res != nil || err == nil {
+		t.Errorf("Close() == %v, %v, want the error of detection", res, err)
+	}
+	if res2, err2 := d.Close(); res2 != nil || err2 != err {
+		t.Errorf("Close() again == %v, %v, want %v", res2, err2, err)
+	}
+	d.Reset()
+	if d.Done() {
+		t.Errorf("Done() after Reset")
+	}
+}
+
+func TestDetectDeclaration(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{`<?xml version="1.0" encoding="windows-1251"?><rss/>`, "windows-1251"},
+		{`<?xml version='1.0' encoding = 'EUC-JP' ?>`, "EUC-JP"},
+		{`<?xml version="1.0" encoding="no-such-charset"?><meta charset="koi8-r">`, "KOI8-R"},
+		{`<html><head><meta charset="Shift_JIS"></head>`, "Shift_JIS"},
+		{`<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=gb2312">`, "GBK"},
+		{`<meta content='text/html; charset="big5"' http-equiv=content-type>`, "Big5"},
+		{`<meta content="text/html; charset=big5">`, ""}, // no http-equiv pragma
+		{`<!-- <meta charset="big5"> --><meta charset=euc-kr>`, "EUC-KR"},
+		{`<title data-x="<meta charset=big5>">t</title>`, ""},
+		{`<meta charset="utf-16le">`, "UTF-8"},
+		{`<p>no declaration</p>`, ""},
+	}
+	for _, c := range cases {
+		got := ""
+		if res := detectDeclaration([]byte(c.in)); res != nil {
+			got = res.Charset
+			if res.Source != SourceDeclaration {
+				t.Errorf("detectDeclaration(%q) source == %s, want %s", c.in, res.Source, SourceDeclaration)
+			}
+		}
+		if got != c.want {
+			t.Errorf("detectDeclaration(%q) == %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestDeclarationPolicy(t *testing.T) {
+	cases := GetTestCases("./tests/windows-1251-bulgarian", true)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		declared := detectDeclaration(content)
+		if declared == nil {
+			continue
+		}
+
+		res, err := DetectEncodingWithOptions(content, &DetectOptions{Declaration: DeclarationOverride})
+		if err != nil {
+			t.Errorf("%s: detect fail: %v", filename, err)
+		} else if res.Charset != declared.Charset || res.Source != SourceDeclaration || res.Confidence != 100 {
+			t.Errorf("%s: got charset %s (%s, %d), want declared %s", filename, res.Charset, res.Source, res.Confidence, declared.Charset)
+		}
+
+		results, _ := DetectAllWithOptions(content, &DetectOptions{Declaration: DeclarationIgnore})
+		for _, res := range results {
+			if res.Source == SourceDeclaration {
+				t.Errorf("%s: declaration is used with DeclarationIgnore", filename)
+			}
+		}
+	}
+}
+
+func TestDeclarationConflict(t *testing.T) {
+	cases := GetTestCases("./tests/GB2312", true)
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.in)
+		filename := filepath.Base(c.in)
+		// GB2312 content declared as UTF-8, which it is not valid under
+		content = append([]byte(`<meta charset="utf-8">`), content...)
+
+		_, res, err := DetectAndConvertToUtf8WithOptions(content, &DetectOptions{Declaration: DeclarationOverrideIfValid})
+		if err != nil {
+			t.Errorf("%s: convert fail: %v", filename, err)
+			continue
+		}
+		if res.Source != SourceStatistics || res.Conflict == nil || res.Conflict.Winner != SourceStatistics {
+			t.Errorf("%s: invalid declaration wins with DeclarationOverrideIfValid, got charset %s", filename, res.Charset)
+		} else if res.Conflict.Declared.Charset != "UTF-8" || res.Conflict.Detected.Charset != res.Charset {
+			t.Errorf("%s: conflict candidates are %s and %s", filename, res.Conflict.Declared.Charset, res.Conflict.Detected.Charset)
+		}
+
+		res, _ = DetectEncodingWithOptions(content, &DetectOptions{Declaration: DeclarationOverride})
+		if res.Charset != "UTF-8" || res.Conflict == nil || res.Conflict.Winner != SourceDeclaration {
+			t.Errorf("%s: declaration doesn't win with DeclarationOverride, got charset %s", filename, res.Charset)
+		}
+
+		res, _ = DetectEncodingWithOptions(content, &DetectOptions{Declaration: DeclarationFallback})
+		if res.Source != SourceStatistics || res.Conflict == nil || res.Conflict.Winner != SourceStatistics {
+			t.Errorf("%s: declaration wins with DeclarationFallback, got charset %s", filename, res.Charset)
+		}
+	}
+
+	// the declaration wins by DeclarationHint, but GBK content is invalid under it, the Conflict is kept
+	content := []byte("<meta charset=\"utf-8\">\xc4\xe3\xba\xc3\xca\xc0\xbd\xe7")
+	if res, _ := DetectEncoding(content); res.Charset != "UTF-8" || res.Conflict == nil || res.Conflict.Winner != SourceDeclaration {
+		t.Errorf("DetectEncoding of invalid UTF-8 declaration gives %s, Conflict %+v", res.Charset, res.Conflict)
+	}
+	_, res, err := DetectAndConvertToUtf8(content)
+	if err != nil || res.Source != SourceStatistics || res.Conflict == nil {
+		t.Fatalf("DetectAndConvertToUtf8 of invalid UTF-8 declaration gives %v, err %v", res, err)
+	}
+	if c := res.Conflict; c.Winner != SourceStatistics || c.Declared.Charset != "UTF-8" || !strings.Contains(c.Reason, "fails validation") {
+		t.Errorf("Conflict of invalid UTF-8 declaration is %+v", c)
+	}
+
+	// the Winner is the Source of the charset finally chosen, by detection and by conversion
+	gb2312, _ := os.ReadFile("./tests/GB2312/cnblog.org.xml")
+	contents := [][]byte{
+		append([]byte(`<meta charset="utf-8">`), gb2312...),
+		[]byte("<meta charset=\"utf-8\">\xc4\xe3\xba\xc3\xca\xc0\xbd\xe7"),
+		[]byte(`<meta charset="windows-1251"><p>caf\u00e9 \u4f60\u597d</p>`),
+	}
+	for _, policy := range []DeclarationPolicy{DeclarationHint, DeclarationOverride, DeclarationOverrideIfValid, DeclarationFallback} {
+		for i, content := range contents {
+			opts := &DetectOptions{Declaration: policy}
+			res, _ := DetectEncodingWithOptions(content, opts)
+			_, converted, err := DetectAndConvertToUtf8WithOptions(content, opts)
+			for _, r := range []*Result{res, converted} {
+				if r == nil || r.Conflict == nil {
+					t.Errorf("policy %d, content %d: no Conflict, err %v", policy, i, err)
+				} else if (r.Conflict.Winner == SourceDeclaration) != (r.Source == SourceDeclaration) {
+					t.Errorf("policy %d, content %d: Winner is %d, but %s from Source %d is chosen", policy, i, r.Conflict.Winner, r.Charset, r.Source)
+				}
+			}
+		}
+	}
+
+	// GBK content declared as GBK is valid, so declaration wins without conflict
+	content = append([]byte(`<meta charset="gbk">`), []byte("\xc4\xe3\xba\xc3")...)
+	res, _ = DetectEncodingWithOptions(content, &DetectOptions{Declaration: DeclarationOverrideIfValid})
+	if res.Charset != "GBK" || res.Source != SourceDeclaration {
+		t.Errorf("valid declaration doesn't win with DeclarationOverrideIfValid, got charset %s", res.Charset)
+	}
+}
+
+func TestDecodeHTTPResponse(t *testing.T) {
+	cases := []struct {
+		path        string
+		contentType string
+		charset     string
+		source      Source
+	}{
+		{"./tests/GB2312/cindychen.com.xml", "text/xml; charset=GBK", "GBK", SourceContentType},
+		{"./tests/GB2312/cindychen.com.xml", "text/xml", "GBK", SourceDeclaration},
+		{"./tests/GB2312/cindychen.com.xml", "text/xml; charset=no-such-charset", "GBK", SourceDeclaration},
+		{"./tests/UTF-16/bom-utf-16-le.srt", "text/plain; charset=ISO-8859-1", "UTF-16LE", SourceBOM},
+		{"./tests/UTF-16LE/nobom-utf16le.txt", "text/plain", "UTF-16LE", SourceStatistics},
+		{"./tests/UTF-16LE/nobom-utf16le.txt", "text/plain;charset=\"utf-16le\"", "UTF-16LE", SourceContentType},
+	}
+	for _, c := range cases {
+		content, _ := os.ReadFile(c.path)
+		filename := filepath.Base(c.path)
+		resp := &http.Response{
+			Header: http.Header{"Content-Type": []string{c.contentType}},
+			Body:   io.NopCloser(bytes.NewReader(content)),
+		}
+		reader, res, err := DecodeHTTPResponse(resp)
+		if err != nil {
+			t.Errorf("%s (%s): decode fail: %v", filename, c.contentType, err)
+			continue
+		}
+		if res.Charset != c.charset || res.Source != c.source {
+			t.Errorf("%s (%s): got charset %s from %s, want %s from %s", filename, c.contentType, res.Charset, res.Source, c.charset, c.source)
+		}
+		got, _ := io.ReadAll(reader)
+		want, _ := ToUtf8WithCharsetName(content[res.BOMLength:], res.Charset)
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s (%s): converted body is different from ToUtf8WithCharsetName", filename, c.contentType)
+		}
+	}
+}
+
+func TestReweight(t *testing.T) {
+	newResults := func() []*Result {
+		return []*Result{
+			{Charset: "ISO-8859-1", Confidence: 40},
+			{Charset: "windows-1251", Confidence: 30},
+			{Charset: "GB-18030", Confidence: 10},
+		}
+	}
+	cases := []struct {
+		opts  *DetectOptions
+		first string
+		conf  int
+	}{
+		{&DetectOptions{}, "ISO-8859-1", 40},
+		{&DetectOptions{Locale: "ru-RU"}, "windows-1251", 30 + localeBonus},
+		{&DetectOptions{Locale: "ru_RU.UTF-8", TLD: "www.yandex.ru"}, "windows-1251", 30 + localeBonus + tldBonus},
+		{&DetectOptions{TLD: ".ru"}, "ISO-8859-1", 40}, // tie keeps the original order
+		{&DetectOptions{Preferred: []string{"gb18030"}, Locale: "zh-CN", TLD: "cn"}, "GB-18030", 10 + preferredBonus + localeBonus + tldBonus},
+		{&DetectOptions{Locale: "xx"}, "ISO-8859-1", 40},
+	}
+	for _, c := range cases {
+		results := reweight(newResults(), c.opts)
+		if results[0].Charset != c.first || results[0].Confidence != c.conf {
+			t.Errorf("reweight(%+v) first == %s (%d), want %s (%d)", *c.opts, results[0].Charset, results[0].Confidence, c.first, c.conf)
+		}
+	}
+
+	results := filterAllowed(newResults(), []string{"gb18030", "windows-1251"})
+	if len(results) != 2 || results[0].Charset != "windows-1251" || results[1].Charset != "GB-18030" {
+		t.Errorf("filterAllowed gives %d results", len(results))
+	}
+	content, _ := os.ReadFile("./tests/UTF-16/bom-utf-16-le.srt")
+	if res, err := DetectEncodingWithOptions(content, &DetectOptions{Allowed: []string{"GBK"}}); err != nil || res.Charset != "UTF-16LE" {
+		t.Errorf("BOM should be kept with Allowed")
+	}
+	content, _ = os.ReadFile("./tests/UTF-16LE/nobom-utf16le.txt")
+	if _, err := DetectEncodingWithOptions(content, &DetectOptions{Allowed: []string{"KOI8-R"}}); err == nil {
+		t.Errorf("detection should fail if no Result is allowed")
+	}
+}
+
+// loadCorpus returns the content of every file in the test directories matched by pattern, and their total size.
+func loadCorpus(b *testing.B, pattern string) (corpus [][]byte, size int64) {
+	dirs, _ := filepath.Glob(filepath.Join("./tests", pattern))
+	for _, dir := range dirs {
+		for _, c := range GetTestCases(dir, true) {
+			content, err := os.ReadFile(c.in)
+			if err != nil {
+				b.Fatal(err)
+			}
+			corpus = append(corpus, content)
+			size += int64(len(content))
+		}
+	}
+	if len(corpus) == 0 {
+		b.Skipf("no test files match %s", pattern)
+	}
+	return
+}
+
+// BenchmarkToUtf8WithDecoder compares ToUtf8WithDecoder with decoding by transform.NewReader and io.ReadAll,
+// over the test files of the charsets.
+func BenchmarkToUtf8WithDecoder(b *testing.B) {
+	cases := []struct {
+		dirs string
+		e    encoding.Encoding
+	}{
+		{"windows-1251-*", Windows1251},
+		{"KOI8-R", KOI8R},
+		{"iso-8859-2-*", ISO8859_2},
+		{"SHIFT_JIS", japanese.ShiftJIS},
+		{"Big5", traditionalchinese.Big5},
+	}
+	for _, c := range cases {
+		corpus, size := loadCorpus(b, c.dirs)
+		decoder := c.e.NewDecoder()
+		b.Run(fmt.Sprintf("%s/Reader", c.e), func(b *testing.B) {
+			b.SetBytes(size)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, content := range corpus {
+					decoder.Reset()
+					if _, err := io.ReadAll(transform.NewReader(bytes.NewReader(content), decoder)); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("%s/ToUtf8WithDecoder", c.e), func(b *testing.B) {
+			b.SetBytes(size)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, content := range corpus {
+					if _, err := ToUtf8WithDecoder(content, decoder); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkConverter converts the test files of GB18030 with a reused Converter and buffer.
+func BenchmarkConverter(b *testing.B) {
+	corpus, size := loadCorpus(b, "GB2312")
+	conv, _ := NewConverter("GB18030")
+	var buf []byte
+	b.SetBytes(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, content := range corpus {
+			buf, _ = conv.AppendUtf8(buf[:0], content)
+		}
+	}
+}
+
+// BenchmarkValidators compares the validators with their byte at a time references, over the test files of the charsets.
+func BenchmarkValidators(b *testing.B) {
+	cases := []struct {
+		dirs      string
+		name      string
+		fast, ref func([]byte) bool
+	}{
+		{"GB2312", "GB18030", isValidGB18030, isValidGB18030ByteAtATime},
+		{"GB2312", "GBK", isValidGBK, isValidGBKByteAtATime},
+		{"Big5", "Big5", isValidBig5, isValidBig5ByteAtATime},
+		{"UTF-16BE", "UTF-16BE", isValidUTF16BE, isValidUTF16BEByteAtATime},
+		{"UTF-16LE", "UTF-16LE", isValidUTF16LE, isValidUTF16LEByteAtATime},
+	}
+	for _, c := range cases {
+		corpus, size := loadCorpus(b, c.dirs)
+		for i, validate := range []func([]byte) bool{c.ref, c.fast} {
+			b.Run(c.name+"/"+[]string{"ByteAtATime", "FastPath"}[i], func(b *testing.B) {
+				b.SetBytes(size)
+				for i := 0; i < b.N; i++ {
+					for _, content := range corpus {
+						validate(content)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package easychars
+
+import (
+	"fmt"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+	"strconv"
+	"unicode/utf8"
+)
+
+// UnsupportedPolicy returns the replacement, in UTF-8, of a rune which the charset can't represent when converting from UTF-8.
+// The replacement is encoded by the charset too, so it must be representable.
+//
+// A nil UnsupportedPolicy fails with an *UnsupportedRuneError, it's the default.
+type UnsupportedPolicy func(r rune) string
+
+var (
+	// Replace an unsupported rune by '?'.
+	UnsupportedQuestionMark UnsupportedPolicy = func(rune) string { return "?" }
+	// Replace an unsupported rune by its HTML numeric character reference, e.g. "&#8364;" for '€'.
+	UnsupportedHTMLEscape UnsupportedPolicy = func(r rune) string { return "&#" + strconv.Itoa(int(r)) + ";" }
+)
+
+// UnsupportedRuneError is returned when the charset can't represent a rune of content, or the replacement of it.
+type UnsupportedRuneError struct {
+	// Byte offset of the rune in UTF-8 content.
+	Offset int
+	// The unsupported rune, utf8.RuneError for invalid UTF-8.
+	Rune rune
+}
+
+func (e *UnsupportedRuneError) Error() string {
+	return fmt.Sprintf("easychars: rune %U at offset %d not supported by encoding", e.Rune, e.Offset)
+}
+
+// Get []byte encoded by encoding.Encoding from UTF-8 content.
+//
+// policy tells how a rune which e can't represent is handled, the error is an *UnsupportedRuneError if it's not given.
+func FromUtf8WithEncoding(content []byte, e encoding.Encoding, policy ...UnsupportedPolicy) ([]byte, error) {
+	var p UnsupportedPolicy
+	if len(policy) > 0 {
+		p = policy[0]
+	}
+	return encodeWithPolicy(content, e.NewEncoder(), p)
+}
+
+// Get []byte encoded by the charset of charsetName from UTF-8 content, see FromUtf8WithEncoding for policy.
+//
+// It will return an *InvalidNameError if charset name is not valid.
+func FromUtf8WithCharsetName(content []byte, charsetName string, policy ...UnsupportedPolicy) ([]byte, error) {
+	e, err := GetEncodingFromCharsetName(charsetName)
+	if err != nil {
+		return content, err
+	}
+	return FromUtf8WithEncoding(content, e, policy...)
+}
+
+// encodeWithPolicy encodes UTF-8 content by encoder t, replacing the unsupported runes by policy.
+func encodeWithPolicy(content []byte, t transform.Transformer, policy UnsupportedPolicy) ([]byte, error) {
+	t.Reset()
+	dst := make([]byte, len(content)+len(content)/2+16)
+	n, pos := 0, 0
+	for {
+		// atEOF is always true, so that stateful encoders like ISO-2022-JP switch back to ASCII at the end
+		nDst, nSrc, err := t.Transform(dst[n:], content[pos:], true)
+		n, pos = n+nDst, pos+nSrc
+		switch err.(type) {
+		case nil:
+			return dst[:n], nil
+		case interface{ Replacement() byte }: // the repertoire error of golang.org/x/text and this package
+			r, size := utf8.DecodeRune(content[pos:])
+			if policy == nil {
+				return nil, &UnsupportedRuneError{Offset: pos, Rune: r}
+			}
+			replacement, err := encodeReplacement(t, policy(r))
+			if err != nil {
+				return nil, &UnsupportedRuneError{Offset: pos, Rune: r}
+			}
+			dst = append(dst[:n], replacement...)
+			n, pos = len(dst), pos+size
+			dst = dst[:cap(dst)]
+		default:
+			if err != transform.ErrShortDst {
+				return nil, err
+			}
+			dst = append(dst[:n], make([]byte, len(dst))...)
+			dst = dst[:cap(dst)]
+		}
+	}
+}
+
+// encodeReplacement encodes the replacement of an unsupported rune by t, continuing the state of t.
+func encodeReplacement(t transform.Transformer, replacement string) ([]byte, error) {
+	dst := make([]byte, maxEncodedLen(len(replacement)))
+	nDst, nSrc, err := t.Transform(dst, []byte(replacement), false)
+	if err != nil || nSrc < len(replacement) {
+		return nil, errUnsupportedRune
+	}
+	return dst[:nDst], nil
+}
+
+// maxEncodedLen returns the maximum size of n bytes of UTF-8 encoded in any charset.
+// A rune takes at least 1 byte in UTF-8 and at most 4 bytes in other charsets, plus an escape sequence of stateful ones.
+func maxEncodedLen(n int) int {
+	return 4*n + 8
+}
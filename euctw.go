@@ -0,0 +1,227 @@
+package easychars
+
+//go:generate go run gen_euctw.go
+
+import (
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+	"sync"
+	"unicode/utf8"
+)
+
+// EUCTW is the EUC-TW encoding of Traditional Chinese, covering CNS 11643 planes 1-7.
+//
+// Plane 1 is encoded in 2 bytes: 0xA1-0xFE 0xA1-0xFE,
+// and every plane in 4 bytes with SS2: 0x8E 0xA1-0xA7 0xA1-0xFE 0xA1-0xFE, where the second byte is 0xA0+plane.
+//
+// Reference: https://en.wikipedia.org/wiki/Extended_Unix_Code#EUC-TW
+var EUCTW encoding.Encoding = eucTW{}
+
+type eucTW struct{}
+
+func (eucTW) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: eucTWDecoder{}}
+}
+
+func (eucTW) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: eucTWEncoder{}}
+}
+
+func (eucTW) String() string {
+	return "EUC-TW"
+}
+
+type eucTWDecoder struct {
+	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
+	transform.NopResetter
+}
+
+func (eucTWDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	r, size := rune(0), 0
+loop:
+	for ; nSrc < len(src); nSrc += size {
+		switch c0 := src[nSrc]; {
+		case c0 < utf8.RuneSelf:
+			r, size = rune(c0), 1
+
+		case c0 == 0x8E: // SS2, 4 bytes for every plane
+			if nSrc+4 > len(src) {
+				if !atEOF && isEUCTWPrefix(src[nSrc:]) {
+					err = transform.ErrShortSrc
+					break loop
+				}
+				r, size = utf8.RuneError, 1
+				break
+			}
+			plane, c2, c3 := src[nSrc+1], src[nSrc+2], src[nSrc+3]
+			if plane < 0xA1 || plane > 0xA0+byte(len(cnsPlanes)) || !isEUCTWByte(c2) || !isEUCTWByte(c3) {
+				r, size = utf8.RuneError, 1
+				break
+			}
+			r, size = cnsRune(int(plane-0xA0), c2, c3), 4
+
+		case isEUCTWByte(c0): // 2 bytes for plane 1
+			if nSrc+2 > len(src) {
+				if !atEOF {
+					err = transform.ErrShortSrc
+					break loop
+				}
+				r, size = utf8.RuneError, 1
+				break
+			}
+			if c1 := src[nSrc+1]; !isEUCTWByte(c1) {
+				r, size = utf8.RuneError, 1
+				break
+			}
+			r, size = cnsRune(1, c0, src[nSrc+1]), 2
+
+		default:
+			r, size = utf8.RuneError, 1
+		}
+
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			err = transform.ErrShortDst
+			break loop
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+	}
+	return
+}
+
+// Guess whether content is encoded by EUC-TW
+//
+// The 2 bytes codes of EUC-TW are also valid in GB2312, EUC-KR and EUC-JP, but most of their characters are
+// not assigned in CNS 11643 plane 1, or are not in its rows of frequently used Hanzi (0xC4-0xFD).
+// So content must be valid under EUC-TW rule, except a character cut at the end, almost all of its codes must be assigned,
+// and at least half of the 2 bytes codes must be frequently used Hanzi.
+//
+// return: the Result of EUC-TW, nil if content doesn't look like EUC-TW
+func probeEUCTW(content []byte) *Result {
+	// up to 3 bytes of a 4 bytes code of planes 1-16 may be left at the end of a sample
+	content, ok := validSample(isValidEUCTW, content)
+	if !ok {
+		return nil
+	}
+	var codes, assigned, frequent int
+	for i := 0; i < len(content); i++ {
+		c0 := content[i]
+		if c0 < utf8.RuneSelf {
+			continue
+		}
+		codes++
+		if c0 == 0x8E {
+			if plane := int(content[i+1] - 0xA0); plane <= len(cnsPlanes) && cnsRune(plane, content[i+2], content[i+3]) != utf8.RuneError {
+				assigned++
+			}
+			i += 3
+			continue
+		}
+		if cnsRune(1, c0, content[i+1]) != utf8.RuneError {
+			assigned++
+		}
+		if c0 >= 0xC4 && c0 <= 0xFD {
+			frequent++
+		}
+		i++
+	}
+	if codes < 10 || assigned*100 < codes*95 || frequent*2 < codes {
+		return nil
+	}
+	return newResult("EUC-TW", "zh", 100*assigned/codes)
+}
+
+// cnsRune returns the rune of CNS 11643 plane at row and column given in EUC-TW bytes, utf8.RuneError if it's not assigned.
+func cnsRune(plane int, row, col byte) rune {
+	r := cnsPlanes[plane-1][int(row-0xA1)*94+int(col-0xA1)]
+	if r == 0 {
+		return utf8.RuneError
+	}
+	return r
+}
+
+// Check whether b is a byte of EUC-TW double-byte code, i.e. 0xA1-0xFE
+func isEUCTWByte(b byte) bool {
+	return b >= 0xA1 && b <= 0xFE
+}
+
+// Check whether p, which is shorter than 4 bytes, may be the beginning of a valid 4 bytes EUC-TW code
+func isEUCTWPrefix(p []byte) bool {
+	if len(p) > 1 && (p[1] < 0xA1 || p[1] > 0xA0+byte(len(cnsPlanes))) {
+		return false
+	}
+	for i := 2; i < len(p); i++ {
+		if !isEUCTWByte(p[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+type eucTWEncoder struct {
+	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
+	transform.NopResetter
+}
+
+func (eucTWEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	cnsOnce.Do(buildCNSReverse)
+	r, size := rune(0), 0
+	for ; nSrc < len(src); nSrc += size {
+		r = rune(src[nSrc])
+		if r < utf8.RuneSelf {
+			size = 1
+			if nDst >= len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			dst[nDst] = byte(r)
+			nDst++
+			continue
+		}
+
+		r, size = utf8.DecodeRune(src[nSrc:])
+		if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			err = transform.ErrShortSrc
+			break
+		}
+		code, ok := cnsReverse[r]
+		if !ok {
+			err = errUnsupportedRune
+			break
+		}
+		plane, row, col := byte(code>>16), byte(code>>8), byte(code)
+		if plane == 1 {
+			if nDst+2 > len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			dst[nDst], dst[nDst+1] = row, col
+			nDst += 2
+		} else {
+			if nDst+4 > len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			dst[nDst], dst[nDst+1], dst[nDst+2], dst[nDst+3] = 0x8E, 0xA0+plane, row, col
+			nDst += 4
+		}
+	}
+	return
+}
+
+var (
+	cnsOnce sync.Once
+	// cnsReverse maps a rune to plane<<16 | row<<8 | column in EUC-TW bytes, built from cnsPlanes on first use
+	cnsReverse map[rune]uint32
+)
+
+// buildCNSReverse builds cnsReverse, a rune in more than one place is encoded by the lowest plane.
+func buildCNSReverse() {
+	cnsReverse = make(map[rune]uint32, 48*1024)
+	for p := len(cnsPlanes) - 1; p >= 0; p-- {
+		for i := len(cnsPlanes[p]) - 1; i >= 0; i-- {
+			if r := cnsPlanes[p][i]; r != 0 {
+				cnsReverse[r] = uint32(p+1)<<16 | uint32(0xA1+i/94)<<8 | uint32(0xA1+i%94)
+			}
+		}
+	}
+}
@@ -0,0 +1,680 @@
+// Code generated by running "go run gen_euctw.go". DO NOT EDIT.
+
+package easychars
+
+// cnsPlanes maps CNS 11643 planes 1-7 to Unicode, indexed by plane-1 and (row-0xA1)*94+(column-0xA1), where row and column are the EUC-TW bytes.
+// 0 means the code point is not assigned.
+var cnsPlanes = [7][94 * 94]rune{
+	{ // plane 1
+		0x3000, 0xff0c, 0x3001, 0x3002, 0xff0e, 0x30fb, 0xff1b, 0xff1a, 0xff1f, 0xff01, 0xfe30, 0x2026, 0x2025, 0xfe50, 0xfe51, 0xfe52, 0xb7, 0xfe54, 0xfe55, 0xfe56, 0xfe57, 0xfe31, 0x2014, 0xfe32, 0x2013, 0x0, 0x0, 0x0, 0x0, 0xff08, 0xff09, 0xfe35, 0xfe36, 0xff5b, 0xff5d, 0xfe37, 0xfe38, 0x3014, 0x3015, 0xfe39, 0xfe3a, 0x3010, 0x3011, 0xfe3b, 0xfe3c, 0x300a, 0x300b, 0xfe3d, 0xfe3e, 0x3008, 0x3009, 0xfe3f, 0xfe40, 0x300c, 0x300d, 0xfe41, 0xfe42, 0x300e, 0x300f, 0xfe43, 0xfe44, 0xfe59, 0xfe5a, 0xfe5b, 0xfe5c, 0xfe5d, 0xfe5e, 0x2018, 0x2019, 0x201c, 0x201d, 0x301d, 0x301e, 0x2032, 0x2035, 0xff03, 0xff06, 0xff0a, 0x203b, 0xa7, 0x3003, 0x25cb, 0x25cf, 0x25b3, 0x25b2, 0x25ce, 0x2606, 0x2605, 0x25c7, 0x25c6, 0x25a1, 0x25a0, 0x25bd, 0x25bc,
+		0x32a3, 0x2105, 0x203e, 0x0, 0xff3f, 0x0, 0xfe49, 0xfe4a, 0xfe4d, 0xfe4e, 0xfe4b, 0xfe4c, 0xfe5f, 0xfe60, 0xfe61, 0xff0b, 0xff0d, 0xd7, 0xf7, 0xb1, 0x221a, 0xff1c, 0xff1e, 0xff1d, 0x2266, 0x2267, 0x2260, 0x221e, 0x2252, 0x2261, 0xfe62, 0xfe63, 0xfe64, 0xfe66, 0xfe65, 0x223c, 0x2229, 0x222a, 0x22a5, 0x2220, 0x221f, 0x22bf, 0x33d2, 0x33d1, 0x222b, 0x222e, 0x2235, 0x2234, 0x2640, 0x2642, 0x2641, 0x2609, 0x2191, 0x2193, 0x2192, 0x2190, 0x2196, 0x2197, 0x2199, 0x2198, 0x2016, 0xff5c, 0xff0f, 0xff3c, 0x2215, 0xfe68, 0xff04, 0xffe5, 0x3012, 0xffe0, 0xffe1, 0xff05, 0xff20, 0x2103, 0x2109, 0xfe69, 0xfe6a, 0xfe6b, 0x33d5, 0x339c, 0x339d, 0x339e, 0x33ce, 0x33a1, 0x338e, 0x338f, 0x33c4, 0xb0, 0x5159, 0x515b, 0x515e, 0x515d, 0x5161, 0x5163,
+		0x55e7, 0x74e9, 0x7cce, 0x2581, 0x2582, 0x2583, 0x2584, 0x2585, 0x2586, 0x2587, 0x2588, 0x258f, 0x258e, 0x258d, 0x258c, 0x258b, 0x258a, 0x2589, 0x253c, 0x2534, 0x252c, 0x2524, 0x251c, 0x2594, 0x2500, 0x2502, 0x2595, 0x250c, 0x2510, 0x2514, 0x2518, 0x256d, 0x256e, 0x2570, 0x256f, 0x2550, 0x255e, 0x256a, 0x2561, 0x25e2, 0x25e3, 0x25e5, 0x25e4, 0x2571, 0x2572, 0x2573, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0xff10, 0xff11, 0xff12, 0xff13, 0xff14, 0xff15, 0xff16, 0xff17, 0xff18, 0xff19, 0x2160, 0x2161, 0x2162, 0x2163, 0x2164, 0x2165, 0x2166, 0x2167, 0x2168, 0x2169, 0x3021, 0x3022, 0x3023, 0x3024, 0x3025, 0x3026, 0x3027, 0x3028, 0x3029, 0x0, 0x5344, 0x0, 0xff21, 0xff22, 0xff23, 0xff24, 0xff25, 0xff26, 0xff27, 0xff28, 0xff29, 0xff2a, 0xff2b, 0xff2c, 0xff2d, 0xff2e, 0xff2f, 0xff30, 0xff31, 0xff32, 0xff33, 0xff34, 0xff35, 0xff36, 0xff37, 0xff38, 0xff39, 0xff3a, 0xff41, 0xff42, 0xff43, 0xff44, 0xff45, 0xff46, 0xff47, 0xff48, 0xff49, 0xff4a, 0xff4b, 0xff4c, 0xff4d, 0xff4e, 0xff4f, 0xff50, 0xff51, 0xff52, 0xff53, 0xff54, 0xff55, 0xff56, 0xff57, 0xff58, 0xff59, 0xff5a, 0x391, 0x392, 0x393, 0x394, 0x395, 0x396, 0x397, 0x398, 0x399, 0x39a,
+		0x39b, 0x39c, 0x39d, 0x39e, 0x39f, 0x3a0, 0x3a1, 0x3a3, 0x3a4, 0x3a5, 0x3a6, 0x3a7, 0x3a8, 0x3a9, 0x3b1, 0x3b2, 0x3b3, 0x3b4, 0x3b5, 0x3b6, 0x3b7, 0x3b8, 0x3b9, 0x3ba, 0x3bb, 0x3bc, 0x3bd, 0x3be, 0x3bf, 0x3c0, 0x3c1, 0x3c3, 0x3c4, 0x3c5, 0x3c6, 0x3c7, 0x3c8, 0x3c9, 0x3105, 0x3106, 0x3107, 0x3108, 0x3109, 0x310a, 0x310b, 0x310c, 0x310d, 0x310e, 0x310f, 0x3110, 0x3111, 0x3112, 0x3113, 0x3114, 0x3115, 0x3116, 0x3117, 0x3118, 0x3119, 0x311a, 0x311b, 0x311c, 0x311d, 0x311e, 0x311f, 0x3120, 0x3121, 0x3122, 0x3123, 0x3124, 0x3125, 0x3126, 0x3127, 0x3128, 0x3129, 0x2d9, 0x2c9, 0x2ca, 0x2c7, 0x2cb, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x2460, 0x2461, 0x2462, 0x2463, 0x2464, 0x2465, 0x2466, 0x2467, 0x2468, 0x2469, 0x2474, 0x2475, 0x2476, 0x2477, 0x2478, 0x2479, 0x247a, 0x247b, 0x247c, 0x247d, 0x2170, 0x2171, 0x2172, 0x2173, 0x2174, 0x2175, 0x2176, 0x2177, 0x2178, 0x2179, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4ea0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x51ab, 0x0, 0x0, 0x0, 0x0, 0x52f9, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x2400, 0x2401, 0x2402, 0x2403, 0x2404, 0x2405, 0x2406, 0x2407, 0x2408, 0x2409, 0x240a, 0x240b, 0x240c, 0x240d, 0x240e, 0x240f, 0x2410, 0x2411, 0x2412, 0x2413, 0x2414, 0x2415, 0x2416, 0x2417, 0x2418, 0x2419, 0x241a, 0x241b, 0x241c, 0x241d, 0x241e, 0x241f, 0x2421, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x4e00, 0x4e59, 0x4e01, 0x4e03, 0x4e43, 0x4e5d, 0x4e86, 0x4e8c, 0x4eba, 0x513f, 0x5165, 0x516b, 0x51e0, 0x5200, 0x5201, 0x529b, 0x5315, 0x5341, 0x535c, 0x53c8, 0x4e09, 0x4e0b, 0x4e08, 0x4e0a, 0x4e2b, 0x4e38, 0x51e1, 0x4e45, 0x4e48, 0x4e5f, 0x4e5e, 0x4e8e, 0x4ea1, 0x5140, 0x5203, 0x52fa, 0x5343, 0x53c9, 0x53e3, 0x571f, 0x58eb, 0x5915, 0x5927, 0x5973, 0x5b50, 0x5b51, 0x5b53, 0x5bf8, 0x5c0f, 0x5c22, 0x5c38, 0x5c71, 0x5ddd, 0x5de5, 0x5df1, 0x5df2, 0x5df3, 0x5dfe, 0x5e72, 0x5efe, 0x5f0b, 0x5f13, 0x624d, 0x4e11, 0x4e10, 0x4e0d, 0x4e2d, 0x4e30, 0x4e39, 0x4e4b, 0x5c39, 0x4e88, 0x4e91, 0x4e95, 0x4e92, 0x4e94, 0x4ea2, 0x4ec1, 0x4ec0, 0x4ec3, 0x4ec6, 0x4ec7, 0x4ecd, 0x4eca, 0x4ecb, 0x4ec4, 0x5143, 0x5141, 0x5167, 0x516d, 0x516e, 0x516c, 0x5197, 0x51f6,
+		0x5206, 0x5207, 0x5208, 0x52fb, 0x52fe, 0x52ff, 0x5316, 0x5339, 0x5348, 0x5347, 0x5345, 0x535e, 0x5384, 0x53cb, 0x53ca, 0x53cd, 0x58ec, 0x5929, 0x592b, 0x592a, 0x592d, 0x5b54, 0x5c11, 0x5c24, 0x5c3a, 0x5c6f, 0x5df4, 0x5e7b, 0x5eff, 0x5f14, 0x5f15, 0x5fc3, 0x6208, 0x6236, 0x624b, 0x624e, 0x652f, 0x6587, 0x6597, 0x65a4, 0x65b9, 0x65e5, 0x66f0, 0x6708, 0x6728, 0x6b20, 0x6b62, 0x6b79, 0x6bcb, 0x6bd4, 0x6bdb, 0x6c0f, 0x6c34, 0x706b, 0x722a, 0x7236, 0x723b, 0x7247, 0x7259, 0x725b, 0x72ac, 0x738b, 0x4e19, 0x4e16, 0x4e15, 0x4e14, 0x4e18, 0x4e3b, 0x4e4d, 0x4e4f, 0x4e4e, 0x4ee5, 0x4ed8, 0x4ed4, 0x4ed5, 0x4ed6, 0x4ed7, 0x4ee3, 0x4ee4, 0x4ed9, 0x4ede, 0x5145, 0x5144, 0x5189, 0x518a, 0x51ac, 0x51f9, 0x51fa, 0x51f8, 0x520a, 0x52a0, 0x529f, 0x5305, 0x5306,
+		0x5317, 0x531d, 0x4edf, 0x534a, 0x5349, 0x5361, 0x5360, 0x536f, 0x536e, 0x53bb, 0x53ef, 0x53e4, 0x53f3, 0x53ec, 0x53ee, 0x53e9, 0x53e8, 0x53fc, 0x53f8, 0x53f5, 0x53eb, 0x53e6, 0x53ea, 0x53f2, 0x53f1, 0x53f0, 0x53e5, 0x53ed, 0x53fb, 0x56db, 0x56da, 0x5916, 0x592e, 0x5931, 0x5974, 0x5976, 0x5b55, 0x5b83, 0x5c3c, 0x5de8, 0x5de7, 0x5de6, 0x5e02, 0x5e03, 0x5e73, 0x5e7c, 0x5f01, 0x5f18, 0x5f17, 0x5fc5, 0x620a, 0x6253, 0x6254, 0x6252, 0x6251, 0x65a5, 0x65e6, 0x672e, 0x672c, 0x672a, 0x672b, 0x672d, 0x6b63, 0x6bcd, 0x6c11, 0x6c10, 0x6c38, 0x6c41, 0x6c40, 0x6c3e, 0x72af, 0x7384, 0x7389, 0x74dc, 0x74e6, 0x7518, 0x751f, 0x7528, 0x7529, 0x7530, 0x7531, 0x7532, 0x7533, 0x758b, 0x767d, 0x76ae, 0x76bf, 0x76ee, 0x77db, 0x77e2, 0x77f3, 0x793a, 0x79be, 0x7a74,
+		0x7acb, 0x4e1e, 0x4e1f, 0x4e52, 0x4e53, 0x4e69, 0x4e99, 0x4ea4, 0x4ea6, 0x4ea5, 0x4eff, 0x4f09, 0x4f19, 0x4f0a, 0x4f15, 0x4f0d, 0x4f10, 0x4f11, 0x4f0f, 0x4ef2, 0x4ef6, 0x4efb, 0x4ef0, 0x4ef3, 0x4efd, 0x4f01, 0x4f0b, 0x5149, 0x5147, 0x5146, 0x5148, 0x5168, 0x5171, 0x518d, 0x51b0, 0x5217, 0x5211, 0x5212, 0x520e, 0x5216, 0x52a3, 0x5308, 0x5321, 0x5320, 0x5370, 0x5371, 0x5409, 0x540f, 0x540c, 0x540a, 0x5410, 0x5401, 0x540b, 0x5404, 0x5411, 0x540d, 0x5408, 0x5403, 0x540e, 0x5406, 0x5412, 0x56e0, 0x56de, 0x56dd, 0x5733, 0x5730, 0x5728, 0x572d, 0x572c, 0x572f, 0x5729, 0x5919, 0x591a, 0x5937, 0x5938, 0x5984, 0x5978, 0x5983, 0x597d, 0x5979, 0x5982, 0x5981, 0x5b57, 0x5b58, 0x5b87, 0x5b88, 0x5b85, 0x5b89, 0x5bfa, 0x5c16, 0x5c79, 0x5dde, 0x5e06, 0x5e76,
+		0x5e74, 0x5f0f, 0x5f1b, 0x5fd9, 0x5fd6, 0x620e, 0x620c, 0x620d, 0x6210, 0x6263, 0x625b, 0x6258, 0x6536, 0x65e9, 0x65e8, 0x65ec, 0x65ed, 0x66f2, 0x66f3, 0x6709, 0x673d, 0x6734, 0x6731, 0x6735, 0x6b21, 0x6b64, 0x6b7b, 0x6c16, 0x6c5d, 0x6c57, 0x6c59, 0x6c5f, 0x6c60, 0x6c50, 0x6c55, 0x6c61, 0x6c5b, 0x6c4d, 0x6c4e, 0x7070, 0x725f, 0x725d, 0x767e, 0x7af9, 0x7c73, 0x7cf8, 0x7f36, 0x7f8a, 0x7fbd, 0x8001, 0x8003, 0x800c, 0x8012, 0x8033, 0x807f, 0x8089, 0x808b, 0x808c, 0x81e3, 0x81ea, 0x81f3, 0x81fc, 0x820c, 0x821b, 0x821f, 0x826e, 0x8272, 0x827e, 0x866b, 0x8840, 0x884c, 0x8863, 0x897f, 0x9621, 0x4e32, 0x4ea8, 0x4f4d, 0x4f4f, 0x4f47, 0x4f57, 0x4f5e, 0x4f34, 0x4f5b, 0x4f55, 0x4f30, 0x4f50, 0x4f51, 0x4f3d, 0x4f3a, 0x4f38, 0x4f43, 0x4f54, 0x4f3c, 0x4f46,
+		0x4f63, 0x4f5c, 0x4f60, 0x4f2f, 0x4f4e, 0x4f36, 0x4f59, 0x4f5d, 0x4f48, 0x4f5a, 0x514c, 0x514b, 0x514d, 0x5175, 0x51b6, 0x51b7, 0x5225, 0x5224, 0x5229, 0x522a, 0x5228, 0x52ab, 0x52a9, 0x52aa, 0x52ac, 0x5323, 0x5373, 0x5375, 0x541d, 0x542d, 0x541e, 0x543e, 0x5426, 0x544e, 0x5427, 0x5446, 0x5443, 0x5433, 0x5448, 0x5442, 0x541b, 0x5429, 0x544a, 0x5439, 0x543b, 0x5438, 0x542e, 0x5435, 0x5436, 0x5420, 0x543c, 0x5440, 0x5431, 0x542b, 0x541f, 0x542c, 0x56ea, 0x56f0, 0x56e4, 0x56eb, 0x574a, 0x5751, 0x5740, 0x574d, 0x5747, 0x574e, 0x573e, 0x5750, 0x574f, 0x573b, 0x58ef, 0x593e, 0x599d, 0x5992, 0x59a8, 0x599e, 0x59a3, 0x5999, 0x5996, 0x598d, 0x59a4, 0x5993, 0x598a, 0x59a5, 0x5b5d, 0x5b5c, 0x5b5a, 0x5b5b, 0x5b8c, 0x5b8b, 0x5b8f, 0x5c2c, 0x5c40, 0x5c41,
+		0x5c3f, 0x5c3e, 0x5c90, 0x5c91, 0x5c94, 0x5c8c, 0x5deb, 0x5e0c, 0x5e8f, 0x5e87, 0x5e8a, 0x5ef7, 0x5f04, 0x5f1f, 0x5f64, 0x5f62, 0x5f77, 0x5f79, 0x5fd8, 0x5fcc, 0x5fd7, 0x5fcd, 0x5ff1, 0x5feb, 0x5ff8, 0x5fea, 0x6212, 0x6211, 0x6284, 0x6297, 0x6296, 0x6280, 0x6276, 0x6289, 0x626d, 0x628a, 0x627c, 0x627e, 0x6279, 0x6273, 0x6292, 0x626f, 0x6298, 0x626e, 0x6295, 0x6293, 0x6291, 0x6286, 0x6539, 0x653b, 0x6538, 0x65f1, 0x66f4, 0x675f, 0x674e, 0x674f, 0x6750, 0x6751, 0x675c, 0x6756, 0x675e, 0x6749, 0x6746, 0x6760, 0x6753, 0x6757, 0x6b65, 0x6bcf, 0x6c42, 0x6c5e, 0x6c99, 0x6c81, 0x6c88, 0x6c89, 0x6c85, 0x6c9b, 0x6c6a, 0x6c7a, 0x6c90, 0x6c70, 0x6c8c, 0x6c68, 0x6c96, 0x6c92, 0x6c7d, 0x6c83, 0x6c72, 0x6c7e, 0x6c74, 0x6c86, 0x6c76, 0x6c8d, 0x6c94, 0x6c98,
+		0x6c82, 0x7076, 0x707c, 0x707d, 0x7078, 0x7262, 0x7261, 0x7260, 0x72c4, 0x72c2, 0x7396, 0x752c, 0x752b, 0x7537, 0x7538, 0x7682, 0x76ef, 0x77e3, 0x79c1, 0x79c0, 0x79bf, 0x7a76, 0x7cfb, 0x7f55, 0x8096, 0x8093, 0x809d, 0x8098, 0x809b, 0x809a, 0x80b2, 0x826f, 0x8292, 0x828b, 0x828d, 0x898b, 0x89d2, 0x8a00, 0x8c37, 0x8c46, 0x8c55, 0x8c9d, 0x8d64, 0x8d70, 0x8db3, 0x8eab, 0x8eca, 0x8f9b, 0x8fb0, 0x8fc2, 0x8fc6, 0x8fc5, 0x8fc4, 0x5de1, 0x9091, 0x90a2, 0x90aa, 0x90a6, 0x90a3, 0x9149, 0x91c6, 0x91cc, 0x9632, 0x962e, 0x9631, 0x962a, 0x962c, 0x4e26, 0x4e56, 0x4e73, 0x4e8b, 0x4e9b, 0x4e9e, 0x4eab, 0x4eac, 0x4f6f, 0x4f9d, 0x4f8d, 0x4f73, 0x4f7f, 0x4f6c, 0x4f9b, 0x4f8b, 0x4f86, 0x4f83, 0x4f70, 0x4f75, 0x4f88, 0x4f69, 0x4f7b, 0x4f96, 0x4f7e, 0x4f8f, 0x4f91,
+		0x4f7a, 0x5154, 0x5152, 0x5155, 0x5169, 0x5177, 0x5176, 0x5178, 0x51bd, 0x51fd, 0x523b, 0x5238, 0x5237, 0x523a, 0x5230, 0x522e, 0x5236, 0x5241, 0x52be, 0x52bb, 0x5352, 0x5354, 0x5353, 0x5351, 0x5366, 0x5377, 0x5378, 0x5379, 0x53d6, 0x53d4, 0x53d7, 0x5473, 0x5475, 0x5496, 0x5478, 0x5495, 0x5480, 0x547b, 0x5477, 0x5484, 0x5492, 0x5486, 0x547c, 0x5490, 0x5471, 0x5476, 0x548c, 0x549a, 0x5462, 0x5468, 0x548b, 0x547d, 0x548e, 0x56fa, 0x5783, 0x5777, 0x576a, 0x5769, 0x5761, 0x5766, 0x5764, 0x577c, 0x591c, 0x5949, 0x5947, 0x5948, 0x5944, 0x5954, 0x59be, 0x59bb, 0x59d4, 0x59b9, 0x59ae, 0x59d1, 0x59c6, 0x59d0, 0x59cd, 0x59cb, 0x59d3, 0x59ca, 0x59af, 0x59b3, 0x59d2, 0x59c5, 0x5b5f, 0x5b64, 0x5b63, 0x5b97, 0x5b9a, 0x5b98, 0x5b9c, 0x5b99, 0x5b9b, 0x5c1a,
+		0x5c48, 0x5c45, 0x5c46, 0x5cb7, 0x5ca1, 0x5cb8, 0x5ca9, 0x5cab, 0x5cb1, 0x5cb3, 0x5e18, 0x5e1a, 0x5e16, 0x5e15, 0x5e1b, 0x5e11, 0x5e78, 0x5e9a, 0x5e97, 0x5e9c, 0x5e95, 0x5e96, 0x5ef6, 0x5f26, 0x5f27, 0x5f29, 0x5f80, 0x5f81, 0x5f7f, 0x5f7c, 0x5fdd, 0x5fe0, 0x5ffd, 0x5ff5, 0x5fff, 0x600f, 0x6014, 0x602f, 0x6035, 0x6016, 0x602a, 0x6015, 0x6021, 0x6027, 0x6029, 0x602b, 0x601b, 0x6216, 0x6215, 0x623f, 0x623e, 0x6240, 0x627f, 0x62c9, 0x62cc, 0x62c4, 0x62bf, 0x62c2, 0x62b9, 0x62d2, 0x62db, 0x62ab, 0x62d3, 0x62d4, 0x62cb, 0x62c8, 0x62a8, 0x62bd, 0x62bc, 0x62d0, 0x62d9, 0x62c7, 0x62cd, 0x62b5, 0x62da, 0x62b1, 0x62d8, 0x62d6, 0x62d7, 0x62c6, 0x62ac, 0x62ce, 0x653e, 0x65a7, 0x65bc, 0x65fa, 0x6614, 0x6613, 0x660c, 0x6606, 0x6602, 0x660e, 0x6600, 0x660f,
+		0x6615, 0x660a, 0x6607, 0x670d, 0x670b, 0x676d, 0x678b, 0x6795, 0x6771, 0x679c, 0x6773, 0x6777, 0x6787, 0x679d, 0x6797, 0x676f, 0x6770, 0x677f, 0x6789, 0x677e, 0x6790, 0x6775, 0x679a, 0x6793, 0x677c, 0x676a, 0x6772, 0x6b23, 0x6b66, 0x6b67, 0x6b7f, 0x6c13, 0x6c1b, 0x6ce3, 0x6ce8, 0x6cf3, 0x6cb1, 0x6ccc, 0x6ce5, 0x6cb3, 0x6cbd, 0x6cbe, 0x6cbc, 0x6ce2, 0x6cab, 0x6cd5, 0x6cd3, 0x6cb8, 0x6cc4, 0x6cb9, 0x6cc1, 0x6cae, 0x6cd7, 0x6cc5, 0x6cf1, 0x6cbf, 0x6cbb, 0x6ce1, 0x6cdb, 0x6cca, 0x6cac, 0x6cef, 0x6cdc, 0x6cd6, 0x6ce0, 0x7095, 0x708e, 0x7092, 0x708a, 0x7099, 0x722c, 0x722d, 0x7238, 0x7248, 0x7267, 0x7269, 0x72c0, 0x72ce, 0x72d9, 0x72d7, 0x72d0, 0x73a9, 0x73a8, 0x739f, 0x73ab, 0x73a5, 0x753d, 0x759d, 0x7599, 0x759a, 0x7684, 0x76c2, 0x76f2, 0x76f4,
+		0x77e5, 0x77fd, 0x793e, 0x7940, 0x7941, 0x79c9, 0x79c8, 0x7a7a, 0x7a79, 0x7afa, 0x7cfe, 0x7f54, 0x7f8c, 0x7f8b, 0x8005, 0x80ba, 0x80a5, 0x80a2, 0x80b1, 0x80a1, 0x80ab, 0x80a9, 0x80b4, 0x80aa, 0x80af, 0x81e5, 0x81fe, 0x820d, 0x82b3, 0x829d, 0x8299, 0x82ad, 0x82bd, 0x829f, 0x82b9, 0x82b1, 0x82ac, 0x82a5, 0x82af, 0x82b8, 0x82a3, 0x82b0, 0x82be, 0x82b7, 0x864e, 0x8671, 0x521d, 0x8868, 0x8ecb, 0x8fce, 0x8fd4, 0x8fd1, 0x90b5, 0x90b8, 0x90b1, 0x90b6, 0x91c7, 0x91d1, 0x9577, 0x9580, 0x961c, 0x9640, 0x963f, 0x963b, 0x9644, 0x9642, 0x96b9, 0x96e8, 0x9752, 0x975e, 0x4e9f, 0x4ead, 0x4eae, 0x4fe1, 0x4fb5, 0x4faf, 0x4fbf, 0x4fe0, 0x4fd1, 0x4fcf, 0x4fdd, 0x4fc3, 0x4fb6, 0x4fd8, 0x4fdf, 0x4fca, 0x4fd7, 0x4fae, 0x4fd0, 0x4fc4, 0x4fc2, 0x4fda, 0x4fce, 0x4fde,
+		0x4fb7, 0x5157, 0x5192, 0x5191, 0x51a0, 0x524e, 0x5243, 0x524a, 0x524d, 0x524c, 0x524b, 0x5247, 0x52c7, 0x52c9, 0x52c3, 0x52c1, 0x530d, 0x5357, 0x537b, 0x539a, 0x53db, 0x54ac, 0x54c0, 0x54a8, 0x54ce, 0x54c9, 0x54b8, 0x54a6, 0x54b3, 0x54c7, 0x54c2, 0x54bd, 0x54aa, 0x54c1, 0x54c4, 0x54c8, 0x54af, 0x54ab, 0x54b1, 0x54bb, 0x54a9, 0x54a7, 0x54bf, 0x56ff, 0x5782, 0x578b, 0x57a0, 0x57a3, 0x57a2, 0x57ce, 0x57ae, 0x5793, 0x5955, 0x5951, 0x594f, 0x594e, 0x5950, 0x59dc, 0x59d8, 0x59ff, 0x59e3, 0x59e8, 0x5a03, 0x59e5, 0x59ea, 0x59da, 0x59e6, 0x5a01, 0x59fb, 0x5b69, 0x5ba3, 0x5ba6, 0x5ba4, 0x5ba2, 0x5ba5, 0x5c01, 0x5c4e, 0x5c4f, 0x5c4d, 0x5c4b, 0x5cd9, 0x5cd2, 0x5df7, 0x5e1d, 0x5e25, 0x5e1f, 0x5e7d, 0x5ea0, 0x5ea6, 0x5efa, 0x5f08, 0x5f2d, 0x5f65, 0x5f88,
+		0x5f85, 0x5f8a, 0x5f8b, 0x5f87, 0x5f8c, 0x5f89, 0x6012, 0x601d, 0x6020, 0x6025, 0x600e, 0x6028, 0x604d, 0x6070, 0x6068, 0x6062, 0x6046, 0x6043, 0x606c, 0x606b, 0x606a, 0x6064, 0x6241, 0x62dc, 0x6316, 0x6309, 0x62fc, 0x62ed, 0x6301, 0x62ee, 0x62fd, 0x6307, 0x62f1, 0x62f7, 0x62ef, 0x62ec, 0x62fe, 0x62f4, 0x6311, 0x6302, 0x653f, 0x6545, 0x65ab, 0x65bd, 0x65e2, 0x6625, 0x662d, 0x6620, 0x6627, 0x662f, 0x661f, 0x6628, 0x6631, 0x6624, 0x66f7, 0x67ff, 0x67d3, 0x67f1, 0x67d4, 0x67d0, 0x67ec, 0x67b6, 0x67af, 0x67f5, 0x67e9, 0x67ef, 0x67c4, 0x67d1, 0x67b4, 0x67da, 0x67e5, 0x67b8, 0x67cf, 0x67de, 0x67f3, 0x67b0, 0x67d9, 0x67e2, 0x67dd, 0x67d2, 0x6b6a, 0x6b83, 0x6b86, 0x6bb5, 0x6bd2, 0x6bd7, 0x6c1f, 0x6cc9, 0x6d0b, 0x6d32, 0x6d2a, 0x6d41, 0x6d25, 0x6d0c,
+		0x6d31, 0x6d1e, 0x6d17, 0x6d3b, 0x6d3d, 0x6d3e, 0x6d36, 0x6d1b, 0x6cf5, 0x6d39, 0x6d27, 0x6d38, 0x6d29, 0x6d2e, 0x6d35, 0x6d0e, 0x6d2b, 0x70ab, 0x70ba, 0x70b3, 0x70ac, 0x70af, 0x70ad, 0x70b8, 0x70ae, 0x70a4, 0x7230, 0x7272, 0x726f, 0x7274, 0x72e9, 0x72e0, 0x72e1, 0x73b7, 0x73ca, 0x73bb, 0x73b2, 0x73cd, 0x73c0, 0x73b3, 0x751a, 0x752d, 0x754f, 0x754c, 0x754e, 0x754b, 0x75ab, 0x75a4, 0x75a5, 0x75a2, 0x75a3, 0x7678, 0x7686, 0x7687, 0x7688, 0x76c8, 0x76c6, 0x76c3, 0x76c5, 0x7701, 0x76f9, 0x76f8, 0x7709, 0x770b, 0x76fe, 0x76fc, 0x7707, 0x77dc, 0x7802, 0x7814, 0x780c, 0x780d, 0x7946, 0x7949, 0x7948, 0x7947, 0x79b9, 0x79ba, 0x79d1, 0x79d2, 0x79cb, 0x7a7f, 0x7a81, 0x7aff, 0x7afd, 0x7c7d, 0x7d02, 0x7d05, 0x7d00, 0x7d09, 0x7d07, 0x7d04, 0x7d06, 0x7f38,
+		0x7f8e, 0x7fbf, 0x8010, 0x800d, 0x8011, 0x8036, 0x80d6, 0x80e5, 0x80da, 0x80c3, 0x80c4, 0x80cc, 0x80e1, 0x80db, 0x80ce, 0x80de, 0x80e4, 0x80dd, 0x81f4, 0x8222, 0x82e7, 0x8303, 0x8305, 0x82e3, 0x82db, 0x82e6, 0x8304, 0x82e5, 0x8302, 0x8309, 0x82d2, 0x82d7, 0x82f1, 0x8301, 0x82dc, 0x82d4, 0x82d1, 0x82de, 0x82d3, 0x82df, 0x82ef, 0x8306, 0x8650, 0x8679, 0x867b, 0x867a, 0x884d, 0x886b, 0x8981, 0x89d4, 0x8a08, 0x8a02, 0x8a03, 0x8c9e, 0x8ca0, 0x8d74, 0x8d73, 0x8db4, 0x8ecd, 0x8ecc, 0x8ff0, 0x8fe6, 0x8fe2, 0x8fea, 0x8fe5, 0x8fed, 0x8feb, 0x8fe4, 0x8fe8, 0x90ca, 0x90ce, 0x90c1, 0x90c3, 0x914b, 0x914a, 0x91cd, 0x9582, 0x9650, 0x964b, 0x964c, 0x964d, 0x9762, 0x9769, 0x97cb, 0x97ed, 0x97f3, 0x9801, 0x98a8, 0x98db, 0x98df, 0x9996, 0x9999, 0x4e58, 0x4eb3,
+		0x500c, 0x500d, 0x5023, 0x4fef, 0x5026, 0x5025, 0x4ff8, 0x5029, 0x5016, 0x5006, 0x503c, 0x501f, 0x501a, 0x5012, 0x5011, 0x4ffa, 0x5000, 0x5014, 0x5028, 0x4ff1, 0x5021, 0x500b, 0x5019, 0x5018, 0x4ff3, 0x4fee, 0x502d, 0x502a, 0x4ffe, 0x502b, 0x5009, 0x517c, 0x51a4, 0x51a5, 0x51a2, 0x51cd, 0x51cc, 0x51c6, 0x51cb, 0x5256, 0x525c, 0x5254, 0x525b, 0x525d, 0x532a, 0x537f, 0x539f, 0x539d, 0x53df, 0x54e8, 0x5510, 0x5501, 0x5537, 0x54fc, 0x54e5, 0x54f2, 0x5506, 0x54fa, 0x5514, 0x54e9, 0x54ed, 0x54e1, 0x5509, 0x54ee, 0x54ea, 0x54e6, 0x5527, 0x5507, 0x54fd, 0x550f, 0x5703, 0x5704, 0x57c2, 0x57d4, 0x57cb, 0x57c3, 0x5809, 0x590f, 0x5957, 0x5958, 0x595a, 0x5a11, 0x5a18, 0x5a1c, 0x5a1f, 0x5a1b, 0x5a13, 0x59ec, 0x5a20, 0x5a23, 0x5a29, 0x5a25, 0x5a0c, 0x5a09,
+		0x5b6b, 0x5c58, 0x5bb0, 0x5bb3, 0x5bb6, 0x5bb4, 0x5bae, 0x5bb5, 0x5bb9, 0x5bb8, 0x5c04, 0x5c51, 0x5c55, 0x5c50, 0x5ced, 0x5cfd, 0x5cfb, 0x5cea, 0x5ce8, 0x5cf0, 0x5cf6, 0x5d01, 0x5cf4, 0x5dee, 0x5e2d, 0x5e2b, 0x5eab, 0x5ead, 0x5ea7, 0x5f31, 0x5f92, 0x5f91, 0x5f90, 0x6059, 0x6063, 0x6065, 0x6050, 0x6055, 0x606d, 0x6069, 0x606f, 0x6084, 0x609f, 0x609a, 0x608d, 0x6094, 0x608c, 0x6085, 0x6096, 0x6247, 0x62f3, 0x6308, 0x62ff, 0x634e, 0x633e, 0x632f, 0x6355, 0x6342, 0x6346, 0x634f, 0x6349, 0x633a, 0x6350, 0x633d, 0x632a, 0x632b, 0x6328, 0x634d, 0x634c, 0x6548, 0x6549, 0x6599, 0x65c1, 0x65c5, 0x6642, 0x6649, 0x664f, 0x6643, 0x6652, 0x664c, 0x6645, 0x6641, 0x66f8, 0x6714, 0x6715, 0x6717, 0x6821, 0x6838, 0x6848, 0x6846, 0x6853, 0x6839, 0x6842, 0x6854,
+		0x6829, 0x68b3, 0x6817, 0x684c, 0x6851, 0x683d, 0x67f4, 0x6850, 0x6840, 0x683c, 0x6843, 0x682a, 0x6845, 0x6813, 0x6818, 0x6841, 0x6b8a, 0x6b89, 0x6bb7, 0x6c23, 0x6c27, 0x6c28, 0x6c26, 0x6c24, 0x6cf0, 0x6d6a, 0x6d95, 0x6d88, 0x6d87, 0x6d66, 0x6d78, 0x6d77, 0x6d59, 0x6d93, 0x6d6c, 0x6d89, 0x6d6e, 0x6d5a, 0x6d74, 0x6d69, 0x6d8c, 0x6d8a, 0x6d79, 0x6d85, 0x6d65, 0x6d94, 0x70ca, 0x70d8, 0x70e4, 0x70d9, 0x70c8, 0x70cf, 0x7239, 0x7279, 0x72fc, 0x72f9, 0x72fd, 0x72f8, 0x72f7, 0x7386, 0x73ed, 0x7409, 0x73ee, 0x73e0, 0x73ea, 0x73de, 0x7554, 0x755d, 0x755c, 0x755a, 0x7559, 0x75be, 0x75c5, 0x75c7, 0x75b2, 0x75b3, 0x75bd, 0x75bc, 0x75b9, 0x75c2, 0x75b8, 0x768b, 0x76b0, 0x76ca, 0x76cd, 0x76ce, 0x7729, 0x771f, 0x7720, 0x7728, 0x77e9, 0x7830, 0x7827, 0x7838,
+		0x781d, 0x7834, 0x7837, 0x7825, 0x782d, 0x7820, 0x781f, 0x7832, 0x7955, 0x7950, 0x7960, 0x795f, 0x7956, 0x795e, 0x795d, 0x7957, 0x795a, 0x79e4, 0x79e3, 0x79e7, 0x79df, 0x79e6, 0x79e9, 0x79d8, 0x7a84, 0x7a88, 0x7ad9, 0x7b06, 0x7b11, 0x7c89, 0x7d21, 0x7d17, 0x7d0b, 0x7d0a, 0x7d20, 0x7d22, 0x7d14, 0x7d10, 0x7d15, 0x7d1a, 0x7d1c, 0x7d0d, 0x7d19, 0x7d1b, 0x7f3a, 0x7f5f, 0x7f94, 0x7fc5, 0x7fc1, 0x8006, 0x8004, 0x8018, 0x8015, 0x8019, 0x8017, 0x803d, 0x803f, 0x80f1, 0x8102, 0x80f0, 0x8105, 0x80ed, 0x80f4, 0x8106, 0x80f8, 0x80f3, 0x8108, 0x80fd, 0x810a, 0x80fc, 0x80ef, 0x81ed, 0x81ec, 0x8200, 0x8210, 0x822a, 0x822b, 0x8228, 0x822c, 0x82bb, 0x832b, 0x8352, 0x8354, 0x834a, 0x8338, 0x8350, 0x8349, 0x8335, 0x8334, 0x834f, 0x8332, 0x8339, 0x8336, 0x8317,
+		0x8340, 0x8331, 0x8328, 0x8343, 0x8654, 0x868a, 0x86aa, 0x8693, 0x86a4, 0x86a9, 0x868c, 0x86a3, 0x869c, 0x8870, 0x8877, 0x8881, 0x8882, 0x887d, 0x8879, 0x8a18, 0x8a10, 0x8a0e, 0x8a0c, 0x8a15, 0x8a0a, 0x8a17, 0x8a13, 0x8a16, 0x8a0f, 0x8a11, 0x8c48, 0x8c7a, 0x8c79, 0x8ca1, 0x8ca2, 0x8d77, 0x8eac, 0x8ed2, 0x8ed4, 0x8ecf, 0x8fb1, 0x9001, 0x9006, 0x8ff7, 0x9000, 0x8ffa, 0x8ff4, 0x9003, 0x8ffd, 0x9005, 0x8ff8, 0x9095, 0x90e1, 0x90dd, 0x90e2, 0x9152, 0x914d, 0x914c, 0x91d8, 0x91dd, 0x91d7, 0x91dc, 0x91d9, 0x9583, 0x9662, 0x9663, 0x9661, 0x965b, 0x965d, 0x9664, 0x9658, 0x965e, 0x96bb, 0x98e2, 0x99ac, 0x9aa8, 0x9ad8, 0x9b25, 0x9b32, 0x9b3c, 0x4e7e, 0x507a, 0x507d, 0x505c, 0x5047, 0x5043, 0x504c, 0x505a, 0x5049, 0x5065, 0x5076, 0x504e, 0x5055, 0x5075,
+		0x5074, 0x5077, 0x504f, 0x500f, 0x506f, 0x506d, 0x515c, 0x5195, 0x51f0, 0x526a, 0x526f, 0x52d2, 0x52d9, 0x52d8, 0x52d5, 0x5310, 0x530f, 0x5319, 0x533f, 0x5340, 0x533e, 0x53c3, 0x66fc, 0x5546, 0x556a, 0x5566, 0x5544, 0x555e, 0x5561, 0x5543, 0x554a, 0x5531, 0x5556, 0x554f, 0x5555, 0x552f, 0x5564, 0x5538, 0x552e, 0x555c, 0x552c, 0x5563, 0x5533, 0x5541, 0x5557, 0x5708, 0x570b, 0x5709, 0x57df, 0x5805, 0x580a, 0x5806, 0x57e0, 0x57e4, 0x57fa, 0x5802, 0x5835, 0x57f7, 0x57f9, 0x5920, 0x5962, 0x5a36, 0x5a41, 0x5a49, 0x5a66, 0x5a6a, 0x5a40, 0x5a3c, 0x5a62, 0x5a5a, 0x5a46, 0x5a4a, 0x5b70, 0x5bc7, 0x5bc5, 0x5bc4, 0x5bc2, 0x5bbf, 0x5bc6, 0x5c09, 0x5c08, 0x5c07, 0x5c60, 0x5c5c, 0x5c5d, 0x5d07, 0x5d06, 0x5d0e, 0x5d1b, 0x5d16, 0x5d22, 0x5d11, 0x5d29, 0x5d14,
+		0x5d19, 0x5d24, 0x5d27, 0x5d17, 0x5de2, 0x5e38, 0x5e36, 0x5e33, 0x5e37, 0x5eb7, 0x5eb8, 0x5eb6, 0x5eb5, 0x5ebe, 0x5f35, 0x5f37, 0x5f57, 0x5f6c, 0x5f69, 0x5f6b, 0x5f97, 0x5f99, 0x5f9e, 0x5f98, 0x5fa1, 0x5fa0, 0x5f9c, 0x607f, 0x60a3, 0x6089, 0x60a0, 0x60a8, 0x60cb, 0x60b4, 0x60e6, 0x60bd, 0x60c5, 0x60bb, 0x60b5, 0x60dc, 0x60bc, 0x60d8, 0x60d5, 0x60c6, 0x60df, 0x60b8, 0x60da, 0x60c7, 0x621a, 0x621b, 0x6248, 0x63a0, 0x63a7, 0x6372, 0x6396, 0x63a2, 0x63a5, 0x6377, 0x6367, 0x6398, 0x63aa, 0x6371, 0x63a9, 0x6389, 0x6383, 0x639b, 0x636b, 0x63a8, 0x6384, 0x6388, 0x6399, 0x63a1, 0x63ac, 0x6392, 0x638f, 0x6380, 0x637b, 0x6369, 0x6368, 0x637a, 0x655d, 0x6556, 0x6551, 0x6559, 0x6557, 0x555f, 0x654f, 0x6558, 0x6555, 0x6554, 0x659c, 0x659b, 0x65ac, 0x65cf,
+		0x65cb, 0x65cc, 0x65ce, 0x665d, 0x665a, 0x6664, 0x6668, 0x6666, 0x665e, 0x66f9, 0x52d7, 0x671b, 0x6881, 0x68af, 0x68a2, 0x6893, 0x68b5, 0x687f, 0x6876, 0x68b1, 0x68a7, 0x6897, 0x68b0, 0x6883, 0x68c4, 0x68ad, 0x6886, 0x6885, 0x6894, 0x689d, 0x68a8, 0x689f, 0x68a1, 0x6882, 0x6b32, 0x6bba, 0x6beb, 0x6bec, 0x6c2b, 0x6d8e, 0x6dbc, 0x6df3, 0x6dd9, 0x6db2, 0x6de1, 0x6dcc, 0x6de4, 0x6dfb, 0x6dfa, 0x6e05, 0x6dc7, 0x6dcb, 0x6daf, 0x6dd1, 0x6dae, 0x6dde, 0x6df9, 0x6db8, 0x6df7, 0x6df5, 0x6dc5, 0x6dd2, 0x6e1a, 0x6db5, 0x6dda, 0x6deb, 0x6dd8, 0x6dea, 0x6df1, 0x6dee, 0x6de8, 0x6dc6, 0x6dc4, 0x6daa, 0x6dec, 0x6dbf, 0x6de6, 0x70f9, 0x7109, 0x710a, 0x70fd, 0x70ef, 0x723d, 0x727d, 0x7281, 0x731c, 0x731b, 0x7316, 0x7313, 0x7319, 0x7387, 0x7405, 0x740a, 0x7403,
+		0x7406, 0x73fe, 0x740d, 0x74e0, 0x74f6, 0x74f7, 0x751c, 0x7522, 0x7565, 0x7566, 0x7562, 0x7570, 0x758f, 0x75d4, 0x75d5, 0x75b5, 0x75ca, 0x75cd, 0x768e, 0x76d4, 0x76d2, 0x76db, 0x7737, 0x773e, 0x773c, 0x7736, 0x7738, 0x773a, 0x786b, 0x7843, 0x784e, 0x7965, 0x7968, 0x796d, 0x79fb, 0x7a92, 0x7a95, 0x7b20, 0x7b28, 0x7b1b, 0x7b2c, 0x7b26, 0x7b19, 0x7b1e, 0x7b2e, 0x7c92, 0x7c97, 0x7c95, 0x7d46, 0x7d43, 0x7d71, 0x7d2e, 0x7d39, 0x7d3c, 0x7d40, 0x7d30, 0x7d33, 0x7d44, 0x7d2f, 0x7d42, 0x7d32, 0x7d31, 0x7f3d, 0x7f9e, 0x7f9a, 0x7fcc, 0x7fce, 0x7fd2, 0x801c, 0x804a, 0x8046, 0x812f, 0x8116, 0x8123, 0x812b, 0x8129, 0x8130, 0x8124, 0x8202, 0x8235, 0x8237, 0x8236, 0x8239, 0x838e, 0x839e, 0x8398, 0x8378, 0x83a2, 0x8396, 0x83bd, 0x83ab, 0x8392, 0x838a, 0x8393,
+		0x8389, 0x83a0, 0x8377, 0x837b, 0x837c, 0x8386, 0x83a7, 0x8655, 0x5f6a, 0x86c7, 0x86c0, 0x86b6, 0x86c4, 0x86b5, 0x86c6, 0x86cb, 0x86b1, 0x86af, 0x86c9, 0x8853, 0x889e, 0x8888, 0x88ab, 0x8892, 0x8896, 0x888d, 0x888b, 0x8993, 0x898f, 0x8a2a, 0x8a1d, 0x8a23, 0x8a25, 0x8a31, 0x8a2d, 0x8a1f, 0x8a1b, 0x8a22, 0x8c49, 0x8c5a, 0x8ca9, 0x8cac, 0x8cab, 0x8ca8, 0x8caa, 0x8ca7, 0x8d67, 0x8d66, 0x8dbe, 0x8dba, 0x8edb, 0x8edf, 0x9019, 0x900d, 0x901a, 0x9017, 0x9023, 0x901f, 0x901d, 0x9010, 0x9015, 0x901e, 0x9020, 0x900f, 0x9022, 0x9016, 0x901b, 0x9014, 0x90e8, 0x90ed, 0x90fd, 0x9157, 0x91ce, 0x91f5, 0x91e6, 0x91e3, 0x91e7, 0x91ed, 0x91e9, 0x9589, 0x966a, 0x9675, 0x9673, 0x9678, 0x9670, 0x9674, 0x9676, 0x9677, 0x966c, 0x96c0, 0x96ea, 0x96e9, 0x7ae0, 0x7adf,
+		0x9802, 0x9803, 0x9b5a, 0x9ce5, 0x9e75, 0x9e7f, 0x9ea5, 0x9ebb, 0x50a2, 0x508d, 0x5085, 0x5099, 0x5091, 0x5080, 0x5096, 0x5098, 0x509a, 0x6700, 0x51f1, 0x5272, 0x5274, 0x5275, 0x5269, 0x52de, 0x52dd, 0x52db, 0x535a, 0x53a5, 0x557b, 0x5580, 0x55a7, 0x557c, 0x558a, 0x559d, 0x5598, 0x5582, 0x559c, 0x55aa, 0x5594, 0x5587, 0x558b, 0x5583, 0x55b3, 0x55ae, 0x559f, 0x553e, 0x55b2, 0x559a, 0x55bb, 0x55ac, 0x55b1, 0x557e, 0x5589, 0x55ab, 0x5599, 0x570d, 0x582f, 0x582a, 0x5834, 0x5824, 0x5830, 0x5831, 0x5821, 0x581d, 0x5820, 0x58f9, 0x58fa, 0x5960, 0x5a77, 0x5a9a, 0x5a7f, 0x5a92, 0x5a9b, 0x5aa7, 0x5b73, 0x5b71, 0x5bd2, 0x5bcc, 0x5bd3, 0x5bd0, 0x5c0a, 0x5c0b, 0x5c31, 0x5d4c, 0x5d50, 0x5d34, 0x5d47, 0x5dfd, 0x5e45, 0x5e3d, 0x5e40, 0x5e43, 0x5e7e, 0x5eca,
+		0x5ec1, 0x5ec2, 0x5ec4, 0x5f3c, 0x5f6d, 0x5fa9, 0x5faa, 0x5fa8, 0x60d1, 0x60e1, 0x60b2, 0x60b6, 0x60e0, 0x611c, 0x6123, 0x60fa, 0x6115, 0x60f0, 0x60fb, 0x60f4, 0x6168, 0x60f1, 0x610e, 0x60f6, 0x6109, 0x6100, 0x6112, 0x621f, 0x6249, 0x63a3, 0x638c, 0x63cf, 0x63c0, 0x63e9, 0x63c9, 0x63c6, 0x63cd, 0x63d2, 0x63e3, 0x63d0, 0x63e1, 0x63d6, 0x63ed, 0x63ee, 0x6376, 0x63f4, 0x63ea, 0x63db, 0x6452, 0x63da, 0x63f9, 0x655e, 0x6566, 0x6562, 0x6563, 0x6591, 0x6590, 0x65af, 0x666e, 0x6670, 0x6674, 0x6676, 0x666f, 0x6691, 0x667a, 0x667e, 0x6677, 0x66fe, 0x66ff, 0x671f, 0x671d, 0x68fa, 0x68d5, 0x68e0, 0x68d8, 0x68d7, 0x6905, 0x68df, 0x68f5, 0x68ee, 0x68e7, 0x68f9, 0x68d2, 0x68f2, 0x68e3, 0x68cb, 0x68cd, 0x690d, 0x6912, 0x690e, 0x68c9, 0x68da, 0x696e, 0x68fb,
+		0x6b3e, 0x6b3a, 0x6b3d, 0x6b98, 0x6b96, 0x6bbc, 0x6bef, 0x6c2e, 0x6c2f, 0x6c2c, 0x6e2f, 0x6e38, 0x6e54, 0x6e21, 0x6e32, 0x6e67, 0x6e4a, 0x6e20, 0x6e25, 0x6e23, 0x6e1b, 0x6e5b, 0x6e58, 0x6e24, 0x6e56, 0x6e6e, 0x6e2d, 0x6e26, 0x6e6f, 0x6e34, 0x6e4d, 0x6e3a, 0x6e2c, 0x6e43, 0x6e1d, 0x6e3e, 0x6ecb, 0x6e89, 0x6e19, 0x6e4e, 0x6e63, 0x6e44, 0x6e72, 0x6e69, 0x6e5f, 0x7119, 0x711a, 0x7126, 0x7130, 0x7121, 0x7136, 0x716e, 0x711c, 0x724c, 0x7284, 0x7280, 0x7336, 0x7325, 0x7334, 0x7329, 0x743a, 0x742a, 0x7433, 0x7422, 0x7425, 0x7435, 0x7436, 0x7434, 0x742f, 0x741b, 0x7426, 0x7428, 0x7525, 0x7526, 0x756b, 0x756a, 0x75e2, 0x75db, 0x75e3, 0x75d9, 0x75d8, 0x75de, 0x75e0, 0x767b, 0x767c, 0x7696, 0x7693, 0x76b4, 0x76dc, 0x774f, 0x77ed, 0x785d, 0x786c, 0x786f,
+		0x7a0d, 0x7a08, 0x7a0b, 0x7a05, 0x7a00, 0x7a98, 0x7a97, 0x7a96, 0x7ae5, 0x7ae3, 0x7b49, 0x7b56, 0x7b46, 0x7b50, 0x7b52, 0x7b54, 0x7b4d, 0x7b4b, 0x7b4f, 0x7b51, 0x7c9f, 0x7ca5, 0x7d5e, 0x7d50, 0x7d68, 0x7d55, 0x7d2b, 0x7d6e, 0x7d72, 0x7d61, 0x7d66, 0x7d62, 0x7d70, 0x7d73, 0x5584, 0x7fd4, 0x7fd5, 0x800b, 0x8052, 0x8085, 0x8155, 0x8154, 0x814b, 0x8151, 0x814e, 0x8139, 0x8146, 0x813e, 0x814c, 0x8153, 0x8174, 0x8212, 0x821c, 0x83e9, 0x8403, 0x83f8, 0x840d, 0x83e0, 0x83c5, 0x840b, 0x83c1, 0x83ef, 0x83f1, 0x83f4, 0x8457, 0x840a, 0x83f0, 0x840c, 0x83cc, 0x83fd, 0x83f2, 0x83ca, 0x8438, 0x840e, 0x8404, 0x83dc, 0x8407, 0x83d4, 0x83df, 0x865b, 0x86df, 0x86d9, 0x86ed, 0x86d4, 0x86db, 0x86e4, 0x86d0, 0x86de, 0x8857, 0x88c1, 0x88c2, 0x88b1, 0x8983, 0x8996,
+		0x8a3b, 0x8a60, 0x8a55, 0x8a5e, 0x8a3c, 0x8a41, 0x8a54, 0x8a5b, 0x8a50, 0x8a46, 0x8a34, 0x8a3a, 0x8a36, 0x8a56, 0x8c61, 0x8c82, 0x8caf, 0x8cbc, 0x8cb3, 0x8cbd, 0x8cc1, 0x8cbb, 0x8cc0, 0x8cb4, 0x8cb7, 0x8cb6, 0x8cbf, 0x8cb8, 0x8d8a, 0x8d85, 0x8d81, 0x8dce, 0x8ddd, 0x8dcb, 0x8dda, 0x8dd1, 0x8dcc, 0x8ddb, 0x8dc6, 0x8efb, 0x8ef8, 0x8efc, 0x8f9c, 0x902e, 0x9035, 0x9031, 0x9038, 0x9032, 0x9036, 0x9102, 0x90f5, 0x9109, 0x90fe, 0x9163, 0x9165, 0x91cf, 0x9214, 0x9215, 0x9223, 0x9209, 0x921e, 0x920d, 0x9210, 0x9207, 0x9211, 0x9594, 0x958f, 0x958b, 0x9591, 0x9593, 0x9592, 0x958e, 0x968a, 0x968e, 0x968b, 0x967d, 0x9685, 0x9686, 0x968d, 0x9672, 0x9684, 0x96c1, 0x96c5, 0x96c4, 0x96c6, 0x96c7, 0x96ef, 0x96f2, 0x97cc, 0x9805, 0x9806, 0x9808, 0x98e7, 0x98ea,
+		0x98ef, 0x98e9, 0x98f2, 0x98ed, 0x99ae, 0x99ad, 0x9ec3, 0x9ecd, 0x9ed1, 0x4e82, 0x50ad, 0x50b5, 0x50b2, 0x50b3, 0x50c5, 0x50be, 0x50ac, 0x50b7, 0x50bb, 0x50af, 0x50c7, 0x527f, 0x5277, 0x527d, 0x52df, 0x52e6, 0x52e4, 0x52e2, 0x52e3, 0x532f, 0x55df, 0x55e8, 0x55d3, 0x55e6, 0x55ce, 0x55dc, 0x55c7, 0x55d1, 0x55e3, 0x55e4, 0x55ef, 0x55da, 0x55e1, 0x55c5, 0x55c6, 0x55e5, 0x55c9, 0x5712, 0x5713, 0x585e, 0x5851, 0x5858, 0x5857, 0x585a, 0x5854, 0x586b, 0x584c, 0x586d, 0x584a, 0x5862, 0x5852, 0x584b, 0x5967, 0x5ac1, 0x5ac9, 0x5acc, 0x5abe, 0x5abd, 0x5abc, 0x5ab3, 0x5ac2, 0x5ab2, 0x5d69, 0x5d6f, 0x5e4c, 0x5e79, 0x5ec9, 0x5ec8, 0x5f12, 0x5f59, 0x5fac, 0x5fae, 0x611a, 0x610f, 0x6148, 0x611f, 0x60f3, 0x611b, 0x60f9, 0x6101, 0x6108, 0x614e, 0x614c, 0x6144,
+		0x614d, 0x613e, 0x6134, 0x6127, 0x610d, 0x6106, 0x6137, 0x6221, 0x6222, 0x6413, 0x643e, 0x641e, 0x642a, 0x642d, 0x643d, 0x642c, 0x640f, 0x641c, 0x6414, 0x640d, 0x6436, 0x6416, 0x6417, 0x6406, 0x656c, 0x659f, 0x65b0, 0x6697, 0x6689, 0x6687, 0x6688, 0x6696, 0x6684, 0x6698, 0x668d, 0x6703, 0x6994, 0x696d, 0x695a, 0x6977, 0x6960, 0x6954, 0x6975, 0x6930, 0x6982, 0x694a, 0x6968, 0x696b, 0x695e, 0x6953, 0x6979, 0x6986, 0x695d, 0x6963, 0x695b, 0x6b47, 0x6b72, 0x6bc0, 0x6bbf, 0x6bd3, 0x6bfd, 0x6ea2, 0x6eaf, 0x6ed3, 0x6eb6, 0x6ec2, 0x6e90, 0x6e9d, 0x6ec7, 0x6ec5, 0x6ea5, 0x6e98, 0x6ebc, 0x6eba, 0x6eab, 0x6ed1, 0x6e96, 0x6e9c, 0x6ec4, 0x6ed4, 0x6eaa, 0x6ea7, 0x6eb4, 0x714e, 0x7159, 0x7169, 0x7164, 0x7149, 0x7167, 0x715c, 0x716c, 0x7166, 0x714c, 0x7165,
+		0x715e, 0x7146, 0x7168, 0x7156, 0x723a, 0x7252, 0x7337, 0x7345, 0x733f, 0x733e, 0x746f, 0x745a, 0x7455, 0x745f, 0x745e, 0x7441, 0x743f, 0x7459, 0x745b, 0x745c, 0x7576, 0x7578, 0x7600, 0x75f0, 0x7601, 0x75f2, 0x75f1, 0x75fa, 0x75ff, 0x75f4, 0x75f3, 0x76de, 0x76df, 0x775b, 0x776b, 0x7766, 0x775e, 0x7763, 0x7779, 0x776a, 0x776c, 0x775c, 0x7765, 0x7768, 0x7762, 0x77ee, 0x788e, 0x78b0, 0x7897, 0x7898, 0x788c, 0x7889, 0x787c, 0x7891, 0x7893, 0x787f, 0x797a, 0x797f, 0x7981, 0x842c, 0x79bd, 0x7a1c, 0x7a1a, 0x7a20, 0x7a14, 0x7a1f, 0x7a1e, 0x7a9f, 0x7aa0, 0x7b77, 0x7bc0, 0x7b60, 0x7b6e, 0x7b67, 0x7cb1, 0x7cb3, 0x7cb5, 0x7d93, 0x7d79, 0x7d91, 0x7d81, 0x7d8f, 0x7d5b, 0x7f6e, 0x7f69, 0x7f6a, 0x7f72, 0x7fa9, 0x7fa8, 0x7fa4, 0x8056, 0x8058, 0x8086, 0x8084,
+		0x8171, 0x8170, 0x8178, 0x8165, 0x816e, 0x8173, 0x816b, 0x8179, 0x817a, 0x8166, 0x8205, 0x8247, 0x8482, 0x8477, 0x843d, 0x8431, 0x8475, 0x8466, 0x846b, 0x8449, 0x846c, 0x845b, 0x843c, 0x8435, 0x8461, 0x8463, 0x8469, 0x846d, 0x8446, 0x865e, 0x865c, 0x865f, 0x86f9, 0x8713, 0x8708, 0x8707, 0x8700, 0x86fe, 0x86fb, 0x8702, 0x8703, 0x8706, 0x870a, 0x8859, 0x88df, 0x88d4, 0x88d9, 0x88dc, 0x88d8, 0x88dd, 0x88e1, 0x88ca, 0x88d5, 0x88d2, 0x899c, 0x89e3, 0x8a6b, 0x8a72, 0x8a73, 0x8a66, 0x8a69, 0x8a70, 0x8a87, 0x8a7c, 0x8a63, 0x8aa0, 0x8a71, 0x8a85, 0x8a6d, 0x8a62, 0x8a6e, 0x8a6c, 0x8a79, 0x8a7b, 0x8a3e, 0x8a68, 0x8c62, 0x8c8a, 0x8c89, 0x8cca, 0x8cc7, 0x8cc8, 0x8cc4, 0x8cb2, 0x8cc3, 0x8cc2, 0x8cc5, 0x8de1, 0x8ddf, 0x8de8, 0x8def, 0x8df3, 0x8dfa, 0x8dea,
+		0x8de4, 0x8de6, 0x8eb2, 0x8f03, 0x8f09, 0x8efe, 0x8f0a, 0x8f9f, 0x8fb2, 0x904b, 0x904a, 0x9053, 0x9042, 0x9054, 0x903c, 0x9055, 0x9050, 0x9047, 0x904f, 0x904e, 0x904d, 0x9051, 0x903e, 0x9041, 0x9112, 0x9117, 0x916c, 0x916a, 0x9169, 0x91c9, 0x9237, 0x9257, 0x9238, 0x923d, 0x9240, 0x923e, 0x925b, 0x924b, 0x9264, 0x9251, 0x9234, 0x9249, 0x924d, 0x9245, 0x9239, 0x923f, 0x925a, 0x9598, 0x9698, 0x9694, 0x9695, 0x96cd, 0x96cb, 0x96c9, 0x96ca, 0x96f7, 0x96fb, 0x96f9, 0x96f6, 0x9756, 0x9774, 0x9776, 0x9810, 0x9811, 0x9813, 0x980a, 0x9812, 0x980c, 0x98fc, 0x98f4, 0x98fd, 0x98fe, 0x99b3, 0x99b1, 0x99b4, 0x9ae1, 0x9ce9, 0x9e82, 0x9f0e, 0x9f13, 0x9f20, 0x50e7, 0x50ee, 0x50e5, 0x50d6, 0x50ed, 0x50da, 0x50d5, 0x50cf, 0x50d1, 0x50f1, 0x50ce, 0x50e9, 0x5162,
+		0x51f3, 0x5283, 0x5282, 0x5331, 0x53ad, 0x55fe, 0x5600, 0x561b, 0x5617, 0x55fd, 0x5614, 0x5606, 0x5609, 0x560d, 0x560e, 0x55f7, 0x5616, 0x561f, 0x5608, 0x5610, 0x55f6, 0x5718, 0x5716, 0x5875, 0x587e, 0x5883, 0x5893, 0x588a, 0x5879, 0x5885, 0x587d, 0x58fd, 0x5925, 0x5922, 0x5924, 0x596a, 0x5969, 0x5ae1, 0x5ae6, 0x5ae9, 0x5ad7, 0x5ad6, 0x5ad8, 0x5ae3, 0x5b75, 0x5bde, 0x5be7, 0x5be1, 0x5be5, 0x5be6, 0x5be8, 0x5be2, 0x5be4, 0x5bdf, 0x5c0d, 0x5c62, 0x5d84, 0x5d87, 0x5e5b, 0x5e63, 0x5e55, 0x5e57, 0x5e54, 0x5ed3, 0x5ed6, 0x5f0a, 0x5f46, 0x5f70, 0x5fb9, 0x6147, 0x613f, 0x614b, 0x6177, 0x6162, 0x6163, 0x615f, 0x615a, 0x6158, 0x6175, 0x622a, 0x6487, 0x6458, 0x6454, 0x64a4, 0x6478, 0x645f, 0x647a, 0x6451, 0x6467, 0x6434, 0x646d, 0x647b, 0x6572, 0x65a1,
+		0x65d7, 0x65d6, 0x66a2, 0x66a8, 0x669d, 0x699c, 0x69a8, 0x6995, 0x69c1, 0x69ae, 0x69d3, 0x69cb, 0x699b, 0x69b7, 0x69bb, 0x69ab, 0x69b4, 0x69d0, 0x69cd, 0x69ad, 0x69cc, 0x69a6, 0x69c3, 0x69a3, 0x6b49, 0x6b4c, 0x6c33, 0x6f33, 0x6f14, 0x6efe, 0x6f13, 0x6ef4, 0x6f29, 0x6f3e, 0x6f20, 0x6f2c, 0x6f0f, 0x6f02, 0x6f22, 0x6eff, 0x6eef, 0x6f06, 0x6f31, 0x6f38, 0x6f32, 0x6f23, 0x6f15, 0x6f2b, 0x6f2f, 0x6f88, 0x6f2a, 0x6eec, 0x6f01, 0x6ef2, 0x6ecc, 0x6ef7, 0x7194, 0x7199, 0x717d, 0x718a, 0x7184, 0x7192, 0x723e, 0x7292, 0x7296, 0x7344, 0x7350, 0x7464, 0x7463, 0x746a, 0x7470, 0x746d, 0x7504, 0x7591, 0x7627, 0x760d, 0x760b, 0x7609, 0x7613, 0x76e1, 0x76e3, 0x7784, 0x777d, 0x777f, 0x7761, 0x78c1, 0x789f, 0x78a7, 0x78b3, 0x78a9, 0x78a3, 0x798e, 0x798f, 0x798d,
+		0x7a2e, 0x7a31, 0x7aaa, 0x7aa9, 0x7aed, 0x7aef, 0x7ba1, 0x7b95, 0x7b8b, 0x7b75, 0x7b97, 0x7b9d, 0x7b94, 0x7b8f, 0x7bb8, 0x7b87, 0x7b84, 0x7cb9, 0x7cbd, 0x7cbe, 0x7dbb, 0x7db0, 0x7d9c, 0x7dbd, 0x7dbe, 0x7da0, 0x7dca, 0x7db4, 0x7db2, 0x7db1, 0x7dba, 0x7da2, 0x7dbf, 0x7db5, 0x7db8, 0x7dad, 0x7dd2, 0x7dc7, 0x7dac, 0x7f70, 0x7fe0, 0x7fe1, 0x7fdf, 0x805e, 0x805a, 0x8087, 0x8150, 0x8180, 0x818f, 0x8188, 0x818a, 0x817f, 0x8182, 0x81e7, 0x81fa, 0x8207, 0x8214, 0x821e, 0x824b, 0x84c9, 0x84bf, 0x84c6, 0x84c4, 0x8499, 0x849e, 0x84b2, 0x849c, 0x84cb, 0x84b8, 0x84c0, 0x84d3, 0x8490, 0x84bc, 0x84d1, 0x84ca, 0x873f, 0x871c, 0x873b, 0x8722, 0x8725, 0x8734, 0x8718, 0x8755, 0x8737, 0x8729, 0x88f3, 0x8902, 0x88f4, 0x88f9, 0x88f8, 0x88fd, 0x88e8, 0x891a, 0x88ef,
+		0x8aa6, 0x8a8c, 0x8a9e, 0x8aa3, 0x8a8d, 0x8aa1, 0x8a93, 0x8aa4, 0x8aaa, 0x8aa5, 0x8aa8, 0x8a98, 0x8a91, 0x8a9a, 0x8aa7, 0x8c6a, 0x8c8d, 0x8c8c, 0x8cd3, 0x8cd1, 0x8cd2, 0x8d6b, 0x8d99, 0x8d95, 0x8dfc, 0x8f14, 0x8f12, 0x8f15, 0x8f13, 0x8fa3, 0x9060, 0x9058, 0x905c, 0x9063, 0x9059, 0x905e, 0x9062, 0x905d, 0x905b, 0x9119, 0x9118, 0x911e, 0x9175, 0x9178, 0x9177, 0x9174, 0x9278, 0x92ac, 0x9280, 0x9285, 0x9298, 0x9296, 0x927b, 0x9293, 0x929c, 0x92a8, 0x927c, 0x9291, 0x95a1, 0x95a8, 0x95a9, 0x95a3, 0x95a5, 0x95a4, 0x9699, 0x969c, 0x969b, 0x96cc, 0x96d2, 0x9700, 0x977c, 0x9785, 0x97f6, 0x9817, 0x9818, 0x98af, 0x98b1, 0x9903, 0x9905, 0x990c, 0x9909, 0x99c1, 0x9aaf, 0x9ab0, 0x9ae6, 0x9b41, 0x9b42, 0x9cf4, 0x9cf6, 0x9cf3, 0x9ebc, 0x9f3b, 0x9f4a, 0x5104,
+		0x5100, 0x50fb, 0x50f5, 0x50f9, 0x5102, 0x5108, 0x5109, 0x5105, 0x51dc, 0x5287, 0x5288, 0x5289, 0x528d, 0x528a, 0x52f0, 0x53b2, 0x562e, 0x563b, 0x5639, 0x5632, 0x563f, 0x5634, 0x5629, 0x5653, 0x564e, 0x5657, 0x5674, 0x5636, 0x562f, 0x5630, 0x5880, 0x589f, 0x589e, 0x58b3, 0x589c, 0x58ae, 0x58a9, 0x58a6, 0x596d, 0x5b09, 0x5afb, 0x5b0b, 0x5af5, 0x5b0c, 0x5b08, 0x5bee, 0x5bec, 0x5be9, 0x5beb, 0x5c64, 0x5c65, 0x5d9d, 0x5d94, 0x5e62, 0x5e5f, 0x5e61, 0x5ee2, 0x5eda, 0x5edf, 0x5edd, 0x5ee3, 0x5ee0, 0x5f48, 0x5f71, 0x5fb7, 0x5fb5, 0x6176, 0x6167, 0x616e, 0x615d, 0x6155, 0x6182, 0x617c, 0x6170, 0x616b, 0x617e, 0x61a7, 0x6190, 0x61ab, 0x618e, 0x61ac, 0x619a, 0x61a4, 0x6194, 0x61ae, 0x622e, 0x6469, 0x646f, 0x6479, 0x649e, 0x64b2, 0x6488, 0x6490, 0x64b0,
+		0x64a5, 0x6493, 0x6495, 0x64a9, 0x6492, 0x64ae, 0x64ad, 0x64ab, 0x649a, 0x64ac, 0x6499, 0x64a2, 0x64b3, 0x6575, 0x6577, 0x6578, 0x66ae, 0x66ab, 0x66b4, 0x66b1, 0x6a23, 0x6a1f, 0x69e8, 0x6a01, 0x6a1e, 0x6a19, 0x69fd, 0x6a21, 0x6a13, 0x6a0a, 0x69f3, 0x6a02, 0x6a05, 0x69ed, 0x6a11, 0x6b50, 0x6b4e, 0x6ba4, 0x6bc5, 0x6bc6, 0x6f3f, 0x6f7c, 0x6f84, 0x6f51, 0x6f66, 0x6f54, 0x6f86, 0x6f6d, 0x6f5b, 0x6f78, 0x6f6e, 0x6f8e, 0x6f7a, 0x6f70, 0x6f64, 0x6f97, 0x6f58, 0x6ed5, 0x6f6f, 0x6f60, 0x6f5f, 0x719f, 0x71ac, 0x71b1, 0x71a8, 0x7256, 0x729b, 0x734e, 0x7357, 0x7469, 0x748b, 0x7483, 0x747e, 0x7480, 0x757f, 0x7620, 0x7629, 0x761f, 0x7624, 0x7626, 0x7621, 0x7622, 0x769a, 0x76ba, 0x76e4, 0x778e, 0x7787, 0x778c, 0x7791, 0x778b, 0x78cb, 0x78c5, 0x78ba, 0x78ca,
+		0x78be, 0x78d5, 0x78bc, 0x78d0, 0x7a3f, 0x7a3c, 0x7a40, 0x7a3d, 0x7a37, 0x7a3b, 0x7aaf, 0x7aae, 0x7bad, 0x7bb1, 0x7bc4, 0x7bb4, 0x7bc6, 0x7bc7, 0x7bc1, 0x7ba0, 0x7bcc, 0x7cca, 0x7de0, 0x7df4, 0x7def, 0x7dfb, 0x7dd8, 0x7dec, 0x7ddd, 0x7de8, 0x7de3, 0x7dda, 0x7dde, 0x7de9, 0x7d9e, 0x7dd9, 0x7df2, 0x7df9, 0x7f75, 0x7f77, 0x7faf, 0x7fe9, 0x8026, 0x819b, 0x819c, 0x819d, 0x81a0, 0x819a, 0x8198, 0x8517, 0x853d, 0x851a, 0x84ee, 0x852c, 0x852d, 0x8513, 0x8511, 0x8523, 0x8521, 0x8514, 0x84ec, 0x8525, 0x84ff, 0x8506, 0x8782, 0x8774, 0x8776, 0x8760, 0x8766, 0x8778, 0x8768, 0x8759, 0x8757, 0x874c, 0x8753, 0x885b, 0x885d, 0x8910, 0x8907, 0x8912, 0x8913, 0x8915, 0x890a, 0x8abc, 0x8ad2, 0x8ac7, 0x8ac4, 0x8a95, 0x8acb, 0x8af8, 0x8ab2, 0x8ac9, 0x8ac2, 0x8abf,
+		0x8ab0, 0x8ad6, 0x8acd, 0x8ab6, 0x8ab9, 0x8adb, 0x8c4c, 0x8c4e, 0x8c6c, 0x8ce0, 0x8cde, 0x8ce6, 0x8ce4, 0x8cec, 0x8ced, 0x8ce2, 0x8ce3, 0x8cdc, 0x8cea, 0x8ce1, 0x8d6d, 0x8d9f, 0x8da3, 0x8e2b, 0x8e10, 0x8e1d, 0x8e22, 0x8e0f, 0x8e29, 0x8e1f, 0x8e21, 0x8e1e, 0x8eba, 0x8f1d, 0x8f1b, 0x8f1f, 0x8f29, 0x8f26, 0x8f2a, 0x8f1c, 0x8f1e, 0x8f25, 0x9069, 0x906e, 0x9068, 0x906d, 0x9077, 0x9130, 0x912d, 0x9127, 0x9131, 0x9187, 0x9189, 0x918b, 0x9183, 0x92c5, 0x92bb, 0x92b7, 0x92ea, 0x92e4, 0x92c1, 0x92b3, 0x92bc, 0x92d2, 0x92c7, 0x92f0, 0x92b2, 0x95ad, 0x95b1, 0x9704, 0x9706, 0x9707, 0x9709, 0x9760, 0x978d, 0x978b, 0x978f, 0x9821, 0x982b, 0x981c, 0x98b3, 0x990a, 0x9913, 0x9912, 0x9918, 0x99dd, 0x99d0, 0x99df, 0x99db, 0x99d1, 0x99d5, 0x99d2, 0x99d9, 0x9ab7,
+		0x9aee, 0x9aef, 0x9b27, 0x9b45, 0x9b44, 0x9b77, 0x9b6f, 0x9d06, 0x9d09, 0x9d03, 0x9ea9, 0x9ebe, 0x9ece, 0x58a8, 0x9f52, 0x5112, 0x5118, 0x5114, 0x5110, 0x5115, 0x5180, 0x51aa, 0x51dd, 0x5291, 0x5293, 0x52f3, 0x5659, 0x566b, 0x5679, 0x5669, 0x5664, 0x5678, 0x566a, 0x5668, 0x5665, 0x5671, 0x566f, 0x566c, 0x5662, 0x5676, 0x58c1, 0x58be, 0x58c7, 0x58c5, 0x596e, 0x5b1d, 0x5b34, 0x5b78, 0x5bf0, 0x5c0e, 0x5f4a, 0x61b2, 0x6191, 0x61a9, 0x618a, 0x61cd, 0x61b6, 0x61be, 0x61ca, 0x61c8, 0x6230, 0x64c5, 0x64c1, 0x64cb, 0x64bb, 0x64bc, 0x64da, 0x64c4, 0x64c7, 0x64c2, 0x64cd, 0x64bf, 0x64d2, 0x64d4, 0x64be, 0x6574, 0x66c6, 0x66c9, 0x66b9, 0x66c4, 0x66c7, 0x66b8, 0x6a3d, 0x6a38, 0x6a3a, 0x6a59, 0x6a6b, 0x6a58, 0x6a39, 0x6a44, 0x6a62, 0x6a61, 0x6a4b, 0x6a47,
+		0x6a35, 0x6a5f, 0x6a48, 0x6b59, 0x6b77, 0x6c05, 0x6fc2, 0x6fb1, 0x6fa1, 0x6fc3, 0x6fa4, 0x6fc1, 0x6fa7, 0x6fb3, 0x6fc0, 0x6fb9, 0x6fb6, 0x6fa6, 0x6fa0, 0x6fb4, 0x71be, 0x71c9, 0x71d0, 0x71d2, 0x71c8, 0x71d5, 0x71b9, 0x71ce, 0x71d9, 0x71dc, 0x71c3, 0x71c4, 0x7368, 0x749c, 0x74a3, 0x7498, 0x749f, 0x749e, 0x74e2, 0x750c, 0x750d, 0x7634, 0x7638, 0x763a, 0x76e7, 0x76e5, 0x77a0, 0x779e, 0x779f, 0x77a5, 0x78e8, 0x78da, 0x78ec, 0x78e7, 0x79a6, 0x7a4d, 0x7a4e, 0x7a46, 0x7a4c, 0x7a4b, 0x7aba, 0x7bd9, 0x7c11, 0x7bc9, 0x7be4, 0x7bdb, 0x7be1, 0x7be9, 0x7be6, 0x7cd5, 0x7cd6, 0x7e0a, 0x7e11, 0x7e08, 0x7e1b, 0x7e23, 0x7e1e, 0x7e1d, 0x7e09, 0x7e10, 0x7f79, 0x7fb2, 0x7ff0, 0x7ff1, 0x7fee, 0x8028, 0x81b3, 0x81a9, 0x81a8, 0x81fb, 0x8208, 0x8258, 0x8259, 0x854a,
+		0x8559, 0x8548, 0x8568, 0x8569, 0x8543, 0x8549, 0x856d, 0x856a, 0x855e, 0x8783, 0x879f, 0x879e, 0x87a2, 0x878d, 0x8861, 0x892a, 0x8932, 0x8925, 0x892b, 0x8921, 0x89aa, 0x89a6, 0x8ae6, 0x8afa, 0x8aeb, 0x8af1, 0x8b00, 0x8adc, 0x8ae7, 0x8aee, 0x8afe, 0x8b01, 0x8b02, 0x8af7, 0x8aed, 0x8af3, 0x8af6, 0x8afc, 0x8c6b, 0x8c6d, 0x8c93, 0x8cf4, 0x8e44, 0x8e31, 0x8e34, 0x8e42, 0x8e39, 0x8e35, 0x8f3b, 0x8f2f, 0x8f38, 0x8f33, 0x8fa8, 0x8fa6, 0x9075, 0x9074, 0x9078, 0x9072, 0x907c, 0x907a, 0x9134, 0x9192, 0x9320, 0x9336, 0x92f8, 0x9333, 0x932f, 0x9322, 0x92fc, 0x932b, 0x9304, 0x931a, 0x9310, 0x9326, 0x9321, 0x9315, 0x932e, 0x9319, 0x95bb, 0x96a7, 0x96a8, 0x96aa, 0x96d5, 0x970e, 0x9711, 0x9716, 0x970d, 0x9713, 0x970f, 0x975b, 0x975c, 0x9766, 0x9798, 0x9830,
+		0x9838, 0x983b, 0x9837, 0x982d, 0x9839, 0x9824, 0x9910, 0x9928, 0x991e, 0x991b, 0x9921, 0x991a, 0x99ed, 0x99e2, 0x99f1, 0x9ab8, 0x9abc, 0x9afb, 0x9aed, 0x9b28, 0x9b91, 0x9d15, 0x9d23, 0x9d26, 0x9d28, 0x9d12, 0x9d1b, 0x9ed8, 0x9ed4, 0x9f8d, 0x9f9c, 0x512a, 0x511f, 0x5121, 0x5132, 0x52f5, 0x568e, 0x5680, 0x5690, 0x5685, 0x5687, 0x568f, 0x58d5, 0x58d3, 0x58d1, 0x58ce, 0x5b30, 0x5b2a, 0x5b24, 0x5b7a, 0x5c37, 0x5c68, 0x5dbc, 0x5dba, 0x5dbd, 0x5db8, 0x5e6b, 0x5f4c, 0x5fbd, 0x61c9, 0x61c2, 0x61c7, 0x61e6, 0x61cb, 0x6232, 0x6234, 0x64ce, 0x64ca, 0x64d8, 0x64e0, 0x64f0, 0x64e6, 0x64ec, 0x64f1, 0x64e2, 0x64ed, 0x6582, 0x6583, 0x66d9, 0x66d6, 0x6a80, 0x6a94, 0x6a84, 0x6aa2, 0x6a9c, 0x6adb, 0x6aa3, 0x6a7e, 0x6a97, 0x6a90, 0x6aa0, 0x6b5c, 0x6bae, 0x6bda,
+		0x6c08, 0x6fd8, 0x6ff1, 0x6fdf, 0x6fe0, 0x6fdb, 0x6fe4, 0x6feb, 0x6fef, 0x6f80, 0x6fec, 0x6fe1, 0x6fe9, 0x6fd5, 0x6fee, 0x6ff0, 0x71e7, 0x71df, 0x71ee, 0x71e6, 0x71e5, 0x71ed, 0x71ec, 0x71f4, 0x71e0, 0x7235, 0x7246, 0x7370, 0x7372, 0x74a9, 0x74b0, 0x74a6, 0x74a8, 0x7646, 0x7642, 0x764c, 0x76ea, 0x77b3, 0x77aa, 0x77b0, 0x77ac, 0x77a7, 0x77ad, 0x77ef, 0x78f7, 0x78fa, 0x78f4, 0x78ef, 0x7901, 0x79a7, 0x79aa, 0x7a57, 0x7abf, 0x7c07, 0x7c0d, 0x7bfe, 0x7bf7, 0x7c0c, 0x7be0, 0x7ce0, 0x7cdc, 0x7cde, 0x7ce2, 0x7cdf, 0x7cd9, 0x7cdd, 0x7e2e, 0x7e3e, 0x7e46, 0x7e37, 0x7e32, 0x7e43, 0x7e2b, 0x7e3d, 0x7e31, 0x7e45, 0x7e41, 0x7e34, 0x7e39, 0x7e48, 0x7e35, 0x7e3f, 0x7e2f, 0x7f44, 0x7ff3, 0x7ffc, 0x8071, 0x8072, 0x8070, 0x806f, 0x8073, 0x81c6, 0x81c3, 0x81ba,
+		0x81c2, 0x81c0, 0x81bf, 0x81bd, 0x81c9, 0x81be, 0x81e8, 0x8209, 0x8271, 0x85aa, 0x8584, 0x857e, 0x859c, 0x8591, 0x8594, 0x85af, 0x859b, 0x8587, 0x85a8, 0x858a, 0x85a6, 0x8667, 0x87c0, 0x87d1, 0x87b3, 0x87d2, 0x87c6, 0x87ab, 0x87bb, 0x87ba, 0x87c8, 0x87cb, 0x893b, 0x8936, 0x8944, 0x8938, 0x893d, 0x89ac, 0x8b0e, 0x8b17, 0x8b19, 0x8b1b, 0x8b0a, 0x8b20, 0x8b1d, 0x8b04, 0x8b10, 0x8c41, 0x8c3f, 0x8c73, 0x8cfa, 0x8cfd, 0x8cfc, 0x8cf8, 0x8cfb, 0x8da8, 0x8e49, 0x8e4b, 0x8e48, 0x8e4a, 0x8f44, 0x8f3e, 0x8f42, 0x8f45, 0x8f3f, 0x907f, 0x907d, 0x9084, 0x9081, 0x9082, 0x9080, 0x9139, 0x91a3, 0x919e, 0x919c, 0x934d, 0x9382, 0x9328, 0x9375, 0x934a, 0x9365, 0x934b, 0x9318, 0x937e, 0x936c, 0x935b, 0x9370, 0x935a, 0x9354, 0x95ca, 0x95cb, 0x95cc, 0x95c8, 0x95c6,
+		0x96b1, 0x96b8, 0x96d6, 0x971c, 0x971e, 0x97a0, 0x97d3, 0x9846, 0x98b6, 0x9935, 0x9a01, 0x99ff, 0x9bae, 0x9bab, 0x9baa, 0x9bad, 0x9d3b, 0x9d3f, 0x9e8b, 0x9ecf, 0x9ede, 0x9edc, 0x9edd, 0x9edb, 0x9f3e, 0x9f4b, 0x53e2, 0x5695, 0x56ae, 0x58d9, 0x58d8, 0x5b38, 0x5f5e, 0x61e3, 0x6233, 0x64f4, 0x64f2, 0x64fe, 0x6506, 0x64fa, 0x64fb, 0x64f7, 0x65b7, 0x66dc, 0x6726, 0x6ab3, 0x6aac, 0x6ac3, 0x6abb, 0x6ab8, 0x6ac2, 0x6aae, 0x6aaf, 0x6b5f, 0x6b78, 0x6baf, 0x7009, 0x700b, 0x6ffe, 0x7006, 0x6ffa, 0x7011, 0x700f, 0x71fb, 0x71fc, 0x71fe, 0x71f8, 0x7377, 0x7375, 0x74a7, 0x74bf, 0x7515, 0x7656, 0x7658, 0x7652, 0x77bd, 0x77bf, 0x77bb, 0x77bc, 0x790e, 0x79ae, 0x7a61, 0x7a62, 0x7a60, 0x7ac4, 0x7ac5, 0x7c2b, 0x7c27, 0x7c2a, 0x7c1e, 0x7c23, 0x7c21, 0x7ce7, 0x7e54,
+		0x7e55, 0x7e5e, 0x7e5a, 0x7e61, 0x7e52, 0x7e59, 0x7f48, 0x7ff9, 0x7ffb, 0x8077, 0x8076, 0x81cd, 0x81cf, 0x820a, 0x85cf, 0x85a9, 0x85cd, 0x85d0, 0x85c9, 0x85b0, 0x85ba, 0x85b9, 0x87ef, 0x87ec, 0x87f2, 0x87e0, 0x8986, 0x89b2, 0x89f4, 0x8b28, 0x8b39, 0x8b2c, 0x8b2b, 0x8c50, 0x8d05, 0x8e59, 0x8e63, 0x8e66, 0x8e64, 0x8e5f, 0x8e55, 0x8ec0, 0x8f49, 0x8f4d, 0x9087, 0x9083, 0x9088, 0x91ab, 0x91ac, 0x91d0, 0x9394, 0x938a, 0x9396, 0x93a2, 0x93b3, 0x93ae, 0x93ac, 0x93b0, 0x9398, 0x939a, 0x9397, 0x95d4, 0x95d6, 0x95d0, 0x95d5, 0x96e2, 0x96dc, 0x96d9, 0x96db, 0x96de, 0x9724, 0x97a3, 0x97a6, 0x97ad, 0x97f9, 0x984d, 0x984f, 0x984c, 0x984e, 0x9853, 0x98ba, 0x993e, 0x993f, 0x993d, 0x992e, 0x99a5, 0x9a0e, 0x9ac1, 0x9b03, 0x9b06, 0x9b4f, 0x9b4e, 0x9b4d, 0x9bca,
+		0x9bc9, 0x9bfd, 0x9bc8, 0x9bc0, 0x9d51, 0x9d5d, 0x9d60, 0x9ee0, 0x9f15, 0x9f2c, 0x5133, 0x56a5, 0x56a8, 0x58de, 0x58df, 0x58e2, 0x5bf5, 0x9f90, 0x5eec, 0x61f2, 0x61f7, 0x61f6, 0x61f5, 0x6500, 0x650f, 0x66e0, 0x66dd, 0x6ae5, 0x6add, 0x6ada, 0x6ad3, 0x701b, 0x701f, 0x7028, 0x701a, 0x701d, 0x7015, 0x7018, 0x7206, 0x720d, 0x7258, 0x72a2, 0x7378, 0x737a, 0x74bd, 0x74ca, 0x74e3, 0x7587, 0x7586, 0x765f, 0x7661, 0x77c7, 0x7919, 0x79b1, 0x7a6b, 0x7a69, 0x7c3e, 0x7c3f, 0x7c38, 0x7c3d, 0x7c37, 0x7c40, 0x7e6b, 0x7e6d, 0x7e79, 0x7e69, 0x7e6a, 0x7e73, 0x7f85, 0x7fb6, 0x7fb9, 0x7fb8, 0x81d8, 0x85e9, 0x85dd, 0x85ea, 0x85d5, 0x85e4, 0x85e5, 0x85f7, 0x87fb, 0x8805, 0x880d, 0x87f9, 0x87fe, 0x8960, 0x895f, 0x8956, 0x895e, 0x8b41, 0x8b5c, 0x8b58, 0x8b49, 0x8b5a,
+		0x8b4e, 0x8b4f, 0x8b46, 0x8b59, 0x8d08, 0x8d0a, 0x8e7c, 0x8e72, 0x8e87, 0x8e76, 0x8e6c, 0x8e7a, 0x8e74, 0x8f54, 0x8f4e, 0x8fad, 0x908a, 0x908b, 0x91b1, 0x91ae, 0x93e1, 0x93d1, 0x93df, 0x93c3, 0x93c8, 0x93dc, 0x93dd, 0x93d6, 0x93e2, 0x93cd, 0x93d8, 0x93e4, 0x93d7, 0x93e8, 0x95dc, 0x96b4, 0x96e3, 0x972a, 0x9727, 0x9761, 0x97dc, 0x97fb, 0x985e, 0x9858, 0x985b, 0x98bc, 0x9945, 0x9949, 0x9a16, 0x9a19, 0x9b0d, 0x9be8, 0x9be7, 0x9bd6, 0x9bdb, 0x9d89, 0x9d61, 0x9d72, 0x9d6a, 0x9d6c, 0x9e92, 0x9e97, 0x9e93, 0x9eb4, 0x52f8, 0x56b7, 0x56b6, 0x56b4, 0x56bc, 0x58e4, 0x5b40, 0x5b43, 0x5b7d, 0x5bf6, 0x5dc9, 0x61f8, 0x61fa, 0x6518, 0x6514, 0x6519, 0x66e6, 0x6727, 0x6aec, 0x703e, 0x7030, 0x7032, 0x7210, 0x737b, 0x74cf, 0x7662, 0x7665, 0x7926, 0x792a, 0x792c,
+		0x792b, 0x7ac7, 0x7af6, 0x7c4c, 0x7c43, 0x7c4d, 0x7cef, 0x7cf0, 0x8fae, 0x7e7d, 0x7e7c, 0x7e82, 0x7f4c, 0x8000, 0x81da, 0x8266, 0x85fb, 0x85f9, 0x8611, 0x85fa, 0x8606, 0x860b, 0x8607, 0x860a, 0x8814, 0x8815, 0x8964, 0x89ba, 0x89f8, 0x8b70, 0x8b6c, 0x8b66, 0x8b6f, 0x8b5f, 0x8b6b, 0x8d0f, 0x8d0d, 0x8e89, 0x8e81, 0x8e85, 0x8e82, 0x91b4, 0x91cb, 0x9418, 0x9403, 0x93fd, 0x95e1, 0x9730, 0x98c4, 0x9952, 0x9951, 0x99a8, 0x9a2b, 0x9a30, 0x9a37, 0x9a35, 0x9c13, 0x9c0d, 0x9e79, 0x9eb5, 0x9ee8, 0x9f2f, 0x9f5f, 0x9f63, 0x9f61, 0x5137, 0x5138, 0x56c1, 0x56c0, 0x56c2, 0x5914, 0x5c6c, 0x5dcd, 0x61fc, 0x61fe, 0x651d, 0x651c, 0x6595, 0x66e9, 0x6afb, 0x6b04, 0x6afa, 0x6bb2, 0x704c, 0x721b, 0x72a7, 0x74d6, 0x74d4, 0x7669, 0x77d3, 0x7c50, 0x7e8f, 0x7e8c, 0x7fbc,
+		0x8617, 0x862d, 0x861a, 0x8823, 0x8822, 0x8821, 0x881f, 0x896a, 0x896c, 0x89bd, 0x8b74, 0x8b77, 0x8b7d, 0x8d13, 0x8e8a, 0x8e8d, 0x8e8b, 0x8f5f, 0x8faf, 0x91ba, 0x942e, 0x9433, 0x9435, 0x943a, 0x9438, 0x9432, 0x942b, 0x95e2, 0x9738, 0x9739, 0x9732, 0x97ff, 0x9867, 0x9865, 0x9957, 0x9a45, 0x9a43, 0x9a40, 0x9a3e, 0x9acf, 0x9b54, 0x9b51, 0x9c2d, 0x9c25, 0x9daf, 0x9db4, 0x9dc2, 0x9db8, 0x9e9d, 0x9eef, 0x9f19, 0x9f5c, 0x9f66, 0x9f67, 0x513c, 0x513b, 0x56c8, 0x56ca, 0x56c9, 0x5b7f, 0x5dd4, 0x5dd2, 0x5f4e, 0x61ff, 0x6524, 0x6b0a, 0x6b61, 0x7051, 0x7058, 0x7380, 0x74e4, 0x758a, 0x766e, 0x766c, 0x79b3, 0x7c60, 0x7c5f, 0x807e, 0x807d, 0x81df, 0x8972, 0x896f, 0x89fc, 0x8b80, 0x8d16, 0x8d17, 0x8e91, 0x8e93, 0x8f61, 0x9148, 0x9444, 0x9451, 0x9452, 0x973d,
+		0x973e, 0x97c3, 0x97c1, 0x986b, 0x9955, 0x9a55, 0x9a4d, 0x9ad2, 0x9b1a, 0x9c49, 0x9c31, 0x9c3e, 0x9c3b, 0x9dd3, 0x9dd7, 0x9f34, 0x9f6c, 0x9f6a, 0x9f94, 0x56cc, 0x5dd6, 0x6200, 0x6523, 0x652b, 0x652a, 0x66ec, 0x6b10, 0x74da, 0x7aca, 0x7c64, 0x7c63, 0x7c65, 0x7e93, 0x7e96, 0x7e94, 0x81e2, 0x8638, 0x863f, 0x8831, 0x8b8a, 0x9090, 0x908f, 0x9463, 0x9460, 0x9464, 0x9768, 0x986f, 0x995c, 0x9a5a, 0x9a5b, 0x9a57, 0x9ad3, 0x9ad4, 0x9ad1, 0x9c54, 0x9c57, 0x9c56, 0x9de5, 0x9e9f, 0x9ef4, 0x56d1, 0x58e9, 0x652c, 0x705e, 0x7671, 0x7672, 0x77d7, 0x7f50, 0x7f88, 0x8836, 0x8839, 0x8862, 0x8b93, 0x8b92, 0x8b96, 0x8277, 0x8d1b, 0x91c0, 0x946a, 0x9742, 0x9748, 0x9744, 0x97c6, 0x9870, 0x9a5f, 0x9b22, 0x9b58, 0x9c5f, 0x9df9, 0x9dfa, 0x9e7c, 0x9e7d, 0x9f07, 0x9f77,
+		0x9f72, 0x5ef3, 0x6b16, 0x7063, 0x7c6c, 0x7c6e, 0x883b, 0x89c0, 0x8ea1, 0x91c1, 0x9472, 0x9470, 0x9871, 0x995e, 0x9ad6, 0x9b23, 0x9ecc, 0x7064, 0x77da, 0x8b9a, 0x9477, 0x97c9, 0x9a62, 0x9a65, 0x7e9c, 0x8b9c, 0x8eaa, 0x91c5, 0x947d, 0x947e, 0x947c, 0x9c77, 0x9c78, 0x9ef7, 0x8c54, 0x947f, 0x9e1a, 0x7228, 0x9a6a, 0x9b31, 0x9e1b, 0x9e1e, 0x7c72, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 2
+		0x4e42, 0x4e5c, 0x51f5, 0x531a, 0x5382, 0x4e07, 0x4e0c, 0x4e47, 0x4e8d, 0x56d7, 0x5c6e, 0x5f73, 0x4e0f, 0x5187, 0x4e0e, 0x4e2e, 0x4e93, 0x4ec2, 0x4ec9, 0x4ec8, 0x5198, 0x52fc, 0x536c, 0x53b9, 0x5720, 0x5903, 0x592c, 0x5c10, 0x5dff, 0x65e1, 0x6bb3, 0x6bcc, 0x6c14, 0x723f, 0x4e31, 0x4e3c, 0x4ee8, 0x4edc, 0x4ee9, 0x4ee1, 0x4edd, 0x4eda, 0x520c, 0x5209, 0x531c, 0x534c, 0x5722, 0x5723, 0x5917, 0x592f, 0x5b81, 0x5b84, 0x5c12, 0x5c3b, 0x5c74, 0x5c73, 0x5e04, 0x5e80, 0x5e82, 0x5fc9, 0x6209, 0x6250, 0x6c15, 0x6c36, 0x6c43, 0x6c3f, 0x6c3b, 0x72ae, 0x72b0, 0x738a, 0x79b8, 0x808a, 0x961e, 0x4f0e, 0x4f18, 0x4f2c, 0x4ef5, 0x4f14, 0x4ef1, 0x4f00, 0x4ef7, 0x4f08, 0x4f1d, 0x4f02, 0x4f05, 0x4f22, 0x4f13, 0x4f04, 0x4ef4, 0x4f12, 0x51b1, 0x5213, 0x5210, 0x52a6,
+		0x5322, 0x531f, 0x534d, 0x538a, 0x5407, 0x56e1, 0x56df, 0x572e, 0x572a, 0x5734, 0x593c, 0x5980, 0x597c, 0x5985, 0x597b, 0x597e, 0x5977, 0x597f, 0x5b56, 0x5c15, 0x5c25, 0x5c7c, 0x5c7a, 0x5c7b, 0x5c7e, 0x5ddf, 0x5e75, 0x5e84, 0x5f02, 0x5f1a, 0x5f74, 0x5fd5, 0x5fd4, 0x5fcf, 0x625c, 0x625e, 0x6264, 0x6261, 0x6266, 0x6262, 0x6259, 0x6260, 0x625a, 0x6265, 0x6537, 0x65ef, 0x65ee, 0x673e, 0x6739, 0x6738, 0x673b, 0x673a, 0x673f, 0x673c, 0x6733, 0x6c18, 0x6c46, 0x6c52, 0x6c5c, 0x6c4f, 0x6c4a, 0x6c54, 0x6c4b, 0x6c4c, 0x7071, 0x725e, 0x72b4, 0x72b5, 0x738e, 0x752a, 0x767f, 0x7a75, 0x7f51, 0x8278, 0x827c, 0x8280, 0x827d, 0x827f, 0x864d, 0x897e, 0x9099, 0x9097, 0x9098, 0x909b, 0x9094, 0x9622, 0x9624, 0x9620, 0x9623, 0x4f56, 0x4f3b, 0x4f62, 0x4f49, 0x4f53,
+		0x4f64, 0x4f3e, 0x4f67, 0x4f52, 0x4f5f, 0x4f41, 0x4f58, 0x4f2d, 0x4f33, 0x4f3f, 0x4f61, 0x518f, 0x51b9, 0x521c, 0x521e, 0x5221, 0x52ad, 0x52ae, 0x5309, 0x5363, 0x5372, 0x538e, 0x538f, 0x5430, 0x5437, 0x542a, 0x5454, 0x5445, 0x5419, 0x541c, 0x5425, 0x5418, 0x543d, 0x544f, 0x5441, 0x5428, 0x5424, 0x5447, 0x56ee, 0x56e7, 0x56e5, 0x5741, 0x5745, 0x574c, 0x5749, 0x574b, 0x5752, 0x5906, 0x5940, 0x59a6, 0x5998, 0x59a0, 0x5997, 0x598e, 0x59a2, 0x5990, 0x598f, 0x59a7, 0x59a1, 0x5b8e, 0x5b92, 0x5c28, 0x5c2a, 0x5c8d, 0x5c8f, 0x5c88, 0x5c8b, 0x5c89, 0x5c92, 0x5c8a, 0x5c86, 0x5c93, 0x5c95, 0x5de0, 0x5e0a, 0x5e0e, 0x5e8b, 0x5e89, 0x5e8c, 0x5e88, 0x5e8d, 0x5f05, 0x5f1d, 0x5f78, 0x5f76, 0x5fd2, 0x5fd1, 0x5fd0, 0x5fed, 0x5fe8, 0x5fee, 0x5ff3, 0x5fe1, 0x5fe4,
+		0x5fe3, 0x5ffa, 0x5fef, 0x5ff7, 0x5ffb, 0x6000, 0x5ff4, 0x623a, 0x6283, 0x628c, 0x628e, 0x628f, 0x6294, 0x6287, 0x6271, 0x627b, 0x627a, 0x6270, 0x6281, 0x6288, 0x6277, 0x627d, 0x6272, 0x6274, 0x65f0, 0x65f4, 0x65f3, 0x65f2, 0x65f5, 0x6745, 0x6747, 0x6759, 0x6755, 0x674c, 0x6748, 0x675d, 0x674d, 0x675a, 0x674b, 0x6bd0, 0x6c19, 0x6c1a, 0x6c78, 0x6c67, 0x6c6b, 0x6c84, 0x6c8b, 0x6c8f, 0x6c71, 0x6c6f, 0x6c69, 0x6c9a, 0x6c6d, 0x6c87, 0x6c95, 0x6c9c, 0x6c66, 0x6c73, 0x6c65, 0x6c7b, 0x6c8e, 0x7074, 0x707a, 0x7263, 0x72bf, 0x72bd, 0x72c3, 0x72c6, 0x72c1, 0x72ba, 0x72c5, 0x7395, 0x7397, 0x7393, 0x7394, 0x7392, 0x753a, 0x7539, 0x7594, 0x7595, 0x7681, 0x793d, 0x8034, 0x8095, 0x8099, 0x8090, 0x8092, 0x809c, 0x8290, 0x828f, 0x8285, 0x828e, 0x8291, 0x8293,
+		0x828a, 0x8283, 0x8284, 0x8c78, 0x8fc9, 0x8fbf, 0x909f, 0x90a1, 0x90a5, 0x909e, 0x90a7, 0x90a0, 0x9630, 0x9628, 0x962f, 0x962d, 0x4e33, 0x4f98, 0x4f7c, 0x4f85, 0x4f7d, 0x4f80, 0x4f87, 0x4f76, 0x4f74, 0x4f89, 0x4f84, 0x4f77, 0x4f4c, 0x4f97, 0x4f6a, 0x4f9a, 0x4f79, 0x4f81, 0x4f78, 0x4f90, 0x4f9c, 0x4f94, 0x4f9e, 0x4f92, 0x4f82, 0x4f95, 0x4f6b, 0x4f6e, 0x519e, 0x51bc, 0x51be, 0x5235, 0x5232, 0x5233, 0x5246, 0x5231, 0x52bc, 0x530a, 0x530b, 0x533c, 0x5392, 0x5394, 0x5487, 0x547f, 0x5481, 0x5491, 0x5482, 0x5488, 0x546b, 0x547a, 0x547e, 0x5465, 0x546c, 0x5474, 0x5466, 0x548d, 0x546f, 0x5461, 0x5460, 0x5498, 0x5463, 0x5467, 0x5464, 0x56f7, 0x56f9, 0x576f, 0x5772, 0x576d, 0x576b, 0x5771, 0x5770, 0x5776, 0x5780, 0x5775, 0x577b, 0x5773, 0x5774, 0x5762,
+		0x5768, 0x577d, 0x590c, 0x5945, 0x59b5, 0x59ba, 0x59cf, 0x59ce, 0x59b2, 0x59cc, 0x59c1, 0x59b6, 0x59bc, 0x59c3, 0x59d6, 0x59b1, 0x59bd, 0x59c0, 0x59c8, 0x59b4, 0x59c7, 0x5b62, 0x5b65, 0x5b93, 0x5b95, 0x5c44, 0x5c47, 0x5cae, 0x5ca4, 0x5ca0, 0x5cb5, 0x5caf, 0x5ca8, 0x5cac, 0x5c9f, 0x5ca3, 0x5cad, 0x5ca2, 0x5caa, 0x5ca7, 0x5c9d, 0x5ca5, 0x5cb6, 0x5cb0, 0x5ca6, 0x5e17, 0x5e14, 0x5e19, 0x5f28, 0x5f22, 0x5f23, 0x5f24, 0x5f54, 0x5f82, 0x5f7e, 0x5f7d, 0x5fde, 0x5fe5, 0x602d, 0x6026, 0x6019, 0x6032, 0x600b, 0x6034, 0x600a, 0x6017, 0x6033, 0x601a, 0x601e, 0x602c, 0x6022, 0x600d, 0x6010, 0x602e, 0x6013, 0x6011, 0x600c, 0x6009, 0x601c, 0x6214, 0x623d, 0x62ad, 0x62b4, 0x62d1, 0x62be, 0x62aa, 0x62b6, 0x62ca, 0x62ae, 0x62b3, 0x62af, 0x62bb, 0x62a9, 0x62b0,
+		0x62b8, 0x653d, 0x65a8, 0x65bb, 0x6609, 0x65fc, 0x6604, 0x6612, 0x6608, 0x65fb, 0x6603, 0x660b, 0x660d, 0x6605, 0x65fd, 0x6611, 0x6610, 0x66f6, 0x670a, 0x6785, 0x676c, 0x678e, 0x6792, 0x6776, 0x677b, 0x6798, 0x6786, 0x6784, 0x6774, 0x678d, 0x678c, 0x677a, 0x679f, 0x6791, 0x6799, 0x6783, 0x677d, 0x6781, 0x6778, 0x6779, 0x6794, 0x6b25, 0x6b80, 0x6b7e, 0x6bde, 0x6c1d, 0x6c93, 0x6cec, 0x6ceb, 0x6cee, 0x6cd9, 0x6cb6, 0x6cd4, 0x6cad, 0x6ce7, 0x6cb7, 0x6cd0, 0x6cc2, 0x6cba, 0x6cc3, 0x6cc6, 0x6ced, 0x6cf2, 0x6cd2, 0x6cdd, 0x6cb4, 0x6c8a, 0x6c9d, 0x6c80, 0x6cde, 0x6cc0, 0x6d30, 0x6ccd, 0x6cc7, 0x6cb0, 0x6cf9, 0x6ccf, 0x6ce9, 0x6cd1, 0x7094, 0x7098, 0x7085, 0x7093, 0x7086, 0x7084, 0x7091, 0x7096, 0x7082, 0x709a, 0x7083, 0x726a, 0x72d6, 0x72cb, 0x72d8,
+		0x72c9, 0x72dc, 0x72d2, 0x72d4, 0x72da, 0x72cc, 0x72d1, 0x73a4, 0x73a1, 0x73ad, 0x73a6, 0x73a2, 0x73a0, 0x73ac, 0x739d, 0x74dd, 0x74e8, 0x753f, 0x7540, 0x753e, 0x758c, 0x7598, 0x76af, 0x76f3, 0x76f1, 0x76f0, 0x76f5, 0x77f8, 0x77fc, 0x77f9, 0x77fb, 0x77fa, 0x77f7, 0x7942, 0x793f, 0x79c5, 0x7a78, 0x7a7b, 0x7afb, 0x7c75, 0x7cfd, 0x8035, 0x808f, 0x80ae, 0x80a3, 0x80b8, 0x80b5, 0x80ad, 0x8220, 0x82a0, 0x82c0, 0x82ab, 0x829a, 0x8298, 0x829b, 0x82b5, 0x82a7, 0x82ae, 0x82bc, 0x829e, 0x82ba, 0x82b4, 0x82a8, 0x82a1, 0x82a9, 0x82c2, 0x82a4, 0x82c3, 0x82b6, 0x82a2, 0x8670, 0x866f, 0x866d, 0x866e, 0x8c56, 0x8fd2, 0x8fcb, 0x8fd3, 0x8fcd, 0x8fd6, 0x8fd5, 0x8fd7, 0x90b2, 0x90b4, 0x90af, 0x90b3, 0x90b0, 0x9639, 0x963d, 0x963c, 0x963a, 0x9643, 0x4fcd, 0x4fc5,
+		0x4fd3, 0x4fb2, 0x4fc9, 0x4fcb, 0x4fc1, 0x4fd4, 0x4fdc, 0x4fd9, 0x4fbb, 0x4fb3, 0x4fdb, 0x4fc7, 0x4fd6, 0x4fba, 0x4fc0, 0x4fb9, 0x4fec, 0x5244, 0x5249, 0x52c0, 0x52c2, 0x533d, 0x537c, 0x5397, 0x5396, 0x5399, 0x5398, 0x54ba, 0x54a1, 0x54ad, 0x54a5, 0x54cf, 0x54c3, 0x830d, 0x54b7, 0x54ae, 0x54d6, 0x54b6, 0x54c5, 0x54c6, 0x54a0, 0x5470, 0x54bc, 0x54a2, 0x54be, 0x5472, 0x54de, 0x54b0, 0x57b5, 0x579e, 0x579f, 0x57a4, 0x578c, 0x5797, 0x579d, 0x579b, 0x5794, 0x5798, 0x578f, 0x5799, 0x57a5, 0x579a, 0x5795, 0x58f4, 0x590d, 0x5953, 0x59e1, 0x59de, 0x59ee, 0x5a00, 0x59f1, 0x59dd, 0x59fa, 0x59fd, 0x59fc, 0x59f6, 0x59e4, 0x59f2, 0x59f7, 0x59db, 0x59e9, 0x59f3, 0x59f5, 0x59e0, 0x59fe, 0x59f4, 0x59ed, 0x5ba8, 0x5c4c, 0x5cd0, 0x5cd8, 0x5ccc, 0x5cd7, 0x5ccb,
+		0x5cdb, 0x5cde, 0x5cda, 0x5cc9, 0x5cc7, 0x5cca, 0x5cd6, 0x5cd3, 0x5cd4, 0x5ccf, 0x5cc8, 0x5cc6, 0x5cce, 0x5cdf, 0x5cf8, 0x5df9, 0x5e21, 0x5e22, 0x5e23, 0x5e20, 0x5e24, 0x5eb0, 0x5ea4, 0x5ea2, 0x5e9b, 0x5ea3, 0x5ea5, 0x5f07, 0x5f2e, 0x5f56, 0x5f86, 0x6037, 0x6039, 0x6054, 0x6072, 0x605e, 0x6045, 0x6053, 0x6047, 0x6049, 0x605b, 0x604c, 0x6040, 0x6042, 0x605f, 0x6024, 0x6044, 0x6058, 0x6066, 0x606e, 0x6242, 0x6243, 0x62cf, 0x630d, 0x630b, 0x62f5, 0x630e, 0x6303, 0x62eb, 0x62f9, 0x630f, 0x630c, 0x62f8, 0x62f6, 0x6300, 0x6313, 0x6314, 0x62fa, 0x6315, 0x62fb, 0x62f0, 0x6541, 0x6543, 0x65aa, 0x65bf, 0x6636, 0x6621, 0x6632, 0x6635, 0x661c, 0x6626, 0x6622, 0x6633, 0x662b, 0x663a, 0x661d, 0x6634, 0x6639, 0x662e, 0x670f, 0x6710, 0x67c1, 0x67f2, 0x67c8,
+		0x67ba, 0x67dc, 0x67bb, 0x67f8, 0x67d8, 0x67c0, 0x67b7, 0x67c5, 0x67eb, 0x67e4, 0x67df, 0x67b5, 0x67cd, 0x67b3, 0x67f7, 0x67f6, 0x67ee, 0x67e3, 0x67c2, 0x67b9, 0x67ce, 0x67e7, 0x67f0, 0x67b2, 0x67fc, 0x67c6, 0x67ed, 0x67cc, 0x67ae, 0x67e6, 0x67db, 0x67fa, 0x67c9, 0x67ca, 0x67c3, 0x67ea, 0x67cb, 0x6b28, 0x6b82, 0x6b84, 0x6bb6, 0x6bd6, 0x6bd8, 0x6be0, 0x6c20, 0x6c21, 0x6d28, 0x6d34, 0x6d2d, 0x6d1f, 0x6d3c, 0x6d3f, 0x6d12, 0x6d0a, 0x6cda, 0x6d33, 0x6d04, 0x6d19, 0x6d3a, 0x6d1a, 0x6d11, 0x6d00, 0x6d1d, 0x6d42, 0x6d01, 0x6d18, 0x6d37, 0x6d03, 0x6d0f, 0x6d40, 0x6d07, 0x6d20, 0x6d2c, 0x6d08, 0x6d22, 0x6d09, 0x6d10, 0x70b7, 0x709f, 0x70be, 0x70b1, 0x70b0, 0x70a1, 0x70b4, 0x70b5, 0x70a9, 0x7241, 0x7249, 0x724a, 0x726c, 0x7270, 0x7273, 0x726e, 0x72ca,
+		0x72e4, 0x72e8, 0x72eb, 0x72df, 0x72ea, 0x72e6, 0x72e3, 0x7385, 0x73cc, 0x73c2, 0x73c8, 0x73c5, 0x73b9, 0x73b6, 0x73b5, 0x73b4, 0x73eb, 0x73bf, 0x73c7, 0x73be, 0x73c3, 0x73c6, 0x73b8, 0x73cb, 0x74ec, 0x74ee, 0x752e, 0x7547, 0x7548, 0x75a7, 0x75aa, 0x7679, 0x76c4, 0x7708, 0x7703, 0x7704, 0x7705, 0x770a, 0x76f7, 0x76fb, 0x76fa, 0x77e7, 0x77e8, 0x7806, 0x7811, 0x7812, 0x7805, 0x7810, 0x780f, 0x780e, 0x7809, 0x7803, 0x7813, 0x794a, 0x794c, 0x794b, 0x7945, 0x7944, 0x79d5, 0x79cd, 0x79cf, 0x79d6, 0x79ce, 0x7a80, 0x7a7e, 0x7ad1, 0x7b00, 0x7b01, 0x7c7a, 0x7c78, 0x7c79, 0x7c7f, 0x7c80, 0x7c81, 0x7d03, 0x7d08, 0x7d01, 0x7f58, 0x7f91, 0x7f8d, 0x7fbe, 0x8007, 0x800e, 0x800f, 0x8014, 0x8037, 0x80d8, 0x80c7, 0x80e0, 0x80d1, 0x80c8, 0x80c2, 0x80d0, 0x80c5,
+		0x80e3, 0x80d9, 0x80dc, 0x80ca, 0x80d5, 0x80c9, 0x80cf, 0x80d7, 0x80e6, 0x80cd, 0x81ff, 0x8221, 0x8294, 0x82d9, 0x82fe, 0x82f9, 0x8307, 0x82e8, 0x8300, 0x82d5, 0x833a, 0x82eb, 0x82d6, 0x82f4, 0x82ec, 0x82e1, 0x82f2, 0x82f5, 0x830c, 0x82fb, 0x82f6, 0x82f0, 0x82ea, 0x82e4, 0x82e0, 0x82fa, 0x82f3, 0x82ed, 0x8677, 0x8674, 0x867c, 0x8673, 0x8841, 0x884e, 0x8867, 0x886a, 0x8869, 0x89d3, 0x8a04, 0x8a07, 0x8d72, 0x8fe3, 0x8fe1, 0x8fee, 0x8fe0, 0x90f1, 0x90bd, 0x90bf, 0x90d5, 0x90c5, 0x90be, 0x90c7, 0x90cb, 0x90c8, 0x91d4, 0x91d3, 0x9654, 0x964f, 0x9651, 0x9653, 0x964a, 0x964e, 0x501e, 0x5005, 0x5007, 0x5013, 0x5022, 0x5030, 0x501b, 0x4ff5, 0x4ff4, 0x5033, 0x5037, 0x502c, 0x4ff6, 0x4ff7, 0x5017, 0x501c, 0x5020, 0x5027, 0x5035, 0x502f, 0x5031, 0x500e,
+		0x515a, 0x5194, 0x5193, 0x51ca, 0x51c4, 0x51c5, 0x51c8, 0x51ce, 0x5261, 0x525a, 0x5252, 0x525e, 0x525f, 0x5255, 0x5262, 0x52cd, 0x530e, 0x539e, 0x5526, 0x54e2, 0x5517, 0x5512, 0x54e7, 0x54f3, 0x54e4, 0x551a, 0x54ff, 0x5504, 0x5508, 0x54eb, 0x5511, 0x5505, 0x54f1, 0x550a, 0x54fb, 0x54f7, 0x54f8, 0x54e0, 0x550e, 0x5503, 0x550b, 0x5701, 0x5702, 0x57cc, 0x5832, 0x57d5, 0x57d2, 0x57ba, 0x57c6, 0x57bd, 0x57bc, 0x57b8, 0x57b6, 0x57bf, 0x57c7, 0x57d0, 0x57b9, 0x57c1, 0x590e, 0x594a, 0x5a19, 0x5a16, 0x5a2d, 0x5a2e, 0x5a15, 0x5a0f, 0x5a17, 0x5a0a, 0x5a1e, 0x5a33, 0x5b6c, 0x5ba7, 0x5bad, 0x5bac, 0x5c03, 0x5c56, 0x5c54, 0x5cec, 0x5cff, 0x5cee, 0x5cf1, 0x5cf7, 0x5d00, 0x5cf9, 0x5e29, 0x5e28, 0x5ea8, 0x5eae, 0x5eaa, 0x5eac, 0x5f33, 0x5f30, 0x5f67, 0x605d,
+		0x605a, 0x6067, 0x6041, 0x60a2, 0x6088, 0x6080, 0x6092, 0x6081, 0x609d, 0x6083, 0x6095, 0x609b, 0x6097, 0x6087, 0x609c, 0x608e, 0x6219, 0x6246, 0x62f2, 0x6310, 0x6356, 0x632c, 0x6344, 0x6345, 0x6336, 0x6343, 0x63e4, 0x6339, 0x634b, 0x634a, 0x633c, 0x6329, 0x6341, 0x6334, 0x6358, 0x6354, 0x6359, 0x632d, 0x6347, 0x6333, 0x635a, 0x6351, 0x6338, 0x6357, 0x6340, 0x6348, 0x654a, 0x6546, 0x65c6, 0x65c3, 0x65c4, 0x65c2, 0x664a, 0x665f, 0x6647, 0x6651, 0x6712, 0x6713, 0x681f, 0x681a, 0x6849, 0x6832, 0x6833, 0x683b, 0x684b, 0x684f, 0x6816, 0x6831, 0x681c, 0x6835, 0x682b, 0x682d, 0x682f, 0x684e, 0x6844, 0x6834, 0x681d, 0x6812, 0x6814, 0x6826, 0x6828, 0x682e, 0x684d, 0x683a, 0x6825, 0x6820, 0x6b2c, 0x6b2f, 0x6b2d, 0x6b31, 0x6b34, 0x6b6d, 0x8082, 0x6b88,
+		0x6be6, 0x6be4, 0x6be8, 0x6be3, 0x6be2, 0x6be7, 0x6c25, 0x6d7a, 0x6d63, 0x6d64, 0x6d76, 0x6d0d, 0x6d61, 0x6d92, 0x6d58, 0x6d62, 0x6d6d, 0x6d6f, 0x6d91, 0x6d8d, 0x6def, 0x6d7f, 0x6d86, 0x6d5e, 0x6d67, 0x6d60, 0x6d97, 0x6d70, 0x6d7c, 0x6d5f, 0x6d82, 0x6d98, 0x6d2f, 0x6d68, 0x6d8b, 0x6d7e, 0x6d80, 0x6d84, 0x6d16, 0x6d83, 0x6d7b, 0x6d7d, 0x6d75, 0x6d90, 0x70dc, 0x70d3, 0x70d1, 0x70dd, 0x70cb, 0x7f39, 0x70e2, 0x70d7, 0x70d2, 0x70de, 0x70e0, 0x70d4, 0x70cd, 0x70c5, 0x70c6, 0x70c7, 0x70da, 0x70ce, 0x70e1, 0x7242, 0x7278, 0x7277, 0x7276, 0x7300, 0x72fa, 0x72f4, 0x72fe, 0x72f6, 0x72f3, 0x72fb, 0x7301, 0x73d3, 0x73d9, 0x73e5, 0x73d6, 0x73bc, 0x73e7, 0x73e3, 0x73e9, 0x73dc, 0x73d2, 0x73db, 0x73d4, 0x73dd, 0x73da, 0x73d7, 0x73d8, 0x73e8, 0x74de, 0x74df,
+		0x74f4, 0x74f5, 0x7521, 0x755b, 0x755f, 0x75b0, 0x75c1, 0x75bb, 0x75c4, 0x75c0, 0x75bf, 0x75b6, 0x75ba, 0x768a, 0x76c9, 0x771d, 0x771b, 0x7710, 0x7713, 0x7712, 0x7723, 0x7711, 0x7715, 0x7719, 0x771a, 0x7722, 0x7727, 0x7823, 0x782c, 0x7822, 0x7835, 0x782f, 0x7828, 0x782e, 0x782b, 0x7821, 0x7829, 0x7833, 0x782a, 0x7831, 0x7954, 0x795b, 0x794f, 0x795c, 0x7953, 0x7952, 0x7951, 0x79eb, 0x79ec, 0x79e0, 0x79ee, 0x79ed, 0x79ea, 0x79dc, 0x79de, 0x79dd, 0x7a86, 0x7a89, 0x7a85, 0x7a8b, 0x7a8c, 0x7a8a, 0x7a87, 0x7ad8, 0x7b10, 0x7b04, 0x7b13, 0x7b05, 0x7b0f, 0x7b08, 0x7b0a, 0x7b0e, 0x7b09, 0x7b12, 0x7c84, 0x7c91, 0x7c8a, 0x7c8c, 0x7c88, 0x7c8d, 0x7c85, 0x7d1e, 0x7d1d, 0x7d11, 0x7d0e, 0x7d18, 0x7d16, 0x7d13, 0x7d1f, 0x7d12, 0x7d0f, 0x7d0c, 0x7f5c, 0x7f61,
+		0x7f5e, 0x7f60, 0x7f5d, 0x7f5b, 0x7f96, 0x7f92, 0x7fc3, 0x7fc2, 0x7fc0, 0x8016, 0x803e, 0x8039, 0x80fa, 0x80f2, 0x80f9, 0x80f5, 0x8101, 0x80fb, 0x8100, 0x8201, 0x822f, 0x8225, 0x8333, 0x832d, 0x8344, 0x8319, 0x8351, 0x8325, 0x8356, 0x833f, 0x8341, 0x8326, 0x831c, 0x8322, 0x8342, 0x834e, 0x831b, 0x832a, 0x8308, 0x833c, 0x834d, 0x8316, 0x8324, 0x8320, 0x8337, 0x832f, 0x8329, 0x8347, 0x8345, 0x834c, 0x8353, 0x831e, 0x832c, 0x834b, 0x8327, 0x8348, 0x8653, 0x8652, 0x86a2, 0x86a8, 0x8696, 0x868d, 0x8691, 0x869e, 0x8687, 0x8697, 0x8686, 0x868b, 0x869a, 0x8685, 0x86a5, 0x8699, 0x86a1, 0x86a7, 0x8695, 0x8698, 0x868e, 0x869d, 0x8690, 0x8694, 0x8843, 0x8844, 0x886d, 0x8875, 0x8876, 0x8872, 0x8880, 0x8871, 0x887f, 0x886f, 0x8883, 0x887e, 0x8874, 0x887c,
+		0x8a12, 0x8c47, 0x8c57, 0x8c7b, 0x8ca4, 0x8ca3, 0x8d76, 0x8d78, 0x8db5, 0x8db7, 0x8db6, 0x8ed1, 0x8ed3, 0x8ffe, 0x8ff5, 0x9002, 0x8fff, 0x8ffb, 0x9004, 0x8ffc, 0x8ff6, 0x90d6, 0x90e0, 0x90d9, 0x90da, 0x90e3, 0x90df, 0x90e5, 0x90d8, 0x90db, 0x90d7, 0x90dc, 0x90e4, 0x9150, 0x914e, 0x914f, 0x91d5, 0x91e2, 0x91da, 0x965c, 0x965f, 0x96bc, 0x98e3, 0x9adf, 0x9b2f, 0x4e7f, 0x5070, 0x506a, 0x5061, 0x505e, 0x5060, 0x5053, 0x504b, 0x505d, 0x5072, 0x5048, 0x504d, 0x5041, 0x505b, 0x504a, 0x5062, 0x5015, 0x5045, 0x505f, 0x5069, 0x506b, 0x5063, 0x5064, 0x5046, 0x5040, 0x506e, 0x5073, 0x5057, 0x5051, 0x51d0, 0x526b, 0x526d, 0x526c, 0x526e, 0x52d6, 0x52d3, 0x532d, 0x539c, 0x5575, 0x5576, 0x553c, 0x554d, 0x5550, 0x5534, 0x552a, 0x5551, 0x5562, 0x5536, 0x5535,
+		0x5530, 0x5552, 0x5545, 0x550c, 0x5532, 0x5565, 0x554e, 0x5539, 0x5548, 0x552d, 0x553b, 0x5540, 0x554b, 0x570a, 0x5707, 0x57fb, 0x5814, 0x57e2, 0x57f6, 0x57dc, 0x57f4, 0x5800, 0x57ed, 0x57fd, 0x5808, 0x57f8, 0x580b, 0x57f3, 0x57cf, 0x5807, 0x57ee, 0x57e3, 0x57f2, 0x57e5, 0x57ec, 0x57e1, 0x580e, 0x57fc, 0x5810, 0x57e7, 0x5801, 0x580c, 0x57f1, 0x57e9, 0x57f0, 0x580d, 0x5804, 0x595c, 0x5a60, 0x5a58, 0x5a55, 0x5a67, 0x5a5e, 0x5a38, 0x5a35, 0x5a6d, 0x5a50, 0x5a5f, 0x5a65, 0x5a6c, 0x5a53, 0x5a64, 0x5a57, 0x5a43, 0x5a5d, 0x5a52, 0x5a44, 0x5a5b, 0x5a48, 0x5a8e, 0x5a3e, 0x5a4d, 0x5a39, 0x5a4c, 0x5a70, 0x5a69, 0x5a47, 0x5a51, 0x5a56, 0x5a42, 0x5a5c, 0x5b72, 0x5b6e, 0x5bc1, 0x5bc0, 0x5c59, 0x5d1e, 0x5d0b, 0x5d1d, 0x5d1a, 0x5d20, 0x5d0c, 0x5d28, 0x5d0d,
+		0x5d26, 0x5d25, 0x5d0f, 0x5d30, 0x5d12, 0x5d23, 0x5d1f, 0x5d2e, 0x5e3e, 0x5e34, 0x5eb1, 0x5eb4, 0x5eb9, 0x5eb2, 0x5eb3, 0x5f36, 0x5f38, 0x5f9b, 0x5f96, 0x5f9f, 0x608a, 0x6090, 0x6086, 0x60be, 0x60b0, 0x60ba, 0x60d3, 0x60d4, 0x60cf, 0x60e4, 0x60d9, 0x60dd, 0x60c8, 0x60b1, 0x60db, 0x60b7, 0x60ca, 0x60bf, 0x60c3, 0x60cd, 0x60c0, 0x6332, 0x6365, 0x638a, 0x6382, 0x637d, 0x63bd, 0x639e, 0x63ad, 0x639d, 0x6397, 0x63ab, 0x638e, 0x636f, 0x6387, 0x6390, 0x636e, 0x63af, 0x6375, 0x639c, 0x636d, 0x63ae, 0x637c, 0x63a4, 0x633b, 0x639f, 0x6378, 0x6385, 0x6381, 0x6391, 0x638d, 0x6370, 0x6553, 0x65cd, 0x6665, 0x6661, 0x665b, 0x6659, 0x665c, 0x6662, 0x6718, 0x6879, 0x6887, 0x6890, 0x689c, 0x686d, 0x686e, 0x68ae, 0x68ab, 0x6956, 0x686f, 0x68a3, 0x68ac, 0x68a9,
+		0x6875, 0x6874, 0x68b2, 0x688f, 0x6877, 0x6892, 0x687c, 0x686b, 0x6872, 0x68aa, 0x6880, 0x6871, 0x687e, 0x689b, 0x6896, 0x688b, 0x68a0, 0x6889, 0x68a4, 0x6878, 0x687b, 0x6891, 0x688c, 0x688a, 0x687d, 0x6b36, 0x6b33, 0x6b37, 0x6b38, 0x6b91, 0x6b8f, 0x6b8d, 0x6b8e, 0x6b8c, 0x6c2a, 0x6dc0, 0x6dab, 0x6db4, 0x6db3, 0x6e74, 0x6dac, 0x6de9, 0x6de2, 0x6db7, 0x6df6, 0x6dd4, 0x6e00, 0x6dc8, 0x6de0, 0x6ddf, 0x6dd6, 0x6dbe, 0x6de5, 0x6ddc, 0x6ddd, 0x6ddb, 0x6df4, 0x6dca, 0x6dbd, 0x6ded, 0x6df0, 0x6dba, 0x6dd5, 0x6dc2, 0x6dcf, 0x6dc9, 0x6dd0, 0x6df2, 0x6dd3, 0x6dfd, 0x6dd7, 0x6dcd, 0x6de3, 0x6dbb, 0x70fa, 0x710d, 0x70f7, 0x7117, 0x70f4, 0x710c, 0x70f0, 0x7104, 0x70f3, 0x7110, 0x70fc, 0x70ff, 0x7106, 0x7113, 0x7100, 0x70f8, 0x70f6, 0x710b, 0x7102, 0x710e,
+		0x727e, 0x727b, 0x727c, 0x727f, 0x731d, 0x7317, 0x7307, 0x7311, 0x7318, 0x730a, 0x7308, 0x72ff, 0x730f, 0x731e, 0x7388, 0x73f6, 0x73f8, 0x73f5, 0x7404, 0x7401, 0x73fd, 0x7407, 0x7400, 0x73fa, 0x73fc, 0x73ff, 0x740c, 0x740b, 0x73f4, 0x7408, 0x7564, 0x7563, 0x75ce, 0x75d2, 0x75cf, 0x75cb, 0x75cc, 0x75d1, 0x75d0, 0x768f, 0x7689, 0x76d3, 0x7739, 0x772f, 0x772d, 0x7731, 0x7732, 0x7734, 0x7733, 0x773d, 0x7725, 0x773b, 0x7735, 0x7848, 0x7852, 0x7849, 0x784d, 0x784a, 0x784c, 0x7826, 0x7845, 0x7850, 0x7964, 0x7967, 0x7969, 0x796a, 0x7963, 0x796b, 0x7961, 0x79bb, 0x79fa, 0x79f8, 0x79f6, 0x79f7, 0x7a8f, 0x7a94, 0x7a90, 0x7b35, 0x7b3b, 0x7b34, 0x7b25, 0x7b30, 0x7b22, 0x7b24, 0x7b33, 0x7b18, 0x7b2a, 0x7b1d, 0x7b31, 0x7b2b, 0x7b2d, 0x7b2f, 0x7b32, 0x7b38,
+		0x7b1a, 0x7b23, 0x7c94, 0x7c98, 0x7c96, 0x7ca3, 0x7d35, 0x7d3d, 0x7d38, 0x7d36, 0x7d3a, 0x7d45, 0x7d2c, 0x7d29, 0x7d41, 0x7d47, 0x7d3e, 0x7d3f, 0x7d4a, 0x7d3b, 0x7d28, 0x7f63, 0x7f95, 0x7f9c, 0x7f9d, 0x7f9b, 0x7fca, 0x7fcb, 0x7fcd, 0x7fd0, 0x7fd1, 0x7fc7, 0x7fcf, 0x7fc9, 0x801f, 0x801e, 0x801b, 0x8047, 0x8043, 0x8048, 0x8118, 0x8125, 0x8119, 0x811b, 0x812d, 0x811f, 0x812c, 0x811e, 0x8121, 0x8115, 0x8127, 0x811d, 0x8122, 0x8211, 0x8238, 0x8233, 0x823a, 0x8234, 0x8232, 0x8274, 0x8390, 0x83a3, 0x83a8, 0x838d, 0x837a, 0x8373, 0x83a4, 0x8374, 0x838f, 0x8381, 0x8395, 0x8399, 0x8375, 0x8394, 0x83a9, 0x837d, 0x8383, 0x838c, 0x839d, 0x839b, 0x83aa, 0x838b, 0x837e, 0x83a5, 0x83af, 0x8388, 0x8397, 0x83b0, 0x837f, 0x83a6, 0x8387, 0x83ae, 0x8376, 0x8659,
+		0x8656, 0x86bf, 0x86b7, 0x86c2, 0x86c1, 0x86c5, 0x86ba, 0x86b0, 0x86c8, 0x86b9, 0x86b3, 0x86b8, 0x86cc, 0x86b4, 0x86bb, 0x86bc, 0x86c3, 0x86bd, 0x86be, 0x8852, 0x8889, 0x8895, 0x88a8, 0x88a2, 0x88aa, 0x889a, 0x8891, 0x88a1, 0x889f, 0x8898, 0x88a7, 0x8899, 0x889b, 0x8897, 0x88a4, 0x88ac, 0x888c, 0x8893, 0x888e, 0x8982, 0x89d6, 0x89d9, 0x89d5, 0x8a30, 0x8a27, 0x8a2c, 0x8a1e, 0x8c39, 0x8c3b, 0x8c5c, 0x8c5d, 0x8c7d, 0x8ca5, 0x8d7d, 0x8d7b, 0x8d79, 0x8dbc, 0x8dc2, 0x8db9, 0x8dbf, 0x8dc1, 0x8ed8, 0x8ede, 0x8edd, 0x8edc, 0x8ed7, 0x8ee0, 0x8ee1, 0x9024, 0x900b, 0x9011, 0x901c, 0x900c, 0x9021, 0x90ef, 0x90ea, 0x90f0, 0x90f4, 0x90f2, 0x90f3, 0x90d4, 0x90eb, 0x90ec, 0x90e9, 0x9156, 0x9158, 0x915a, 0x9153, 0x9155, 0x91ec, 0x91f4, 0x91f1, 0x91f3, 0x91f8,
+		0x91e4, 0x91f9, 0x91ea, 0x91eb, 0x91f7, 0x91e8, 0x91ee, 0x957a, 0x9586, 0x9588, 0x967c, 0x966d, 0x966b, 0x9671, 0x966f, 0x96bf, 0x976a, 0x9804, 0x98e5, 0x9997, 0x509b, 0x5095, 0x5094, 0x509e, 0x508b, 0x50a3, 0x5083, 0x508c, 0x508e, 0x509d, 0x5068, 0x509c, 0x5092, 0x5082, 0x5087, 0x515f, 0x51d4, 0x5312, 0x5311, 0x53a4, 0x53a7, 0x5591, 0x55a8, 0x55a5, 0x55ad, 0x5577, 0x5645, 0x55a2, 0x5593, 0x5588, 0x558f, 0x55b5, 0x5581, 0x55a3, 0x5592, 0x55a4, 0x557d, 0x558c, 0x55a6, 0x557f, 0x5595, 0x55a1, 0x558e, 0x570c, 0x5829, 0x5837, 0x5819, 0x581e, 0x5827, 0x5823, 0x5828, 0x57f5, 0x5848, 0x5825, 0x581c, 0x581b, 0x5833, 0x583f, 0x5836, 0x582e, 0x5839, 0x5838, 0x582d, 0x582c, 0x583b, 0x5961, 0x5aaf, 0x5a94, 0x5a9f, 0x5a7a, 0x5aa2, 0x5a9e, 0x5a78, 0x5aa6,
+		0x5a7c, 0x5aa5, 0x5aac, 0x5a95, 0x5aae, 0x5a37, 0x5a84, 0x5a8a, 0x5a97, 0x5a83, 0x5a8b, 0x5aa9, 0x5a7b, 0x5a7d, 0x5a8c, 0x5a9c, 0x5a8f, 0x5a93, 0x5a9d, 0x5bea, 0x5bcd, 0x5bcb, 0x5bd4, 0x5bd1, 0x5bca, 0x5bce, 0x5c0c, 0x5c30, 0x5d37, 0x5d43, 0x5d6b, 0x5d41, 0x5d4b, 0x5d3f, 0x5d35, 0x5d51, 0x5d4e, 0x5d55, 0x5d33, 0x5d3a, 0x5d52, 0x5d3d, 0x5d31, 0x5d59, 0x5d42, 0x5d39, 0x5d49, 0x5d38, 0x5d3c, 0x5d32, 0x5d36, 0x5d40, 0x5d45, 0x5e44, 0x5e41, 0x5f58, 0x5fa6, 0x5fa5, 0x5fab, 0x60c9, 0x60b9, 0x60cc, 0x60e2, 0x60ce, 0x60c4, 0x6114, 0x60f2, 0x610a, 0x6116, 0x6105, 0x60f5, 0x6113, 0x60f8, 0x60fc, 0x60fe, 0x60c1, 0x6103, 0x6118, 0x611d, 0x6110, 0x60ff, 0x6104, 0x610b, 0x624a, 0x6394, 0x63b1, 0x63b0, 0x63ce, 0x63e5, 0x63e8, 0x63ef, 0x63c3, 0x649d, 0x63f3,
+		0x63ca, 0x63e0, 0x63f6, 0x63d5, 0x63f2, 0x63f5, 0x6461, 0x63df, 0x63be, 0x63dd, 0x63dc, 0x63c4, 0x63d8, 0x63d3, 0x63c2, 0x63c7, 0x63cc, 0x63cb, 0x63c8, 0x63f0, 0x63d7, 0x63d9, 0x6532, 0x6567, 0x656a, 0x6564, 0x655c, 0x6568, 0x6565, 0x658c, 0x659d, 0x659e, 0x65ae, 0x65d0, 0x65d2, 0x667c, 0x666c, 0x667b, 0x6680, 0x6671, 0x6679, 0x666a, 0x6672, 0x6701, 0x690c, 0x68d3, 0x6904, 0x68dc, 0x692a, 0x68ec, 0x68ea, 0x68f1, 0x690f, 0x68d6, 0x68f7, 0x68eb, 0x68e4, 0x68f6, 0x6913, 0x6910, 0x68f3, 0x68e1, 0x6907, 0x68cc, 0x6908, 0x6970, 0x68b4, 0x6911, 0x68ef, 0x68c6, 0x6914, 0x68f8, 0x68d0, 0x68fd, 0x68fc, 0x68e8, 0x690b, 0x690a, 0x6917, 0x68ce, 0x68c8, 0x68dd, 0x68de, 0x68e6, 0x68f4, 0x68d1, 0x6906, 0x68d4, 0x68e9, 0x6915, 0x6925, 0x68c7, 0x6b39, 0x6b3b,
+		0x6b3f, 0x6b3c, 0x6b94, 0x6b97, 0x6b99, 0x6b95, 0x6bbd, 0x6bf0, 0x6bf2, 0x6bf3, 0x6c30, 0x6dfc, 0x6e46, 0x6e47, 0x6e1f, 0x6e49, 0x6e88, 0x6e3c, 0x6e3d, 0x6e45, 0x6e62, 0x6e2b, 0x6e3f, 0x6e41, 0x6e5d, 0x6e73, 0x6e1c, 0x6e33, 0x6e4b, 0x6e40, 0x6e51, 0x6e3b, 0x6e03, 0x6e2e, 0x6e5e, 0x6e68, 0x6e5c, 0x6e61, 0x6e31, 0x6e28, 0x6e60, 0x6e71, 0x6e6b, 0x6e39, 0x6e22, 0x6e30, 0x6e53, 0x6e65, 0x6e27, 0x6e78, 0x6e64, 0x6e77, 0x6e55, 0x6e79, 0x6e52, 0x6e66, 0x6e35, 0x6e36, 0x6e5a, 0x7120, 0x711e, 0x712f, 0x70fb, 0x712e, 0x7131, 0x7123, 0x7125, 0x7122, 0x7132, 0x711f, 0x7128, 0x713a, 0x711b, 0x724b, 0x725a, 0x7288, 0x7289, 0x7286, 0x7285, 0x728b, 0x7312, 0x730b, 0x7330, 0x7322, 0x7331, 0x7333, 0x7327, 0x7332, 0x732d, 0x7326, 0x7323, 0x7335, 0x730c, 0x742e,
+		0x742c, 0x7430, 0x742b, 0x7416, 0x741a, 0x7421, 0x742d, 0x7431, 0x7424, 0x7423, 0x741d, 0x7429, 0x7420, 0x7432, 0x74fb, 0x752f, 0x756f, 0x756c, 0x75e7, 0x75da, 0x75e1, 0x75e6, 0x75dd, 0x75df, 0x75e4, 0x75d7, 0x7695, 0x7692, 0x76da, 0x7746, 0x7747, 0x7744, 0x774d, 0x7745, 0x774a, 0x774e, 0x774b, 0x774c, 0x77de, 0x77ec, 0x7860, 0x7864, 0x7865, 0x785c, 0x786d, 0x7871, 0x786a, 0x786e, 0x7870, 0x7869, 0x7868, 0x785e, 0x7862, 0x7974, 0x7973, 0x7972, 0x7970, 0x7a02, 0x7a0a, 0x7a03, 0x7a0c, 0x7a04, 0x7a99, 0x7ae6, 0x7ae4, 0x7b4a, 0x7b47, 0x7b44, 0x7b48, 0x7b4c, 0x7b4e, 0x7b40, 0x7b58, 0x7b45, 0x7ca2, 0x7c9e, 0x7ca8, 0x7ca1, 0x7d58, 0x7d6f, 0x7d63, 0x7d53, 0x7d56, 0x7d67, 0x7d6a, 0x7d4f, 0x7d6d, 0x7d5c, 0x7d6b, 0x7d52, 0x7d54, 0x7d69, 0x7d51, 0x7d5f,
+		0x7d4e, 0x7f3e, 0x7f3f, 0x7f65, 0x7f66, 0x7fa2, 0x7fa0, 0x7fa1, 0x7fd7, 0x8051, 0x804f, 0x8050, 0x80fe, 0x80d4, 0x8143, 0x814a, 0x8152, 0x814f, 0x8147, 0x813d, 0x814d, 0x813a, 0x81e6, 0x81ee, 0x81f7, 0x81f8, 0x81f9, 0x8204, 0x823c, 0x823d, 0x823f, 0x8275, 0x833b, 0x83cf, 0x83f9, 0x8423, 0x83c0, 0x83e8, 0x8412, 0x83e7, 0x83e4, 0x83fc, 0x83f6, 0x8410, 0x83c6, 0x83c8, 0x83eb, 0x83e3, 0x83bf, 0x8401, 0x83dd, 0x83e5, 0x83d8, 0x83ff, 0x83e1, 0x83cb, 0x83ce, 0x83d6, 0x83f5, 0x83c9, 0x8409, 0x840f, 0x83de, 0x8411, 0x8406, 0x83c2, 0x83f3, 0x83d5, 0x83fa, 0x83c7, 0x83d1, 0x83ea, 0x8413, 0x839a, 0x83c3, 0x83ec, 0x83ee, 0x83c4, 0x83fb, 0x83d7, 0x83e2, 0x841b, 0x83db, 0x83fe, 0x86d8, 0x86e2, 0x86e6, 0x86d3, 0x86e3, 0x86da, 0x86ea, 0x86dd, 0x86eb, 0x86dc,
+		0x86ec, 0x86e9, 0x86d7, 0x86e8, 0x86d1, 0x8848, 0x8856, 0x8855, 0x88ba, 0x88d7, 0x88b9, 0x88b8, 0x88c0, 0x88be, 0x88b6, 0x88bc, 0x88b7, 0x88bd, 0x88b2, 0x8901, 0x88c9, 0x8995, 0x8998, 0x8997, 0x89dd, 0x89da, 0x89db, 0x8a4e, 0x8a4d, 0x8a39, 0x8a59, 0x8a40, 0x8a57, 0x8a58, 0x8a44, 0x8a45, 0x8a52, 0x8a48, 0x8a51, 0x8a4a, 0x8a4c, 0x8a4f, 0x8c5f, 0x8c81, 0x8c80, 0x8cba, 0x8cbe, 0x8cb0, 0x8cb9, 0x8cb5, 0x8d84, 0x8d80, 0x8d89, 0x8dd8, 0x8dd3, 0x8dcd, 0x8dc7, 0x8dd6, 0x8ddc, 0x8dcf, 0x8dd5, 0x8dd9, 0x8dc8, 0x8dd7, 0x8dc5, 0x8eef, 0x8ef7, 0x8efa, 0x8ef9, 0x8ee6, 0x8eee, 0x8ee5, 0x8ef5, 0x8ee7, 0x8ee8, 0x8ef6, 0x8eeb, 0x8ef1, 0x8eec, 0x8ef4, 0x8ee9, 0x902d, 0x9034, 0x902f, 0x9106, 0x912c, 0x9104, 0x90ff, 0x90fc, 0x9108, 0x90f9, 0x90fb, 0x9101, 0x9100,
+		0x9107, 0x9105, 0x9103, 0x9161, 0x9164, 0x915f, 0x9162, 0x9160, 0x9201, 0x920a, 0x9225, 0x9203, 0x921a, 0x9226, 0x920f, 0x920c, 0x9200, 0x9212, 0x91ff, 0x91fd, 0x9206, 0x9204, 0x9227, 0x9202, 0x921c, 0x9224, 0x9219, 0x9217, 0x9205, 0x9216, 0x957b, 0x958d, 0x958c, 0x9590, 0x9687, 0x967e, 0x9688, 0x9689, 0x9683, 0x9680, 0x96c2, 0x96c8, 0x96c3, 0x96f1, 0x96f0, 0x976c, 0x9770, 0x976e, 0x9807, 0x98a9, 0x98eb, 0x9ce6, 0x9ef9, 0x4e83, 0x4e84, 0x4eb6, 0x50bd, 0x50bf, 0x50c6, 0x50ae, 0x50c4, 0x50ca, 0x50b4, 0x50c8, 0x50c2, 0x50b0, 0x50c1, 0x50ba, 0x50b1, 0x50cb, 0x50c9, 0x50b6, 0x50b8, 0x51d7, 0x527a, 0x5278, 0x527b, 0x527c, 0x55c3, 0x55db, 0x55cc, 0x55d0, 0x55cb, 0x55ca, 0x55dd, 0x55c0, 0x55d4, 0x55c4, 0x55e9, 0x55bf, 0x55d2, 0x558d, 0x55cf, 0x55d5,
+		0x55e2, 0x55d6, 0x55c8, 0x55f2, 0x55cd, 0x55d9, 0x55c2, 0x5714, 0x5853, 0x5868, 0x5864, 0x584f, 0x584d, 0x5849, 0x586f, 0x5855, 0x584e, 0x585d, 0x5859, 0x5865, 0x585b, 0x583d, 0x5863, 0x5871, 0x58fc, 0x5ac7, 0x5ac4, 0x5acb, 0x5aba, 0x5ab8, 0x5ab1, 0x5ab5, 0x5ab0, 0x5abf, 0x5ac8, 0x5abb, 0x5ac6, 0x5ab7, 0x5ac0, 0x5aca, 0x5ab4, 0x5ab6, 0x5acd, 0x5ab9, 0x5a90, 0x5bd6, 0x5bd8, 0x5bd9, 0x5c1f, 0x5c33, 0x5d71, 0x5d63, 0x5d4a, 0x5d65, 0x5d72, 0x5d6c, 0x5d5e, 0x5d68, 0x5d67, 0x5d62, 0x5df0, 0x5e4f, 0x5e4e, 0x5e4a, 0x5e4d, 0x5e4b, 0x5ec5, 0x5ecc, 0x5ec6, 0x5ecb, 0x5ec7, 0x5f40, 0x5faf, 0x5fad, 0x60f7, 0x6149, 0x614a, 0x612b, 0x6145, 0x6136, 0x6132, 0x612e, 0x6146, 0x612f, 0x614f, 0x6129, 0x6140, 0x6220, 0x9168, 0x6223, 0x6225, 0x6224, 0x63c5, 0x63f1,
+		0x63eb, 0x6410, 0x6412, 0x6409, 0x6420, 0x6424, 0x6433, 0x6443, 0x641f, 0x6415, 0x6418, 0x6439, 0x6437, 0x6422, 0x6423, 0x640c, 0x6426, 0x6430, 0x6428, 0x6441, 0x6435, 0x642f, 0x640a, 0x641a, 0x6440, 0x6425, 0x6427, 0x640b, 0x63e7, 0x641b, 0x642e, 0x6421, 0x640e, 0x656f, 0x6592, 0x65d3, 0x6686, 0x668c, 0x6695, 0x6690, 0x668b, 0x668a, 0x6699, 0x6694, 0x6678, 0x6720, 0x6966, 0x695f, 0x6938, 0x694e, 0x6962, 0x6971, 0x693f, 0x6945, 0x696a, 0x6939, 0x6942, 0x6957, 0x6959, 0x697a, 0x6948, 0x6949, 0x6935, 0x696c, 0x6933, 0x693d, 0x6965, 0x68f0, 0x6978, 0x6934, 0x6969, 0x6940, 0x696f, 0x6944, 0x6976, 0x6958, 0x6941, 0x6974, 0x694c, 0x693b, 0x694b, 0x6937, 0x695c, 0x694f, 0x6951, 0x6932, 0x6952, 0x692f, 0x697b, 0x693c, 0x6b46, 0x6b45, 0x6b43, 0x6b42,
+		0x6b48, 0x6b41, 0x6b9b, 0x6bfb, 0x6bfc, 0x6bf9, 0x6bf7, 0x6bf8, 0x6e9b, 0x6ed6, 0x6ec8, 0x6e8f, 0x6ec0, 0x6e9f, 0x6e93, 0x6e94, 0x6ea0, 0x6eb1, 0x6eb9, 0x6ec6, 0x6ed2, 0x6ebd, 0x6ec1, 0x6e9e, 0x6ec9, 0x6eb7, 0x6eb0, 0x6ecd, 0x6ea6, 0x6ecf, 0x6eb2, 0x6ebe, 0x6ec3, 0x6edc, 0x6ed8, 0x6e99, 0x6e92, 0x6e8e, 0x6e8d, 0x6ea4, 0x6ea1, 0x6ebf, 0x6eb3, 0x6ed0, 0x6eca, 0x6e97, 0x6eae, 0x6ea3, 0x7147, 0x7154, 0x7152, 0x7163, 0x7160, 0x7141, 0x715d, 0x7162, 0x7172, 0x7178, 0x716a, 0x7161, 0x7142, 0x7158, 0x7143, 0x714b, 0x7170, 0x715f, 0x7150, 0x7153, 0x7144, 0x714d, 0x715a, 0x724f, 0x728d, 0x728c, 0x7291, 0x7290, 0x728e, 0x733c, 0x7342, 0x733b, 0x733a, 0x7340, 0x734a, 0x7349, 0x7444, 0x744a, 0x744b, 0x7452, 0x7451, 0x7457, 0x7440, 0x744f, 0x7450, 0x744e,
+		0x7442, 0x7446, 0x744d, 0x7454, 0x74e1, 0x74ff, 0x74fe, 0x74fd, 0x751d, 0x7579, 0x7577, 0x6983, 0x75ef, 0x760f, 0x7603, 0x75f7, 0x75fe, 0x75fc, 0x75f9, 0x75f8, 0x7610, 0x75fb, 0x75f6, 0x75ed, 0x75f5, 0x75fd, 0x7699, 0x76b5, 0x76dd, 0x7755, 0x775f, 0x7760, 0x7752, 0x7756, 0x775a, 0x7769, 0x7767, 0x7754, 0x7759, 0x776d, 0x77e0, 0x7887, 0x789a, 0x7894, 0x788f, 0x7884, 0x7895, 0x7885, 0x7886, 0x78a1, 0x7883, 0x7879, 0x7899, 0x7880, 0x7896, 0x787b, 0x797c, 0x7982, 0x797d, 0x7979, 0x7a11, 0x7a18, 0x7a19, 0x7a12, 0x7a17, 0x7a15, 0x7a22, 0x7a13, 0x7a1b, 0x7a10, 0x7aa3, 0x7aa2, 0x7a9e, 0x7aeb, 0x7b66, 0x7b64, 0x7b6d, 0x7b74, 0x7b69, 0x7b72, 0x7b65, 0x7b73, 0x7b71, 0x7b70, 0x7b61, 0x7b78, 0x7b76, 0x7b63, 0x7cb2, 0x7cb4, 0x7caf, 0x7d88, 0x7d86, 0x7d80,
+		0x7d8d, 0x7d7f, 0x7d85, 0x7d7a, 0x7d8e, 0x7d7b, 0x7d83, 0x7d7c, 0x7d8c, 0x7d94, 0x7d84, 0x7d7d, 0x7d92, 0x7f6d, 0x7f6b, 0x7f67, 0x7f68, 0x7f6c, 0x7fa6, 0x7fa5, 0x7fa7, 0x7fdb, 0x7fdc, 0x8021, 0x8164, 0x8160, 0x8177, 0x815c, 0x8169, 0x815b, 0x8162, 0x8172, 0x6721, 0x815e, 0x8176, 0x8167, 0x816f, 0x8144, 0x8161, 0x821d, 0x8249, 0x8244, 0x8240, 0x8242, 0x8245, 0x84f1, 0x843f, 0x8456, 0x8476, 0x8479, 0x848f, 0x848d, 0x8465, 0x8451, 0x8440, 0x8486, 0x8467, 0x8430, 0x844d, 0x847d, 0x845a, 0x8459, 0x8474, 0x8473, 0x845d, 0x8507, 0x845e, 0x8437, 0x843a, 0x8434, 0x847a, 0x8443, 0x8478, 0x8432, 0x8445, 0x8429, 0x83d9, 0x844b, 0x842f, 0x8442, 0x842d, 0x845f, 0x8470, 0x8439, 0x844e, 0x844c, 0x8452, 0x846f, 0x84c5, 0x848e, 0x843b, 0x8447, 0x8436, 0x8433,
+		0x8468, 0x847e, 0x8444, 0x842b, 0x8460, 0x8454, 0x846e, 0x8450, 0x870b, 0x8704, 0x86f7, 0x870c, 0x86fa, 0x86d6, 0x86f5, 0x874d, 0x86f8, 0x870e, 0x8709, 0x8701, 0x86f6, 0x870d, 0x8705, 0x88d6, 0x88cb, 0x88cd, 0x88ce, 0x88de, 0x88db, 0x88da, 0x88cc, 0x88d0, 0x8985, 0x899b, 0x89df, 0x89e5, 0x89e4, 0x89e1, 0x89e0, 0x89e2, 0x89dc, 0x89e6, 0x8a76, 0x8a86, 0x8a7f, 0x8a61, 0x8a3f, 0x8a77, 0x8a82, 0x8a84, 0x8a75, 0x8a83, 0x8a81, 0x8a74, 0x8a7a, 0x8c3c, 0x8c4b, 0x8c4a, 0x8c65, 0x8c64, 0x8c66, 0x8c86, 0x8c84, 0x8c85, 0x8ccc, 0x8d68, 0x8d69, 0x8d91, 0x8d8c, 0x8d8e, 0x8d8f, 0x8d8d, 0x8d93, 0x8d94, 0x8d90, 0x8d92, 0x8df0, 0x8de0, 0x8dec, 0x8df1, 0x8dee, 0x8dd0, 0x8de9, 0x8de3, 0x8de2, 0x8de7, 0x8df2, 0x8deb, 0x8df4, 0x8f06, 0x8eff, 0x8f01, 0x8f00, 0x8f05,
+		0x8f07, 0x8f08, 0x8f02, 0x8f0b, 0x9052, 0x903f, 0x9044, 0x9049, 0x903d, 0x9110, 0x910d, 0x910f, 0x9111, 0x9116, 0x9114, 0x910b, 0x910e, 0x916e, 0x916f, 0x9248, 0x9252, 0x9230, 0x923a, 0x9266, 0x9233, 0x9265, 0x925e, 0x9283, 0x922e, 0x924a, 0x9246, 0x926d, 0x926c, 0x924f, 0x9260, 0x9267, 0x926f, 0x9236, 0x9261, 0x9270, 0x9231, 0x9254, 0x9263, 0x9250, 0x9272, 0x924e, 0x9253, 0x924c, 0x9256, 0x9232, 0x959f, 0x959c, 0x959e, 0x959b, 0x9692, 0x9693, 0x9691, 0x9697, 0x96ce, 0x96fa, 0x96fd, 0x96f8, 0x96f5, 0x9773, 0x9777, 0x9778, 0x9772, 0x980f, 0x980d, 0x980e, 0x98ac, 0x98f6, 0x98f9, 0x99af, 0x99b2, 0x99b0, 0x99b5, 0x9aad, 0x9aab, 0x9b5b, 0x9cea, 0x9ced, 0x9ce7, 0x9e80, 0x9efd, 0x50e6, 0x50d4, 0x50d7, 0x50e8, 0x50f3, 0x50db, 0x50ea, 0x50dd, 0x50e4,
+		0x50d3, 0x50ec, 0x50f0, 0x50ef, 0x50e3, 0x50e0, 0x51d8, 0x5280, 0x5281, 0x52e9, 0x52eb, 0x5330, 0x53ac, 0x5627, 0x5615, 0x560c, 0x5612, 0x55fc, 0x560f, 0x561c, 0x5601, 0x5613, 0x5602, 0x55fa, 0x561d, 0x5604, 0x55ff, 0x55f9, 0x5889, 0x587c, 0x5890, 0x5898, 0x5886, 0x5881, 0x587f, 0x5874, 0x588b, 0x587a, 0x5887, 0x5891, 0x588e, 0x5876, 0x5882, 0x5888, 0x587b, 0x5894, 0x588f, 0x58fe, 0x596b, 0x5adc, 0x5aee, 0x5ae5, 0x5ad5, 0x5aea, 0x5ada, 0x5aed, 0x5aeb, 0x5af3, 0x5ae2, 0x5ae0, 0x5adb, 0x5aec, 0x5ade, 0x5add, 0x5ad9, 0x5ae8, 0x5adf, 0x5b77, 0x5be0, 0x5be3, 0x5c63, 0x5d82, 0x5d80, 0x5d7d, 0x5d86, 0x5d7a, 0x5d81, 0x5d77, 0x5d8a, 0x5d89, 0x5d88, 0x5d7e, 0x5d7c, 0x5d8d, 0x5d79, 0x5d7f, 0x5e58, 0x5e59, 0x5e53, 0x5ed8, 0x5ed1, 0x5ed7, 0x5ece, 0x5edc,
+		0x5ed5, 0x5ed9, 0x5ed2, 0x5ed4, 0x5f44, 0x5f43, 0x5f6f, 0x5fb6, 0x612c, 0x6128, 0x6141, 0x615e, 0x6171, 0x6173, 0x6152, 0x6153, 0x6172, 0x616c, 0x6180, 0x6174, 0x6154, 0x617a, 0x615b, 0x6165, 0x613b, 0x616a, 0x6161, 0x6156, 0x6229, 0x6227, 0x622b, 0x642b, 0x644d, 0x645b, 0x645d, 0x6474, 0x6476, 0x6472, 0x6473, 0x647d, 0x6475, 0x6466, 0x64a6, 0x644e, 0x6482, 0x645e, 0x645c, 0x644b, 0x6453, 0x6460, 0x6450, 0x647f, 0x643f, 0x646c, 0x646b, 0x6459, 0x6465, 0x6477, 0x6573, 0x65a0, 0x66a1, 0x66a0, 0x669f, 0x6705, 0x6704, 0x6722, 0x69b1, 0x69b6, 0x69c9, 0x69a0, 0x69ce, 0x6996, 0x69b0, 0x69ac, 0x69bc, 0x6991, 0x6999, 0x698e, 0x69a7, 0x698d, 0x69a9, 0x69be, 0x69af, 0x69bf, 0x69c4, 0x69bd, 0x69a4, 0x69d4, 0x69b9, 0x69ca, 0x699a, 0x69cf, 0x69b3, 0x6993,
+		0x69aa, 0x69a1, 0x699e, 0x69d9, 0x6997, 0x6990, 0x69c2, 0x69b5, 0x69a5, 0x69c6, 0x6b4a, 0x6b4d, 0x6b4b, 0x6b9e, 0x6b9f, 0x6ba0, 0x6bc3, 0x6bc4, 0x6bfe, 0x6ece, 0x6ef5, 0x6ef1, 0x6f03, 0x6f25, 0x6ef8, 0x6f37, 0x6efb, 0x6f2e, 0x6f09, 0x6f4e, 0x6f19, 0x6f1a, 0x6f27, 0x6f18, 0x6f3b, 0x6f12, 0x6eed, 0x6f0a, 0x6f36, 0x6f73, 0x6ef9, 0x6eee, 0x6f2d, 0x6f40, 0x6f30, 0x6f3c, 0x6f35, 0x6eeb, 0x6f07, 0x6f0e, 0x6f43, 0x6f05, 0x6efd, 0x6ef6, 0x6f39, 0x6f1c, 0x6efc, 0x6f3a, 0x6f1f, 0x6f0d, 0x6f1e, 0x6f08, 0x6f21, 0x7187, 0x7190, 0x7189, 0x7180, 0x7185, 0x7182, 0x718f, 0x717b, 0x7186, 0x7181, 0x7197, 0x7244, 0x7253, 0x7297, 0x7295, 0x7293, 0x7343, 0x734d, 0x7351, 0x734c, 0x7462, 0x7473, 0x7471, 0x7475, 0x7472, 0x7467, 0x746e, 0x7500, 0x7502, 0x7503, 0x757d,
+		0x7590, 0x7616, 0x7608, 0x760c, 0x7615, 0x7611, 0x760a, 0x7614, 0x76b8, 0x7781, 0x777c, 0x7785, 0x7782, 0x776e, 0x7780, 0x776f, 0x777e, 0x7783, 0x78b2, 0x78aa, 0x78b4, 0x78ad, 0x78a8, 0x787e, 0x78ab, 0x789e, 0x78a5, 0x78a0, 0x78ac, 0x78a2, 0x78a4, 0x7998, 0x798a, 0x798b, 0x7996, 0x7995, 0x7994, 0x7993, 0x7997, 0x7988, 0x7992, 0x7990, 0x7a2b, 0x7a4a, 0x7a30, 0x7a2f, 0x7a28, 0x7a26, 0x7aa8, 0x7aab, 0x7aac, 0x7aee, 0x7b88, 0x7b9c, 0x7b8a, 0x7b91, 0x7b90, 0x7b96, 0x7b8d, 0x7b8c, 0x7b9b, 0x7b8e, 0x7b85, 0x7b98, 0x5284, 0x7b99, 0x7ba4, 0x7b82, 0x7cbb, 0x7cbf, 0x7cbc, 0x7cba, 0x7da7, 0x7db7, 0x7dc2, 0x7da3, 0x7daa, 0x7dc1, 0x7dc0, 0x7dc5, 0x7d9d, 0x7dce, 0x7dc4, 0x7dc6, 0x7dcb, 0x7dcc, 0x7daf, 0x7db9, 0x7d96, 0x7dbc, 0x7d9f, 0x7da6, 0x7dae, 0x7da9,
+		0x7da1, 0x7dc9, 0x7f73, 0x7fe2, 0x7fe3, 0x7fe5, 0x7fde, 0x8024, 0x805d, 0x805c, 0x8189, 0x8186, 0x8183, 0x8187, 0x818d, 0x818c, 0x818b, 0x8215, 0x8497, 0x84a4, 0x84a1, 0x849f, 0x84ba, 0x84ce, 0x84c2, 0x84ac, 0x84ae, 0x84ab, 0x84b9, 0x84b4, 0x84c1, 0x84cd, 0x84aa, 0x849a, 0x84b1, 0x84d0, 0x849d, 0x84a7, 0x84bb, 0x84a2, 0x8494, 0x84c7, 0x84cc, 0x849b, 0x84a9, 0x84af, 0x84a8, 0x84d6, 0x8498, 0x84b6, 0x84cf, 0x84a0, 0x84d7, 0x84d4, 0x84d2, 0x84db, 0x84b0, 0x8491, 0x8661, 0x8733, 0x8723, 0x8728, 0x876b, 0x8740, 0x872e, 0x871e, 0x8721, 0x8719, 0x871b, 0x8743, 0x872c, 0x8741, 0x873e, 0x8746, 0x8720, 0x8732, 0x872a, 0x872d, 0x873c, 0x8712, 0x873a, 0x8731, 0x8735, 0x8742, 0x8726, 0x8727, 0x8738, 0x8724, 0x871a, 0x8730, 0x8711, 0x88f7, 0x88e7, 0x88f1,
+		0x88f2, 0x88fa, 0x88fe, 0x88ee, 0x88fc, 0x88f6, 0x88fb, 0x88f0, 0x88ec, 0x88eb, 0x899d, 0x89a1, 0x899f, 0x899e, 0x89e9, 0x89eb, 0x89e8, 0x8aab, 0x8a99, 0x8a8b, 0x8a92, 0x8a8f, 0x8a96, 0x8c3d, 0x8c68, 0x8c69, 0x8cd5, 0x8ccf, 0x8cd7, 0x8d96, 0x8e09, 0x8e02, 0x8dff, 0x8e0d, 0x8dfd, 0x8e0a, 0x8e03, 0x8e07, 0x8e06, 0x8e05, 0x8dfe, 0x8e00, 0x8e04, 0x8f10, 0x8f11, 0x8f0e, 0x8f0d, 0x9123, 0x911c, 0x9120, 0x9122, 0x911f, 0x911d, 0x911a, 0x9124, 0x9121, 0x911b, 0x917a, 0x9172, 0x9179, 0x9173, 0x92a5, 0x92a4, 0x9276, 0x929b, 0x927a, 0x92a0, 0x9294, 0x92aa, 0x928d, 0x92a6, 0x929a, 0x92ab, 0x9279, 0x9297, 0x927f, 0x92a3, 0x92ee, 0x928e, 0x9282, 0x9295, 0x92a2, 0x927d, 0x9288, 0x92a1, 0x928a, 0x9286, 0x928c, 0x9299, 0x92a7, 0x927e, 0x9287, 0x92a9, 0x929d,
+		0x928b, 0x922d, 0x969e, 0x96a1, 0x96ff, 0x9758, 0x977d, 0x977a, 0x977e, 0x9783, 0x9780, 0x9782, 0x977b, 0x9784, 0x9781, 0x977f, 0x97ce, 0x97cd, 0x9816, 0x98ad, 0x98ae, 0x9902, 0x9900, 0x9907, 0x999d, 0x999c, 0x99c3, 0x99b9, 0x99bb, 0x99ba, 0x99c2, 0x99bd, 0x99c7, 0x9ab1, 0x9ae3, 0x9ae7, 0x9b3e, 0x9b3f, 0x9b60, 0x9b61, 0x9b5f, 0x9cf1, 0x9cf2, 0x9cf5, 0x9ea7, 0x50ff, 0x5103, 0x5130, 0x50f8, 0x5106, 0x5107, 0x50f6, 0x50fe, 0x510b, 0x510c, 0x50fd, 0x510a, 0x528b, 0x528c, 0x52f1, 0x52ef, 0x5648, 0x5642, 0x564c, 0x5635, 0x5641, 0x564a, 0x5649, 0x5646, 0x5658, 0x565a, 0x5640, 0x5633, 0x563d, 0x562c, 0x563e, 0x5638, 0x562a, 0x563a, 0x571a, 0x58ab, 0x589d, 0x58b1, 0x58a0, 0x58a3, 0x58af, 0x58ac, 0x58a5, 0x58a1, 0x58ff, 0x5aff, 0x5af4, 0x5afd, 0x5af7,
+		0x5af6, 0x5b03, 0x5af8, 0x5b02, 0x5af9, 0x5b01, 0x5b07, 0x5b05, 0x5b0f, 0x5c67, 0x5d99, 0x5d97, 0x5d9f, 0x5d92, 0x5da2, 0x5d93, 0x5d95, 0x5da0, 0x5d9c, 0x5da1, 0x5d9a, 0x5d9e, 0x5e69, 0x5e5d, 0x5e60, 0x5e5c, 0x7df3, 0x5edb, 0x5ede, 0x5ee1, 0x5f49, 0x5fb2, 0x618b, 0x6183, 0x6179, 0x61b1, 0x61b0, 0x61a2, 0x6189, 0x619b, 0x6193, 0x61af, 0x61ad, 0x619f, 0x6192, 0x61aa, 0x61a1, 0x618d, 0x6166, 0x61b3, 0x622d, 0x646e, 0x6470, 0x6496, 0x64a0, 0x6485, 0x6497, 0x649c, 0x648f, 0x648b, 0x648a, 0x648c, 0x64a3, 0x649f, 0x6468, 0x64b1, 0x6498, 0x6576, 0x657a, 0x6579, 0x657b, 0x65b2, 0x65b3, 0x66b5, 0x66b0, 0x66a9, 0x66b2, 0x66b7, 0x66aa, 0x66af, 0x6a00, 0x6a06, 0x6a17, 0x69e5, 0x69f8, 0x6a15, 0x69f1, 0x69e4, 0x6a20, 0x69ff, 0x69ec, 0x69e2, 0x6a1b, 0x6a1d,
+		0x69fe, 0x6a27, 0x69f2, 0x69ee, 0x6a14, 0x69f7, 0x69e7, 0x6a40, 0x6a08, 0x69e6, 0x69fb, 0x6a0d, 0x69fc, 0x69eb, 0x6a09, 0x6a04, 0x6a18, 0x6a25, 0x6a0f, 0x69f6, 0x6a26, 0x6a07, 0x69f4, 0x6a16, 0x6b51, 0x6ba5, 0x6ba3, 0x6ba2, 0x6ba6, 0x6c01, 0x6c00, 0x6bff, 0x6c02, 0x6f41, 0x6f26, 0x6f7e, 0x6f87, 0x6fc6, 0x6f92, 0x6f8d, 0x6f89, 0x6f8c, 0x6f62, 0x6f4f, 0x6f85, 0x6f5a, 0x6f96, 0x6f76, 0x6f6c, 0x6f82, 0x6f55, 0x6f72, 0x6f52, 0x6f50, 0x6f57, 0x6f94, 0x6f93, 0x6f5d, 0x6f00, 0x6f61, 0x6f6b, 0x6f7d, 0x6f67, 0x6f90, 0x6f53, 0x6f8b, 0x6f69, 0x6f7f, 0x6f95, 0x6f63, 0x6f77, 0x6f6a, 0x6f7b, 0x71b2, 0x71af, 0x719b, 0x71b0, 0x71a0, 0x719a, 0x71a9, 0x71b5, 0x719d, 0x71a5, 0x719e, 0x71a4, 0x71a1, 0x71aa, 0x719c, 0x71a7, 0x71b3, 0x7298, 0x729a, 0x7358, 0x7352,
+		0x735e, 0x735f, 0x7360, 0x735d, 0x735b, 0x7361, 0x735a, 0x7359, 0x7362, 0x7487, 0x7489, 0x748a, 0x7486, 0x7481, 0x747d, 0x7485, 0x7488, 0x747c, 0x7479, 0x7508, 0x7507, 0x757e, 0x7625, 0x761e, 0x7619, 0x761d, 0x761c, 0x7623, 0x761a, 0x7628, 0x761b, 0x769c, 0x769d, 0x769e, 0x769b, 0x778d, 0x778f, 0x7789, 0x7788, 0x78cd, 0x78bb, 0x78cf, 0x78cc, 0x78d1, 0x78ce, 0x78d4, 0x78c8, 0x78c3, 0x78c4, 0x78c9, 0x799a, 0x79a1, 0x79a0, 0x799c, 0x79a2, 0x799b, 0x6b76, 0x7a39, 0x7ab2, 0x7ab4, 0x7ab3, 0x7bb7, 0x7bcb, 0x7bbe, 0x7bac, 0x7bce, 0x7baf, 0x7bb9, 0x7bca, 0x7bb5, 0x7cc5, 0x7cc8, 0x7ccc, 0x7ccb, 0x7df7, 0x7ddb, 0x7dea, 0x7de7, 0x7dd7, 0x7de1, 0x7e03, 0x7dfa, 0x7de6, 0x7df6, 0x7df1, 0x7df0, 0x7dee, 0x7ddf, 0x7f76, 0x7fac, 0x7fb0, 0x7fad, 0x7fed, 0x7feb,
+		0x7fea, 0x7fec, 0x7fe6, 0x7fe8, 0x8064, 0x8067, 0x81a3, 0x819f, 0x819e, 0x8195, 0x81a2, 0x8199, 0x8197, 0x8216, 0x824f, 0x8253, 0x8252, 0x8250, 0x824e, 0x8251, 0x8524, 0x853b, 0x850f, 0x8500, 0x8529, 0x850e, 0x8509, 0x850d, 0x851f, 0x850a, 0x8527, 0x851c, 0x84fb, 0x852b, 0x84fa, 0x8508, 0x850c, 0x84f4, 0x852a, 0x84f2, 0x8515, 0x84f7, 0x84eb, 0x84f3, 0x84fc, 0x8512, 0x84ea, 0x84e9, 0x8516, 0x84fe, 0x8528, 0x851d, 0x852e, 0x8502, 0x84fd, 0x851e, 0x84f6, 0x8531, 0x8526, 0x84e7, 0x84e8, 0x84f0, 0x84ef, 0x84f9, 0x8518, 0x8520, 0x8530, 0x850b, 0x8519, 0x852f, 0x8662, 0x8756, 0x8763, 0x8764, 0x8777, 0x87e1, 0x8773, 0x8758, 0x8754, 0x875b, 0x8752, 0x8761, 0x875a, 0x8751, 0x875e, 0x876d, 0x876a, 0x8750, 0x874e, 0x875f, 0x875d, 0x876f, 0x876c, 0x877a,
+		0x876e, 0x875c, 0x8765, 0x874f, 0x877b, 0x8775, 0x8762, 0x8767, 0x8769, 0x885a, 0x8905, 0x890c, 0x8914, 0x890b, 0x8917, 0x8918, 0x8919, 0x8906, 0x8916, 0x8911, 0x890e, 0x8909, 0x89a2, 0x89a4, 0x89a3, 0x89ed, 0x89f0, 0x89ec, 0x8acf, 0x8ac6, 0x8ab8, 0x8ad3, 0x8ad1, 0x8ad4, 0x8ad5, 0x8abb, 0x8ad7, 0x8abe, 0x8ac0, 0x8ac5, 0x8ad8, 0x8ac3, 0x8aba, 0x8abd, 0x8ad9, 0x8c3e, 0x8c4d, 0x8c8f, 0x8ce5, 0x8cdf, 0x8cd9, 0x8ce8, 0x8cda, 0x8cdd, 0x8ce7, 0x8da0, 0x8d9c, 0x8da1, 0x8d9b, 0x8e20, 0x8e23, 0x8e25, 0x8e24, 0x8e2e, 0x8e15, 0x8e1b, 0x8e16, 0x8e11, 0x8e19, 0x8e26, 0x8e27, 0x8e14, 0x8e12, 0x8e18, 0x8e13, 0x8e1c, 0x8e17, 0x8e1a, 0x8f2c, 0x8f24, 0x8f18, 0x8f1a, 0x8f20, 0x8f23, 0x8f16, 0x8f17, 0x9073, 0x9070, 0x906f, 0x9067, 0x906b, 0x912f, 0x912b, 0x9129,
+		0x912a, 0x9132, 0x9126, 0x912e, 0x9185, 0x9186, 0x918a, 0x9181, 0x9182, 0x9184, 0x9180, 0x92d0, 0x92c3, 0x92c4, 0x92c0, 0x92d9, 0x92b6, 0x92cf, 0x92f1, 0x92df, 0x92d8, 0x92e9, 0x92d7, 0x92dd, 0x92cc, 0x92ef, 0x92c2, 0x92e8, 0x92ca, 0x92c8, 0x92ce, 0x92e6, 0x92cd, 0x92d5, 0x92c9, 0x92e0, 0x92de, 0x92e7, 0x92d1, 0x92d3, 0x92b5, 0x92e1, 0x9325, 0x92c6, 0x92b4, 0x957c, 0x95ac, 0x95ab, 0x95ae, 0x95b0, 0x96a4, 0x96a2, 0x96d3, 0x9705, 0x9708, 0x9702, 0x975a, 0x978a, 0x978e, 0x9788, 0x97d0, 0x97cf, 0x981e, 0x981d, 0x9826, 0x9829, 0x9828, 0x9820, 0x981b, 0x9827, 0x98b2, 0x9908, 0x98fa, 0x9911, 0x9914, 0x9916, 0x9917, 0x9915, 0x99dc, 0x99cd, 0x99cf, 0x99d3, 0x99d4, 0x99ce, 0x99c9, 0x99d6, 0x99d8, 0x99cb, 0x99d7, 0x99cc, 0x9ab3, 0x9aec, 0x9aeb, 0x9af3,
+		0x9af2, 0x9af1, 0x9b46, 0x9b43, 0x9b67, 0x9b74, 0x9b71, 0x9b66, 0x9b76, 0x9b75, 0x9b70, 0x9b68, 0x9b64, 0x9b6c, 0x9cfc, 0x9cfa, 0x9cfd, 0x9cff, 0x9cf7, 0x9d07, 0x9d00, 0x9cf9, 0x9cfb, 0x9d08, 0x9d05, 0x9d04, 0x9e83, 0x9ed3, 0x9f0f, 0x9f10, 0x511c, 0x5113, 0x5117, 0x511a, 0x5111, 0x51de, 0x5334, 0x53e1, 0x5670, 0x5660, 0x566e, 0x5673, 0x5666, 0x5663, 0x566d, 0x5672, 0x565e, 0x5677, 0x571c, 0x571b, 0x58c8, 0x58bd, 0x58c9, 0x58bf, 0x58ba, 0x58c2, 0x58bc, 0x58c6, 0x5b17, 0x5b19, 0x5b1b, 0x5b21, 0x5b14, 0x5b13, 0x5b10, 0x5b16, 0x5b28, 0x5b1a, 0x5b20, 0x5b1e, 0x5bef, 0x5dac, 0x5db1, 0x5da9, 0x5da7, 0x5db5, 0x5db0, 0x5dae, 0x5daa, 0x5da8, 0x5db2, 0x5dad, 0x5daf, 0x5db4, 0x5e67, 0x5e68, 0x5e66, 0x5e6f, 0x5ee9, 0x5ee7, 0x5ee6, 0x5ee8, 0x5ee5, 0x5f4b,
+		0x5fbc, 0x5fbb, 0x619d, 0x61a8, 0x6196, 0x61c5, 0x61b4, 0x61c6, 0x61c1, 0x61cc, 0x61ba, 0x61bf, 0x61b8, 0x618c, 0x64d7, 0x64d6, 0x64d0, 0x64cf, 0x64c9, 0x64bd, 0x6489, 0x64c3, 0x64db, 0x64f3, 0x64d9, 0x6533, 0x657f, 0x657c, 0x65a2, 0x66c8, 0x66be, 0x66c0, 0x66ca, 0x66cb, 0x66cf, 0x66bd, 0x66bb, 0x66ba, 0x66cc, 0x6723, 0x6a34, 0x6a66, 0x6a49, 0x6a67, 0x6a32, 0x6a68, 0x6a3e, 0x6a5d, 0x6a6d, 0x6a76, 0x6a5b, 0x6a51, 0x6a28, 0x6a5a, 0x6a3b, 0x6a3f, 0x6a41, 0x6a6a, 0x6a64, 0x6a50, 0x6a4f, 0x6a54, 0x6a6f, 0x6a69, 0x6a60, 0x6a3c, 0x6a5e, 0x6a56, 0x6a55, 0x6a4d, 0x6a4e, 0x6a46, 0x6b55, 0x6b54, 0x6b56, 0x6ba7, 0x6baa, 0x6bab, 0x6bc8, 0x6bc7, 0x6c04, 0x6c03, 0x6c06, 0x6fad, 0x6fcb, 0x6fa3, 0x6fc7, 0x6fbc, 0x6fce, 0x6fc8, 0x6f5e, 0x6fc4, 0x6fbd, 0x6f9e,
+		0x6fca, 0x6fa8, 0x7004, 0x6fa5, 0x6fae, 0x6fba, 0x6fac, 0x6faa, 0x6fcf, 0x6fbf, 0x6fb8, 0x6fa2, 0x6fc9, 0x6fab, 0x6fcd, 0x6faf, 0x6fb2, 0x6fb0, 0x71c5, 0x71c2, 0x71bf, 0x71b8, 0x71d6, 0x71c0, 0x71c1, 0x71cb, 0x71d4, 0x71ca, 0x71c7, 0x71cf, 0x71bd, 0x71d8, 0x71bc, 0x71c6, 0x71da, 0x71db, 0x729d, 0x729e, 0x7369, 0x7366, 0x7367, 0x736c, 0x7365, 0x736b, 0x736a, 0x747f, 0x749a, 0x74a0, 0x7494, 0x7492, 0x7495, 0x74a1, 0x750b, 0x7580, 0x762f, 0x762d, 0x7631, 0x763d, 0x7633, 0x763c, 0x7635, 0x7632, 0x7630, 0x76bb, 0x76e6, 0x779a, 0x779d, 0x77a1, 0x779c, 0x779b, 0x77a2, 0x77a3, 0x7795, 0x7799, 0x7797, 0x78dd, 0x78e9, 0x78e5, 0x78ea, 0x78de, 0x78e3, 0x78db, 0x78e1, 0x78e2, 0x78ed, 0x78df, 0x78e0, 0x79a4, 0x7a44, 0x7a48, 0x7a47, 0x7ab6, 0x7ab8, 0x7ab5,
+		0x7ab1, 0x7ab7, 0x7bde, 0x7be3, 0x7be7, 0x7bdd, 0x7bd5, 0x7be5, 0x7bda, 0x7be8, 0x7bf9, 0x7bd4, 0x7bea, 0x7be2, 0x7bdc, 0x7beb, 0x7bd8, 0x7bdf, 0x7cd2, 0x7cd4, 0x7cd7, 0x7cd0, 0x7cd1, 0x7e12, 0x7e21, 0x7e17, 0x7e0c, 0x7e1f, 0x7e20, 0x7e13, 0x7e0e, 0x7e1c, 0x7e15, 0x7e1a, 0x7e22, 0x7e0b, 0x7e0f, 0x7e16, 0x7e0d, 0x7e14, 0x7e25, 0x7e24, 0x7f43, 0x7f7b, 0x7f7c, 0x7f7a, 0x7fb1, 0x7fef, 0x802a, 0x8029, 0x806c, 0x81b1, 0x81a6, 0x81ae, 0x81b9, 0x81b5, 0x81ab, 0x81b0, 0x81ac, 0x81b4, 0x81b2, 0x81b7, 0x81a7, 0x81f2, 0x8255, 0x8256, 0x8257, 0x8556, 0x8545, 0x856b, 0x854d, 0x8553, 0x8561, 0x8558, 0x8540, 0x8546, 0x8564, 0x8541, 0x8562, 0x8544, 0x8551, 0x8547, 0x8563, 0x853e, 0x855b, 0x8571, 0x854e, 0x856e, 0x8575, 0x8555, 0x8567, 0x8560, 0x858c, 0x8566,
+		0x855d, 0x8554, 0x8565, 0x856c, 0x8663, 0x8665, 0x8664, 0x87a4, 0x879b, 0x878f, 0x8797, 0x8793, 0x8792, 0x8788, 0x8781, 0x8796, 0x8798, 0x8779, 0x8787, 0x87a3, 0x8785, 0x8790, 0x8791, 0x879d, 0x8784, 0x8794, 0x879c, 0x879a, 0x8789, 0x891e, 0x8926, 0x8930, 0x892d, 0x892e, 0x8927, 0x8931, 0x8922, 0x8929, 0x8923, 0x892f, 0x892c, 0x891f, 0x89f1, 0x8ae0, 0x8ae2, 0x8af2, 0x8af4, 0x8af5, 0x8add, 0x8b14, 0x8ae4, 0x8adf, 0x8af0, 0x8ac8, 0x8ade, 0x8ae1, 0x8ae8, 0x8aff, 0x8aef, 0x8afb, 0x8c91, 0x8c92, 0x8c90, 0x8cf5, 0x8cee, 0x8cf1, 0x8cf0, 0x8cf3, 0x8d6c, 0x8d6e, 0x8da5, 0x8da7, 0x8e33, 0x8e3e, 0x8e38, 0x8e40, 0x8e45, 0x8e36, 0x8e3c, 0x8e3d, 0x8e41, 0x8e30, 0x8e3f, 0x8ebd, 0x8f36, 0x8f2e, 0x8f35, 0x8f32, 0x8f39, 0x8f37, 0x8f34, 0x9076, 0x9079, 0x907b,
+		0x9086, 0x90fa, 0x9133, 0x9135, 0x9136, 0x9193, 0x9190, 0x9191, 0x918d, 0x918f, 0x9327, 0x931e, 0x9308, 0x931f, 0x9306, 0x930f, 0x937a, 0x9338, 0x933c, 0x931b, 0x9323, 0x9312, 0x9301, 0x9346, 0x932d, 0x930e, 0x930d, 0x92cb, 0x931d, 0x92fa, 0x9313, 0x92f9, 0x92f7, 0x9334, 0x9302, 0x9324, 0x92ff, 0x9329, 0x9339, 0x9335, 0x932a, 0x9314, 0x930c, 0x930b, 0x92fe, 0x9309, 0x9300, 0x92fb, 0x9316, 0x95bc, 0x95cd, 0x95be, 0x95b9, 0x95ba, 0x95b6, 0x95bf, 0x95b5, 0x95bd, 0x96a9, 0x96d4, 0x970b, 0x9712, 0x9710, 0x9799, 0x9797, 0x9794, 0x97f0, 0x97f8, 0x9835, 0x982f, 0x9832, 0x9924, 0x991f, 0x9927, 0x9929, 0x999e, 0x99ee, 0x99ec, 0x99e5, 0x99e4, 0x99f0, 0x99e3, 0x99ea, 0x99e9, 0x99e7, 0x9ab9, 0x9abf, 0x9ab4, 0x9abb, 0x9af6, 0x9afa, 0x9af9, 0x9af7, 0x9b33,
+		0x9b80, 0x9b85, 0x9b87, 0x9b7c, 0x9b7e, 0x9b7b, 0x9b82, 0x9b93, 0x9b92, 0x9b90, 0x9b7a, 0x9b95, 0x9b7d, 0x9b88, 0x9d25, 0x9d17, 0x9d20, 0x9d1e, 0x9d14, 0x9d29, 0x9d1d, 0x9d18, 0x9d22, 0x9d10, 0x9d19, 0x9d1f, 0x9e88, 0x9e86, 0x9e87, 0x9eae, 0x9ead, 0x9ed5, 0x9ed6, 0x9efa, 0x9f12, 0x9f3d, 0x5126, 0x5125, 0x5122, 0x5124, 0x5120, 0x5129, 0x52f4, 0x5693, 0x568c, 0x568d, 0x5686, 0x5684, 0x5683, 0x567e, 0x5682, 0x567f, 0x5681, 0x58d6, 0x58d4, 0x58cf, 0x58d2, 0x5b2d, 0x5b25, 0x5b32, 0x5b23, 0x5b2c, 0x5b27, 0x5b26, 0x5b2f, 0x5b2e, 0x5b7b, 0x5bf1, 0x5bf2, 0x5db7, 0x5e6c, 0x5e6a, 0x5fbe, 0x61c3, 0x61b5, 0x61bc, 0x61e7, 0x61e0, 0x61e5, 0x61e4, 0x61e8, 0x61de, 0x64ef, 0x64e9, 0x64e3, 0x64eb, 0x64e4, 0x64e8, 0x6581, 0x6580, 0x65b6, 0x65da, 0x66d2, 0x6a8d,
+		0x6a96, 0x6a81, 0x6aa5, 0x6a89, 0x6a9f, 0x6a9b, 0x6aa1, 0x6a9e, 0x6a87, 0x6a93, 0x6a8e, 0x6a95, 0x6a83, 0x6aa8, 0x6aa4, 0x6a91, 0x6a7f, 0x6aa6, 0x6a9a, 0x6a85, 0x6a8c, 0x6a92, 0x6b5b, 0x6bad, 0x6c09, 0x6fcc, 0x6fa9, 0x6ff4, 0x6fd4, 0x6fe3, 0x6fdc, 0x6fed, 0x6fe7, 0x6fe6, 0x6fde, 0x6ff2, 0x6fdd, 0x6fe2, 0x6fe8, 0x71e1, 0x71f1, 0x71e8, 0x71f2, 0x71e4, 0x71f0, 0x71e2, 0x7373, 0x736e, 0x736f, 0x7497, 0x74b2, 0x74ab, 0x7490, 0x74aa, 0x74ad, 0x74b1, 0x74a5, 0x74af, 0x7510, 0x7511, 0x7512, 0x750f, 0x7584, 0x7643, 0x7648, 0x7649, 0x7647, 0x76a4, 0x76e9, 0x77b5, 0x77ab, 0x77b2, 0x77b7, 0x77b6, 0x77b4, 0x77b1, 0x77a8, 0x77f0, 0x78f3, 0x78fd, 0x7902, 0x78fb, 0x78fc, 0x78ff, 0x78f2, 0x7905, 0x78f9, 0x78fe, 0x7904, 0x79ab, 0x79a8, 0x7a5c, 0x7a5b, 0x7a56,
+		0x7a58, 0x7a54, 0x7a5a, 0x7abe, 0x7ac0, 0x7ac1, 0x7c05, 0x7c0f, 0x7bf2, 0x7c00, 0x7bff, 0x7bfb, 0x7c0e, 0x7bf4, 0x7c0b, 0x7bf3, 0x7c02, 0x7c09, 0x7c03, 0x7c01, 0x7bf8, 0x7bfd, 0x7c06, 0x7bf0, 0x7bf1, 0x7c10, 0x7c0a, 0x7ce8, 0x7e2d, 0x7e3c, 0x7e42, 0x7e33, 0x9848, 0x7e38, 0x7e2a, 0x7e49, 0x7e40, 0x7e47, 0x7e29, 0x7e4c, 0x7e30, 0x7e3b, 0x7e36, 0x7e44, 0x7e3a, 0x7f45, 0x7f7f, 0x7f7e, 0x7f7d, 0x7ff4, 0x7ff2, 0x802c, 0x81bb, 0x81c4, 0x81cc, 0x81ca, 0x81c5, 0x81c7, 0x81bc, 0x81e9, 0x825b, 0x825a, 0x825c, 0x8583, 0x8580, 0x858f, 0x85a7, 0x8595, 0x85a0, 0x858b, 0x85a3, 0x857b, 0x85a4, 0x859a, 0x859e, 0x8577, 0x857c, 0x8589, 0x85a1, 0x857a, 0x8578, 0x8557, 0x858e, 0x8596, 0x8586, 0x858d, 0x8599, 0x859d, 0x8581, 0x85a2, 0x8582, 0x8588, 0x8585, 0x8579,
+		0x8576, 0x8598, 0x8590, 0x859f, 0x8668, 0x87be, 0x87aa, 0x87ad, 0x87c5, 0x87b0, 0x87ac, 0x87b9, 0x87b5, 0x87bc, 0x87ae, 0x87c9, 0x87c3, 0x87c2, 0x87cc, 0x87b7, 0x87af, 0x87c4, 0x87ca, 0x87b4, 0x87b6, 0x87bf, 0x87b8, 0x87bd, 0x87de, 0x87b2, 0x8935, 0x8933, 0x893c, 0x893e, 0x8941, 0x8952, 0x8937, 0x8942, 0x89ad, 0x89af, 0x89ae, 0x89f2, 0x89f3, 0x8b1e, 0x8b18, 0x8b16, 0x8b11, 0x8b05, 0x8b0b, 0x8b22, 0x8b0f, 0x8b12, 0x8b15, 0x8b07, 0x8b0d, 0x8b08, 0x8b06, 0x8b1c, 0x8b13, 0x8b1a, 0x8c4f, 0x8c70, 0x8c72, 0x8c71, 0x8c6f, 0x8c95, 0x8c94, 0x8cf9, 0x8d6f, 0x8e4e, 0x8e4d, 0x8e53, 0x8e50, 0x8e4c, 0x8e47, 0x8f43, 0x8f40, 0x9085, 0x907e, 0x9138, 0x919a, 0x91a2, 0x919b, 0x9199, 0x919f, 0x91a1, 0x919d, 0x91a0, 0x93a1, 0x9383, 0x93af, 0x9364, 0x9356, 0x9347,
+		0x937c, 0x9358, 0x935c, 0x9376, 0x9349, 0x9350, 0x9351, 0x9360, 0x936d, 0x938f, 0x934c, 0x936a, 0x9379, 0x9357, 0x9355, 0x9352, 0x934f, 0x9371, 0x9377, 0x937b, 0x9361, 0x935e, 0x9363, 0x9367, 0x934e, 0x9359, 0x95c7, 0x95c0, 0x95c9, 0x95c3, 0x95c5, 0x95b7, 0x96ae, 0x96b0, 0x96ac, 0x9720, 0x971f, 0x9718, 0x971d, 0x9719, 0x979a, 0x97a1, 0x979c, 0x979e, 0x979d, 0x97d5, 0x97d4, 0x97f1, 0x9841, 0x9844, 0x984a, 0x9849, 0x9845, 0x9843, 0x9925, 0x992b, 0x992c, 0x992a, 0x9933, 0x9932, 0x992f, 0x992d, 0x9931, 0x9930, 0x9998, 0x99a3, 0x99a1, 0x9a02, 0x99fa, 0x99f4, 0x99f7, 0x99f9, 0x99f8, 0x99f6, 0x99fb, 0x99fd, 0x99fe, 0x99fc, 0x9a03, 0x9abe, 0x9afe, 0x9afd, 0x9b01, 0x9afc, 0x9b48, 0x9b9a, 0x9ba8, 0x9b9e, 0x9b9b, 0x9ba6, 0x9ba1, 0x9ba5, 0x9ba4, 0x9b86,
+		0x9ba2, 0x9ba0, 0x9baf, 0x9d33, 0x9d41, 0x9d67, 0x9d36, 0x9d2e, 0x9d2f, 0x9d31, 0x9d38, 0x9d30, 0x9d45, 0x9d42, 0x9d43, 0x9d3e, 0x9d37, 0x9d40, 0x9d3d, 0x7ff5, 0x9d2d, 0x9e8a, 0x9e89, 0x9e8d, 0x9eb0, 0x9ec8, 0x9eda, 0x9efb, 0x9eff, 0x9f24, 0x9f23, 0x9f22, 0x9f54, 0x9fa0, 0x5131, 0x512d, 0x512e, 0x5698, 0x569c, 0x5697, 0x569a, 0x569d, 0x5699, 0x5970, 0x5b3c, 0x5c69, 0x5c6a, 0x5dc0, 0x5e6d, 0x5e6e, 0x61d8, 0x61df, 0x61ed, 0x61ee, 0x61f1, 0x61ea, 0x61f0, 0x61eb, 0x61d6, 0x61e9, 0x64ff, 0x6504, 0x64fd, 0x64f8, 0x6501, 0x6503, 0x64fc, 0x6594, 0x65db, 0x66da, 0x66db, 0x66d8, 0x6ac5, 0x6ab9, 0x6abd, 0x6ae1, 0x6ac6, 0x6aba, 0x6ab6, 0x6ab7, 0x6ac7, 0x6ab4, 0x6aad, 0x6b5e, 0x6bc9, 0x6c0b, 0x7007, 0x700c, 0x700d, 0x7001, 0x7005, 0x7014, 0x700e, 0x6fff,
+		0x7000, 0x6ffb, 0x7026, 0x6ffc, 0x6ff7, 0x700a, 0x7201, 0x71ff, 0x71f9, 0x7203, 0x71fd, 0x7376, 0x74b8, 0x74c0, 0x74b5, 0x74c1, 0x74be, 0x74b6, 0x74bb, 0x74c2, 0x7514, 0x7513, 0x765c, 0x7664, 0x7659, 0x7650, 0x7653, 0x7657, 0x765a, 0x76a6, 0x76bd, 0x76ec, 0x77c2, 0x77ba, 0x790c, 0x7913, 0x7914, 0x7909, 0x7910, 0x7912, 0x7911, 0x79ad, 0x79ac, 0x7a5f, 0x7c1c, 0x7c29, 0x7c19, 0x7c20, 0x7c1f, 0x7c2d, 0x7c1d, 0x7c26, 0x7c28, 0x7c22, 0x7c25, 0x7c30, 0x7e5c, 0x7e50, 0x7e56, 0x7e63, 0x7e58, 0x7e62, 0x7e5f, 0x7e51, 0x7e60, 0x7e57, 0x7e53, 0x7fb5, 0x7fb3, 0x7ff7, 0x7ff8, 0x8075, 0x81d1, 0x81d2, 0x81d0, 0x825f, 0x825e, 0x85b4, 0x85c6, 0x85c0, 0x85c3, 0x85c2, 0x85b3, 0x85b5, 0x85bd, 0x85c7, 0x85c4, 0x85bf, 0x85cb, 0x85ce, 0x85c8, 0x85c5, 0x85b1, 0x85b6,
+		0x85d2, 0x8624, 0x85b8, 0x85b7, 0x85be, 0x8669, 0x87e7, 0x87e6, 0x87e2, 0x87db, 0x87eb, 0x87ea, 0x87e5, 0x87df, 0x87f3, 0x87e4, 0x87d4, 0x87dc, 0x87d3, 0x87ed, 0x87d8, 0x87e3, 0x87d7, 0x87d9, 0x8801, 0x87f4, 0x87e8, 0x87dd, 0x8953, 0x894b, 0x894f, 0x894c, 0x8946, 0x8950, 0x8951, 0x8949, 0x8b2a, 0x8b27, 0x8b23, 0x8b33, 0x8b30, 0x8b35, 0x8b47, 0x8b2f, 0x8b3c, 0x8b3e, 0x8b31, 0x8b25, 0x8b37, 0x8b26, 0x8b36, 0x8b2e, 0x8b24, 0x8b3b, 0x8b3d, 0x8b3a, 0x8c42, 0x8c75, 0x8c99, 0x8c98, 0x8c97, 0x8cfe, 0x8d04, 0x8d02, 0x8d00, 0x8e5c, 0x8e62, 0x8e60, 0x8e57, 0x8e56, 0x8e5e, 0x8e65, 0x8e67, 0x8e5b, 0x8e5a, 0x8e61, 0x8e5d, 0x8e69, 0x8e54, 0x8f46, 0x8f47, 0x8f48, 0x8f4b, 0x9128, 0x913a, 0x913b, 0x913e, 0x91a8, 0x91a5, 0x91a7, 0x91af, 0x91aa, 0x93b5, 0x938c,
+		0x9392, 0x93b7, 0x939b, 0x939d, 0x9389, 0x93a7, 0x938e, 0x93aa, 0x939e, 0x93a6, 0x9395, 0x9388, 0x9399, 0x939f, 0x9380, 0x938d, 0x93b1, 0x9391, 0x93b2, 0x93a4, 0x93a8, 0x93b4, 0x93a3, 0x95d2, 0x95d3, 0x95d1, 0x96b3, 0x96d7, 0x96da, 0x5dc2, 0x96df, 0x96d8, 0x96dd, 0x9723, 0x9722, 0x9725, 0x97ac, 0x97ae, 0x97a8, 0x97ab, 0x97a4, 0x97aa, 0x97a2, 0x97a5, 0x97d7, 0x97d9, 0x97d6, 0x97d8, 0x97fa, 0x9850, 0x9851, 0x9852, 0x98b8, 0x9941, 0x993c, 0x993a, 0x9a0f, 0x9a0b, 0x9a09, 0x9a0d, 0x9a04, 0x9a11, 0x9a0a, 0x9a05, 0x9a07, 0x9a06, 0x9ac0, 0x9adc, 0x9b08, 0x9b04, 0x9b05, 0x9b29, 0x9b35, 0x9b4a, 0x9b4c, 0x9b4b, 0x9bc7, 0x9bc6, 0x9bc3, 0x9bbf, 0x9bc1, 0x9bb5, 0x9bb8, 0x9bd3, 0x9bb6, 0x9bc4, 0x9bb9, 0x9bbd, 0x9d5c, 0x9d53, 0x9d4f, 0x9d4a, 0x9d5b, 0x9d4b,
+		0x9d59, 0x9d56, 0x9d4c, 0x9d57, 0x9d52, 0x9d54, 0x9d5f, 0x9d58, 0x9d5a, 0x9e8e, 0x9e8c, 0x9edf, 0x9f01, 0x9f00, 0x9f16, 0x9f25, 0x9f2b, 0x9f2a, 0x9f29, 0x9f28, 0x9f4c, 0x9f55, 0x5134, 0x5135, 0x5296, 0x52f7, 0x53b4, 0x56ab, 0x56ad, 0x56a6, 0x56a7, 0x56aa, 0x56ac, 0x58da, 0x58dd, 0x58db, 0x5912, 0x5b3d, 0x5b3e, 0x5b3f, 0x5dc3, 0x5e70, 0x5fbf, 0x61fb, 0x6507, 0x6510, 0x650d, 0x6509, 0x650c, 0x650e, 0x6584, 0x65de, 0x65dd, 0x66de, 0x6ae7, 0x6ae0, 0x6acc, 0x6ad1, 0x6ad9, 0x6acb, 0x6adf, 0x6adc, 0x6ad0, 0x6aeb, 0x6acf, 0x6acd, 0x6ade, 0x6b60, 0x6bb0, 0x6c0c, 0x7019, 0x7027, 0x7020, 0x7016, 0x702b, 0x7021, 0x7022, 0x7023, 0x7029, 0x7017, 0x7024, 0x701c, 0x720c, 0x720a, 0x7207, 0x7202, 0x7205, 0x72a5, 0x72a6, 0x72a4, 0x72a3, 0x72a1, 0x74cb, 0x74c5,
+		0x74b7, 0x74c3, 0x7516, 0x7660, 0x77c9, 0x77ca, 0x77c4, 0x77f1, 0x791d, 0x791b, 0x7921, 0x791c, 0x7917, 0x791e, 0x79b0, 0x7a67, 0x7a68, 0x7c33, 0x7c3c, 0x7c39, 0x7c2c, 0x7c3b, 0x7cec, 0x7cea, 0x7e76, 0x7e75, 0x7e78, 0x7e70, 0x7e77, 0x7e6f, 0x7e7a, 0x7e72, 0x7e74, 0x7e68, 0x7f4b, 0x7f4a, 0x7f83, 0x7f86, 0x7fb7, 0x7ffd, 0x7ffe, 0x8078, 0x81d7, 0x81d5, 0x820b, 0x8264, 0x8261, 0x8263, 0x85eb, 0x85f1, 0x85ed, 0x85d9, 0x85e1, 0x85e8, 0x85da, 0x85d7, 0x85ec, 0x85f2, 0x85f8, 0x85d8, 0x85df, 0x85e3, 0x85dc, 0x85d1, 0x85f0, 0x85e6, 0x85ef, 0x85de, 0x85e2, 0x8800, 0x87fa, 0x8803, 0x87f6, 0x87f7, 0x8809, 0x880c, 0x880b, 0x8806, 0x87fc, 0x8808, 0x87ff, 0x880a, 0x8802, 0x8962, 0x895a, 0x895b, 0x8957, 0x8961, 0x895c, 0x8958, 0x895d, 0x8959, 0x8988, 0x89b7,
+		0x89b6, 0x89f6, 0x8b50, 0x8b48, 0x8b4a, 0x8b40, 0x8b53, 0x8b56, 0x8b54, 0x8b4b, 0x8b55, 0x8b51, 0x8b42, 0x8b52, 0x8b57, 0x8c43, 0x8c77, 0x8c76, 0x8c9a, 0x8d06, 0x8d07, 0x8d09, 0x8dac, 0x8daa, 0x8dad, 0x8dab, 0x8e6d, 0x8e78, 0x8e73, 0x8e6a, 0x8e6f, 0x8e7b, 0x8ec2, 0x8f52, 0x8f51, 0x8f4f, 0x8f50, 0x8f53, 0x8fb4, 0x9140, 0x913f, 0x91b0, 0x91ad, 0x93de, 0x93c7, 0x93cf, 0x93c2, 0x93da, 0x93d0, 0x93f9, 0x93ec, 0x93cc, 0x93d9, 0x93a9, 0x93e6, 0x93ca, 0x93d4, 0x93ee, 0x93e3, 0x93d5, 0x93c4, 0x93ce, 0x93c0, 0x93d2, 0x93a5, 0x93e7, 0x957d, 0x95da, 0x95db, 0x96e1, 0x9729, 0x972b, 0x972c, 0x9728, 0x9726, 0x97b3, 0x97b7, 0x97b6, 0x97dd, 0x97de, 0x97df, 0x985c, 0x9859, 0x985d, 0x9857, 0x98bf, 0x98bd, 0x98bb, 0x98be, 0x9948, 0x9947, 0x9943, 0x99a6, 0x99a7,
+		0x9a1a, 0x9a15, 0x9a25, 0x9a1d, 0x9a24, 0x9a1b, 0x9a22, 0x9a20, 0x9a27, 0x9a23, 0x9a1e, 0x9a1c, 0x9a14, 0x9ac2, 0x9b0b, 0x9b0a, 0x9b0e, 0x9b0c, 0x9b37, 0x9bea, 0x9beb, 0x9be0, 0x9bde, 0x9be4, 0x9be6, 0x9be2, 0x9bf0, 0x9bd4, 0x9bd7, 0x9bec, 0x9bdc, 0x9bd9, 0x9be5, 0x9bd5, 0x9be1, 0x9bda, 0x9d77, 0x9d81, 0x9d8a, 0x9d84, 0x9d88, 0x9d71, 0x9d80, 0x9d78, 0x9d86, 0x9d8b, 0x9d8c, 0x9d7d, 0x9d6b, 0x9d74, 0x9d75, 0x9d70, 0x9d69, 0x9d85, 0x9d73, 0x9d7b, 0x9d82, 0x9d6f, 0x9d79, 0x9d7f, 0x9d87, 0x9d68, 0x9e94, 0x9e91, 0x9ec0, 0x9efc, 0x9f2d, 0x9f40, 0x9f41, 0x9f4d, 0x9f56, 0x9f57, 0x9f58, 0x5337, 0x56b2, 0x56b5, 0x56b3, 0x58e3, 0x5b45, 0x5dc6, 0x5dc7, 0x5eee, 0x5eef, 0x5fc0, 0x5fc1, 0x61f9, 0x6517, 0x6516, 0x6515, 0x6513, 0x65df, 0x66e8, 0x66e3, 0x66e4,
+		0x6af3, 0x6af0, 0x6aea, 0x6ae8, 0x6af9, 0x6af1, 0x6aee, 0x6aef, 0x703c, 0x7035, 0x702f, 0x7037, 0x7034, 0x7031, 0x7042, 0x7038, 0x703f, 0x703a, 0x7039, 0x702a, 0x7040, 0x703b, 0x7033, 0x7041, 0x7213, 0x7214, 0x72a8, 0x737d, 0x737c, 0x74ba, 0x76ab, 0x76aa, 0x76be, 0x76ed, 0x77cc, 0x77ce, 0x77cf, 0x77cd, 0x77f2, 0x7925, 0x7923, 0x7927, 0x7928, 0x7924, 0x7929, 0x79b2, 0x7a6e, 0x7a6c, 0x7a6d, 0x7af7, 0x7c49, 0x7c48, 0x7c4a, 0x7c47, 0x7c45, 0x7cee, 0x7e7b, 0x7e7e, 0x7e81, 0x7e80, 0x7fba, 0x7fff, 0x8079, 0x81db, 0x81d9, 0x8268, 0x8269, 0x8622, 0x85ff, 0x8601, 0x85fe, 0x861b, 0x8600, 0x85f6, 0x8604, 0x8609, 0x8605, 0x860c, 0x85fd, 0x8819, 0x8810, 0x8811, 0x8817, 0x8813, 0x8816, 0x8963, 0x8966, 0x89b9, 0x89f7, 0x8b60, 0x8b6a, 0x8b5d, 0x8b68, 0x8b63,
+		0x8b65, 0x8b67, 0x8b6d, 0x8dae, 0x8e86, 0x8e88, 0x8e84, 0x8f59, 0x8f56, 0x8f57, 0x8f55, 0x8f58, 0x8f5a, 0x908d, 0x9143, 0x9141, 0x91b7, 0x91b5, 0x91b2, 0x91b3, 0x940b, 0x9413, 0x93fb, 0x9420, 0x940f, 0x9414, 0x93fe, 0x9415, 0x9410, 0x9428, 0x9419, 0x940d, 0x93f5, 0x9400, 0x93f7, 0x9407, 0x940e, 0x9416, 0x9412, 0x93fa, 0x9409, 0x93f8, 0x943c, 0x940a, 0x93ff, 0x93fc, 0x940c, 0x93f6, 0x9411, 0x9406, 0x95de, 0x95e0, 0x95df, 0x972e, 0x972f, 0x97b9, 0x97bb, 0x97fd, 0x97fe, 0x9860, 0x9862, 0x9863, 0x985f, 0x98c1, 0x98c2, 0x9950, 0x994e, 0x9959, 0x994c, 0x994b, 0x9953, 0x9a32, 0x9a34, 0x9a31, 0x9a2c, 0x9a2a, 0x9a36, 0x9a29, 0x9a2e, 0x9a38, 0x9a2d, 0x9ac7, 0x9aca, 0x9ac6, 0x9b10, 0x9b12, 0x9b11, 0x9c0b, 0x9c08, 0x9bf7, 0x9c05, 0x9c12, 0x9bf8, 0x9c40,
+		0x9c07, 0x9c0e, 0x9c06, 0x9c17, 0x9c14, 0x9c09, 0x9d9f, 0x9d99, 0x9da4, 0x9d9d, 0x9d92, 0x9d98, 0x9d90, 0x9d9b, 0x9da0, 0x9d94, 0x9d9c, 0x9daa, 0x9d97, 0x9da1, 0x9d9a, 0x9da2, 0x9da8, 0x9d9e, 0x9da3, 0x9dbf, 0x9da9, 0x9d96, 0x9da6, 0x9da7, 0x9e99, 0x9e9b, 0x9e9a, 0x9ee5, 0x9ee4, 0x9ee7, 0x9ee6, 0x9f30, 0x9f2e, 0x9f5b, 0x9f60, 0x9f5e, 0x9f5d, 0x9f59, 0x9f91, 0x513a, 0x5139, 0x5298, 0x5297, 0x56c3, 0x56bd, 0x56be, 0x5b48, 0x5b47, 0x5dcb, 0x5dcf, 0x5ef1, 0x61fd, 0x651b, 0x6b02, 0x6afc, 0x6b03, 0x6af8, 0x6b00, 0x7043, 0x7044, 0x704a, 0x7048, 0x7049, 0x7045, 0x7046, 0x721d, 0x721a, 0x7219, 0x737e, 0x7517, 0x766a, 0x77d0, 0x792d, 0x7931, 0x792f, 0x7c54, 0x7c53, 0x7cf2, 0x7e8a, 0x7e87, 0x7e88, 0x7e8b, 0x7e86, 0x7e8d, 0x7f4d, 0x7fbb, 0x8030, 0x81dd,
+		0x8618, 0x862a, 0x8626, 0x861f, 0x8623, 0x861c, 0x8619, 0x8627, 0x862e, 0x8621, 0x8620, 0x8629, 0x861e, 0x8625, 0x8829, 0x881d, 0x881b, 0x8820, 0x8824, 0x881c, 0x882b, 0x884a, 0x896d, 0x8969, 0x896e, 0x896b, 0x89fa, 0x8b79, 0x8b78, 0x8b45, 0x8b7a, 0x8b7b, 0x8d10, 0x8d14, 0x8daf, 0x8e8e, 0x8e8c, 0x8f5e, 0x8f5b, 0x8f5d, 0x9146, 0x9144, 0x9145, 0x91b9, 0x943f, 0x943b, 0x9436, 0x9429, 0x943d, 0x9430, 0x9439, 0x942a, 0x9437, 0x942c, 0x9440, 0x9431, 0x95e5, 0x95e4, 0x95e3, 0x9735, 0x973a, 0x97bf, 0x97e1, 0x9864, 0x98c9, 0x98c6, 0x98c0, 0x9958, 0x9956, 0x9a39, 0x9a3d, 0x9a46, 0x9a44, 0x9a42, 0x9a41, 0x9a3a, 0x9a3f, 0x9acd, 0x9b15, 0x9b17, 0x9b18, 0x9b16, 0x9b3a, 0x9b52, 0x9c2b, 0x9c1d, 0x9c1c, 0x9c2c, 0x9c23, 0x9c28, 0x9c29, 0x9c24, 0x9c21, 0x9db7,
+		0x9db6, 0x9dbc, 0x9dc1, 0x9dc7, 0x9dca, 0x9dcf, 0x9dbe, 0x9dc5, 0x9dc3, 0x9dbb, 0x9db5, 0x9dce, 0x9db9, 0x9dba, 0x9dac, 0x9dc8, 0x9db1, 0x9dad, 0x9dcc, 0x9db3, 0x9dcd, 0x9db2, 0x9e7a, 0x9e9c, 0x9eeb, 0x9eee, 0x9eed, 0x9f1b, 0x9f18, 0x9f1a, 0x9f31, 0x9f4e, 0x9f65, 0x9f64, 0x9f92, 0x4eb9, 0x56c6, 0x56c5, 0x56cb, 0x5971, 0x5b4b, 0x5b4c, 0x5dd5, 0x5dd1, 0x5ef2, 0x6521, 0x6520, 0x6526, 0x6522, 0x6b0b, 0x6b08, 0x6b09, 0x6c0d, 0x7055, 0x7056, 0x7057, 0x7052, 0x721e, 0x721f, 0x72a9, 0x737f, 0x74d8, 0x74d5, 0x74d9, 0x74d7, 0x766d, 0x76ad, 0x7935, 0x79b4, 0x7a70, 0x7a71, 0x7c57, 0x7c5c, 0x7c59, 0x7c5b, 0x7c5a, 0x7cf4, 0x7cf1, 0x7e91, 0x7f4f, 0x7f87, 0x81de, 0x826b, 0x8634, 0x8635, 0x8633, 0x862c, 0x8632, 0x8636, 0x882c, 0x8828, 0x8826, 0x882a, 0x8825,
+		0x8971, 0x89bf, 0x89be, 0x89fb, 0x8b7e, 0x8b84, 0x8b82, 0x8b86, 0x8b85, 0x8b7f, 0x8d15, 0x8e95, 0x8e94, 0x8e9a, 0x8e92, 0x8e90, 0x8e96, 0x8e97, 0x8f60, 0x8f62, 0x9147, 0x944c, 0x9450, 0x944a, 0x944b, 0x944f, 0x9447, 0x9445, 0x9448, 0x9449, 0x9446, 0x973f, 0x97e3, 0x986a, 0x9869, 0x98cb, 0x9954, 0x995b, 0x9a4e, 0x9a53, 0x9a54, 0x9a4c, 0x9a4f, 0x9a48, 0x9a4a, 0x9a49, 0x9a52, 0x9a50, 0x9ad0, 0x9b19, 0x9b2b, 0x9b3b, 0x9b56, 0x9b55, 0x9c46, 0x9c48, 0x9c3f, 0x9c44, 0x9c39, 0x9c33, 0x9c41, 0x9c3c, 0x9c37, 0x9c34, 0x9c32, 0x9c3d, 0x9c36, 0x9ddb, 0x9dd2, 0x9dde, 0x9dda, 0x9dcb, 0x9dd0, 0x9ddc, 0x9dd1, 0x9ddf, 0x9de9, 0x9dd9, 0x9dd8, 0x9dd6, 0x9df5, 0x9dd5, 0x9ddd, 0x9eb6, 0x9ef0, 0x9f35, 0x9f33, 0x9f32, 0x9f42, 0x9f6b, 0x9f95, 0x9fa2, 0x513d, 0x5299,
+		0x58e8, 0x58e7, 0x5972, 0x5b4d, 0x5dd8, 0x882f, 0x5f4f, 0x6201, 0x6203, 0x6204, 0x6529, 0x6525, 0x6596, 0x66eb, 0x6b11, 0x6b12, 0x6b0f, 0x6bca, 0x705b, 0x705a, 0x7222, 0x7382, 0x7381, 0x7383, 0x7670, 0x77d4, 0x7c67, 0x7c66, 0x7e95, 0x826c, 0x863a, 0x8640, 0x8639, 0x863c, 0x8631, 0x863b, 0x863e, 0x8830, 0x8832, 0x882e, 0x8833, 0x8976, 0x8974, 0x8973, 0x89fe, 0x8b8c, 0x8b8e, 0x8b8b, 0x8b88, 0x8c45, 0x8d19, 0x8e98, 0x8f64, 0x8f63, 0x91bc, 0x9462, 0x9455, 0x945d, 0x9457, 0x945e, 0x97c4, 0x97c5, 0x9800, 0x9a56, 0x9a59, 0x9b1e, 0x9b1f, 0x9b20, 0x9c52, 0x9c58, 0x9c50, 0x9c4a, 0x9c4d, 0x9c4b, 0x9c55, 0x9c59, 0x9c4c, 0x9c4e, 0x9dfb, 0x9df7, 0x9def, 0x9de3, 0x9deb, 0x9df8, 0x9de4, 0x9df6, 0x9de1, 0x9dee, 0x9de6, 0x9df2, 0x9df0, 0x9de2, 0x9dec, 0x9df4,
+		0x9df3, 0x9de8, 0x9ded, 0x9ec2, 0x9ed0, 0x9ef2, 0x9ef3, 0x9f06, 0x9f1c, 0x9f38, 0x9f37, 0x9f36, 0x9f43, 0x9f4f, 0x9f71, 0x9f70, 0x9f6e, 0x9f6f, 0x56d3, 0x56cd, 0x5b4e, 0x5c6d, 0x652d, 0x66ed, 0x66ee, 0x6b13, 0x705f, 0x7061, 0x705d, 0x7060, 0x7223, 0x74db, 0x74e5, 0x77d5, 0x7938, 0x79b7, 0x79b6, 0x7c6a, 0x7e97, 0x7f89, 0x826d, 0x8643, 0x8838, 0x8837, 0x8835, 0x884b, 0x8b94, 0x8b95, 0x8e9e, 0x8e9f, 0x8ea0, 0x8e9d, 0x91be, 0x91bd, 0x91c2, 0x946b, 0x9468, 0x9469, 0x96e5, 0x9746, 0x9743, 0x9747, 0x97c7, 0x97e5, 0x9a5e, 0x9ad5, 0x9b59, 0x9c63, 0x9c67, 0x9c66, 0x9c62, 0x9c5e, 0x9c60, 0x9e02, 0x9dfe, 0x9e07, 0x9e03, 0x9e06, 0x9e05, 0x9e00, 0x9e01, 0x9e09, 0x9dff, 0x9dfd, 0x9e04, 0x9ea0, 0x9f1e, 0x9f46, 0x9f74, 0x9f75, 0x9f76, 0x56d4, 0x652e, 0x65b8,
+		0x6b18, 0x6b19, 0x6b17, 0x6b1a, 0x7062, 0x7226, 0x72aa, 0x77d8, 0x77d9, 0x7939, 0x7c69, 0x7c6b, 0x7cf6, 0x7e9a, 0x7e98, 0x7e9b, 0x7e99, 0x81e0, 0x81e1, 0x8646, 0x8647, 0x8648, 0x8979, 0x897a, 0x897c, 0x897b, 0x89ff, 0x8b98, 0x8b99, 0x8ea5, 0x8ea4, 0x8ea3, 0x946e, 0x946d, 0x946f, 0x9471, 0x9473, 0x9749, 0x9872, 0x995f, 0x9c68, 0x9c6e, 0x9c6d, 0x9e0b, 0x9e0d, 0x9e10, 0x9e0f, 0x9e12, 0x9e11, 0x9ea1, 0x9ef5, 0x9f09, 0x9f47, 0x9f78, 0x9f7b, 0x9f7a, 0x9f79, 0x571e, 0x7066, 0x7c6f, 0x883c, 0x8db2, 0x8ea6, 0x91c3, 0x9474, 0x9478, 0x9476, 0x9475, 0x9a60, 0x9b2e, 0x9c74, 0x9c73, 0x9c71, 0x9c75, 0x9e14, 0x9e13, 0x9ef6, 0x9f0a, 0x9fa4, 0x7068, 0x7065, 0x7cf7, 0x866a, 0x883e, 0x883d, 0x883f, 0x8b9e, 0x8c9c, 0x8ea9, 0x8ec9, 0x974b, 0x9873, 0x9874, 0x98cc,
+		0x9961, 0x99ab, 0x9a64, 0x9a66, 0x9a67, 0x9b24, 0x9e15, 0x9e17, 0x9f48, 0x6207, 0x6b1e, 0x7227, 0x864c, 0x8ea8, 0x9482, 0x9480, 0x9481, 0x9a69, 0x9a68, 0x9e19, 0x864b, 0x8b9f, 0x9483, 0x9c79, 0x9eb7, 0x7675, 0x9a6b, 0x9c7a, 0x9e1d, 0x7069, 0x706a, 0x7229, 0x9ea4, 0x9f7e, 0x9f49, 0x9f98, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 3
+		0x4e28, 0x4e36, 0x4e3f, 0x4e85, 0x4e05, 0x4e04, 0x5182, 0x5196, 0x5338, 0x5369, 0x53b6, 0x4e2a, 0x4e87, 0x4e49, 0x51e2, 0x4e46, 0x4e8f, 0x4ebc, 0x4ebe, 0x5166, 0x51e3, 0x5204, 0x529c, 0x5344, 0x5902, 0x590a, 0x5b80, 0x5ddb, 0x5e7a, 0x5e7f, 0x5ef4, 0x5f50, 0x5f51, 0x5f61, 0x961d, 0x2000b, 0x4e63, 0x4e62, 0x4ea3, 0x5185, 0x4ec5, 0x4ecf, 0x4ece, 0x4ecc, 0x5184, 0x5186, 0x2f817, 0x34c5, 0x51e4, 0x5205, 0x529e, 0x529d, 0x52fd, 0x5300, 0x533a, 0x3539, 0x5346, 0x535d, 0x5386, 0x53b7, 0x3555, 0x53cc, 0x355b, 0x53ce, 0x5721, 0x37a2, 0x5e00, 0x5f0c, 0x6237, 0x6238, 0x6534, 0x6535, 0x65e0, 0x3e26, 0x738d, 0x4e97, 0x4ee0, 0x3432, 0x201a9, 0x4ee7, 0x3433, 0x4ee6, 0x3434, 0x36a2, 0x3431, 0x34b0, 0x56d8, 0x518b, 0x518c, 0x5199, 0x51e5, 0x2f850, 0x520b, 0x34dc,
+		0x361e, 0x5304, 0x5303, 0x5307, 0x2f82a, 0x531e, 0x535f, 0x536d, 0x5389, 0x53ba, 0x53d0, 0x3565, 0x53f6, 0x53f7, 0x53f9, 0x3564, 0x53f4, 0x361d, 0x3626, 0x5724, 0x5904, 0x5918, 0x5932, 0x5930, 0x5934, 0x368e, 0x5975, 0x374a, 0x5b82, 0x5bf9, 0x5c14, 0x378b, 0x37a6, 0x37a4, 0x37a5, 0x37a7, 0x382f, 0x3832, 0x5e81, 0x5e83, 0x5f0d, 0x5f52, 0x38d4, 0x5fca, 0x5fc7, 0x6239, 0x39c5, 0x624f, 0x65e7, 0x672f, 0x6b7a, 0x6c39, 0x3cba, 0x3cb9, 0x6c37, 0x6c44, 0x6c45, 0x738c, 0x7592, 0x7676, 0x9093, 0x9092, 0x48b3, 0x49ba, 0x4e21, 0x4e20, 0x4e22, 0x4e68, 0x4e89, 0x4e98, 0x4ef9, 0x4eef, 0x343b, 0x343c, 0x4ef8, 0x4f06, 0x4f03, 0x4efc, 0x4eee, 0x4f16, 0x3439, 0x4f28, 0x4f1c, 0x4f07, 0x4f1a, 0x4efa, 0x4f17, 0x514a, 0x34b2, 0x5172, 0x2f815, 0x51b4, 0x51b3, 0x51b2,
+		0x34c7, 0x51e8, 0x342b, 0x5214, 0x520f, 0x5215, 0x5218, 0x52a8, 0x2f82c, 0x534b, 0x534f, 0x353b, 0x5350, 0x3544, 0x538b, 0x3542, 0x53be, 0x355c, 0x53d2, 0x5416, 0x53ff, 0x3567, 0x5400, 0x3566, 0x5405, 0x5413, 0x5415, 0x2f83b, 0x361f, 0x56e3, 0x5735, 0x5736, 0x5731, 0x5732, 0x58ee, 0x5905, 0x4e54, 0x368f, 0x5936, 0x3690, 0x36a8, 0x36a4, 0x597a, 0x36a3, 0x5986, 0x373d, 0x374c, 0x5b86, 0x5f53, 0x5c18, 0x378c, 0x5c3d, 0x5c78, 0x37a8, 0x37ad, 0x37af, 0x21d46, 0x5c80, 0x3829, 0x5e08, 0x3836, 0x3871, 0x3870, 0x386f, 0x5ef5, 0x5f0e, 0x38a9, 0x38aa, 0x38fb, 0x5fd3, 0x5fda, 0x38fc, 0x5fdb, 0x39ae, 0x620f, 0x625d, 0x625f, 0x6267, 0x6257, 0x9f50, 0x3ac3, 0x65eb, 0x65ea, 0x3b30, 0x6737, 0x3b41, 0x6732, 0x6736, 0x6b22, 0x6bce, 0x3c8c, 0x6c58, 0x6c51, 0x6c77,
+		0x6c3c, 0x3cbb, 0x6c5a, 0x23c86, 0x6c53, 0x706f, 0x7072, 0x706e, 0x2f835, 0x3da1, 0x7073, 0x72b1, 0x72b2, 0x3ea8, 0x738f, 0x3eaa, 0x3eab, 0x4096, 0x793c, 0x41c2, 0x808d, 0x808e, 0x4493, 0x827b, 0x4494, 0x8d71, 0x8fb9, 0x9096, 0x909a, 0x49bb, 0x4e24, 0x4e71, 0x2f81b, 0x4e9c, 0x4f45, 0x4f4a, 0x4f39, 0x4f37, 0x3443, 0x4f32, 0x4f42, 0x3442, 0x4f44, 0x4f4b, 0x3444, 0x4f40, 0x4f35, 0x4f31, 0x5151, 0x2f80e, 0x5150, 0x514e, 0x34b3, 0x34b7, 0x519d, 0x34c8, 0x51b5, 0x51b8, 0x51ec, 0x5223, 0x5227, 0x5226, 0x521f, 0x522b, 0x5220, 0x52b4, 0x52b3, 0x3518, 0x5325, 0x533b, 0x5374, 0x3547, 0x3546, 0x3545, 0x356b, 0x3569, 0x544d, 0x3572, 0x3571, 0x543a, 0x356c, 0x356f, 0x5444, 0x544c, 0x5423, 0x541a, 0x5432, 0x544b, 0x5421, 0x3573, 0x5434, 0x5449, 0x5450, 0x5422,
+		0x543f, 0x5451, 0x545a, 0x542f, 0x3576, 0x56e9, 0x56f2, 0x56f3, 0x56ef, 0x56ed, 0x56ec, 0x56e6, 0x5748, 0x3627, 0x5744, 0x573f, 0x573c, 0x5753, 0x5756, 0x3630, 0x575f, 0x5743, 0x5758, 0x5757, 0x3629, 0x362a, 0x362f, 0x5746, 0x362c, 0x573d, 0x362d, 0x5742, 0x5754, 0x5755, 0x58f1, 0x58f2, 0x58f0, 0x590b, 0x9ea6, 0x56f1, 0x593d, 0x3693, 0x5994, 0x598c, 0x36ad, 0x599c, 0x36ac, 0x36ab, 0x599f, 0x36a9, 0x599b, 0x36ae, 0x5989, 0x599a, 0x36aa, 0x6588, 0x374e, 0x5b8d, 0x3750, 0x5bfe, 0x5bff, 0x5bfd, 0x5c2b, 0x37b2, 0x5c84, 0x5c8e, 0x5c9c, 0x37b5, 0x37b6, 0x5c85, 0x5df5, 0x5e09, 0x3839, 0x383b, 0x5e0b, 0x3872, 0x5e92, 0x5e90, 0x5f03, 0x38ac, 0x5f1e, 0x5f63, 0x3908, 0x5fe7, 0x5ffe, 0x5fe6, 0x5fdc, 0x5fce, 0x3903, 0x5ffc, 0x5fdf, 0x5fec, 0x5ff6, 0x225d7,
+		0x5ff2, 0x5ff0, 0x5ff9, 0x390b, 0x6213, 0x39af, 0x2f8b2, 0x623b, 0x623c, 0x6282, 0x39ce, 0x39cb, 0x39cc, 0x6278, 0x628b, 0x39cd, 0x629e, 0x62a5, 0x629b, 0x629c, 0x6299, 0x628d, 0x6285, 0x629d, 0x6275, 0x3a80, 0x3aaf, 0x3ad3, 0x65f6, 0x3ad5, 0x3ad4, 0x3ad7, 0x66f5, 0x675b, 0x3b42, 0x6754, 0x6752, 0x3b44, 0x6758, 0x6744, 0x674a, 0x6761, 0x3cc6, 0x6c7f, 0x6c91, 0x6c9e, 0x3cc0, 0x6c6e, 0x6c7c, 0x6c9f, 0x6c75, 0x3cbe, 0x6c56, 0x6ca2, 0x6c79, 0x3cca, 0x6ca1, 0x3cc4, 0x6caa, 0x6ca0, 0x3cc2, 0x7079, 0x7077, 0x707e, 0x3da4, 0x7075, 0x707b, 0x7264, 0x3e29, 0x72bb, 0x72bc, 0x72c7, 0x72b9, 0x72be, 0x72b6, 0x3e60, 0x3e5e, 0x7398, 0x3ead, 0x3eae, 0x3eac, 0x3f57, 0x7593, 0x7680, 0x3fdd, 0x7683, 0x76c0, 0x76c1, 0x400e, 0x4097, 0x77f4, 0x77f5, 0x4127, 0x7acc,
+		0x7acd, 0x7cfa, 0x809f, 0x8091, 0x8097, 0x8094, 0x4495, 0x8286, 0x828c, 0x2f98f, 0x8295, 0x4498, 0x866c, 0x459d, 0x8fb5, 0x8fbe, 0x8fc7, 0x488a, 0x8fc1, 0x90a9, 0x90a4, 0x48b5, 0x48b6, 0x48b7, 0x90a8, 0x9627, 0x9626, 0x962b, 0x9633, 0x9634, 0x9629, 0x4e3d, 0x3428, 0x4e9d, 0x4f93, 0x4f8a, 0x344d, 0x3449, 0x4f6d, 0x4f8e, 0x4fa0, 0x4fa2, 0x4fa1, 0x4f9f, 0x4fa3, 0x20209, 0x4f72, 0x3451, 0x4f8c, 0x5156, 0x2f80f, 0x2f811, 0x5190, 0x34cb, 0x34ca, 0x34cc, 0x51ed, 0x51fe, 0x522f, 0x206ec, 0x523c, 0x5234, 0x5239, 0x52b9, 0x52b5, 0x52bf, 0x5355, 0x353d, 0x5376, 0x537a, 0x5393, 0x3548, 0x53c1, 0x53c2, 0x53d5, 0x5485, 0x3578, 0x545f, 0x5493, 0x5489, 0x5479, 0x9efe, 0x548f, 0x5469, 0x546d, 0x357a, 0x5494, 0x546a, 0x548a, 0x3577, 0x56fd, 0x56fb, 0x56f8, 0x3621,
+		0x56fc, 0x56f6, 0x5765, 0x5781, 0x5763, 0x5767, 0x3631, 0x576e, 0x5778, 0x577f, 0x3633, 0x3634, 0x58f3, 0x594b, 0x594c, 0x36c1, 0x36b0, 0x36b4, 0x59ad, 0x36b8, 0x59c4, 0x36bc, 0x59c2, 0x59b0, 0x36bf, 0x36b5, 0x36b1, 0x36bd, 0x59bf, 0x36bb, 0x59c9, 0x59b8, 0x59ac, 0x36b3, 0x36b6, 0x36ba, 0x59b7, 0x59d7, 0x36b7, 0x5b60, 0x3740, 0x5b96, 0x5b9e, 0x5b94, 0x5b9f, 0x5b9d, 0x3752, 0x5c00, 0x5c19, 0x3790, 0x3791, 0x5c49, 0x5c4a, 0x37be, 0x5cbb, 0x5cc1, 0x37c0, 0x37c1, 0x37b9, 0x5cb9, 0x5c9e, 0x5cb4, 0x5cba, 0x5df6, 0x5e13, 0x5e12, 0x5e77, 0x3879, 0x5e98, 0x387b, 0x5e99, 0x5e9d, 0x5ef8, 0x38a0, 0x5ef9, 0x3429, 0x5f06, 0x5f21, 0x38ae, 0x5f25, 0x5f55, 0x38cd, 0x38cb, 0x38d9, 0x5f84, 0x5f83, 0x6030, 0x6007, 0x390c, 0x6036, 0x3901, 0x3905, 0x3902, 0x5fe9,
+		0x603d, 0x6008, 0x3913, 0x3911, 0x62ba, 0x62b2, 0x39e4, 0x62b7, 0x62e4, 0x62a7, 0x39da, 0x39d5, 0x39d3, 0x62d5, 0x62e1, 0x62dd, 0x62a6, 0x62c1, 0x62c5, 0x62c0, 0x62df, 0x62e0, 0x62de, 0x39d6, 0x6589, 0x3ab4, 0x65a6, 0x65ba, 0x3ad9, 0x65ff, 0x3ad8, 0x6617, 0x6618, 0x6601, 0x65fe, 0x3b33, 0x670c, 0x3b48, 0x676b, 0x6796, 0x6782, 0x678a, 0x3b47, 0x67a3, 0x3b4b, 0x67a2, 0x678f, 0x3b4a, 0x67f9, 0x6780, 0x6b26, 0x6b27, 0x6b68, 0x6b69, 0x3c5a, 0x6b81, 0x6bb4, 0x6bd1, 0x3c8e, 0x3cb4, 0x6c1c, 0x3ccd, 0x3ccc, 0x3ccf, 0x3ccb, 0x3cce, 0x6c97, 0x6c6c, 0x6cdf, 0x3cd2, 0x6cea, 0x3cd1, 0x6ce4, 0x6cd8, 0x6cb2, 0x6cce, 0x6cc8, 0x3da6, 0x708b, 0x7088, 0x7090, 0x708f, 0x3daa, 0x7087, 0x7089, 0x708d, 0x7081, 0x3da8, 0x708c, 0x3e13, 0x3e1a, 0x7240, 0x3e1d, 0x3e1e,
+		0x7265, 0x7266, 0x7268, 0x3e65, 0x3e66, 0x72cd, 0x72d3, 0x72db, 0x3e64, 0x72cf, 0x73a7, 0x73a3, 0x739e, 0x3eb0, 0x73af, 0x3eb3, 0x3eb5, 0x73aa, 0x739c, 0x3f19, 0x7542, 0x7544, 0x753b, 0x7541, 0x2233f, 0x759b, 0x759e, 0x3f75, 0x79c4, 0x79c3, 0x79c6, 0x412b, 0x412c, 0x79c7, 0x412d, 0x79ca, 0x2592e, 0x41c3, 0x7acf, 0x7c76, 0x7c74, 0x7cff, 0x7cfc, 0x34ba, 0x4350, 0x7f59, 0x80a8, 0x43d3, 0x43d0, 0x80b0, 0x43dc, 0x80b3, 0x43d2, 0x80a4, 0x80b6, 0x80a7, 0x80ac, 0x43db, 0x80a6, 0x5367, 0x820e, 0x82c4, 0x833e, 0x829c, 0x44a5, 0x449f, 0x449a, 0x449c, 0x44a2, 0x82aa, 0x449b, 0x82c9, 0x44a3, 0x449d, 0x82a6, 0x82b2, 0x4588, 0x461a, 0x488d, 0x8fcc, 0x8fd9, 0x8fca, 0x8fd8, 0x8fcf, 0x90b7, 0x48b8, 0x90ad, 0x90b9, 0x9637, 0x49c3, 0x9641, 0x963e, 0x96b6, 0x9751,
+		0x9763, 0x4e57, 0x4e79, 0x4eb2, 0x4eb0, 0x4eaf, 0x4eb1, 0x4fd2, 0x4fd5, 0x345d, 0x4fbe, 0x4fb8, 0x4fb0, 0x4fb1, 0x4fc8, 0x345a, 0x3457, 0x4fc6, 0x4fcc, 0x4fe5, 0x4fe3, 0x4fb4, 0x516a, 0x34b8, 0x519f, 0x34c2, 0x51c1, 0x34cf, 0x51c2, 0x51c3, 0x5245, 0x5248, 0x34e7, 0x34e9, 0x524f, 0x4452, 0x34e8, 0x52c5, 0x52ca, 0x52c4, 0x5327, 0x5358, 0x537d, 0x354a, 0x53dd, 0x53dc, 0x53da, 0x53d9, 0x54b9, 0x3580, 0x54d0, 0x54b4, 0x54ca, 0x3587, 0x54a3, 0x54da, 0x54a4, 0x3584, 0x54b2, 0x549e, 0x549f, 0x54b5, 0x3582, 0x3581, 0x54cd, 0x3583, 0x54cc, 0x3622, 0x5700, 0x57ac, 0x5791, 0x578e, 0x578d, 0x5792, 0x57a1, 0x5790, 0x57a6, 0x57a8, 0x363b, 0x579c, 0x5796, 0x57a7, 0x363a, 0x3638, 0x3639, 0x3636, 0x58f5, 0x3685, 0x5909, 0x5908, 0x3c54, 0x5952, 0x369a, 0x36c4,
+		0x59df, 0x36c5, 0x59eb, 0x59ef, 0x59f0, 0x59d5, 0x5a0d, 0x5a04, 0x59f9, 0x5a02, 0x59f8, 0x59e2, 0x59d9, 0x59e7, 0x5b6a, 0x3754, 0x3755, 0x5bab, 0x3756, 0x5c1b, 0x5c2f, 0x3796, 0x663c, 0x3795, 0x3794, 0x37c4, 0x5cd1, 0x5cdc, 0x5ce6, 0x5ce1, 0x5ccd, 0x2f87a, 0x5ce2, 0x5cdd, 0x5ce5, 0x5dfb, 0x5dfa, 0x5e1e, 0x3844, 0x5ea1, 0x387d, 0x387e, 0x5efc, 0x5efb, 0x5f2f, 0x38b2, 0x38b6, 0x5f66, 0x2f899, 0x38dc, 0x38df, 0x605c, 0x3928, 0x604e, 0x6051, 0x3919, 0x3910, 0x6023, 0x6031, 0x607c, 0x6052, 0x392c, 0x6060, 0x604a, 0x6061, 0x391b, 0x6218, 0x39c2, 0x39ef, 0x39e3, 0x39e5, 0x39ea, 0x39e6, 0x39ee, 0x631f, 0x6317, 0x62ea, 0x6321, 0x6304, 0x6305, 0x39e8, 0x6531, 0x6544, 0x6540, 0x3a85, 0x6542, 0x65be, 0x3ae0, 0x6629, 0x661b, 0x3add, 0x6623, 0x662c, 0x661a,
+		0x6630, 0x663b, 0x661e, 0x6637, 0x6638, 0x3ae1, 0x670e, 0x3b51, 0x3b55, 0x67e8, 0x67d6, 0x3b52, 0x67c7, 0x67bc, 0x6852, 0x67bf, 0x67d5, 0x67fe, 0x8363, 0x67fb, 0x2f8df, 0x67b1, 0x6801, 0x6805, 0x6800, 0x67d7, 0x409e, 0x6b2a, 0x6b6b, 0x3c52, 0x3c5e, 0x3c60, 0x3c5f, 0x6be1, 0x3c92, 0x3cd6, 0x6d23, 0x6cff, 0x6d14, 0x6d05, 0x6d13, 0x6d06, 0x6d21, 0x3cde, 0x6d15, 0x6caf, 0x6cf4, 0x6d02, 0x6d45, 0x2f8fe, 0x6d26, 0x3cd9, 0x6d44, 0x3cdd, 0x6d24, 0x70a5, 0x3dac, 0x70a3, 0x3db0, 0x70a2, 0x70bb, 0x70a0, 0x70aa, 0x3daf, 0x3dae, 0x70a8, 0x70b6, 0x70b2, 0x70a7, 0x3dad, 0x3dab, 0x70b9, 0x722e, 0x3e16, 0x723c, 0x3e30, 0x726d, 0x3e33, 0x3e31, 0x72e7, 0x72ed, 0x3e6e, 0x72ec, 0x72e5, 0x72e2, 0x3eb1, 0x73c4, 0x73bd, 0x73cf, 0x73c9, 0x73c1, 0x73d0, 0x3eb7, 0x73ce,
+		0x74ed, 0x74eb, 0x3f1a, 0x74ef, 0x7549, 0x7550, 0x7546, 0x754a, 0x3f59, 0x754d, 0x75a6, 0x3f7a, 0x3f78, 0x3f7b, 0x75a8, 0x3fde, 0x3fec, 0x76c7, 0x76ff, 0x401e, 0x76fd, 0x77e6, 0x780a, 0x409b, 0x7804, 0x780b, 0x7807, 0x409d, 0x7815, 0x7808, 0x40fd, 0x79d3, 0x79d4, 0x79d0, 0x79d7, 0x7a7c, 0x4194, 0x4193, 0x7a7d, 0x7a83, 0x7a82, 0x41c6, 0x7ad4, 0x7ad5, 0x7ad3, 0x7ad0, 0x7ad2, 0x7afe, 0x7afc, 0x7c77, 0x7c7c, 0x7c7b, 0x42b8, 0x2f96a, 0x42b7, 0x42b9, 0x4353, 0x25133, 0x4352, 0x4351, 0x7f8f, 0x80d3, 0x43e3, 0x80cb, 0x80d2, 0x43e2, 0x8109, 0x80e2, 0x80df, 0x80c6, 0x4463, 0x8224, 0x82f7, 0x82d8, 0x82dd, 0x44aa, 0x44a6, 0x82f8, 0x82fc, 0x44a8, 0x44a9, 0x82e9, 0x44ab, 0x82ee, 0x44ac, 0x82d0, 0x830e, 0x82e2, 0x830b, 0x82fd, 0x5179, 0x8676, 0x459e, 0x8678,
+		0x459f, 0x45a0, 0x8675, 0x867d, 0x460f, 0x8842, 0x8866, 0x461c, 0x898c, 0x8a05, 0x46ae, 0x8a06, 0x46b0, 0x8c9f, 0x47d4, 0x8ff1, 0x8fe7, 0x8fe9, 0x8fef, 0x90c2, 0x90bc, 0x48bb, 0x90c6, 0x90c0, 0x48c1, 0x48c2, 0x90cd, 0x90c9, 0x48be, 0x90c4, 0x48e5, 0x9581, 0x49c6, 0x9cec, 0x5032, 0x4ff9, 0x501d, 0x4fff, 0x5004, 0x4ff0, 0x5003, 0x462e, 0x5002, 0x4ffc, 0x4ff2, 0x5024, 0x5008, 0x5036, 0x502e, 0x3465, 0x5010, 0x5038, 0x5039, 0x4ffd, 0x5056, 0x4ffb, 0x51a3, 0x51a6, 0x51a1, 0x34d1, 0x34d0, 0x51c7, 0x51c9, 0x5260, 0x5264, 0x5259, 0x5265, 0x5267, 0x5257, 0x5263, 0x34ee, 0x5253, 0x34ef, 0x52cf, 0x351e, 0x52ce, 0x52d0, 0x52d1, 0x52cc, 0x354b, 0x354d, 0x3556, 0x550d, 0x54f4, 0x3592, 0x5513, 0x54ef, 0x54f5, 0x54f9, 0x5502, 0x5500, 0x3593, 0x3590, 0x5518,
+		0x54f0, 0x54f6, 0x2f841, 0x3597, 0x5519, 0x3623, 0x5705, 0x57c9, 0x363f, 0x57b7, 0x57cd, 0x3643, 0x3642, 0x3644, 0x57be, 0x57bb, 0x3645, 0x57db, 0x57c8, 0x57c4, 0x57c5, 0x57d1, 0x57ca, 0x57c0, 0x36d9, 0x36de, 0x5a21, 0x5a2a, 0x36cf, 0x5a1d, 0x36cd, 0x5a0b, 0x36dd, 0x36ce, 0x36d3, 0x36d6, 0x5a22, 0x36dc, 0x36d1, 0x5a24, 0x36d0, 0x5a14, 0x5a31, 0x36d5, 0x5a2f, 0x5a1a, 0x5a12, 0x36d4, 0x36db, 0x5a26, 0x2172e, 0x3743, 0x5bbc, 0x5bbb, 0x5bb7, 0x5c05, 0x5c06, 0x5c52, 0x5c53, 0x37cd, 0x37d1, 0x5cfa, 0x5ceb, 0x37ca, 0x5cf3, 0x5cf5, 0x5ce9, 0x5cef, 0x37d4, 0x5e2a, 0x5e30, 0x5e2e, 0x5e2c, 0x5e2f, 0x5eaf, 0x5ea9, 0x3886, 0x5efd, 0x5f32, 0x5f8e, 0x5f93, 0x5f8f, 0x604f, 0x6099, 0x3933, 0x607e, 0x3937, 0x6074, 0x604b, 0x6073, 0x6075, 0x392a, 0x391f, 0x6056,
+		0x60a9, 0x608b, 0x60a6, 0x3939, 0x6093, 0x60ae, 0x609e, 0x60a7, 0x6245, 0x39f2, 0x39f8, 0x632e, 0x39f7, 0x6352, 0x6330, 0x635b, 0x39f4, 0x6319, 0x631b, 0x39f1, 0x6331, 0x635d, 0x6337, 0x6335, 0x6353, 0x39f5, 0x635c, 0x633f, 0x654b, 0x3a87, 0x4369, 0x658b, 0x3ab6, 0x659a, 0x6650, 0x6646, 0x664e, 0x6640, 0x3ae9, 0x664b, 0x6648, 0x3aeb, 0x6660, 0x6644, 0x664d, 0x3b34, 0x6837, 0x6824, 0x3b62, 0x3b5c, 0x681b, 0x6836, 0x3b60, 0x682c, 0x6819, 0x6856, 0x6847, 0x683e, 0x681e, 0x2f8e1, 0x6815, 0x6822, 0x6827, 0x6859, 0x6858, 0x6855, 0x6830, 0x6823, 0x6b2e, 0x6b2b, 0x6b30, 0x6b6c, 0x3c61, 0x6b8b, 0x3c7f, 0x6be9, 0x6bea, 0x6be5, 0x6d6b, 0x3ce5, 0x3ce6, 0x6d73, 0x6d57, 0x3ce9, 0x3cf3, 0x6d5d, 0x6d56, 0x6d8f, 0x6d5b, 0x6d1c, 0x6d9a, 0x6d9b, 0x6d99, 0x3cee,
+		0x6d81, 0x6d71, 0x3ced, 0x3cec, 0x6d72, 0x6d5c, 0x6d96, 0x70c4, 0x70db, 0x70cc, 0x70d0, 0x70e3, 0x70df, 0x3db3, 0x70d6, 0x70ee, 0x70d5, 0x3db5, 0x3e27, 0x3e35, 0x3e36, 0x727a, 0x3e71, 0x72f5, 0x7302, 0x3eb8, 0x3ec2, 0x73e2, 0x73ec, 0x73d5, 0x73f9, 0x73df, 0x73e6, 0x3ec8, 0x3ec0, 0x3ec1, 0x3ec4, 0x73e4, 0x73e1, 0x74f3, 0x3f1f, 0x3f1c, 0x3f1d, 0x3f4d, 0x7556, 0x7555, 0x7558, 0x7557, 0x755e, 0x75c3, 0x3f87, 0x3f82, 0x75b4, 0x3f7d, 0x75b1, 0x3fdf, 0x4000, 0x76cb, 0x76cc, 0x772a, 0x4020, 0x7716, 0x770f, 0x4022, 0x4024, 0x773f, 0x772b, 0x770e, 0x7724, 0x4021, 0x7721, 0x7718, 0x77dd, 0x40a4, 0x40a5, 0x7824, 0x7836, 0x4101, 0x7958, 0x7959, 0x4103, 0x7962, 0x79da, 0x79d9, 0x4137, 0x79e1, 0x79e5, 0x79e8, 0x79db, 0x4138, 0x79e2, 0x79f0, 0x4199, 0x4198,
+		0x4197, 0x41c9, 0x7ada, 0x7add, 0x41c7, 0x7adb, 0x7adc, 0x41d9, 0x41db, 0x7b0d, 0x7b0b, 0x7b14, 0x7c8e, 0x7c86, 0x427b, 0x7c87, 0x7c83, 0x7c8b, 0x427c, 0x42bd, 0x42bc, 0x42c3, 0x7d24, 0x42c1, 0x42bf, 0x42c4, 0x7d25, 0x7f62, 0x7f93, 0x7f99, 0x7f97, 0x437e, 0x437f, 0x7fc4, 0x7fc6, 0x800a, 0x43b4, 0x43b3, 0x8040, 0x803c, 0x803b, 0x80f6, 0x80ff, 0x80ee, 0x8104, 0x8103, 0x8107, 0x2f983, 0x43e6, 0x80f7, 0x4459, 0x445a, 0x822d, 0x4464, 0x8227, 0x8229, 0x831f, 0x8357, 0x44b4, 0x44b9, 0x44b7, 0x44b5, 0x8321, 0x44c1, 0x44b1, 0x8318, 0x8358, 0x44b3, 0x44ba, 0x458c, 0x458b, 0x458d, 0x8684, 0x869f, 0x869b, 0x8689, 0x86a6, 0x8692, 0x868f, 0x86a0, 0x884f, 0x8878, 0x887a, 0x886e, 0x887b, 0x8884, 0x8873, 0x4678, 0x4677, 0x8a0d, 0x8a0b, 0x8a19, 0x46b2, 0x47d6,
+		0x8ed0, 0x4845, 0x4892, 0x4895, 0x8ff9, 0x9009, 0x9008, 0x48c6, 0x90de, 0x9151, 0x48e7, 0x48e8, 0x91db, 0x91df, 0x91de, 0x91d6, 0x91e0, 0x9585, 0x9660, 0x9659, 0x49cb, 0x9656, 0x49cd, 0x49f1, 0x96bd, 0x4b22, 0x3421, 0x5042, 0x5059, 0x346f, 0x5044, 0x5066, 0x5052, 0x5054, 0x5071, 0x5050, 0x507b, 0x507c, 0x5058, 0x3470, 0x3464, 0x5079, 0x506c, 0x5078, 0x51a8, 0x51d1, 0x51cf, 0x5268, 0x5276, 0x52d4, 0x352d, 0x53a0, 0x53c4, 0x3558, 0x5558, 0x554c, 0x5568, 0x35a6, 0x5549, 0x35a4, 0x359f, 0x555d, 0x5529, 0x20dae, 0x5554, 0x5553, 0x35a3, 0x555a, 0x35a0, 0x553a, 0x553f, 0x552b, 0x57ea, 0x364a, 0x57ef, 0x3647, 0x3648, 0x57dd, 0x57fe, 0x2f855, 0x57de, 0x57e6, 0x3649, 0x57e8, 0x57ff, 0x5803, 0x58f7, 0x68a6, 0x591f, 0x369e, 0x595b, 0x595d, 0x595e, 0x21637,
+		0x36e8, 0x5a2b, 0x36ec, 0x5a3b, 0x36ed, 0x36e6, 0x5a61, 0x5a3a, 0x5a6e, 0x5a4b, 0x5a6b, 0x36eb, 0x36e7, 0x5a45, 0x5a4e, 0x5a68, 0x5a3d, 0x5a71, 0x5a3f, 0x5a6f, 0x5a75, 0x36e9, 0x5a73, 0x5a2c, 0x5a59, 0x5a54, 0x5a4f, 0x5a63, 0x375c, 0x375d, 0x5bc8, 0x3760, 0x5bc3, 0x375b, 0x5c5b, 0x5c61, 0x3799, 0x5d21, 0x5d0a, 0x5d09, 0x37d8, 0x5d2c, 0x5d08, 0x37da, 0x37dd, 0x5d2a, 0x5d15, 0x37e0, 0x5d10, 0x5d13, 0x37e5, 0x5d2f, 0x5d18, 0x37d7, 0x5de3, 0x5e39, 0x5e35, 0x5e3a, 0x5e32, 0x384e, 0x388c, 0x3888, 0x2f88d, 0x5ebb, 0x5eba, 0x5f34, 0x5f39, 0x38ce, 0x2f89c, 0x38e5, 0x38e6, 0x6098, 0x3932, 0x60d0, 0x3940, 0x3947, 0x394c, 0x60d7, 0x60aa, 0x3935, 0x60a1, 0x60a4, 0x3930, 0x60ee, 0x3943, 0x60e7, 0x394d, 0x60e8, 0x60de, 0x39b7, 0x39f3, 0x637e, 0x638b, 0x3a02,
+		0x3a0b, 0x6379, 0x6386, 0x6393, 0x3a04, 0x6373, 0x636a, 0x2f8ba, 0x636c, 0x3a08, 0x637f, 0x39fc, 0x63b2, 0x63ba, 0x39ff, 0x3a00, 0x6366, 0x6374, 0x3a8b, 0x655a, 0x3a8d, 0x654e, 0x654d, 0x658d, 0x658e, 0x65ad, 0x3aca, 0x65c7, 0x65ca, 0x3acb, 0x65c9, 0x2f8cb, 0x65e3, 0x6657, 0x3af3, 0x6663, 0x6667, 0x671a, 0x6719, 0x6716, 0x3b36, 0x3b6a, 0x689e, 0x68b6, 0x6898, 0x6873, 0x3b6b, 0x689a, 0x688e, 0x68b7, 0x68db, 0x68a5, 0x686c, 0x68c1, 0x6884, 0x3b71, 0x3b68, 0x6895, 0x687a, 0x6899, 0x3b72, 0x68b8, 0x68b9, 0x6870, 0x3c2e, 0x6b35, 0x3c62, 0x6b90, 0x6bbb, 0x6bed, 0x3c98, 0x3cb5, 0x3ceb, 0x6dc1, 0x6dc3, 0x6dce, 0x3cfb, 0x3cf8, 0x6dad, 0x6e04, 0x3cf5, 0x6db9, 0x3d08, 0x6de7, 0x2f907, 0x6e08, 0x6e06, 0x3d0a, 0x6e0a, 0x6db0, 0x3d06, 0x6df8, 0x6e0c, 0x3cfd,
+		0x6db1, 0x3cfa, 0x6e02, 0x6e07, 0x6e09, 0x6e01, 0x6e17, 0x6dff, 0x6e12, 0x3dba, 0x3db9, 0x7103, 0x7107, 0x7101, 0x70f5, 0x70f1, 0x7108, 0x70f2, 0x710f, 0x3dbb, 0x70fe, 0x3e18, 0x3e40, 0x3e3d, 0x731a, 0x7310, 0x730e, 0x7402, 0x73f3, 0x3ecd, 0x3ec9, 0x73fb, 0x3ecb, 0x3eca, 0x3ece, 0x751b, 0x7523, 0x7561, 0x7568, 0x3f5e, 0x7567, 0x75d3, 0x3f91, 0x3f8c, 0x7690, 0x3fe1, 0x4002, 0x76d5, 0x76d7, 0x76d6, 0x7730, 0x402b, 0x7726, 0x402a, 0x7740, 0x3e14, 0x771e, 0x40ad, 0x40a3, 0x40ab, 0x7847, 0x40af, 0x784b, 0x7851, 0x784f, 0x7842, 0x7846, 0x4104, 0x796e, 0x796c, 0x79f2, 0x4144, 0x79f1, 0x79f5, 0x79f3, 0x79f9, 0x413d, 0x4147, 0x419c, 0x7a9a, 0x7a93, 0x7a91, 0x7ae1, 0x41e0, 0x41e4, 0x7b21, 0x7b1c, 0x7b16, 0x7b17, 0x7b36, 0x7b1f, 0x4280, 0x7c93, 0x7c99,
+		0x7c9a, 0x7c9c, 0x42ca, 0x7d49, 0x42d4, 0x7d34, 0x7d37, 0x42d2, 0x7d2d, 0x42cb, 0x7d4c, 0x42ce, 0x42d3, 0x7d48, 0x4344, 0x4348, 0x7f3b, 0x4345, 0x4381, 0x4386, 0x4385, 0x8008, 0x801a, 0x43a3, 0x801d, 0x43b5, 0x8049, 0x8045, 0x8044, 0x7c9b, 0x43fa, 0x43f9, 0x812a, 0x812e, 0x43fb, 0x43f2, 0x8131, 0x43ef, 0x811a, 0x8134, 0x8117, 0x445b, 0x4466, 0x44ce, 0x831d, 0x8371, 0x8384, 0x8380, 0x8372, 0x83a1, 0x35b4, 0x8379, 0x8391, 0x44c8, 0x839f, 0x83ad, 0x44d1, 0x44c5, 0x8323, 0x44d2, 0x8385, 0x839c, 0x83b7, 0x8658, 0x865a, 0x458f, 0x8657, 0x86b2, 0x45a7, 0x86ae, 0x45a5, 0x45a4, 0x4611, 0x8845, 0x889c, 0x8894, 0x88a3, 0x888f, 0x88a5, 0x88a9, 0x88a6, 0x888a, 0x88a0, 0x8890, 0x8992, 0x8991, 0x8994, 0x46b5, 0x8a26, 0x8a32, 0x8a28, 0x46b4, 0x46bd, 0x8a1c,
+		0x46bb, 0x8a2b, 0x8a20, 0x46b9, 0x8a29, 0x46c2, 0x46be, 0x46ba, 0x8a21, 0x8c3a, 0x3ab7, 0x8c5b, 0x8c58, 0x8c7c, 0x4758, 0x8ca6, 0x8cae, 0x8cad, 0x8d65, 0x479b, 0x8d7e, 0x479c, 0x8d7c, 0x8d7f, 0x8d7a, 0x8dbd, 0x47da, 0x47de, 0x8dc0, 0x8dbb, 0x8ead, 0x8eaf, 0x8ed6, 0x484d, 0x4846, 0x4847, 0x484b, 0x484c, 0x8ed9, 0x4848, 0x4899, 0x9012, 0x900e, 0x9025, 0x489b, 0x9013, 0x90ee, 0x48ce, 0x90ab, 0x90f7, 0x48eb, 0x9159, 0x9154, 0x91f2, 0x91f0, 0x91e5, 0x91f6, 0x491c, 0x498c, 0x9587, 0x49d1, 0x965a, 0x49d6, 0x49d3, 0x966e, 0x49d4, 0x49d0, 0x49d5, 0x9679, 0x4a0b, 0x98e1, 0x98e6, 0x4bc6, 0x9ec4, 0x9ed2, 0x4e80, 0x3424, 0x4e81, 0x508f, 0x5097, 0x5088, 0x5089, 0x3474, 0x347a, 0x5081, 0x5160, 0x20564, 0x34c3, 0x5e42, 0x51d3, 0x34d4, 0x34d5, 0x51d2, 0x51d6,
+		0x5273, 0x34fb, 0x5270, 0x34f7, 0x3532, 0x2f833, 0x53a8, 0x53a6, 0x53c5, 0x5597, 0x55de, 0x35ba, 0x35bf, 0x5596, 0x55b4, 0x35c7, 0x5585, 0x35b7, 0x559b, 0x55a0, 0x35b9, 0x5559, 0x35c3, 0x5586, 0x35bd, 0x35d0, 0x55af, 0x557a, 0x35c1, 0x35be, 0x35cd, 0x559e, 0x35cb, 0x55a9, 0x570f, 0x570e, 0x581a, 0x364f, 0x581f, 0x3653, 0x583c, 0x5818, 0x583e, 0x5826, 0x3655, 0x583a, 0x21364, 0x5822, 0x3651, 0x58fb, 0x5963, 0x5964, 0x369f, 0x5aa8, 0x5aa3, 0x5a82, 0x5a88, 0x5aa1, 0x5a85, 0x5a98, 0x36fe, 0x5a99, 0x36fb, 0x5a89, 0x5a81, 0x5a96, 0x5a80, 0x36f1, 0x36f5, 0x5a91, 0x36ef, 0x3704, 0x3703, 0x36f4, 0x5acf, 0x36f3, 0x3702, 0x36f7, 0x36fa, 0x36fd, 0x36ee, 0x5a87, 0x5aa0, 0x36f0, 0x5a79, 0x36f2, 0x5a86, 0x5aab, 0x5aaa, 0x5aa4, 0x5a8d, 0x5a7e, 0x3744, 0x5bd5,
+		0x3762, 0x3777, 0x3dc9, 0x5c1e, 0x5c5f, 0x5c5e, 0x5d44, 0x5d3e, 0x37e8, 0x5d48, 0x5d1c, 0x37ef, 0x5d5b, 0x5d4d, 0x37e6, 0x37ed, 0x5d57, 0x37e7, 0x5d53, 0x5d4f, 0x37eb, 0x5d3b, 0x5d46, 0x382d, 0x3855, 0x5e46, 0x5e47, 0x3853, 0x5e48, 0x5ec0, 0x5ebd, 0x5ebf, 0x3890, 0x5f11, 0x38be, 0x5f3e, 0x5f3b, 0x38bd, 0x5f3a, 0x38cf, 0x38d0, 0x38ec, 0x5fa7, 0x394b, 0x60ea, 0x3948, 0x6107, 0x6122, 0x610c, 0x3955, 0x3951, 0x60b3, 0x60d6, 0x60d2, 0x394e, 0x60e3, 0x60e5, 0x60e9, 0x396b, 0x395e, 0x6111, 0x60fd, 0x3960, 0x3967, 0x611e, 0x6120, 0x6121, 0x621e, 0x39b8, 0x63e2, 0x63de, 0x63e6, 0x3a14, 0x3a0f, 0x3a07, 0x3a13, 0x63f8, 0x3a17, 0x63fe, 0x63c1, 0x63bf, 0x63f7, 0x63d1, 0x655f, 0x6560, 0x6561, 0x3a9a, 0x3ab8, 0x65d1, 0x3af7, 0x3af8, 0x667d, 0x666b, 0x667f,
+		0x3afd, 0x3af5, 0x6673, 0x6681, 0x666d, 0x6669, 0x3afa, 0x3b38, 0x671e, 0x68ed, 0x3b87, 0x3b80, 0x3b88, 0x3b79, 0x6903, 0x3b7c, 0x68fe, 0x68e5, 0x691e, 0x6902, 0x3b83, 0x3b85, 0x6909, 0x68ca, 0x6900, 0x2f8e5, 0x6901, 0x6918, 0x68e2, 0x68cf, 0x3b7b, 0x692e, 0x68c5, 0x68ff, 0x3b86, 0x691c, 0x68c3, 0x3c34, 0x6b6f, 0x3c55, 0x6b6e, 0x3c68, 0x6bbe, 0x3c9c, 0x6bf4, 0x6c2d, 0x3cfc, 0x6db6, 0x6e75, 0x6e1e, 0x3d1a, 0x6e18, 0x3d17, 0x6e48, 0x3d1b, 0x6e4f, 0x3d13, 0x6e42, 0x6e6a, 0x6e70, 0x6dfe, 0x3d05, 0x3d07, 0x6e6d, 0x3d1c, 0x6e7b, 0x6e7e, 0x6e59, 0x3d11, 0x6e57, 0x3d16, 0x6e80, 0x6e50, 0x3d15, 0x6e29, 0x6e76, 0x6e2a, 0x6e4c, 0x712a, 0x3dcb, 0x7135, 0x712c, 0x7137, 0x711d, 0x3dc5, 0x3dc2, 0x7138, 0x3dcd, 0x7134, 0x712b, 0x7133, 0x7127, 0x7124, 0x3dca,
+		0x712d, 0x7232, 0x7283, 0x7282, 0x7287, 0x7306, 0x7324, 0x7338, 0x732a, 0x732c, 0x732b, 0x3e83, 0x732f, 0x7328, 0x7417, 0x3ed6, 0x3ed5, 0x7419, 0x7438, 0x3ed1, 0x741f, 0x7414, 0x743c, 0x73f7, 0x741c, 0x7415, 0x7418, 0x7439, 0x74f9, 0x7524, 0x2f934, 0x3f52, 0x3f5f, 0x756e, 0x756d, 0x7571, 0x758e, 0x3f95, 0x75e5, 0x3f9d, 0x3f98, 0x3f9e, 0x3f96, 0x7694, 0x76b3, 0x4003, 0x76d9, 0x402f, 0x7748, 0x7749, 0x7743, 0x4031, 0x4033, 0x7742, 0x77df, 0x40b4, 0x7863, 0x7876, 0x40b0, 0x785f, 0x7866, 0x7966, 0x7971, 0x4108, 0x4107, 0x7976, 0x7984, 0x7975, 0x79ff, 0x7a07, 0x414e, 0x7a0e, 0x7a09, 0x4150, 0x4152, 0x41a1, 0x41a3, 0x41a5, 0x41cc, 0x7ae7, 0x7ae2, 0x7b55, 0x41ef, 0x41ea, 0x7b43, 0x7b57, 0x7b6c, 0x7b42, 0x7b53, 0x41ed, 0x7b41, 0x4285, 0x4284, 0x7ca7,
+		0x7ca0, 0x7ca6, 0x7ca4, 0x7d74, 0x42db, 0x7d59, 0x42d9, 0x7d60, 0x7d57, 0x7d6c, 0x7d7e, 0x7d64, 0x42d7, 0x7d5a, 0x7d5d, 0x42da, 0x42de, 0x42d8, 0x7d76, 0x7d4d, 0x7d75, 0x42d5, 0x7fd3, 0x7fd6, 0x439c, 0x439d, 0x8060, 0x804e, 0x8145, 0x813b, 0x43fe, 0x8148, 0x8142, 0x8149, 0x8140, 0x8114, 0x8141, 0x4407, 0x81ef, 0x81f6, 0x8203, 0x446a, 0x83ed, 0x44e7, 0x83da, 0x8418, 0x83d2, 0x8408, 0x44e2, 0x8400, 0x44df, 0x44e1, 0x44e5, 0x8417, 0x8346, 0x8414, 0x83d3, 0x8405, 0x841f, 0x8402, 0x8416, 0x83cd, 0x83e6, 0x4591, 0x865d, 0x86d5, 0x86e1, 0x45b4, 0x45b0, 0x45b5, 0x45ae, 0x86ee, 0x8847, 0x8846, 0x462d, 0x462c, 0x88bb, 0x462b, 0x88bf, 0x88b4, 0x4629, 0x88b5, 0x467f, 0x899a, 0x8a43, 0x46c9, 0x46cb, 0x8a5a, 0x46c5, 0x46c6, 0x46ca, 0x8a35, 0x8a38, 0x8a42,
+		0x8a49, 0x8a5d, 0x8a4b, 0x8a3d, 0x46d2, 0x46d0, 0x472d, 0x4735, 0x8c60, 0x8c5e, 0x8c7f, 0x8c7e, 0x8c83, 0x476c, 0x8cb1, 0x8d87, 0x479d, 0x47a0, 0x8d88, 0x8d83, 0x47a2, 0x479f, 0x8d86, 0x8d8b, 0x8d82, 0x8dca, 0x8dd2, 0x47eb, 0x47e2, 0x8dd4, 0x8dc9, 0x8eb0, 0x4836, 0x4832, 0x4850, 0x8ef2, 0x8ee4, 0x8ef3, 0x8eea, 0x484f, 0x8efd, 0x4852, 0x8f9d, 0x902b, 0x902a, 0x489e, 0x9028, 0x9029, 0x902c, 0x48a0, 0x489c, 0x903a, 0x9030, 0x9037, 0x903b, 0x48d1, 0x910a, 0x48ef, 0x48f0, 0x48f1, 0x91fe, 0x9220, 0x491d, 0x920b, 0x491f, 0x9218, 0x9222, 0x491e, 0x921b, 0x9208, 0x4920, 0x920e, 0x9213, 0x498e, 0x4991, 0x9595, 0x28cdd, 0x4990, 0x49d7, 0x968c, 0x967b, 0x967f, 0x9681, 0x49d9, 0x9682, 0x49f4, 0x49f6, 0x3560, 0x49f5, 0x49f3, 0x96ee, 0x96ed, 0x4a0c, 0x96ec,
+		0x975f, 0x976f, 0x4a51, 0x976d, 0x4aa6, 0x4aa7, 0x4aa8, 0x4b27, 0x4b24, 0x4b25, 0x98f0, 0x4b2a, 0x4b74, 0x4bc7, 0x9aa9, 0x4be7, 0x4bed, 0x9ae0, 0x4eb7, 0x342e, 0x347b, 0x50cc, 0x50bc, 0x347c, 0x50aa, 0x50b9, 0x347d, 0x50ab, 0x50c3, 0x50cd, 0x517e, 0x527e, 0x5279, 0x34fd, 0x2f823, 0x52e1, 0x52e0, 0x52e7, 0x5380, 0x53ab, 0x53aa, 0x53a9, 0x53e0, 0x55ea, 0x35da, 0x55d7, 0x35d6, 0x35db, 0x55c1, 0x5715, 0x365b, 0x586c, 0x365c, 0x585c, 0x5850, 0x5861, 0x586a, 0x5869, 0x5856, 0x5860, 0x5866, 0x585f, 0x5923, 0x5966, 0x5968, 0x3706, 0x370b, 0x5ace, 0x370d, 0x5ac5, 0x5ac3, 0x370a, 0x3713, 0x5ad0, 0x3710, 0x3712, 0x3709, 0x3708, 0x3711, 0x370f, 0x5b74, 0x5b76, 0x5bdc, 0x5bd7, 0x5bda, 0x5bdb, 0x3767, 0x5c20, 0x5d6d, 0x5d66, 0x37f6, 0x5d64, 0x5d6e, 0x2f87e,
+		0x5d60, 0x5f42, 0x5f5a, 0x5f6e, 0x3964, 0x396c, 0x6130, 0x613a, 0x612a, 0x6143, 0x6119, 0x6131, 0x396d, 0x613d, 0x397a, 0x3975, 0x3a0d, 0x6408, 0x6432, 0x6438, 0x3a1e, 0x6431, 0x3a1b, 0x6419, 0x3a2a, 0x6411, 0x3a1f, 0x3a22, 0x6429, 0x641d, 0x3a25, 0x3a27, 0x3a29, 0x643c, 0x3a24, 0x6446, 0x6447, 0x3a28, 0x3a26, 0x643a, 0x6407, 0x3a23, 0x656b, 0x3a9f, 0x6570, 0x656d, 0x3ab1, 0x65e4, 0x6693, 0x3b03, 0x3b07, 0x3b0c, 0x3b06, 0x668f, 0x3b04, 0x3b09, 0x6692, 0x3b05, 0x668e, 0x3b08, 0x6946, 0x3b96, 0x3b9c, 0x3b9f, 0x3b9b, 0x3b98, 0x3b99, 0x3b94, 0x6931, 0x3b8d, 0x3ba3, 0x693e, 0x3b93, 0x697c, 0x6943, 0x3b92, 0x6973, 0x2f8e8, 0x6955, 0x3b8e, 0x3b8c, 0x6985, 0x694d, 0x6950, 0x6947, 0x6967, 0x6936, 0x6964, 0x6961, 0x3b9a, 0x697d, 0x6b44, 0x6b40, 0x6b71,
+		0x6b73, 0x6b9c, 0x3c6a, 0x3c6d, 0x3c84, 0x6bc1, 0x3ca0, 0x6bfa, 0x6c31, 0x6c32, 0x3d1d, 0x3d26, 0x6eb8, 0x6ea8, 0x3d33, 0x6e91, 0x6ebb, 0x3d38, 0x6e9a, 0x3d30, 0x3d28, 0x6ea9, 0x3d27, 0x3d2a, 0x6eb5, 0x6e6c, 0x6ee8, 0x3d31, 0x6edd, 0x6eda, 0x6ee6, 0x6eac, 0x3d34, 0x3d2e, 0x3d3b, 0x6ed9, 0x6ee3, 0x6ee9, 0x6edb, 0x3d29, 0x716f, 0x3dd2, 0x3dd8, 0x7148, 0x3dcf, 0x714a, 0x716b, 0x3dd9, 0x714f, 0x7157, 0x7174, 0x3dce, 0x3dd3, 0x3dd0, 0x7145, 0x7151, 0x716d, 0x3ba1, 0x7251, 0x7250, 0x724e, 0x3e47, 0x7341, 0x3e8b, 0x732e, 0x7346, 0x3ed4, 0x7427, 0x3ede, 0x7448, 0x7453, 0x743d, 0x3edf, 0x745d, 0x7456, 0x3ed7, 0x741e, 0x7447, 0x7443, 0x7458, 0x7449, 0x3ee1, 0x744c, 0x7445, 0x743e, 0x3f2f, 0x7501, 0x751e, 0x3f62, 0x3f63, 0x757a, 0x75ee, 0x7602, 0x7697,
+		0x7698, 0x3fe2, 0x4004, 0x4043, 0x775d, 0x7764, 0x7753, 0x7758, 0x7882, 0x7890, 0x788a, 0x40be, 0x787a, 0x787d, 0x40ba, 0x788b, 0x7878, 0x40bc, 0x2f94e, 0x788d, 0x7888, 0x7892, 0x7881, 0x797e, 0x7983, 0x410d, 0x410e, 0x4111, 0x7980, 0x410f, 0x4112, 0x4155, 0x7a0f, 0x4159, 0x415b, 0x7a1d, 0x4157, 0x7aa1, 0x7aa4, 0x41ce, 0x7ae9, 0x7aea, 0x41fe, 0x7b62, 0x7b6b, 0x41fc, 0x7b5e, 0x41f5, 0x7b79, 0x41f9, 0x41fa, 0x7b6f, 0x7b68, 0x4288, 0x4289, 0x7cae, 0x428a, 0x4287, 0x428b, 0x7cb0, 0x42e6, 0x7d90, 0x42ed, 0x7d8a, 0x42e5, 0x7d8b, 0x7d99, 0x7d95, 0x42e0, 0x7d87, 0x7d78, 0x7d97, 0x7d89, 0x7d98, 0x42e1, 0x435b, 0x435c, 0x7fa3, 0x438f, 0x438b, 0x438d, 0x7fdd, 0x8057, 0x43b9, 0x8163, 0x816a, 0x816c, 0x440f, 0x4419, 0x4413, 0x815d, 0x8175, 0x4418, 0x815f,
+		0x4416, 0x817d, 0x816d, 0x4453, 0x2f98d, 0x8241, 0x844f, 0x8484, 0x44f6, 0x847f, 0x44f5, 0x8448, 0x842a, 0x847b, 0x8472, 0x8464, 0x842e, 0x845c, 0x8453, 0x44f7, 0x8441, 0x84c8, 0x44f0, 0x8462, 0x8480, 0x843e, 0x8483, 0x8471, 0x44f9, 0x844a, 0x8455, 0x8458, 0x4592, 0x4595, 0x4596, 0x86fc, 0x86fd, 0x8715, 0x45b9, 0x8716, 0x86ff, 0x45bd, 0x45b8, 0x4612, 0x8858, 0x88cf, 0x88e0, 0x4680, 0x4681, 0x469a, 0x4698, 0x89e7, 0x8a6a, 0x8a80, 0x46d4, 0x8a6f, 0x8a65, 0x46da, 0x8a78, 0x8a7d, 0x8a88, 0x46d6, 0x46db, 0x8a64, 0x8a7e, 0x46dc, 0x8a67, 0x8c63, 0x8c88, 0x4771, 0x8ccd, 0x4772, 0x8cc9, 0x47a8, 0x8ded, 0x47f0, 0x2f9db, 0x47f1, 0x47fd, 0x4838, 0x4837, 0x4839, 0x8eb1, 0x4855, 0x4853, 0x8f04, 0x8f9e, 0x8fa0, 0x9043, 0x9046, 0x9048, 0x9045, 0x9040, 0x904c,
+		0x48d5, 0x48bd, 0x910c, 0x9113, 0x9115, 0x48f5, 0x916b, 0x9167, 0x925d, 0x9255, 0x9235, 0x4921, 0x9259, 0x922f, 0x923c, 0x928f, 0x925c, 0x926a, 0x9262, 0x925f, 0x926b, 0x926e, 0x923b, 0x9244, 0x9241, 0x959a, 0x4992, 0x9599, 0x49de, 0x49db, 0x49da, 0x968f, 0x49df, 0x9696, 0x49f9, 0x49f8, 0x49fa, 0x96f4, 0x96fc, 0x4a0e, 0x9755, 0x4a43, 0x9779, 0x4a56, 0x4a53, 0x4a9e, 0x97ee, 0x97f5, 0x4aa9, 0x980b, 0x4afa, 0x98f3, 0x4b31, 0x4b30, 0x98f7, 0x98ff, 0x98f5, 0x4b32, 0x98ec, 0x98f1, 0x4b29, 0x4b2e, 0x999a, 0x4b76, 0x9ae2, 0x9b3d, 0x9b5d, 0x9ce8, 0x4ca5, 0x9ceb, 0x9cef, 0x9cee, 0x9e81, 0x9f14, 0x50d0, 0x50d9, 0x50dc, 0x50d8, 0x348c, 0x50e1, 0x50eb, 0x348b, 0x3489, 0x50f4, 0x50e2, 0x50de, 0x348d, 0x3486, 0x34d7, 0x51f4, 0x3504, 0x3507, 0x3503, 0x52ed,
+		0x52ea, 0x3522, 0x5332, 0x3551, 0x53ae, 0x53b0, 0x3561, 0x55fb, 0x5603, 0x560b, 0x35e9, 0x5607, 0x35e5, 0x55f8, 0x35e4, 0x5628, 0x561e, 0x35e3, 0x5618, 0x5611, 0x5651, 0x5605, 0x5717, 0x5892, 0x3665, 0x588c, 0x3663, 0x5878, 0x5884, 0x5873, 0x58ad, 0x5897, 0x5895, 0x5877, 0x5872, 0x5896, 0x588d, 0x5910, 0x368c, 0x596c, 0x371a, 0x5ae7, 0x3715, 0x5ae4, 0x3720, 0x3721, 0x5aef, 0x5626, 0x371c, 0x371b, 0x5af0, 0x5d7b, 0x37fe, 0x5d83, 0x3804, 0x3801, 0x5d8b, 0x5d8c, 0x3800, 0x5d78, 0x5e52, 0x386d, 0x3893, 0x5ed0, 0x5ecf, 0x38a1, 0x5fb3, 0x5fb4, 0x3976, 0x3979, 0x3972, 0x617b, 0x3983, 0x616f, 0x6181, 0x613c, 0x6142, 0x6138, 0x6133, 0x2f8a6, 0x6160, 0x6169, 0x617d, 0x6186, 0x622c, 0x6228, 0x3a38, 0x644c, 0x3a30, 0x6457, 0x647c, 0x3a34, 0x3a3a, 0x6455,
+		0x6462, 0x6471, 0x646a, 0x6456, 0x643b, 0x6481, 0x3a35, 0x644f, 0x647e, 0x6464, 0x3a3f, 0x3a40, 0x3a32, 0x3a31, 0x3a36, 0x6571, 0x230ba, 0x3b0f, 0x66a5, 0x669a, 0x669c, 0x3b10, 0x66a6, 0x3b0d, 0x66a4, 0x698f, 0x69c5, 0x69c8, 0x6992, 0x69b2, 0x3ba9, 0x3bb4, 0x3bac, 0x69e3, 0x69c0, 0x69d6, 0x69d1, 0x699f, 0x69a2, 0x69d2, 0x3bb8, 0x3bae, 0x235f3, 0x69e1, 0x69d5, 0x699d, 0x3bb3, 0x3bba, 0x6998, 0x3c3f, 0x6b74, 0x6ba1, 0x3d3c, 0x6ef0, 0x6ef3, 0x3d42, 0x3d40, 0x6f1b, 0x6f0c, 0x6f1d, 0x6f34, 0x6f28, 0x6f17, 0x3d3e, 0x6f44, 0x6f42, 0x6f04, 0x6f11, 0x6efa, 0x6f4a, 0x7191, 0x718e, 0x3de1, 0x718b, 0x718d, 0x717f, 0x718c, 0x717e, 0x717c, 0x7183, 0x3de6, 0x7188, 0x3de0, 0x3e15, 0x7294, 0x3e93, 0x7355, 0x7353, 0x734f, 0x7354, 0x746c, 0x7465, 0x7466, 0x7461,
+		0x746b, 0x7468, 0x7476, 0x3ee7, 0x7460, 0x24a0f, 0x7474, 0x7506, 0x760e, 0x3fad, 0x7607, 0x3fae, 0x3fe3, 0x76b9, 0x3ff5, 0x76b7, 0x76e2, 0x4006, 0x7774, 0x7777, 0x7776, 0x7775, 0x404f, 0x7778, 0x7771, 0x4054, 0x777a, 0x715b, 0x777b, 0x78a6, 0x78ae, 0x78b8, 0x40cb, 0x40e3, 0x40c9, 0x78b1, 0x78af, 0x4113, 0x7989, 0x7987, 0x4115, 0x4161, 0x7a29, 0x4166, 0x7a2a, 0x4164, 0x7a2d, 0x7a2c, 0x4160, 0x7a32, 0x4163, 0x7aec, 0x7af0, 0x7b81, 0x7b9e, 0x7b83, 0x420a, 0x7b92, 0x4204, 0x7ba3, 0x7b9f, 0x7b93, 0x4207, 0x7b86, 0x7cb8, 0x7cb7, 0x428d, 0x428f, 0x4290, 0x4292, 0x42ec, 0x7dc8, 0x7db6, 0x2f96c, 0x7dd1, 0x42e7, 0x7da8, 0x7dab, 0x42f2, 0x7db3, 0x7dcd, 0x42ee, 0x7dcf, 0x7da4, 0x42ef, 0x434c, 0x7f41, 0x7f6f, 0x7f71, 0x435e, 0x435f, 0x4376, 0x4374, 0x4372,
+		0x4390, 0x8023, 0x805b, 0x43be, 0x8061, 0x805f, 0x8181, 0x4426, 0x4425, 0x8184, 0x8213, 0x4474, 0x824a, 0x824c, 0x44fd, 0x4505, 0x4501, 0x84bd, 0x8495, 0x4509, 0x8492, 0x84c3, 0x450c, 0x8496, 0x84a5, 0x84b5, 0x84b3, 0x84a3, 0x84e4, 0x84d8, 0x84d5, 0x450d, 0x84b7, 0x84ad, 0x84da, 0x8493, 0x8736, 0x45c0, 0x45c5, 0x45c9, 0x873d, 0x872b, 0x8747, 0x8739, 0x45d5, 0x8745, 0x871d, 0x4641, 0x88ff, 0x88ea, 0x4633, 0x88f5, 0x463a, 0x8900, 0x88ed, 0x8903, 0x88e9, 0x4640, 0x4642, 0x89ea, 0x46e8, 0x8a9b, 0x8a8e, 0x8aa2, 0x46e4, 0x8a9c, 0x8a94, 0x8a90, 0x8aa9, 0x8aac, 0x46e7, 0x8a9f, 0x46e6, 0x46e1, 0x8a9d, 0x4739, 0x8c67, 0x475c, 0x4775, 0x8cd0, 0x8cd6, 0x8cd4, 0x8d98, 0x8d9a, 0x8d97, 0x47ae, 0x47b0, 0x47fa, 0x8e0b, 0x8e08, 0x8e01, 0x8eb4, 0x8eb3, 0x485b,
+		0x8fa1, 0x8fa2, 0x48a5, 0x905a, 0x48a2, 0x9061, 0x905f, 0x48db, 0x48da, 0x9125, 0x917b, 0x9176, 0x917c, 0x4924, 0x9289, 0x92f6, 0x92b1, 0x92ad, 0x9292, 0x9281, 0x9284, 0x4926, 0x92ae, 0x9290, 0x929e, 0x4998, 0x4996, 0x499a, 0x95a2, 0x95a7, 0x4997, 0x49e1, 0x49e0, 0x49e3, 0x49e2, 0x96a0, 0x969d, 0x969f, 0x96d0, 0x49fb, 0x96d1, 0x4a12, 0x4a14, 0x9759, 0x4a45, 0x9764, 0x4a5c, 0x4a5d, 0x4ab8, 0x9819, 0x4aba, 0x9814, 0x9815, 0x981a, 0x4b03, 0x4b35, 0x4b36, 0x4b39, 0x9906, 0x4b2d, 0x98f8, 0x9901, 0x4b7a, 0x99be, 0x99bc, 0x99b7, 0x99b6, 0x99c0, 0x4b78, 0x99b8, 0x4b7b, 0x4b7c, 0x4b7e, 0x99c4, 0x4b7d, 0x99bf, 0x4bc9, 0x9ada, 0x9ae4, 0x9ae9, 0x9ae8, 0x9aea, 0x9ae5, 0x4bf3, 0x9b26, 0x4c1a, 0x4c19, 0x9b40, 0x4c1f, 0x4ca6, 0x4ca7, 0x4ca8, 0x4cab, 0x4ca9,
+		0x4d2e, 0x9ebd, 0x4d5e, 0x3495, 0x3493, 0x3492, 0x510e, 0x3496, 0x50f7, 0x3497, 0x50fc, 0x510d, 0x5101, 0x51da, 0x51d9, 0x51db, 0x5286, 0x528e, 0x52ee, 0x5333, 0x53b1, 0x35f5, 0x5647, 0x562d, 0x5654, 0x35ea, 0x564b, 0x5652, 0x5631, 0x5644, 0x5656, 0x5650, 0x562b, 0x35f3, 0x564d, 0x5637, 0x564f, 0x58a2, 0x58b7, 0x3669, 0x58b2, 0x366b, 0x58aa, 0x58b5, 0x58b0, 0x366c, 0x58b4, 0x58a4, 0x58a7, 0x3668, 0x5926, 0x5afe, 0x3728, 0x5b04, 0x3726, 0x5afc, 0x3725, 0x5b06, 0x5b0a, 0x5afa, 0x5b0d, 0x5b00, 0x5b0e, 0x376b, 0x380f, 0x3808, 0x5d91, 0x380c, 0x5d8f, 0x5d90, 0x5d98, 0x5da4, 0x5d9b, 0x5da3, 0x5d96, 0x5de4, 0x5e5a, 0x3860, 0x3862, 0x5e5e, 0x3898, 0x5fb8, 0x6157, 0x615c, 0x61a6, 0x6195, 0x6188, 0x398a, 0x61a3, 0x618f, 0x3984, 0x6164, 0x397f, 0x6159,
+		0x6178, 0x3982, 0x6185, 0x6187, 0x619e, 0x3996, 0x3989, 0x6198, 0x619c, 0x398d, 0x39bc, 0x622f, 0x6480, 0x649b, 0x648e, 0x648d, 0x6494, 0x64c6, 0x3a44, 0x64a8, 0x6483, 0x3a3c, 0x64b9, 0x6486, 0x64b4, 0x64af, 0x6491, 0x3a4e, 0x64aa, 0x64a1, 0x64a7, 0x66b6, 0x66b3, 0x3b14, 0x66bc, 0x66ac, 0x3b15, 0x66ad, 0x6a0e, 0x3bce, 0x6a1c, 0x6a1a, 0x3be0, 0x3bc2, 0x6a0b, 0x3bbf, 0x69ef, 0x6a0c, 0x69f0, 0x6a22, 0x3bc4, 0x69d8, 0x3bcf, 0x6a12, 0x69fa, 0x3bc8, 0x6a2a, 0x3bcc, 0x6a10, 0x3bcd, 0x3bc7, 0x6a29, 0x69f9, 0x69ea, 0x6a2c, 0x6a24, 0x4cb7, 0x69e9, 0x6b52, 0x6b4f, 0x6b53, 0x3c43, 0x3cb6, 0x6f10, 0x6f65, 0x6f75, 0x3d51, 0x3d4a, 0x3d4d, 0x3d56, 0x6fd0, 0x3d53, 0x6f5c, 0x6f3d, 0x6f71, 0x3d59, 0x6f91, 0x6f0b, 0x6f79, 0x6f81, 0x6f8f, 0x3d4e, 0x6f59, 0x6f74,
+		0x3dee, 0x71ae, 0x3dec, 0x71a3, 0x71ad, 0x3deb, 0x3def, 0x71ab, 0x71a6, 0x71a2, 0x3ded, 0x52f2, 0x7257, 0x7255, 0x7299, 0x734b, 0x747a, 0x3ef2, 0x3eef, 0x3ef1, 0x748c, 0x7484, 0x3eed, 0x3ef0, 0x7482, 0x7493, 0x747b, 0x3eee, 0x7509, 0x4c1b, 0x3f50, 0x3f66, 0x3684, 0x3fb8, 0x3ff6, 0x778a, 0x4057, 0x7790, 0x405e, 0x78c6, 0x78d3, 0x78c0, 0x78d2, 0x78c7, 0x78c2, 0x4119, 0x799f, 0x799d, 0x799e, 0x4170, 0x7a41, 0x416e, 0x7a38, 0x7a3a, 0x7a42, 0x4172, 0x4176, 0x7a3e, 0x7ab0, 0x7bae, 0x7bb3, 0x4212, 0x421f, 0x7bbf, 0x4211, 0x4216, 0x7bcd, 0x4219, 0x7bb2, 0x4224, 0x4214, 0x4225, 0x4295, 0x4296, 0x4293, 0x4294, 0x7cc4, 0x7ccd, 0x7cc2, 0x7cc6, 0x7cc3, 0x7cc9, 0x7cc7, 0x42a0, 0x7df8, 0x42fb, 0x7ded, 0x7de2, 0x42fc, 0x4300, 0x42f8, 0x7ddc, 0x7e02, 0x7e01,
+		0x42f9, 0x7dd6, 0x4304, 0x7de4, 0x7dfe, 0x4303, 0x7e00, 0x7dfc, 0x7dfd, 0x42f3, 0x7df5, 0x7dff, 0x42fa, 0x7deb, 0x7de5, 0x7f78, 0x7fae, 0x7fe7, 0x43bf, 0x8065, 0x806a, 0x8066, 0x8068, 0x806b, 0x8194, 0x81a1, 0x8192, 0x8196, 0x8193, 0x4479, 0x4510, 0x8501, 0x4514, 0x84f8, 0x450e, 0x84f5, 0x451a, 0x8504, 0x4519, 0x4521, 0x4523, 0x451f, 0x851b, 0x8503, 0x8533, 0x8534, 0x84ed, 0x4525, 0x452b, 0x8535, 0x4516, 0x8505, 0x4522, 0x451b, 0x45ce, 0x45cf, 0x877d, 0x45cb, 0x45d1, 0x45cc, 0x8771, 0x4617, 0x885c, 0x88e6, 0x890f, 0x891b, 0x4651, 0x89a9, 0x89a5, 0x89ee, 0x8ab1, 0x46ed, 0x8acc, 0x8ace, 0x46f4, 0x8ab7, 0x46f1, 0x8ab5, 0x8ae9, 0x8ab4, 0x46f8, 0x8ab3, 0x8ac1, 0x8aaf, 0x8aca, 0x8ad0, 0x472f, 0x475e, 0x475d, 0x8c8e, 0x4776, 0x4777, 0x8ce9, 0x8cdb,
+		0x477e, 0x8ceb, 0x8da4, 0x47b6, 0x8da2, 0x8d9d, 0x47b3, 0x47fc, 0x4803, 0x4800, 0x8e2a, 0x8e28, 0x480a, 0x4802, 0x8eb8, 0x8eb6, 0x8eb9, 0x8eb7, 0x8f22, 0x8f2b, 0x8f27, 0x8f19, 0x8fa4, 0x4887, 0x8fb3, 0x48a6, 0x9071, 0x906a, 0x48a9, 0x48de, 0x9188, 0x918c, 0x92bf, 0x92b8, 0x92be, 0x92dc, 0x92e5, 0x492e, 0x492d, 0x92d4, 0x92d6, 0x4930, 0x92da, 0x92ed, 0x92f3, 0x92db, 0x492b, 0x92b9, 0x92e2, 0x92eb, 0x95af, 0x499e, 0x95b2, 0x95b3, 0x499f, 0x49e5, 0x49e4, 0x96a3, 0x96a5, 0x49fd, 0x49fc, 0x4a17, 0x4a19, 0x970a, 0x4a18, 0x9787, 0x9789, 0x978c, 0x97ef, 0x982a, 0x9822, 0x4abf, 0x981f, 0x4b3c, 0x9919, 0x4b6b, 0x99ca, 0x99da, 0x4b83, 0x4b81, 0x4b80, 0x99de, 0x99c8, 0x99e0, 0x4bca, 0x9ab6, 0x9ab5, 0x4bce, 0x9af4, 0x4bf6, 0x9b6b, 0x9b69, 0x9b72, 0x9b63,
+		0x4c39, 0x9d0d, 0x4cae, 0x9d01, 0x9d0c, 0x4cb5, 0x9cf8, 0x4cb3, 0x4cb4, 0x9cfe, 0x9d02, 0x9e84, 0x4d22, 0x9eab, 0x9eaa, 0x511d, 0x5116, 0x3499, 0x512b, 0x511e, 0x511b, 0x5290, 0x5294, 0x5314, 0x20b89, 0x3602, 0x5667, 0x3601, 0x567b, 0x36a1, 0x565f, 0x5661, 0x35fd, 0x3673, 0x3674, 0x3670, 0x3676, 0x3675, 0x3672, 0x58c3, 0x58ca, 0x58bb, 0x58c0, 0x58c4, 0x5901, 0x5b1f, 0x5b18, 0x5b11, 0x5b15, 0x3729, 0x5b12, 0x5b1c, 0x372a, 0x5b22, 0x5b79, 0x5da6, 0x3816, 0x5db3, 0x5dab, 0x5eea, 0x3899, 0x5f5b, 0x38d3, 0x38f5, 0x61b7, 0x61ce, 0x61b9, 0x61bd, 0x61cf, 0x61c0, 0x6199, 0x6197, 0x3994, 0x61bb, 0x61d0, 0x61c4, 0x6231, 0x3a56, 0x64d3, 0x64c0, 0x3a59, 0x3a58, 0x3a55, 0x3a52, 0x64dc, 0x64d1, 0x64c8, 0x3a57, 0x64d5, 0x66c3, 0x3b1b, 0x3b1c, 0x66bf, 0x66c5,
+		0x3b19, 0x66cd, 0x66c1, 0x6706, 0x3b3f, 0x6724, 0x6a63, 0x6a42, 0x6a52, 0x3bdb, 0x6a43, 0x6a33, 0x3be2, 0x6a6c, 0x6a57, 0x3bd7, 0x6a4c, 0x6a6e, 0x3bde, 0x3be5, 0x3be4, 0x3be6, 0x3bd6, 0x6a37, 0x3bdf, 0x6a71, 0x6a4a, 0x6a36, 0x3bdc, 0x6a53, 0x3bda, 0x6a45, 0x6a70, 0x3bd3, 0x3bd0, 0x6a5c, 0x6b58, 0x6b57, 0x3c86, 0x3c87, 0x3cad, 0x3cb7, 0x3d58, 0x3d6a, 0x6fbb, 0x3d62, 0x3d61, 0x6fbe, 0x3d69, 0x3d6c, 0x3d65, 0x6fb5, 0x6fd3, 0x6f9f, 0x3d66, 0x6fb7, 0x6ff5, 0x71b7, 0x3df5, 0x71bb, 0x3df4, 0x71d1, 0x3df7, 0x71ba, 0x3df8, 0x71b6, 0x71cc, 0x3dfb, 0x3dfc, 0x71d3, 0x749b, 0x3ef5, 0x3ef8, 0x7496, 0x74a2, 0x749d, 0x750a, 0x750e, 0x3f3c, 0x7581, 0x762c, 0x7637, 0x7636, 0x763b, 0x3fc5, 0x76a1, 0x4062, 0x4063, 0x7798, 0x4067, 0x7796, 0x4066, 0x40d9, 0x40db,
+		0x78d6, 0x78eb, 0x40d8, 0x78dc, 0x411b, 0x79a5, 0x79a9, 0x9834, 0x7a53, 0x7a45, 0x4179, 0x7a4f, 0x417d, 0x7abd, 0x7abb, 0x7af1, 0x422c, 0x4237, 0x7bec, 0x7bed, 0x4230, 0x429a, 0x7cd3, 0x4a00, 0x7ce1, 0x4305, 0x7e19, 0x4307, 0x4309, 0x430a, 0x7e27, 0x7e26, 0x4379, 0x43c2, 0x806e, 0x81af, 0x4438, 0x4437, 0x81ad, 0x4421, 0x81aa, 0x8218, 0x445e, 0x453d, 0x4537, 0x4540, 0x856f, 0x854c, 0x451d, 0x8542, 0x4533, 0x855c, 0x8570, 0x855f, 0x4535, 0x855a, 0x854b, 0x853f, 0x878a, 0x45d8, 0x878b, 0x87a1, 0x878e, 0x45dc, 0x45de, 0x8799, 0x885e, 0x885f, 0x8924, 0x89a7, 0x8aea, 0x8afd, 0x8af9, 0x8ae3, 0x8ae5, 0x46fa, 0x46fb, 0x8aec, 0x473d, 0x473b, 0x473f, 0x475f, 0x8cf2, 0x477f, 0x8cef, 0x4784, 0x8da6, 0x47bc, 0x4814, 0x480f, 0x8e3b, 0x8e43, 0x480e, 0x8e32,
+		0x8f31, 0x8f30, 0x4860, 0x8f2d, 0x8f3c, 0x8fa7, 0x8fa5, 0x48ab, 0x48ac, 0x48aa, 0x9137, 0x9195, 0x918e, 0x4904, 0x9196, 0x4908, 0x9345, 0x930a, 0x4933, 0x4934, 0x92fd, 0x9317, 0x931c, 0x9307, 0x9331, 0x9332, 0x932c, 0x9330, 0x9303, 0x9305, 0x49a2, 0x95c2, 0x49a4, 0x95b8, 0x49a5, 0x95c1, 0x49a7, 0x49a6, 0x49e7, 0x96ab, 0x96b7, 0x49ff, 0x49fe, 0x9715, 0x9714, 0x4a1d, 0x4a1c, 0x970c, 0x9717, 0x4a67, 0x9793, 0x4a94, 0x97d2, 0x4ac5, 0x4ac8, 0x9836, 0x9831, 0x9833, 0x983c, 0x982e, 0x983a, 0x4ac9, 0x983d, 0x4ac7, 0x98b5, 0x9922, 0x9923, 0x9920, 0x991c, 0x991d, 0x4b6c, 0x99a0, 0x4b8a, 0x99ef, 0x99e8, 0x99eb, 0x4b88, 0x4b87, 0x4b86, 0x99e1, 0x99e6, 0x4bcf, 0x4bd0, 0x9af8, 0x9af5, 0x4c1c, 0x4c23, 0x9b83, 0x9b94, 0x9b84, 0x4c49, 0x9b8b, 0x9b8f, 0x4c43,
+		0x9b8c, 0x4c48, 0x9b89, 0x4c47, 0x9b8e, 0x4c46, 0x4c3f, 0x4c44, 0x9d24, 0x9d0f, 0x4cbe, 0x9d13, 0x9d0a, 0x4cc2, 0x4cba, 0x4cbc, 0x4cc6, 0x9d2a, 0x9d1a, 0x4cc8, 0x9d27, 0x9d16, 0x9d21, 0x4d23, 0x9e85, 0x9eac, 0x9ec6, 0x9ec5, 0x9ed7, 0x9f53, 0x349d, 0x5128, 0x5127, 0x51df, 0x3524, 0x5335, 0x53b3, 0x3607, 0x568a, 0x567d, 0x5689, 0x3679, 0x58cd, 0x58d0, 0x3678, 0x5b2b, 0x5b33, 0x5b29, 0x5b35, 0x5b31, 0x5b37, 0x5c36, 0x5dbe, 0x3819, 0x5db9, 0x381c, 0x5dbb, 0x3818, 0x61e2, 0x61db, 0x61dd, 0x61dc, 0x61da, 0x2f8af, 0x61d9, 0x39bd, 0x3a5d, 0x64df, 0x3a5a, 0x3a5e, 0x64e1, 0x3a5c, 0x64ee, 0x3a5b, 0x65b5, 0x66d4, 0x66d5, 0x3b21, 0x66d0, 0x66d1, 0x66ce, 0x66d7, 0x3b20, 0x3b32, 0x6a7d, 0x6a8a, 0x3bf2, 0x6aa7, 0x3bf5, 0x6a99, 0x6a82, 0x6a88, 0x3bee, 0x3bec,
+		0x6a86, 0x3bea, 0x6a98, 0x6a9d, 0x3bed, 0x3bf3, 0x6a8f, 0x3bf6, 0x6aaa, 0x3c48, 0x6b5d, 0x3c49, 0x6c0a, 0x3d75, 0x6fd7, 0x6fd6, 0x6fe5, 0x3d6f, 0x3d7b, 0x3d73, 0x6fd9, 0x6fda, 0x6fea, 0x3d70, 0x6ff6, 0x24039, 0x3d78, 0x71e3, 0x3dfe, 0x71e9, 0x3e00, 0x71eb, 0x71ef, 0x71f3, 0x71ea, 0x3e01, 0x2f921, 0x3e55, 0x3e56, 0x3e9d, 0x7371, 0x3ef9, 0x74ae, 0x3eff, 0x74b3, 0x3efd, 0x74ac, 0x3f43, 0x3f41, 0x7583, 0x7645, 0x764e, 0x7644, 0x76a3, 0x76a5, 0x77a6, 0x77a4, 0x406f, 0x77a9, 0x77af, 0x408a, 0x40e5, 0x40e6, 0x78f0, 0x78f8, 0x78f1, 0x417f, 0x7a49, 0x41b5, 0x41b6, 0x41bb, 0x7ac2, 0x7af2, 0x7af3, 0x7bfa, 0x4240, 0x7bf6, 0x7bfc, 0x7c18, 0x7c08, 0x7c12, 0x429d, 0x429c, 0x7cdb, 0x7cda, 0x430f, 0x4311, 0x430d, 0x7e2c, 0x7e4d, 0x4314, 0x4313, 0x7f46, 0x7ff6,
+		0x802b, 0x8074, 0x81b8, 0x81c8, 0x4482, 0x4483, 0x454d, 0x8592, 0x8593, 0x454f, 0x857f, 0x85ab, 0x8597, 0x454c, 0x4551, 0x85ac, 0x45ee, 0x45e8, 0x4ccb, 0x87ce, 0x45eb, 0x87cd, 0x45e2, 0x45e6, 0x87c1, 0x87b1, 0x87c7, 0x45ec, 0x8940, 0x4659, 0x893f, 0x8939, 0x465d, 0x8943, 0x4657, 0x465b, 0x4656, 0x89ab, 0x46fe, 0x8b1f, 0x8b09, 0x8b0c, 0x4700, 0x4701, 0x8c40, 0x4742, 0x8c96, 0x4760, 0x8cf6, 0x8cf7, 0x481d, 0x8e46, 0x8e4f, 0x483e, 0x4869, 0x4865, 0x8f3d, 0x8f41, 0x9366, 0x9378, 0x935d, 0x9369, 0x9374, 0x937d, 0x936e, 0x9372, 0x9373, 0x9362, 0x9348, 0x9353, 0x935f, 0x9368, 0x4938, 0x937f, 0x936b, 0x49ae, 0x95c4, 0x49ad, 0x96af, 0x96ad, 0x96b2, 0x4a02, 0x4a1f, 0x971a, 0x971b, 0x4a22, 0x4a20, 0x2f9f5, 0x4a6c, 0x979b, 0x979f, 0x4a68, 0x4a6d, 0x4a6e,
+		0x4aa0, 0x4ace, 0x4ad0, 0x4ad1, 0x4acb, 0x9840, 0x4ad2, 0x9847, 0x4ad3, 0x98b7, 0x4b20, 0x4b4e, 0x4b4b, 0x4b72, 0x4b70, 0x99a2, 0x4b92, 0x4b8f, 0x9a00, 0x99f3, 0x4b90, 0x29937, 0x99f5, 0x4bd9, 0x4bd5, 0x9abd, 0x9b00, 0x9b02, 0x4bfa, 0x9b34, 0x9b49, 0x9b9f, 0x4c4b, 0x9ba3, 0x9bcd, 0x9b99, 0x9b9d, 0x4cd0, 0x4cce, 0x9d39, 0x4ccf, 0x9d44, 0x4cc4, 0x4ccc, 0x9d35, 0x4cd2, 0x4d35, 0x9eaf, 0x3e03, 0x512f, 0x349e, 0x34af, 0x9f8e, 0x360c, 0x569f, 0x569b, 0x569e, 0x5696, 0x5694, 0x56a0, 0x367c, 0x5b3b, 0x3730, 0x3731, 0x5b3a, 0x5dc1, 0x5f4d, 0x5f5d, 0x61f3, 0x39a1, 0x399e, 0x3a68, 0x3a61, 0x64f6, 0x64e5, 0x64ea, 0x64e7, 0x6505, 0x3a65, 0x64f9, 0x3a66, 0x3a6a, 0x3aab, 0x6aab, 0x6aed, 0x6ab2, 0x6ab0, 0x6ab5, 0x6abe, 0x6ac1, 0x6ac8, 0x3bf9, 0x6ac0, 0x6abc,
+		0x6ab1, 0x6ac4, 0x6abf, 0x3c58, 0x3c8a, 0x7008, 0x7003, 0x6ffd, 0x7010, 0x7002, 0x7013, 0x3e04, 0x71fa, 0x7200, 0x74b9, 0x74bc, 0x3f02, 0x765b, 0x7651, 0x764f, 0x76eb, 0x77b8, 0x4079, 0x77b9, 0x77c1, 0x77c0, 0x77be, 0x790b, 0x40eb, 0x7907, 0x790a, 0x7908, 0x40e9, 0x790d, 0x7906, 0x7915, 0x79af, 0x4120, 0x4121, 0x4181, 0x7af5, 0x424d, 0x4259, 0x7c2e, 0x4258, 0x7c1b, 0x25cd1, 0x7c1a, 0x7c24, 0x42a5, 0x42a9, 0x7ce6, 0x7ce3, 0x431a, 0x4319, 0x7e5d, 0x7e4f, 0x7e66, 0x7e5b, 0x7f47, 0x7fb4, 0x4396, 0x4398, 0x4397, 0x7ffa, 0x802e, 0x265df, 0x43c8, 0x81ce, 0x4443, 0x4445, 0x8219, 0x4552, 0x4557, 0x85cc, 0x85b2, 0x4555, 0x85bb, 0x85c1, 0x4556, 0x4558, 0x45f2, 0x87e9, 0x87ee, 0x87f0, 0x87d6, 0x880e, 0x87da, 0x8948, 0x894a, 0x894e, 0x894d, 0x89b1, 0x89b0,
+		0x89b3, 0x4707, 0x8b38, 0x8b32, 0x4708, 0x8b2d, 0x470a, 0x8b34, 0x431b, 0x8b29, 0x8c74, 0x4761, 0x4762, 0x8d03, 0x47c2, 0x47c6, 0x8da9, 0x8e58, 0x481e, 0x4825, 0x8ebf, 0x8ec1, 0x8f4a, 0x8fac, 0x48b0, 0x9089, 0x913d, 0x913c, 0x91a9, 0x93a0, 0x493d, 0x9390, 0x493e, 0x9393, 0x938b, 0x93ad, 0x93bb, 0x93b8, 0x4946, 0x4945, 0x939c, 0x95d8, 0x95d7, 0x4a03, 0x4a26, 0x4a27, 0x975d, 0x97a9, 0x97da, 0x4a98, 0x4aad, 0x4ad5, 0x4ada, 0x9854, 0x4ad9, 0x9855, 0x984b, 0x4add, 0x983f, 0x98b9, 0x4b15, 0x4b16, 0x4b17, 0x4b21, 0x9938, 0x9936, 0x9940, 0x4b4c, 0x993b, 0x9939, 0x99a4, 0x4b96, 0x4b98, 0x9a08, 0x9a0c, 0x4b9b, 0x9a10, 0x4bff, 0x9b07, 0x4c25, 0x9bd2, 0x4c4f, 0x9bc2, 0x9bbb, 0x9bcc, 0x9bcb, 0x4c56, 0x4c54, 0x9d4d, 0x9d63, 0x9d4e, 0x4cd8, 0x9d50, 0x9d55,
+		0x4cd7, 0x9d5e, 0x4d26, 0x9e90, 0x9eb2, 0x9eb1, 0x4d38, 0x9eca, 0x9f02, 0x9f27, 0x9f26, 0x4d8a, 0x56af, 0x58e0, 0x58dc, 0x3734, 0x5b39, 0x3735, 0x2f86a, 0x5b7c, 0x5bf3, 0x2f870, 0x37a1, 0x5c6b, 0x5dc4, 0x650b, 0x6508, 0x650a, 0x3a6c, 0x3a6d, 0x65dc, 0x3b29, 0x3b2a, 0x66e1, 0x66df, 0x6ace, 0x6ad4, 0x6ae3, 0x6ad7, 0x6ae2, 0x3c00, 0x3c08, 0x3c06, 0x3c05, 0x6ad8, 0x6ad5, 0x6ad2, 0x3cb1, 0x3d88, 0x701e, 0x702c, 0x7025, 0x6ff3, 0x7204, 0x7208, 0x7215, 0x3e09, 0x74c4, 0x74c9, 0x74c7, 0x74c8, 0x76a9, 0x77c6, 0x77c5, 0x7918, 0x791a, 0x7920, 0x4122, 0x7a66, 0x7a64, 0x7a6a, 0x41d5, 0x4261, 0x425d, 0x4262, 0x424f, 0x4260, 0x7c35, 0x7c34, 0x42aa, 0x4322, 0x7e6c, 0x4321, 0x7e6e, 0x7e71, 0x4446, 0x81d4, 0x81d6, 0x821a, 0x8262, 0x8265, 0x8276, 0x85db, 0x85d6,
+		0x4562, 0x85e7, 0x4560, 0x4564, 0x85f4, 0x2f9b6, 0x87fd, 0x87d5, 0x8807, 0x45f6, 0x880f, 0x87f8, 0x2f9c1, 0x4619, 0x8987, 0x4691, 0x89b5, 0x89f5, 0x470d, 0x8b3f, 0x8b43, 0x8b4c, 0x4765, 0x8d0b, 0x8e6b, 0x8e68, 0x8e70, 0x8e75, 0x8e77, 0x483f, 0x8ec3, 0x494b, 0x93e9, 0x93ea, 0x93cb, 0x93c5, 0x93c6, 0x4948, 0x93ed, 0x93d3, 0x4952, 0x93e5, 0x494a, 0x4951, 0x93db, 0x93eb, 0x93e0, 0x93c1, 0x4950, 0x494c, 0x95dd, 0x49ee, 0x4a04, 0x4a06, 0x4a2d, 0x4a2e, 0x4a2f, 0x4a7b, 0x4a78, 0x4a77, 0x97b2, 0x97b4, 0x97b1, 0x97b5, 0x97f2, 0x4aa2, 0x4aa1, 0x4ae3, 0x9856, 0x4b1a, 0x4b19, 0x4b57, 0x9944, 0x4b9e, 0x9a26, 0x9a1f, 0x9a18, 0x9a21, 0x9a17, 0x4bdd, 0x9b09, 0x4c05, 0x4c28, 0x9bc5, 0x9bdf, 0x4c60, 0x9be3, 0x4c66, 0x9be9, 0x9bee, 0x4c67, 0x4c68, 0x9d66, 0x9d7a,
+		0x4cde, 0x9d6e, 0x9d91, 0x9d83, 0x9d76, 0x9d7e, 0x9d6d, 0x4ce1, 0x9e95, 0x9ee3, 0x4d69, 0x4d77, 0x9f03, 0x9f04, 0x2fa19, 0x9f17, 0x34a6, 0x5136, 0x34a5, 0x5336, 0x3614, 0x5b42, 0x3736, 0x3738, 0x5b44, 0x5b46, 0x5b7e, 0x5dca, 0x5dc8, 0x5dcc, 0x5ef0, 0x3a70, 0x6585, 0x66e5, 0x66e7, 0x3b2b, 0x3c11, 0x3c0a, 0x6af4, 0x3c0d, 0x6ae9, 0x3c16, 0x3c10, 0x3c09, 0x3c0e, 0x3c7a, 0x703d, 0x3d8c, 0x7036, 0x3d91, 0x7216, 0x3e0a, 0x7212, 0x720f, 0x7217, 0x7211, 0x720b, 0x3e08, 0x3e0b, 0x74cd, 0x74d0, 0x74cc, 0x74ce, 0x74d1, 0x3f07, 0x7589, 0x40f2, 0x7a6f, 0x7c4b, 0x7c44, 0x7c55, 0x42ae, 0x4324, 0x4326, 0x4327, 0x7e7f, 0x8b71, 0x4399, 0x802f, 0x807a, 0x807b, 0x807c, 0x455f, 0x456a, 0x4571, 0x85fc, 0x8610, 0x8602, 0x456c, 0x456f, 0x85ee, 0x8603, 0x4568, 0x860d,
+		0x8613, 0x8608, 0x860f, 0x8818, 0x8812, 0x4601, 0x4668, 0x8967, 0x8965, 0x89bb, 0x8b69, 0x8b62, 0x4713, 0x8b6e, 0x4716, 0x8b61, 0x4718, 0x8b64, 0x8b4d, 0x8c51, 0x4789, 0x47c8, 0x8e83, 0x8ec6, 0x4884, 0x941f, 0x4954, 0x9404, 0x9417, 0x9408, 0x9405, 0x4956, 0x93f3, 0x941e, 0x9402, 0x941a, 0x941b, 0x9427, 0x941c, 0x495a, 0x96b5, 0x4a05, 0x4a07, 0x9733, 0x4a31, 0x9734, 0x9731, 0x97b8, 0x97ba, 0x4aa3, 0x97fc, 0x4aeb, 0x4b1c, 0x98c3, 0x4b5a, 0x994d, 0x4b5b, 0x9a2f, 0x4ba6, 0x4baa, 0x4ba5, 0x9ac9, 0x4be1, 0x9ac8, 0x9ac4, 0x9b2a, 0x9b38, 0x9b50, 0x4c2a, 0x9c0a, 0x9bfb, 0x9c04, 0x9bfc, 0x9bfe, 0x4c72, 0x4c6f, 0x4c73, 0x9c02, 0x9bf6, 0x9c1b, 0x9bf9, 0x9c15, 0x9c10, 0x9bff, 0x9c00, 0x9c0c, 0x4c6b, 0x4ce6, 0x9d95, 0x9da5, 0x4ce9, 0x4cec, 0x4ce8, 0x4cf0,
+		0x9e98, 0x9ec1, 0x4d8c, 0x9f5a, 0x5164, 0x56bb, 0x3615, 0x58e6, 0x5b49, 0x5bf7, 0x3771, 0x3826, 0x5dd0, 0x38c6, 0x5fc2, 0x39a8, 0x6511, 0x3a73, 0x6aff, 0x6afe, 0x6afd, 0x3c15, 0x6b01, 0x3d98, 0x3d97, 0x704b, 0x704d, 0x7047, 0x74d3, 0x7668, 0x7667, 0x3fd7, 0x4080, 0x77d1, 0x7930, 0x7932, 0x792e, 0x4188, 0x9f9d, 0x7ac9, 0x7ac8, 0x4269, 0x7c56, 0x7c51, 0x426b, 0x4329, 0x4328, 0x7e85, 0x7e89, 0x7e8e, 0x7e84, 0x445f, 0x826a, 0x862b, 0x862f, 0x8628, 0x4574, 0x8616, 0x8615, 0x861d, 0x881a, 0x4602, 0x466a, 0x4694, 0x89bc, 0x8b75, 0x8b7c, 0x478a, 0x8d11, 0x8d12, 0x8f5c, 0x91bb, 0x4964, 0x93f4, 0x495e, 0x4961, 0x942d, 0x4965, 0x4966, 0x96e4, 0x9737, 0x9736, 0x9767, 0x97be, 0x97bd, 0x97e2, 0x9868, 0x9866, 0x98c8, 0x98ca, 0x98c7, 0x98dc, 0x4b5f, 0x994f,
+		0x99a9, 0x9a3c, 0x4baf, 0x9a3b, 0x9ace, 0x4c0d, 0x9b14, 0x9b53, 0x4c7c, 0x9c2e, 0x4c7a, 0x9c1f, 0x4c76, 0x4c79, 0x4c7d, 0x4c77, 0x9db0, 0x9dbd, 0x4cf6, 0x4cf1, 0x9dae, 0x9dc4, 0x9e7b, 0x400b, 0x4d29, 0x9e9e, 0x4d6f, 0x9f05, 0x4d9a, 0x9f69, 0x9fa1, 0x56c7, 0x571d, 0x5b4a, 0x5dd3, 0x3869, 0x5f72, 0x6202, 0x39ab, 0x6235, 0x6527, 0x651e, 0x651f, 0x3b2c, 0x3b2d, 0x6b07, 0x6b06, 0x3c17, 0x3d9a, 0x7054, 0x721c, 0x7220, 0x7af8, 0x426e, 0x7c5d, 0x7c58, 0x432c, 0x7e92, 0x7f4e, 0x43ca, 0x4578, 0x4606, 0x8827, 0x4607, 0x8b81, 0x8b83, 0x4720, 0x8c44, 0x4753, 0x47ce, 0x487a, 0x4879, 0x9442, 0x944d, 0x9454, 0x944e, 0x496b, 0x9443, 0x4967, 0x496d, 0x973c, 0x9740, 0x97c0, 0x4a85, 0x4ab0, 0x4af3, 0x4b63, 0x995a, 0x9a51, 0x4bb6, 0x9add, 0x4c82, 0x4c7f, 0x9c38,
+		0x4c86, 0x9c45, 0x9c3a, 0x4c84, 0x9c35, 0x4cfc, 0x4cfd, 0x4cfa, 0x9ef1, 0x4d87, 0x9f93, 0x529a, 0x361a, 0x3619, 0x8641, 0x5dd7, 0x3a75, 0x6528, 0x3c1a, 0x3c1b, 0x3c19, 0x7053, 0x7059, 0x3d9c, 0x7221, 0x3e10, 0x766f, 0x7937, 0x79b5, 0x7c62, 0x7c5e, 0x7cf5, 0x457b, 0x457c, 0x863d, 0x4608, 0x882d, 0x8989, 0x8b8d, 0x8b87, 0x8b90, 0x8d1a, 0x8e99, 0x4841, 0x48e3, 0x4972, 0x945f, 0x4973, 0x4968, 0x9456, 0x9461, 0x945b, 0x945a, 0x945c, 0x9465, 0x4a35, 0x9741, 0x4a88, 0x4a9d, 0x986e, 0x986c, 0x986d, 0x4275, 0x99aa, 0x9a5c, 0x9a58, 0x9ade, 0x4c8f, 0x9c4f, 0x9c51, 0x4c8e, 0x9c53, 0x4d05, 0x4d04, 0x4cff, 0x9dfc, 0x9f39, 0x4d9e, 0x513e, 0x3554, 0x56d2, 0x3681, 0x5b4f, 0x6b14, 0x40fa, 0x7a72, 0x7a73, 0x4332, 0x4670, 0x466e, 0x8b91, 0x2f9d6, 0x487c, 0x91bf,
+		0x4975, 0x946c, 0x4974, 0x4977, 0x96e6, 0x9745, 0x4a37, 0x97c8, 0x97e4, 0x995d, 0x4bba, 0x9b21, 0x4c11, 0x9b2c, 0x9b57, 0x4c92, 0x4c99, 0x9c5d, 0x9c61, 0x9c65, 0x9e08, 0x4d0a, 0x4d2a, 0x4d2b, 0x4d44, 0x4d79, 0x9f45, 0x34aa, 0x3748, 0x6205, 0x66ef, 0x6b1b, 0x6b1d, 0x7225, 0x7224, 0x7c6d, 0x42b4, 0x8642, 0x8649, 0x460d, 0x8978, 0x898a, 0x8b97, 0x4754, 0x8c9b, 0x8d1c, 0x4830, 0x8ea2, 0x4a09, 0x4a38, 0x4a36, 0x4a8b, 0x4af7, 0x4b66, 0x4bbd, 0x4c1e, 0x9c6c, 0x4c96, 0x9c6f, 0x4d0d, 0x9e0e, 0x4d73, 0x9f08, 0x9f1d, 0x9fa3, 0x373b, 0x373c, 0x5f60, 0x6b1c, 0x3da0, 0x40fb, 0x0, 0x7cf3, 0x4581, 0x8b9b, 0x8ea7, 0x91c4, 0x4978, 0x947a, 0x4a8d, 0x4b73, 0x9a61, 0x9a63, 0x9ad7, 0x9c76, 0x4da6, 0x9fa5, 0x39ad, 0x7067, 0x3e11, 0x72ab, 0x864a, 0x897d, 0x8b9d,
+		0x8c53, 0x8f65, 0x947b, 0x4a39, 0x98cd, 0x98dd, 0x4bbf, 0x9b30, 0x9e16, 0x4d0f, 0x4da7, 0x4db5, 0x3fdc, 0x4831, 0x96e7, 0x9e18, 0x9ea2, 0x4da8, 0x9f7c, 0x4125, 0x7e9e, 0x9484, 0x4bc1, 0x9e1c, 0x4190, 0x7c71, 0x97ca, 0x4696, 0x487f, 0x4d10, 0x9ea3, 0x4a0a, 0x9c7b, 0x9f97, 0x4d12, 0x4a3a, 0x9750, 0x4a3b, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x4f66, 0x4f68, 0x4fe7, 0x503f, 0x0, 0x50a6, 0x510f, 0x523e, 0x5324, 0x5365, 0x539b, 0x517f, 0x54cb, 0x5573, 0x5571, 0x556b, 0x55f4, 0x5622, 0x5620, 0x5692, 0x56ba, 0x5691, 0x56b0, 0x5759, 0x578a, 0x580f, 0x5812, 0x5813, 0x5847, 0x589b, 0x5900, 0x594d, 0x5ad1, 0x5ad3, 0x5b67, 0x5c57, 0x5c77, 0x5cd5, 0x5d75, 0x5d8e, 0x5da5, 0x5db6, 0x5dbf, 0x5e65, 0x5ecd, 0x5eed, 0x5f94, 0x5f9a, 0x5fba, 0x6125, 0x6150, 0x62a3, 0x6360, 0x6364, 0x63b6,
+		0x6403, 0x64b6, 0x651a, 0x7a25, 0x5c21, 0x66e2, 0x6702, 0x67a4, 0x67ac, 0x6810, 0x6806, 0x685e, 0x685a, 0x692c, 0x6929, 0x6a2d, 0x6a77, 0x6a7a, 0x6aca, 0x6ae6, 0x6af5, 0x6b0d, 0x6b0e, 0x6bdc, 0x6bdd, 0x6bf6, 0x6c1e, 0x6c63, 0x6da5, 0x6e0f, 0x6e8a, 0x6e84, 0x6e8b, 0x6e7c, 0x6f4c, 0x6f48, 0x6f49, 0x6f9d, 0x6f99, 0x6ff8, 0x702e, 0x702d, 0x705c, 0x79cc, 0x70bf, 0x70ea, 0x70e5, 0x7111, 0x7112, 0x713f, 0x7139, 0x713b, 0x713d, 0x7177, 0x7175, 0x7176, 0x7171, 0x7196, 0x7193, 0x71b4, 0x71dd, 0x71de, 0x720e, 0x5911, 0x7218, 0x7347, 0x7348, 0x73ef, 0x7412, 0x743b, 0x74a4, 0x748d, 0x74b4, 0x7673, 0x7677, 0x76bc, 0x7819, 0x781b, 0x783d, 0x7853, 0x7854, 0x7858, 0x78b7, 0x78d8, 0x78ee, 0x7922, 0x794d, 0x7986, 0x7999, 0x79a3, 0x79bc, 0x7aa7, 0x7b37, 0x7b59,
+		0x7bd0, 0x7c2f, 0x7c32, 0x7c42, 0x7c4e, 0x7c68, 0x7ca9, 0x7ced, 0x7dd0, 0x7e07, 0x7dd3, 0x7e64, 0x7f40, 0x0, 0x8041, 0x8063, 0x80bb, 0x6711, 0x6725, 0x8248, 0x8310, 0x8362, 0x8312, 0x8421, 0x841e, 0x84e2, 0x84de, 0x84e1, 0x8573, 0x85d4, 0x85f5, 0x8637, 0x8645, 0x8672, 0x874a, 0x87a9, 0x87a5, 0x87f5, 0x8834, 0x8850, 0x8887, 0x8954, 0x8984, 0x8b03, 0x8c52, 0x8cd8, 0x8d0c, 0x8d18, 0x8db0, 0x8ebc, 0x8ed5, 0x8faa, 0x909c, 0x0, 0x915c, 0x922b, 0x9221, 0x9273, 0x92f4, 0x92f5, 0x933f, 0x9342, 0x9386, 0x93be, 0x93bc, 0x93bd, 0x93f1, 0x93f2, 0x93ef, 0x9422, 0x9423, 0x9424, 0x9467, 0x9466, 0x9597, 0x95ce, 0x95e7, 0x973b, 0x974d, 0x98e4, 0x9942, 0x9b1d, 0x9b98, 0x0, 0x9d49, 0x6449, 0x5e71, 0x5e85, 0x61d3, 0x990e, 0x8002, 0x781e, 0x0, 0x0,
+		0x5528, 0x5572, 0x55ba, 0x55f0, 0x55ee, 0x56b8, 0x56b9, 0x56c4, 0x8053, 0x92b0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 4
+		0x20086, 0x4e40, 0x4e41, 0x4e5a, 0x21fe8, 0x4e02, 0x4e29, 0x2010e, 0x20627, 0x5202, 0x353e, 0x5ddc, 0x2053c, 0x20675, 0x2f828, 0x5342, 0x536a, 0x5b52, 0x2193c, 0x2f875, 0x2f878, 0x5fc4, 0x624c, 0x72ad, 0x4e12, 0x4e2f, 0x4e96, 0x4ed0, 0x5142, 0x5183, 0x2f819, 0x206a5, 0x206aa, 0x2090f, 0x20a0e, 0x5383, 0x53b8, 0x20b9c, 0x20b9b, 0x2123c, 0x5928, 0x21bc2, 0x5c23, 0x5e01, 0x5f00, 0x233b4, 0x3cb8, 0x706c, 0x722b, 0x5188, 0x8279, 0x8fb6, 0x4e17, 0x20065, 0x340c, 0x201b2, 0x3430, 0x4ee2, 0x4edb, 0x20477, 0x20542, 0x51ad, 0x20633, 0x51f7, 0x34da, 0x206af, 0x20836, 0x3513, 0x531b, 0x5388, 0x5387, 0x20b22, 0x53cf, 0x53fd, 0x3563, 0x53e7, 0x56dc, 0x211a3, 0x56d9, 0x5725, 0x5727, 0x5933, 0x5c13, 0x21c25, 0x21c24, 0x5c75, 0x22052, 0x22189, 0x224bf, 0x39c4, 0x39c3, 0x66f1, 0x233b5, 0x2574c,
+		0x7f52, 0x28e14, 0x28e12, 0x3401, 0x200a3, 0x200a2, 0x4e51, 0x4e6a, 0x201b6, 0x4f0c, 0x201b5, 0x201b4, 0x4efe, 0x4f1b, 0x201c2, 0x201b8, 0x343a, 0x20479, 0x34ab, 0x5173, 0x20508, 0x518e, 0x2057a, 0x205b6, 0x206bd, 0x206b7, 0x206bc, 0x34dd, 0x206c4, 0x206c9, 0x52a5, 0x3515, 0x52a7, 0x52a4, 0x20930, 0x20983, 0x20985, 0x20a12, 0x20a34, 0x53bd, 0x20ae4, 0x20ade, 0x20b2a, 0x5402, 0x20baf, 0x20bb4, 0x20bb3, 0x20bb1, 0x572b, 0x591b, 0x5935, 0x215de, 0x36a7, 0x36a5, 0x216b8, 0x216b0, 0x36a6, 0x219c2, 0x0, 0x5c17, 0x377c, 0x21c2a, 0x5c70, 0x5c7d, 0x37a9, 0x21fe9, 0x5de9, 0x22057, 0x3834, 0x3835, 0x221b6, 0x221d7, 0x221de, 0x38a8, 0x5f19, 0x5f1c, 0x5f75, 0x224c2, 0x225ab, 0x38ff, 0x5fc8, 0x2298f, 0x39c7, 0x39c6, 0x39c8, 0x22a6d, 0x22eb5, 0x3ad0, 0x3ad1, 0x233c0, 0x233bf, 0x3c59, 0x239b9, 0x23b1c,
+		0x6c12, 0x3cbd, 0x23c80, 0x24184, 0x24185, 0x3e28, 0x72b3, 0x24724, 0x3ea9, 0x7390, 0x7536, 0x2634c, 0x43cc, 0x26af6, 0x8281, 0x8fb8, 0x28670, 0x48b4, 0x28675, 0x28e17, 0x4e23, 0x3416, 0x342c, 0x201f1, 0x4f2e, 0x201da, 0x514f, 0x205b9, 0x51ba, 0x34df, 0x34e0, 0x5222, 0x206ce, 0x206d2, 0x206d0, 0x3517, 0x20843, 0x52af, 0x52b0, 0x52b1, 0x2084d, 0x20934, 0x352f, 0x209d9, 0x5364, 0x20a18, 0x53d3, 0x20b38, 0x356a, 0x20bd7, 0x3570, 0x356d, 0x20bdc, 0x20bcb, 0x20bd6, 0x20bd8, 0x356e, 0x20bd1, 0x2f83e, 0x2f83c, 0x20bd2, 0x362b, 0x3628, 0x21259, 0x0, 0x593f, 0x215e5, 0x215e6, 0x3692, 0x215e8, 0x598b, 0x216bd, 0x5991, 0x5995, 0x216be, 0x21949, 0x373f, 0x219cd, 0x5b8a, 0x374f, 0x3774, 0x21b5e, 0x21bd0, 0x377d, 0x21d06, 0x37b7, 0x37a3, 0x37b0, 0x37b1, 0x5c87, 0x37ab, 0x21d7c, 0x21d71, 0x21fef,
+		0x383a, 0x3837, 0x5e0d, 0x3838, 0x3840, 0x2218d, 0x221b7, 0x5e8e, 0x389f, 0x22333, 0x2237f, 0x5f7a, 0x225f2, 0x3904, 0x3909, 0x3906, 0x38fd, 0x390a, 0x3907, 0x22a2a, 0x22a8b, 0x39ca, 0x22a83, 0x22a8a, 0x6290, 0x39c9, 0x22a8e, 0x629a, 0x22eb6, 0x653c, 0x653a, 0x3a7f, 0x6598, 0x230d8, 0x3ad2, 0x23156, 0x233d2, 0x6765, 0x2f8db, 0x3b43, 0x233c2, 0x23887, 0x23881, 0x239c2, 0x239bc, 0x23b23, 0x3cc1, 0x23c93, 0x3cc5, 0x3da3, 0x2418d, 0x2418e, 0x3e2a, 0x3e5f, 0x2473b, 0x2474d, 0x3e5d, 0x24734, 0x24731, 0x248f2, 0x248f0, 0x3f17, 0x24c15, 0x24d28, 0x3f71, 0x3f72, 0x24f25, 0x250e7, 0x400f, 0x250e9, 0x25417, 0x79c2, 0x4191, 0x2626c, 0x26280, 0x2634b, 0x43b2, 0x43cf, 0x43ce, 0x809e, 0x268de, 0x268fb, 0x81eb, 0x26951, 0x8289, 0x4496, 0x26b01, 0x26b02, 0x8296, 0x26b05, 0x8287, 0x26b03, 0x4497, 0x28451,
+		0x8fc0, 0x488b, 0x8fc3, 0x28453, 0x2867a, 0x28678, 0x28676, 0x28679, 0x2868f, 0x2867c, 0x9578, 0x28e1b, 0x28e1c, 0x9625, 0x23d92, 0x4e75, 0x4e74, 0x2012e, 0x2012c, 0x342d, 0x2020c, 0x4f99, 0x2020b, 0x3450, 0x344b, 0x20205, 0x344f, 0x344c, 0x2f805, 0x4f71, 0x5153, 0x51bf, 0x205c2, 0x205c3, 0x51c0, 0x20648, 0x51ee, 0x20646, 0x34e4, 0x34e3, 0x206ea, 0x34e1, 0x206eb, 0x34e2, 0x206ee, 0x523d, 0x3519, 0x52bd, 0x530c, 0x208d8, 0x24c1e, 0x209df, 0x209de, 0x3541, 0x7f37, 0x20a4f, 0x53c0, 0x355e, 0x20c0d, 0x20c08, 0x3579, 0x20c09, 0x546e, 0x5483, 0x20c12, 0x20c65, 0x545e, 0x545d, 0x577e, 0x5779, 0x21289, 0x577a, 0x576c, 0x21284, 0x2129e, 0x2128b, 0x3632, 0x5787, 0x21562, 0x591d, 0x3694, 0x5946, 0x3697, 0x215f9, 0x5943, 0x215f7, 0x3696, 0x3698, 0x21606, 0x216dd, 0x36b2, 0x216d9, 0x216df, 0x216e2,
+		0x36b9, 0x5b61, 0x5b66, 0x200f1, 0x5b90, 0x3775, 0x377f, 0x377e, 0x5c29, 0x378f, 0x21c3f, 0x21c3e, 0x21d0b, 0x37bd, 0x5cb2, 0x21d83, 0x37bb, 0x37bc, 0x21d86, 0x5cc0, 0x2207c, 0x383d, 0x383e, 0x3874, 0x221f7, 0x387a, 0x3876, 0x3878, 0x3875, 0x221fb, 0x2233b, 0x38af, 0x38b0, 0x38c7, 0x38cc, 0x225f9, 0x225ca, 0x3916, 0x225ff, 0x3912, 0x391d, 0x22609, 0x22603, 0x3915, 0x390f, 0x3914, 0x601f, 0x5fe2, 0x22610, 0x2299a, 0x2299f, 0x39b0, 0x39bf, 0x39c0, 0x22a87, 0x39d2, 0x39d9, 0x22eb7, 0x3a7a, 0x22f08, 0x22ef9, 0x3a81, 0x3a82, 0x22ef4, 0x6616, 0x65f9, 0x3ada, 0x26657, 0x6788, 0x233d6, 0x679b, 0x2f8de, 0x676e, 0x679e, 0x233d4, 0x3c22, 0x3c1f, 0x23890, 0x2388d, 0x3c21, 0x6b24, 0x23960, 0x239ce, 0x239cf, 0x3c5c, 0x6b7d, 0x239d1, 0x3c7d, 0x3c8d, 0x3c8f, 0x6ce6, 0x23cca, 0x6ccb, 0x3cd0,
+		0x23cb7, 0x3cd8, 0x23cb2, 0x23cbb, 0x23cb9, 0x6cb5, 0x3da7, 0x2419e, 0x7097, 0x241b2, 0x709b, 0x3e12, 0x2456f, 0x245ac, 0x3e2f, 0x24623, 0x726b, 0x3e2e, 0x3e2c, 0x3e5c, 0x2476c, 0x72d5, 0x24732, 0x2475e, 0x3e62, 0x3e67, 0x3eb4, 0x248fb, 0x24b29, 0x24bbd, 0x24c1f, 0x7543, 0x22341, 0x24c1d, 0x759c, 0x24d31, 0x24d2f, 0x3fea, 0x25043, 0x3ffb, 0x25041, 0x4014, 0x250f5, 0x4013, 0x4012, 0x4010, 0x4011, 0x250f2, 0x4086, 0x77e4, 0x4098, 0x2541e, 0x25754, 0x412a, 0x25756, 0x25929, 0x25927, 0x25928, 0x7ace, 0x42b5, 0x26279, 0x2627e, 0x264b6, 0x264b7, 0x8013, 0x43d6, 0x43d8, 0x80b7, 0x43d9, 0x43d4, 0x43d7, 0x2f981, 0x80b9, 0x0, 0x2664d, 0x81e4, 0x81fd, 0x820f, 0x4460, 0x26a09, 0x26a08, 0x449e, 0x44a1, 0x26b1e, 0x26b1a, 0x26b23, 0x26b15, 0x26b19, 0x26b16, 0x82bf, 0x82ca, 0x26b14, 0x26b18, 0x82c1,
+		0x44a0, 0x2721f, 0x275dd, 0x275df, 0x28469, 0x8fd0, 0x0, 0x2846c, 0x48b9, 0x28693, 0x90ae, 0x28695, 0x28694, 0x28691, 0x28697, 0x28e30, 0x49c1, 0x49c2, 0x9638, 0x2fa18, 0x341c, 0x20231, 0x345e, 0x4fbc, 0x3459, 0x345c, 0x20236, 0x345f, 0x4fe9, 0x4fbd, 0x4fe2, 0x5158, 0x205c9, 0x205ca, 0x34ce, 0x20718, 0x20712, 0x20719, 0x20710, 0x20716, 0x52c6, 0x2085f, 0x52c8, 0x208df, 0x20917, 0x5328, 0x2093c, 0x5329, 0x209ea, 0x209e9, 0x20a1c, 0x20a58, 0x20a64, 0x20af3, 0x355f, 0x20c97, 0x3585, 0x20c5a, 0x20c59, 0x3586, 0x20c8e, 0x57b4, 0x212bc, 0x57a9, 0x3687, 0x2160d, 0x215fc, 0x2160c, 0x21707, 0x2170a, 0x36ca, 0x21702, 0x36c3, 0x21726, 0x21708, 0x2171d, 0x36c2, 0x5b68, 0x2194e, 0x3741, 0x219ed, 0x219ee, 0x219ef, 0x3780, 0x3781, 0x21c50, 0x21c4c, 0x3793, 0x3792, 0x21c4f, 0x37c5, 0x21dd4, 0x0, 0x21db0,
+		0x3846, 0x3841, 0x3845, 0x3842, 0x383f, 0x220a5, 0x22209, 0x3882, 0x3881, 0x387f, 0x38a5, 0x5f2b, 0x38b3, 0x38b5, 0x223f3, 0x223d7, 0x5f8d, 0x38da, 0x224dc, 0x38db, 0x390d, 0x6018, 0x390e, 0x2260b, 0x391e, 0x3925, 0x3926, 0x391c, 0x3921, 0x6057, 0x6048, 0x3927, 0x391a, 0x2263a, 0x2260d, 0x22611, 0x6038, 0x22620, 0x22601, 0x3924, 0x22637, 0x2f8a3, 0x6071, 0x229aa, 0x39c1, 0x39e1, 0x22ae7, 0x22ae8, 0x6312, 0x39eb, 0x22af5, 0x39e2, 0x39d7, 0x39e9, 0x22b35, 0x22ae6, 0x630a, 0x22af8, 0x6323, 0x22af1, 0x3a84, 0x22f14, 0x22f15, 0x22f09, 0x3ab5, 0x3abc, 0x3adc, 0x3ade, 0x3adf, 0x2317b, 0x662a, 0x2317e, 0x23366, 0x23367, 0x2340a, 0x3b54, 0x67e0, 0x67be, 0x3b53, 0x3c24, 0x3c25, 0x6b29, 0x3c28, 0x3c27, 0x2389c, 0x23962, 0x239e1, 0x239de, 0x239df, 0x239e9, 0x3c8b, 0x23ce4, 0x23cf7, 0x3cdc,
+		0x6d43, 0x23cea, 0x23cf6, 0x23d12, 0x2f901, 0x70a6, 0x3db2, 0x70c0, 0x241d9, 0x722f, 0x2455f, 0x3e1b, 0x24573, 0x3e32, 0x7271, 0x2463f, 0x24778, 0x3e6b, 0x2477d, 0x3e6c, 0x3e6d, 0x24912, 0x3eb9, 0x3eba, 0x3f09, 0x3f0a, 0x24b2f, 0x74ea, 0x3f1b, 0x24b30, 0x24bdb, 0x7520, 0x3f58, 0x24c29, 0x3f5a, 0x2f936, 0x24d40, 0x3f77, 0x24d3d, 0x24d3e, 0x3f79, 0x75a9, 0x24d38, 0x7685, 0x24f48, 0x3feb, 0x24fd0, 0x3ffd, 0x3ffc, 0x7706, 0x4015, 0x4018, 0x76f6, 0x4016, 0x4017, 0x4019, 0x7700, 0x401b, 0x2510e, 0x25109, 0x7702, 0x25107, 0x4087, 0x2535f, 0x25360, 0x409c, 0x2542a, 0x409a, 0x25429, 0x40ff, 0x40fe, 0x2564d, 0x4131, 0x412e, 0x4130, 0x4132, 0x2576c, 0x0, 0x412f, 0x25767, 0x4195, 0x4196, 0x25939, 0x25934, 0x25a59, 0x41c5, 0x427a, 0x26223, 0x4342, 0x26282, 0x4354, 0x26283, 0x262a8, 0x26355,
+		0x263f9, 0x263f8, 0x263fc, 0x263fa, 0x8009, 0x2f97a, 0x439f, 0x43a0, 0x43a2, 0x43e0, 0x26674, 0x43e1, 0x2666b, 0x26671, 0x43df, 0x26676, 0x26a0e, 0x4462, 0x4461, 0x26a10, 0x26a0f, 0x44a7, 0x26b38, 0x26b39, 0x26b3a, 0x82da, 0x26b37, 0x26b3e, 0x830a, 0x4589, 0x2719c, 0x2722a, 0x2723a, 0x2722b, 0x27228, 0x275aa, 0x2760f, 0x461d, 0x27610, 0x277e6, 0x472a, 0x27d25, 0x27e8e, 0x47d3, 0x4842, 0x4843, 0x2848b, 0x4891, 0x2848c, 0x2849a, 0x4890, 0x48bf, 0x286b3, 0x48bc, 0x286b2, 0x48c0, 0x49c7, 0x49c5, 0x9655, 0x28e44, 0x9652, 0x4e35, 0x20158, 0x202a7, 0x5034, 0x5001, 0x20263, 0x500a, 0x3466, 0x2026a, 0x20265, 0x2026b, 0x34ad, 0x204ed, 0x205d9, 0x2073b, 0x20732, 0x5258, 0x20735, 0x20731, 0x2073e, 0x20743, 0x20736, 0x2086d, 0x351d, 0x208e4, 0x3531, 0x532b, 0x209f4, 0x354c, 0x20a69, 0x20a6b, 0x3595, 0x3591,
+		0x3594, 0x20cbf, 0x20cc3, 0x20cae, 0x358f, 0x54ec, 0x20cb1, 0x5515, 0x54fe, 0x20cbb, 0x20caf, 0x20cb0, 0x54e3, 0x5516, 0x3640, 0x212ef, 0x3641, 0x212ed, 0x57d3, 0x212f0, 0x21593, 0x21634, 0x2162b, 0x5959, 0x5a27, 0x36d8, 0x36d2, 0x36da, 0x5a28, 0x5a10, 0x36d7, 0x5a0e, 0x3742, 0x3757, 0x21a04, 0x21a03, 0x21a02, 0x5baf, 0x21a05, 0x5bba, 0x5bb1, 0x21a00, 0x21b74, 0x3778, 0x3782, 0x3797, 0x21c65, 0x21c63, 0x37c9, 0x21dd7, 0x37c8, 0x37d6, 0x37cc, 0x21de8, 0x37d0, 0x21ddb, 0x21dd8, 0x37ce, 0x37c7, 0x5cfc, 0x21e09, 0x37cf, 0x37cb, 0x5cf2, 0x5cfe, 0x21de5, 0x21de7, 0x5df8, 0x2203f, 0x3847, 0x220c0, 0x3848, 0x220b9, 0x220ba, 0x3883, 0x3885, 0x3884, 0x22220, 0x2234f, 0x5f2c, 0x38b8, 0x38bc, 0x22491, 0x22490, 0x224ee, 0x224f3, 0x224ec, 0x224f1, 0x224eb, 0x224f2, 0x224f4, 0x224ed, 0x22641, 0x22648,
+		0x22645, 0x3931, 0x3934, 0x3936, 0x6082, 0x2267f, 0x2268b, 0x3923, 0x2263b, 0x2264e, 0x393a, 0x6091, 0x608f, 0x2267c, 0x39b4, 0x39b5, 0x39ed, 0x39ec, 0x39d8, 0x22af0, 0x22b36, 0x22b3f, 0x22b43, 0x22b31, 0x22b34, 0x22b46, 0x39f6, 0x39e7, 0x22b2f, 0x22ec3, 0x3a7c, 0x3a7b, 0x22f36, 0x3a88, 0x6547, 0x654c, 0x22f21, 0x2f8c8, 0x658a, 0x23073, 0x3abe, 0x230e6, 0x3ae7, 0x3ae5, 0x3aee, 0x67e1, 0x684a, 0x2343f, 0x3b59, 0x3b5e, 0x2346b, 0x3b5a, 0x683f, 0x3b61, 0x3b58, 0x3b5b, 0x67bd, 0x23471, 0x3b5f, 0x2f8e2, 0x3c2a, 0x3c2d, 0x238b0, 0x3c23, 0x3c2b, 0x3c2c, 0x239f3, 0x3c7e, 0x2f8f5, 0x3c93, 0x3c99, 0x23b61, 0x23b5f, 0x3cb3, 0x3ce7, 0x3cea, 0x23d28, 0x23d1e, 0x23d29, 0x2f902, 0x23ceb, 0x23d66, 0x3db4, 0x241f6, 0x70c9, 0x3e17, 0x24560, 0x24577, 0x245bb, 0x3e21, 0x245bc, 0x3e38, 0x3e37, 0x3e74,
+		0x3e73, 0x3e75, 0x247a2, 0x247b2, 0x2479e, 0x3e76, 0x3e78, 0x2493a, 0x24938, 0x73ba, 0x3f0c, 0x3f20, 0x3f1e, 0x24c4d, 0x3f5b, 0x24c5e, 0x3f5c, 0x24d17, 0x3f83, 0x75c6, 0x3f80, 0x3f81, 0x3f7e, 0x3f88, 0x3f85, 0x3f89, 0x3f7f, 0x3f8e, 0x24d64, 0x24d67, 0x3f84, 0x75b7, 0x768c, 0x24f59, 0x768d, 0x24fda, 0x3fee, 0x3fed, 0x3ffe, 0x25051, 0x3fff, 0x25055, 0x2513b, 0x25134, 0x4023, 0x25137, 0x7717, 0x25131, 0x771c, 0x401f, 0x2513f, 0x25159, 0x7714, 0x25361, 0x408f, 0x4090, 0x25458, 0x25462, 0x25450, 0x40a0, 0x25451, 0x40a6, 0x409f, 0x25446, 0x40a7, 0x40a1, 0x2562d, 0x4102, 0x4136, 0x25948, 0x25951, 0x2594a, 0x2594f, 0x25950, 0x25949, 0x25a63, 0x41c8, 0x25a66, 0x25a60, 0x41d8, 0x7b0c, 0x41dd, 0x41dc, 0x25af1, 0x41d7, 0x25b07, 0x41da, 0x42ba, 0x25f9b, 0x25fa3, 0x25f9d, 0x42be, 0x42c2, 0x42bb,
+		0x42c0, 0x25fa9, 0x25fa7, 0x25fa4, 0x7d23, 0x25fba, 0x221c1, 0x26226, 0x4343, 0x4355, 0x26294, 0x26293, 0x4357, 0x4368, 0x7f98, 0x7f90, 0x26407, 0x2640d, 0x26402, 0x2640c, 0x26409, 0x26408, 0x26413, 0x43a1, 0x264d2, 0x803a, 0x2653e, 0x2653c, 0x26667, 0x2669e, 0x43ea, 0x266ad, 0x43e7, 0x266d9, 0x26642, 0x266a3, 0x266a0, 0x43e8, 0x43e9, 0x2667c, 0x26904, 0x4454, 0x26958, 0x26960, 0x269c9, 0x269c8, 0x8226, 0x4465, 0x26a1c, 0x26a1a, 0x448a, 0x26b74, 0x44b0, 0x26b6e, 0x26b6f, 0x26b79, 0x44bc, 0x832e, 0x26b77, 0x8355, 0x831a, 0x44b8, 0x833d, 0x26b7c, 0x44b2, 0x26b70, 0x8330, 0x44bd, 0x26b7a, 0x26b82, 0x458a, 0x8651, 0x45a1, 0x27244, 0x45a2, 0x27243, 0x8688, 0x27245, 0x4615, 0x27625, 0x2761f, 0x4620, 0x4673, 0x277e8, 0x898e, 0x898d, 0x27809, 0x278b7, 0x278b9, 0x27964, 0x27963, 0x8a09, 0x8a14, 0x46b1,
+		0x27962, 0x27965, 0x472b, 0x27bac, 0x4745, 0x27d2a, 0x4797, 0x4798, 0x27e96, 0x47d5, 0x27fc2, 0x2f9de, 0x4893, 0x4896, 0x9007, 0x4894, 0x284aa, 0x284b0, 0x48c7, 0x48c5, 0x286d4, 0x286cd, 0x286d6, 0x48c4, 0x287f0, 0x9579, 0x9584, 0x49ce, 0x49ca, 0x49cc, 0x9657, 0x49c9, 0x96ba, 0x0, 0x29d4b, 0x20162, 0x346e, 0x202b7, 0x5067, 0x202ac, 0x202ab, 0x3471, 0x202c1, 0x34bb, 0x34d3, 0x205e8, 0x20769, 0x2075d, 0x2075f, 0x34f3, 0x34ed, 0x34f5, 0x20764, 0x20762, 0x34f1, 0x34f2, 0x34f6, 0x3520, 0x208f9, 0x3528, 0x208ee, 0x5318, 0x532c, 0x5359, 0x209ab, 0x209ac, 0x209aa, 0x5368, 0x537e, 0x20a86, 0x20a7a, 0x53a1, 0x20d28, 0x35a1, 0x555b, 0x35aa, 0x35a9, 0x20d31, 0x35b5, 0x35a5, 0x35a8, 0x5542, 0x35a7, 0x5547, 0x20d2d, 0x2f843, 0x553d, 0x20d2b, 0x20d32, 0x5560, 0x57eb, 0x21329, 0x21326, 0x21353,
+		0x364d, 0x21333, 0x21334, 0x2159d, 0x369c, 0x595f, 0x21641, 0x36ea, 0x36e5, 0x21791, 0x21769, 0x5b6f, 0x21a22, 0x375e, 0x21a34, 0x2f86d, 0x21b2e, 0x3786, 0x3784, 0x21bf4, 0x5c5a, 0x21e28, 0x37d9, 0x21e1c, 0x37de, 0x21e11, 0x21e24, 0x21e15, 0x37db, 0x21e16, 0x21e54, 0x21e23, 0x21fff, 0x3831, 0x220cf, 0x220d0, 0x384b, 0x220ce, 0x3849, 0x384a, 0x384c, 0x221c7, 0x2225c, 0x388a, 0x22236, 0x3889, 0x388b, 0x22243, 0x38bb, 0x5fa2, 0x5f9d, 0x38e4, 0x2252a, 0x22501, 0x5fa3, 0x2250b, 0x2250f, 0x393b, 0x392e, 0x393e, 0x3946, 0x3953, 0x226df, 0x3944, 0x226d2, 0x393f, 0x3942, 0x394f, 0x226cd, 0x3952, 0x394a, 0x60c2, 0x226db, 0x395a, 0x60a5, 0x3949, 0x226cf, 0x621c, 0x229c0, 0x621d, 0x3a03, 0x22b81, 0x22b82, 0x22b8a, 0x22ba8, 0x22b8c, 0x6395, 0x639a, 0x3a01, 0x3a06, 0x39fb, 0x39f9, 0x22b8e, 0x3a05,
+		0x39fa, 0x22b8b, 0x63a6, 0x22b96, 0x39fe, 0x22bcc, 0x3a7d, 0x3a8c, 0x22f4f, 0x22f4a, 0x6550, 0x3a8e, 0x22f4b, 0x22f3d, 0x6552, 0x22f44, 0x22f49, 0x2309e, 0x230f3, 0x65c8, 0x230f5, 0x3af0, 0x3af2, 0x231b3, 0x6658, 0x3af1, 0x3ae6, 0x231e5, 0x231b6, 0x2f8d8, 0x6888, 0x23486, 0x3b6f, 0x3b6d, 0x3b69, 0x234b6, 0x23485, 0x3b6e, 0x234ab, 0x3c2f, 0x3c30, 0x3c63, 0x23a0d, 0x23a8b, 0x23a8c, 0x6bb8, 0x3c80, 0x6bb9, 0x3c9a, 0x3c94, 0x3c96, 0x3c95, 0x3c97, 0x23b72, 0x3cf4, 0x3cfe, 0x23d8f, 0x3d01, 0x23d79, 0x3d02, 0x23d77, 0x3cf9, 0x23d90, 0x23d88, 0x23d80, 0x23d9e, 0x3cf6, 0x3cf7, 0x23d82, 0x23d34, 0x23d89, 0x3cff, 0x23d85, 0x23d7e, 0x23d7a, 0x23da6, 0x6e0b, 0x23db5, 0x241f4, 0x3dbf, 0x3dbc, 0x7105, 0x24229, 0x24226, 0x2422a, 0x3dbe, 0x3dc0, 0x2457e, 0x3e3b, 0x3e39, 0x2465b, 0x24661, 0x24660, 0x3e3c,
+		0x2479b, 0x7314, 0x7304, 0x247c3, 0x3e7d, 0x247cd, 0x3e7f, 0x3e7a, 0x3e7c, 0x7305, 0x3e7e, 0x7315, 0x730d, 0x3e80, 0x3ebf, 0x3ec3, 0x24954, 0x24959, 0x3ecc, 0x3f0e, 0x3f0d, 0x24af5, 0x3f26, 0x3f24, 0x3f25, 0x3f23, 0x3f21, 0x3f29, 0x24c69, 0x24c65, 0x3f8f, 0x3f8d, 0x24d7c, 0x3f8b, 0x3f92, 0x24d80, 0x3f90, 0x24f65, 0x3fef, 0x3ff0, 0x24fdf, 0x25060, 0x4001, 0x2505e, 0x402e, 0x402d, 0x772e, 0x4028, 0x4029, 0x402c, 0x2518f, 0x25161, 0x2515a, 0x7741, 0x4088, 0x25367, 0x4092, 0x4091, 0x77ea, 0x253bb, 0x7844, 0x40a9, 0x40ac, 0x40ae, 0x40aa, 0x4106, 0x4105, 0x414a, 0x413e, 0x413c, 0x413b, 0x2579b, 0x4142, 0x4141, 0x4143, 0x257a9, 0x4145, 0x25966, 0x419a, 0x419b, 0x419f, 0x419e, 0x25a72, 0x25a76, 0x25b14, 0x41de, 0x41e2, 0x41e6, 0x25b0f, 0x7b29, 0x41e3, 0x7b27, 0x41df, 0x25e41,
+		0x25e46, 0x7c9d, 0x25e4b, 0x25e43, 0x427e, 0x42c9, 0x42cc, 0x25fc6, 0x42d1, 0x25fc7, 0x42d0, 0x25fce, 0x42cf, 0x42c8, 0x25fc9, 0x42cd, 0x25fcb, 0x25fc5, 0x4349, 0x4347, 0x4358, 0x436b, 0x436c, 0x436a, 0x26369, 0x4380, 0x4382, 0x4384, 0x7fc8, 0x4383, 0x2641e, 0x264ba, 0x43b6, 0x26552, 0x26551, 0x26553, 0x2669f, 0x266db, 0x8126, 0x43f1, 0x266e3, 0x43f6, 0x43f3, 0x43f0, 0x811c, 0x266d7, 0x266e9, 0x8128, 0x43f5, 0x43f4, 0x43f7, 0x266de, 0x266dc, 0x2693c, 0x2f98b, 0x4455, 0x26a22, 0x26a32, 0x26a23, 0x448b, 0x44cb, 0x44c2, 0x26bd4, 0x26bc8, 0x26bbc, 0x44ca, 0x44cc, 0x26bcd, 0x44c7, 0x44c9, 0x8370, 0x26bbe, 0x44c6, 0x26c40, 0x26bd6, 0x44c3, 0x8382, 0x26bbd, 0x83ac, 0x26bba, 0x44c4, 0x26bc0, 0x2f99b, 0x2f99d, 0x26bc1, 0x271a6, 0x271a5, 0x271a8, 0x45a9, 0x86ad, 0x45a8, 0x45a6, 0x2725f, 0x27270,
+		0x2726a, 0x27265, 0x27267, 0x2726f, 0x27287, 0x86ca, 0x8851, 0x2763d, 0x27643, 0x4622, 0x27640, 0x4626, 0x4624, 0x4625, 0x889d, 0x462a, 0x4674, 0x27818, 0x4679, 0x8990, 0x467a, 0x27819, 0x278c1, 0x89d8, 0x89d7, 0x4697, 0x278bc, 0x278c8, 0x8a2e, 0x27971, 0x46bc, 0x2796e, 0x46b3, 0x27978, 0x46bf, 0x46b7, 0x2f9cd, 0x2797a, 0x27bb1, 0x27baf, 0x27bb0, 0x4734, 0x4746, 0x4748, 0x8c59, 0x27ca8, 0x27ca6, 0x4756, 0x4767, 0x27d48, 0x27d45, 0x4768, 0x4799, 0x479a, 0x27ea0, 0x27ea4, 0x47d8, 0x27fd4, 0x47db, 0x47dc, 0x47dd, 0x47d7, 0x27fd2, 0x28222, 0x4849, 0x484a, 0x8eda, 0x282b7, 0x282cc, 0x9033, 0x284e6, 0x9018, 0x489a, 0x284c8, 0x48cd, 0x48ca, 0x286f4, 0x48cb, 0x286ed, 0x48cf, 0x2867e, 0x2f9e3, 0x48cc, 0x48ea, 0x48ed, 0x287f9, 0x287fd, 0x48e9, 0x28807, 0x491a, 0x91ef, 0x498d, 0x49d2, 0x28e78,
+		0x28e7a, 0x28e79, 0x28f8a, 0x49f2, 0x2907b, 0x291d5, 0x291e9, 0x4a3d, 0x291eb, 0x4a3e, 0x4af8, 0x4b23, 0x9ad9, 0x4eb4, 0x2016c, 0x50a0, 0x5090, 0x3475, 0x5086, 0x5084, 0x202fa, 0x508a, 0x3476, 0x3473, 0x509f, 0x50a1, 0x20318, 0x5093, 0x34bd, 0x205f6, 0x51d5, 0x2078a, 0x20789, 0x34f9, 0x2077f, 0x20788, 0x34fa, 0x20783, 0x20784, 0x20798, 0x20763, 0x20787, 0x3521, 0x2f827, 0x3529, 0x208f5, 0x20950, 0x3538, 0x354e, 0x20a87, 0x20a8a, 0x20b65, 0x20db7, 0x20dc1, 0x20dc7, 0x35c8, 0x35bc, 0x20dc5, 0x20dcb, 0x5590, 0x20dd1, 0x35bb, 0x35c2, 0x35c0, 0x20db8, 0x20dca, 0x35ca, 0x20dde, 0x35c9, 0x20dce, 0x35b8, 0x5710, 0x5817, 0x21368, 0x364e, 0x2136b, 0x5844, 0x3650, 0x582b, 0x2136a, 0x5845, 0x21366, 0x21377, 0x21396, 0x2136e, 0x2f856, 0x368a, 0x21657, 0x5965, 0x21656, 0x2179c, 0x2179e, 0x36fc, 0x36f9,
+		0x217a1, 0x3763, 0x21a42, 0x5bcf, 0x21a46, 0x21a3e, 0x21b33, 0x3787, 0x3788, 0x21bfa, 0x379a, 0x21c97, 0x21c9b, 0x21caa, 0x5d56, 0x21e73, 0x21e7c, 0x37e9, 0x21e86, 0x37ea, 0x5d54, 0x3850, 0x220f3, 0x220f0, 0x3856, 0x3852, 0x384f, 0x3854, 0x3851, 0x220ec, 0x222af, 0x388e, 0x388f, 0x22264, 0x22268, 0x2235a, 0x5f3d, 0x2251f, 0x22525, 0x38ed, 0x38eb, 0x5fa4, 0x226c6, 0x226d6, 0x226c3, 0x3962, 0x2272c, 0x395d, 0x2272e, 0x2275e, 0x22733, 0x3961, 0x3965, 0x395c, 0x2272d, 0x22746, 0x395f, 0x226c1, 0x2273a, 0x229cc, 0x22b9d, 0x22b83, 0x22bf6, 0x63ec, 0x3a16, 0x3a0a, 0x22bf8, 0x3a0e, 0x3a12, 0x22bfe, 0x22bf3, 0x3a11, 0x22beb, 0x22bfa, 0x3a10, 0x22c07, 0x22bfc, 0x3a09, 0x63fa, 0x3a15, 0x63d4, 0x22f72, 0x22f73, 0x22f74, 0x3a91, 0x3a95, 0x3a93, 0x3a92, 0x3a8f, 0x22f5f, 0x22f60, 0x230aa, 0x23134, 0x3af6,
+		0x231b4, 0x3afb, 0x231f0, 0x6675, 0x3af9, 0x2320d, 0x231f3, 0x2320f, 0x671c, 0x3b7d, 0x234c9, 0x3b7a, 0x3b7f, 0x234c5, 0x3b78, 0x234d7, 0x234cc, 0x68d9, 0x234e7, 0x3b70, 0x3b82, 0x234c1, 0x3b84, 0x234e8, 0x3c33, 0x238cb, 0x3c32, 0x3c36, 0x238c8, 0x2397a, 0x3c56, 0x23979, 0x2397e, 0x3c67, 0x23a1b, 0x3c65, 0x3c64, 0x3c66, 0x23a1f, 0x23a19, 0x3c81, 0x3c82, 0x3c83, 0x3c9e, 0x23b89, 0x6bf1, 0x3c9d, 0x23b8b, 0x3d0f, 0x3d12, 0x23e09, 0x23dfe, 0x23e00, 0x3d10, 0x3d18, 0x23e0b, 0x3d14, 0x3d19, 0x6e37, 0x0, 0x23e4a, 0x6e7d, 0x6e86, 0x3dc8, 0x3dc4, 0x3dc6, 0x24279, 0x3dc7, 0x3dc3, 0x2426c, 0x24299, 0x2f91b, 0x24262, 0x24514, 0x3e19, 0x3e1c, 0x245c8, 0x245d7, 0x2460c, 0x3e41, 0x24670, 0x3e42, 0x3e43, 0x24671, 0x247df, 0x247e4, 0x3e82, 0x247e7, 0x3e81, 0x3e94, 0x3e84, 0x24990, 0x3ed2, 0x3f0f,
+		0x3f22, 0x24b4c, 0x3f27, 0x3f2a, 0x74fa, 0x3f28, 0x24b4f, 0x3f60, 0x24c7d, 0x24c7e, 0x24c7c, 0x7572, 0x24c8d, 0x24d19, 0x3f9b, 0x3f9c, 0x24dc0, 0x3f93, 0x3f94, 0x75dc, 0x3fa0, 0x3f99, 0x24db6, 0x3fa1, 0x24da8, 0x3ff1, 0x24feb, 0x24fe7, 0x2506f, 0x251c7, 0x4036, 0x2519f, 0x4037, 0x403f, 0x403c, 0x251c4, 0x4034, 0x4039, 0x403b, 0x4035, 0x4030, 0x4032, 0x4038, 0x403e, 0x403a, 0x2519b, 0x25197, 0x251c5, 0x2536b, 0x253ce, 0x40b6, 0x7867, 0x254b1, 0x254ae, 0x254b0, 0x40b3, 0x4109, 0x7977, 0x2573e, 0x257b5, 0x257ba, 0x414c, 0x257b2, 0x257b4, 0x4153, 0x414d, 0x4151, 0x414f, 0x7a9b, 0x25977, 0x41a2, 0x25986, 0x2597b, 0x41cd, 0x25a7e, 0x25b2e, 0x41e7, 0x25b2f, 0x41f0, 0x25b37, 0x41e9, 0x41ec, 0x25b32, 0x41e8, 0x41ee, 0x4202, 0x25b36, 0x25b39, 0x25b35, 0x25e5c, 0x4282, 0x4283, 0x4286, 0x25e7b,
+		0x25e61, 0x25fee, 0x25feb, 0x25fef, 0x26020, 0x7d2a, 0x7d65, 0x434a, 0x26230, 0x435a, 0x7f64, 0x262be, 0x436e, 0x26367, 0x2637c, 0x4370, 0x436f, 0x26424, 0x438a, 0x4387, 0x4388, 0x26429, 0x264ef, 0x8020, 0x43b7, 0x43fd, 0x8120, 0x2670d, 0x4405, 0x813c, 0x4408, 0x4403, 0x4402, 0x4404, 0x3b39, 0x4409, 0x43ff, 0x26764, 0x813f, 0x26715, 0x43fc, 0x4401, 0x440a, 0x81f0, 0x81f5, 0x446b, 0x446c, 0x26a35, 0x26a36, 0x26a34, 0x26a37, 0x26c47, 0x26c2b, 0x44de, 0x26c41, 0x26c43, 0x44db, 0x26c42, 0x44dd, 0x44e3, 0x26c32, 0x44e0, 0x44d9, 0x44d8, 0x44e4, 0x26c44, 0x26c4a, 0x44da, 0x44ef, 0x2f9a0, 0x8415, 0x83be, 0x26c54, 0x26c6e, 0x26c52, 0x44d7, 0x45b3, 0x45bb, 0x86e5, 0x45b2, 0x86d2, 0x45ad, 0x27292, 0x45af, 0x2f9b8, 0x86e0, 0x272bf, 0x4616, 0x4628, 0x4623, 0x88b3, 0x27641, 0x27669, 0x27665,
+		0x4675, 0x467e, 0x467c, 0x27822, 0x2781d, 0x278d2, 0x278da, 0x278db, 0x46ce, 0x46cd, 0x46cf, 0x8a53, 0x279a4, 0x2799e, 0x8a37, 0x8a47, 0x8a5c, 0x2799d, 0x46c4, 0x46cc, 0x46c8, 0x46c7, 0x279ad, 0x279a6, 0x279a7, 0x27bb3, 0x27be0, 0x27c35, 0x27c37, 0x475a, 0x476a, 0x27d4a, 0x476b, 0x476d, 0x476f, 0x27e5e, 0x479e, 0x27ec0, 0x47a4, 0x47a3, 0x47e4, 0x47e8, 0x47e9, 0x47e0, 0x47e3, 0x27ff9, 0x47ea, 0x47e1, 0x47ed, 0x4834, 0x4835, 0x4851, 0x8ef0, 0x284f0, 0x489d, 0x284f3, 0x2851b, 0x284f2, 0x284fb, 0x2871c, 0x28713, 0x48d0, 0x2870f, 0x48ee, 0x28816, 0x28811, 0x28812, 0x48f2, 0x2880e, 0x921d, 0x4988, 0x28c67, 0x28cf2, 0x28cda, 0x498f, 0x28cdc, 0x49d8, 0x28f94, 0x28f9b, 0x28f92, 0x28f9a, 0x29088, 0x29086, 0x4a3f, 0x291ef, 0x4a52, 0x976b, 0x2925e, 0x4a50, 0x29458, 0x4ab1, 0x29456, 0x29454, 0x4af9,
+		0x2959b, 0x29696, 0x296a4, 0x4b26, 0x4b28, 0x2969a, 0x29a12, 0x3480, 0x50c0, 0x3481, 0x2036b, 0x347e, 0x347f, 0x20337, 0x2033c, 0x2f80a, 0x204f7, 0x34be, 0x20566, 0x34d6, 0x207a9, 0x207ae, 0x207ad, 0x207c8, 0x207a5, 0x207b0, 0x20895, 0x52e5, 0x20925, 0x3534, 0x20a99, 0x53af, 0x20b74, 0x20b70, 0x20b6f, 0x20e41, 0x20e54, 0x35d5, 0x35d8, 0x20e40, 0x20e38, 0x35d4, 0x55d8, 0x35d9, 0x20e52, 0x20e3a, 0x20e57, 0x2f84a, 0x20e59, 0x5711, 0x5867, 0x213b4, 0x213c0, 0x365d, 0x5843, 0x365e, 0x3659, 0x21375, 0x365a, 0x36a0, 0x21660, 0x21647, 0x3705, 0x217f0, 0x3707, 0x217ef, 0x370e, 0x370c, 0x217ec, 0x21983, 0x3745, 0x21984, 0x2198f, 0x3764, 0x21a61, 0x3765, 0x21a69, 0x5bdd, 0x3766, 0x21a62, 0x3789, 0x37ec, 0x37f1, 0x5d70, 0x5d6a, 0x37f0, 0x37f8, 0x5d74, 0x5d5f, 0x21eae, 0x5d61, 0x5d73, 0x21eb2, 0x37f2,
+		0x37f4, 0x2210b, 0x3858, 0x22110, 0x2210d, 0x385a, 0x3859, 0x3857, 0x385b, 0x5e50, 0x2227f, 0x22281, 0x38a6, 0x38c2, 0x38c1, 0x5f3f, 0x2240b, 0x2254e, 0x38ef, 0x5fb0, 0x227ea, 0x22745, 0x3968, 0x6135, 0x612d, 0x3973, 0x396e, 0x3974, 0x6102, 0x3966, 0x22728, 0x2275d, 0x229dc, 0x39b9, 0x6226, 0x229d4, 0x3a0c, 0x22c64, 0x22c68, 0x3a20, 0x22c62, 0x3a1d, 0x22c61, 0x3a1c, 0x22c66, 0x3a21, 0x3a1a, 0x3a19, 0x22bf2, 0x22c69, 0x22c67, 0x3a7e, 0x22f9a, 0x22f9b, 0x22f85, 0x22f9c, 0x3a9d, 0x22fa4, 0x3a9e, 0x3a9c, 0x656e, 0x22f99, 0x22f86, 0x22f90, 0x23081, 0x230ae, 0x65b1, 0x65d4, 0x3acd, 0x23138, 0x3b0b, 0x3b0a, 0x6685, 0x2353b, 0x3b8f, 0x6972, 0x3b95, 0x3b90, 0x3b91, 0x2354c, 0x693a, 0x3bb9, 0x23547, 0x23535, 0x3b97, 0x3b9e, 0x23533, 0x3b8b, 0x23582, 0x23540, 0x3c3b, 0x3c3a, 0x3c3c, 0x3c3d,
+		0x3c39, 0x3c3e, 0x3c6b, 0x3c6c, 0x23a36, 0x23b0e, 0x3ca2, 0x3ca1, 0x3c9f, 0x23bad, 0x23baa, 0x23e17, 0x3d2d, 0x23e67, 0x3d36, 0x3d2b, 0x23e72, 0x3d37, 0x2f90a, 0x23e61, 0x23eb0, 0x6ead, 0x3d25, 0x3d2f, 0x3d2c, 0x23e6e, 0x23e64, 0x3d32, 0x23e8c, 0x23e66, 0x6e95, 0x23e6b, 0x23e5f, 0x23e74, 0x23e65, 0x242bb, 0x242be, 0x3dd5, 0x242b9, 0x3dd4, 0x3dd6, 0x242ef, 0x3dd1, 0x7243, 0x245da, 0x3e46, 0x728f, 0x24690, 0x247e9, 0x3e8c, 0x3e8a, 0x3e88, 0x24811, 0x2480d, 0x247ed, 0x24821, 0x3edd, 0x24981, 0x249b1, 0x24b01, 0x3f2d, 0x24b5e, 0x24b62, 0x3f2e, 0x3f2c, 0x3f2b, 0x3f30, 0x24b5b, 0x3f4e, 0x24c96, 0x24c93, 0x3f64, 0x3f61, 0x24c92, 0x7575, 0x2f939, 0x3f70, 0x3fa6, 0x3fa4, 0x24dc4, 0x24dc7, 0x24dc3, 0x3fa8, 0x3fa2, 0x24dc8, 0x3fa7, 0x75ec, 0x3fa5, 0x24dca, 0x3fa9, 0x24dc5, 0x24dcf, 0x24ddc, 0x24f7c,
+		0x25001, 0x403d, 0x251d3, 0x251dc, 0x4044, 0x4045, 0x4046, 0x251d4, 0x7757, 0x251cc, 0x4047, 0x4048, 0x4042, 0x251d6, 0x251db, 0x4041, 0x251d5, 0x253d9, 0x4094, 0x253dd, 0x253dc, 0x253e0, 0x254cc, 0x40c0, 0x40b8, 0x40c1, 0x40c2, 0x40bb, 0x40bd, 0x40bf, 0x40b9, 0x40b7, 0x254d2, 0x40c7, 0x254d3, 0x410c, 0x410b, 0x797b, 0x4110, 0x2568d, 0x415d, 0x7a21, 0x415a, 0x4158, 0x4156, 0x257d8, 0x4154, 0x7a16, 0x257bc, 0x41a8, 0x41a7, 0x41cf, 0x41d0, 0x25a8a, 0x7ae8, 0x25a8b, 0x25a8d, 0x41d1, 0x41eb, 0x25b53, 0x41fb, 0x7b6a, 0x25b59, 0x41fd, 0x41f8, 0x41f7, 0x4200, 0x25b56, 0x25b57, 0x41f6, 0x7b5f, 0x25e73, 0x2601b, 0x42df, 0x26021, 0x26016, 0x26018, 0x42e2, 0x42e4, 0x26044, 0x7d82, 0x26026, 0x42e3, 0x26236, 0x4359, 0x4371, 0x438e, 0x438c, 0x2643a, 0x43a4, 0x264f4, 0x8055, 0x4414, 0x26762,
+		0x2675b, 0x2676a, 0x4411, 0x26768, 0x441b, 0x4412, 0x440e, 0x4415, 0x8168, 0x4410, 0x26781, 0x4417, 0x8246, 0x8243, 0x4470, 0x44ed, 0x26ce7, 0x44ee, 0x26ce6, 0x26cd1, 0x26d11, 0x8481, 0x26cd0, 0x26cd9, 0x26d0a, 0x44f4, 0x26cda, 0x26cdd, 0x26cc8, 0x26ce2, 0x26ce9, 0x26ccb, 0x847c, 0x26ccc, 0x26cc1, 0x846a, 0x26cde, 0x8488, 0x44f2, 0x44f8, 0x44f3, 0x26d51, 0x26d12, 0x44fa, 0x26ce0, 0x26d2b, 0x2f9b4, 0x272d8, 0x272d1, 0x8710, 0x272d2, 0x272cd, 0x871f, 0x45b6, 0x45b7, 0x2733d, 0x870f, 0x272ca, 0x45ba, 0x272cb, 0x45bc, 0x272d7, 0x275f4, 0x463b, 0x88d3, 0x462f, 0x27687, 0x2768b, 0x27683, 0x4637, 0x4699, 0x279c5, 0x279d2, 0x279c6, 0x279d3, 0x46d9, 0x46d8, 0x46d7, 0x279f0, 0x279cd, 0x279cc, 0x279dc, 0x279d6, 0x4736, 0x27be6, 0x27c41, 0x27c3f, 0x8c87, 0x27cc5, 0x27cc0, 0x27cc6, 0x27cbe, 0x27cbf, 0x8cc6,
+		0x4770, 0x27d63, 0x27ed3, 0x27eda, 0x27ed5, 0x47a5, 0x47a6, 0x47a9, 0x47ee, 0x4854, 0x282ee, 0x4857, 0x282fc, 0x28414, 0x48a1, 0x28523, 0x28522, 0x28530, 0x286b5, 0x48d3, 0x28738, 0x48d4, 0x2873f, 0x48d7, 0x90cc, 0x916d, 0x9170, 0x48f7, 0x48f6, 0x48f9, 0x48f8, 0x9258, 0x9242, 0x9268, 0x9269, 0x28968, 0x28967, 0x9243, 0x28965, 0x9247, 0x498a, 0x28c75, 0x28cf7, 0x28cf3, 0x28cf6, 0x4994, 0x28d00, 0x4993, 0x28cff, 0x28cf5, 0x959d, 0x49dd, 0x49dc, 0x49f7, 0x96cf, 0x29092, 0x29206, 0x4a42, 0x29207, 0x2926b, 0x4a54, 0x2926c, 0x4a55, 0x29393, 0x4a8f, 0x29394, 0x97f4, 0x4ab4, 0x4ab3, 0x29462, 0x29463, 0x9809, 0x2945f, 0x29464, 0x2945b, 0x29459, 0x4afb, 0x4afd, 0x295a4, 0x295ac, 0x98ab, 0x4afc, 0x296b5, 0x4b2c, 0x4b2f, 0x296ae, 0x4b2b, 0x296c5, 0x4b33, 0x4b34, 0x98fb, 0x2983b, 0x9aac, 0x9aae,
+		0x9aaa, 0x4be8, 0x29abf, 0x29c2b, 0x29c84, 0x29c80, 0x29d4d, 0x29d4f, 0x9b5c, 0x29d4c, 0x29f92, 0x4d5d, 0x50d2, 0x3485, 0x3488, 0x203ae, 0x20379, 0x348e, 0x3484, 0x20371, 0x50df, 0x203b3, 0x3483, 0x2032c, 0x20377, 0x2052f, 0x207c3, 0x3502, 0x207cd, 0x3506, 0x3505, 0x207c9, 0x34fe, 0x3501, 0x3500, 0x207ce, 0x208a5, 0x208a0, 0x208fe, 0x20959, 0x20a9a, 0x35e7, 0x5619, 0x20ee4, 0x20ed7, 0x20edc, 0x35e6, 0x20ee7, 0x35ed, 0x35e2, 0x35eb, 0x20ee5, 0x35e8, 0x35ec, 0x560a, 0x3624, 0x589a, 0x21414, 0x3662, 0x213f1, 0x3661, 0x3660, 0x3664, 0x368b, 0x215b6, 0x2166e, 0x2166c, 0x21681, 0x21842, 0x3719, 0x3716, 0x3718, 0x3722, 0x2181a, 0x371d, 0x3717, 0x371e, 0x21827, 0x21825, 0x21817, 0x2181c, 0x2183d, 0x21820, 0x3769, 0x376a, 0x21a81, 0x376c, 0x377a, 0x378a, 0x21c09, 0x21cc6, 0x21cc4, 0x379c, 0x21cc5,
+		0x37fd, 0x37f9, 0x21eef, 0x37ff, 0x21edf, 0x21ede, 0x21eee, 0x21ef5, 0x21eec, 0x37fc, 0x21edd, 0x5d85, 0x37fb, 0x3802, 0x385f, 0x5e56, 0x385e, 0x385d, 0x385c, 0x22122, 0x5e51, 0x3892, 0x22297, 0x22295, 0x3894, 0x3895, 0x38d1, 0x224a9, 0x38f1, 0x22553, 0x5fb1, 0x22556, 0x22555, 0x3977, 0x396f, 0x227e3, 0x227e2, 0x3987, 0x397d, 0x397c, 0x397e, 0x3985, 0x398b, 0x3986, 0x3980, 0x0, 0x227a9, 0x3978, 0x2f8ab, 0x22833, 0x2280a, 0x39ba, 0x22a59, 0x3a33, 0x22cbc, 0x3a2d, 0x22cc4, 0x22cc7, 0x22cc6, 0x3a37, 0x645a, 0x6463, 0x22cb8, 0x22cda, 0x3a2e, 0x22cb7, 0x22cc0, 0x3a3d, 0x22ccd, 0x3aa0, 0x22fbc, 0x22fab, 0x3aa3, 0x23086, 0x669b, 0x2325a, 0x66a3, 0x3b0e, 0x669e, 0x23343, 0x3bb6, 0x235ac, 0x3bab, 0x3bad, 0x3ba6, 0x235be, 0x69b8, 0x3baa, 0x69ba, 0x3bb1, 0x235ab, 0x3ba8, 0x3baf, 0x3bb0,
+		0x3ba7, 0x3bb2, 0x3b9d, 0x3ba5, 0x3bb5, 0x21b3e, 0x69c7, 0x69d7, 0x238f9, 0x3c41, 0x238f3, 0x238f7, 0x6b70, 0x23a45, 0x23a48, 0x3c72, 0x6b9d, 0x3c6f, 0x3c71, 0x23a44, 0x3c85, 0x23aaf, 0x23ab1, 0x3ca4, 0x3ca5, 0x3ca6, 0x23bcf, 0x3ca8, 0x23bcb, 0x23bcd, 0x3ca3, 0x23c50, 0x23eec, 0x23f1a, 0x6f16, 0x6f24, 0x23ed8, 0x3d43, 0x23ed0, 0x23ed1, 0x3d3d, 0x3d45, 0x23e7b, 0x23ed2, 0x3d44, 0x23ed4, 0x23ee7, 0x23edf, 0x23ede, 0x6f45, 0x23ed9, 0x23ecf, 0x2430d, 0x3de3, 0x7179, 0x0, 0x3ddf, 0x3de4, 0x717a, 0x3de5, 0x24338, 0x7254, 0x3e22, 0x3e4a, 0x246a9, 0x3e49, 0x3e44, 0x3e4b, 0x3e87, 0x3e89, 0x3e92, 0x3e91, 0x3e90, 0x3e8e, 0x2483e, 0x24846, 0x249ed, 0x2f930, 0x3f12, 0x3f10, 0x3f11, 0x24b6c, 0x3f32, 0x3f34, 0x3f37, 0x3f33, 0x3f36, 0x3f35, 0x3f65, 0x24cac, 0x757c, 0x757b, 0x24dc9, 0x7612,
+		0x3fb0, 0x24e12, 0x3faf, 0x3faa, 0x24dfd, 0x24e01, 0x24e11, 0x3fab, 0x24e89, 0x24e05, 0x24dfe, 0x24e0b, 0x24e20, 0x24e04, 0x24f88, 0x3ff3, 0x25002, 0x3ff4, 0x25003, 0x76b6, 0x76e0, 0x4008, 0x404e, 0x4055, 0x404b, 0x25229, 0x2523b, 0x404d, 0x7773, 0x25206, 0x4052, 0x7772, 0x404c, 0x7770, 0x4050, 0x4053, 0x25203, 0x4051, 0x25378, 0x25379, 0x2537d, 0x4089, 0x2537f, 0x4095, 0x40cc, 0x40c8, 0x40ce, 0x25532, 0x40ca, 0x25500, 0x789d, 0x25522, 0x40cd, 0x256a9, 0x415c, 0x4167, 0x4169, 0x4165, 0x4162, 0x25804, 0x7a27, 0x7a35, 0x2f959, 0x41aa, 0x259b8, 0x25a9b, 0x41d2, 0x7ba2, 0x4203, 0x420c, 0x25b92, 0x4209, 0x4206, 0x4205, 0x7b89, 0x25b8f, 0x420b, 0x4208, 0x25b98, 0x7ba5, 0x25bae, 0x428e, 0x25e9d, 0x7cb6, 0x42e8, 0x26049, 0x26050, 0x26046, 0x42ea, 0x2604e, 0x7da5, 0x7dc3, 0x26055, 0x42e9,
+		0x42eb, 0x26064, 0x42f0, 0x26238, 0x434b, 0x7fab, 0x4373, 0x4375, 0x4392, 0x4391, 0x4393, 0x8025, 0x43a7, 0x43a6, 0x43a8, 0x43aa, 0x264fe, 0x43a9, 0x8059, 0x43bb, 0x43bc, 0x43ba, 0x43bd, 0x4427, 0x8185, 0x267a6, 0x4424, 0x441e, 0x441f, 0x441d, 0x4420, 0x4423, 0x4429, 0x4422, 0x2679c, 0x26799, 0x441c, 0x818e, 0x4428, 0x267b5, 0x267a3, 0x267a0, 0x267a7, 0x2679b, 0x269df, 0x269e1, 0x4471, 0x4473, 0x4472, 0x26a4d, 0x4502, 0x26df3, 0x26d6f, 0x26d69, 0x44fe, 0x84be, 0x26d6b, 0x26d78, 0x26d87, 0x4508, 0x26d85, 0x26d82, 0x26d90, 0x4507, 0x4504, 0x26d80, 0x26d67, 0x4500, 0x44fc, 0x26d61, 0x4544, 0x26d93, 0x44f1, 0x26df2, 0x26d86, 0x84a6, 0x4506, 0x271cd, 0x271c4, 0x45c6, 0x45c3, 0x45c1, 0x45c2, 0x2730f, 0x45c4, 0x45c7, 0x2730d, 0x45bf, 0x45d2, 0x27373, 0x45ca, 0x2f9ba, 0x2730a, 0x872f,
+		0x27308, 0x27313, 0x4613, 0x275f8, 0x4630, 0x463e, 0x4639, 0x276ab, 0x276a8, 0x463c, 0x463f, 0x276a5, 0x4634, 0x463d, 0x276c3, 0x276a4, 0x4638, 0x276d4, 0x276ba, 0x277f1, 0x89a0, 0x4682, 0x2783f, 0x4683, 0x278ea, 0x469b, 0x279f7, 0x46e0, 0x46dd, 0x279fa, 0x279f5, 0x46de, 0x279fe, 0x46e3, 0x46e5, 0x27a00, 0x8a97, 0x46e2, 0x27a02, 0x279fb, 0x27a07, 0x46df, 0x27a1a, 0x472e, 0x27bb8, 0x4737, 0x4738, 0x27be9, 0x27beb, 0x27c50, 0x27c4f, 0x8c8b, 0x27d86, 0x3562, 0x27d8e, 0x4794, 0x4793, 0x47ab, 0x47ad, 0x27ef0, 0x27efb, 0x47f5, 0x47f7, 0x28042, 0x47f6, 0x47f8, 0x28085, 0x47fb, 0x47f9, 0x4858, 0x485a, 0x28305, 0x4859, 0x8f0f, 0x4885, 0x48a4, 0x48d8, 0x48d9, 0x2874b, 0x48dd, 0x48c8, 0x28763, 0x48fa, 0x48fb, 0x9275, 0x4927, 0x929f, 0x492a, 0x4925, 0x28999, 0x4928, 0x28c81, 0x28d17, 0x28d10,
+		0x28d12, 0x95a6, 0x4995, 0x969a, 0x28efa, 0x28ef3, 0x28ef2, 0x28ef5, 0x28ef6, 0x28fbb, 0x28fc2, 0x290a7, 0x4a0f, 0x4a11, 0x290a8, 0x290a3, 0x4a10, 0x290aa, 0x4a15, 0x4a13, 0x9757, 0x291dc, 0x4a47, 0x4a46, 0x2920f, 0x4a59, 0x4a5b, 0x29279, 0x4a5e, 0x2927f, 0x29285, 0x4a5a, 0x4a91, 0x4a92, 0x4a90, 0x4a93, 0x2941b, 0x97f7, 0x4abe, 0x29477, 0x29476, 0x29498, 0x4abc, 0x4abb, 0x4ab7, 0x4ab9, 0x2947a, 0x4b01, 0x4afe, 0x295bc, 0x295ba, 0x4b02, 0x295b6, 0x4aff, 0x98b0, 0x295b4, 0x4b00, 0x296cf, 0x4b37, 0x4b3a, 0x4b6f, 0x4b77, 0x4b79, 0x99c6, 0x2987b, 0x4bc8, 0x29a1c, 0x29a21, 0x29a1d, 0x29ac0, 0x4bf2, 0x29aff, 0x4bf1, 0x4bf0, 0x9b62, 0x29d56, 0x4c34, 0x29fa1, 0x29fa2, 0x29fa6, 0x2a256, 0x2a257, 0x4d2c, 0x4d2d, 0x2a301, 0x2a3ed, 0x2fa1c, 0x2a5f2, 0x50fa, 0x203b8, 0x3491, 0x203c0, 0x3494, 0x203b7,
+		0x203e1, 0x34c4, 0x350a, 0x207e8, 0x5285, 0x208b2, 0x3552, 0x20aae, 0x3559, 0x366f, 0x20f7e, 0x35f2, 0x35f4, 0x5643, 0x20f76, 0x35f1, 0x563c, 0x20f96, 0x366a, 0x21420, 0x21421, 0x21423, 0x21429, 0x21677, 0x21851, 0x3724, 0x21856, 0x3723, 0x21888, 0x21859, 0x21855, 0x3727, 0x21997, 0x21998, 0x376d, 0x5bed, 0x376e, 0x376f, 0x21aa1, 0x21aa3, 0x5c35, 0x379f, 0x380a, 0x3806, 0x380e, 0x21f0a, 0x380d, 0x3805, 0x21f3d, 0x21f0c, 0x380b, 0x3810, 0x382e, 0x2213d, 0x22139, 0x222b0, 0x222b2, 0x222ae, 0x3896, 0x3897, 0x38c4, 0x5f47, 0x38c5, 0x22420, 0x38d2, 0x2256e, 0x2256d, 0x2256a, 0x3981, 0x22832, 0x398e, 0x3990, 0x398f, 0x22839, 0x3991, 0x3995, 0x3993, 0x227a3, 0x616d, 0x2287f, 0x22835, 0x2283d, 0x229f4, 0x229f5, 0x3a3b, 0x3a48, 0x22d48, 0x22d28, 0x3a46, 0x3a47, 0x22d27, 0x22d32, 0x22d2c, 0x3a4c,
+		0x22d2e, 0x3a4a, 0x3a50, 0x3a43, 0x22d23, 0x22d31, 0x2f8c5, 0x3a49, 0x3aa6, 0x3aa5, 0x3aa4, 0x22fc9, 0x23089, 0x3ab9, 0x230bb, 0x3ace, 0x3acf, 0x3b13, 0x23397, 0x23393, 0x23628, 0x3bc6, 0x3bc5, 0x3bca, 0x3bd9, 0x3bc1, 0x23618, 0x2363b, 0x69f5, 0x23627, 0x23624, 0x2361b, 0x23631, 0x3bcb, 0x23626, 0x236a3, 0x2363f, 0x23622, 0x23619, 0x6a03, 0x23641, 0x2362b, 0x6a65, 0x3c42, 0x2390c, 0x6b75, 0x3c74, 0x3c73, 0x23a4e, 0x23ab9, 0x23afa, 0x23be9, 0x23be8, 0x23be4, 0x6f8a, 0x6f56, 0x3d52, 0x23f5f, 0x23f5d, 0x23f52, 0x3d50, 0x23f74, 0x3d4b, 0x23f46, 0x3d4c, 0x3d6d, 0x23faa, 0x6f98, 0x23f4a, 0x23f59, 0x23f4b, 0x3d4f, 0x6f68, 0x3df0, 0x24350, 0x7234, 0x7245, 0x3e4d, 0x3e4c, 0x246d0, 0x3e4f, 0x3e4e, 0x3e50, 0x246cc, 0x735c, 0x3e96, 0x7356, 0x24864, 0x24865, 0x3e97, 0x3e95, 0x3e98, 0x2485c, 0x24a15,
+		0x3eec, 0x3eeb, 0x3f13, 0x3f14, 0x3f38, 0x3f3a, 0x3f39, 0x24b79, 0x3f68, 0x3f67, 0x24cc5, 0x24cb8, 0x24e2c, 0x3fbe, 0x3fbc, 0x24e37, 0x24e35, 0x24e31, 0x3fbb, 0x24e2f, 0x3fba, 0x24e2b, 0x3fb9, 0x3fb7, 0x24e2d, 0x24e2a, 0x3fc1, 0x24f95, 0x3ff7, 0x2523e, 0x4060, 0x25247, 0x25245, 0x4059, 0x405c, 0x405a, 0x4058, 0x25252, 0x405b, 0x25270, 0x25250, 0x25258, 0x25251, 0x2523d, 0x405d, 0x25241, 0x2520c, 0x2523c, 0x25386, 0x25383, 0x25389, 0x253f3, 0x40d4, 0x40d3, 0x78bf, 0x40d2, 0x78bd, 0x2552d, 0x40d7, 0x40d1, 0x78e4, 0x40d5, 0x256c5, 0x2f955, 0x416d, 0x416f, 0x7a34, 0x4175, 0x416c, 0x4174, 0x25843, 0x4173, 0x25837, 0x25845, 0x7a36, 0x41ac, 0x259e5, 0x25aa6, 0x25bdb, 0x4210, 0x25bda, 0x25be6, 0x7bba, 0x7bbc, 0x420f, 0x7bc8, 0x4223, 0x7bc3, 0x421d, 0x7bb6, 0x420e, 0x25bf8, 0x25be9, 0x4215,
+		0x7bc2, 0x4213, 0x25be8, 0x25bf6, 0x421b, 0x7bc5, 0x4222, 0x4226, 0x25be7, 0x7bbd, 0x7bb0, 0x4221, 0x421c, 0x4217, 0x25bd5, 0x421a, 0x7bbb, 0x25ed3, 0x25ec7, 0x25ed1, 0x4299, 0x25ec3, 0x4297, 0x26080, 0x26098, 0x42fd, 0x26084, 0x42f6, 0x42fe, 0x42f5, 0x42ff, 0x42f7, 0x26097, 0x26083, 0x26081, 0x4301, 0x7e04, 0x2608c, 0x26242, 0x263ab, 0x4377, 0x263a3, 0x263a6, 0x2645c, 0x26469, 0x26467, 0x43ab, 0x2658b, 0x265a8, 0x43c0, 0x267d8, 0x4431, 0x442e, 0x2f989, 0x267dc, 0x442c, 0x267e0, 0x267e5, 0x4432, 0x442f, 0x442b, 0x442d, 0x4433, 0x26918, 0x26919, 0x81f1, 0x4457, 0x445c, 0x447b, 0x26a5f, 0x26a5e, 0x447a, 0x26e02, 0x8522, 0x4513, 0x451e, 0x4517, 0x4520, 0x452a, 0x4511, 0x4515, 0x450f, 0x4518, 0x8538, 0x26e12, 0x26e36, 0x452c, 0x8532, 0x2f9a8, 0x8510, 0x26e23, 0x26e03, 0x451c, 0x26e00,
+		0x4529, 0x26e46, 0x26e61, 0x4512, 0x854f, 0x4597, 0x27384, 0x8772, 0x2736b, 0x27362, 0x27356, 0x2736a, 0x27352, 0x27355, 0x45d4, 0x45d0, 0x877c, 0x27361, 0x2f9bb, 0x27358, 0x27377, 0x45d3, 0x4614, 0x276d7, 0x4646, 0x4645, 0x276de, 0x4643, 0x276df, 0x890d, 0x4644, 0x4648, 0x276d1, 0x4647, 0x276e2, 0x276e1, 0x276dd, 0x8908, 0x4649, 0x4685, 0x2784b, 0x4684, 0x2784e, 0x469d, 0x278fc, 0x469e, 0x46a0, 0x278fa, 0x469c, 0x278fb, 0x469f, 0x278fe, 0x46f7, 0x46ea, 0x27a31, 0x46ef, 0x46e9, 0x46f3, 0x46f0, 0x46eb, 0x27a38, 0x46ec, 0x46f2, 0x46f5, 0x46ee, 0x27a3a, 0x27bbb, 0x473a, 0x474b, 0x27c59, 0x474a, 0x474c, 0x27cdb, 0x27cdf, 0x27ce2, 0x4779, 0x477b, 0x4778, 0x27d9e, 0x27da1, 0x47b5, 0x27f10, 0x47b4, 0x47b7, 0x8d9e, 0x4809, 0x47fe, 0x4808, 0x4807, 0x28076, 0x2807f, 0x2807d, 0x4806, 0x4804,
+		0x4805, 0x47ff, 0x480b, 0x28082, 0x28257, 0x483b, 0x485d, 0x485c, 0x485f, 0x485e, 0x8f28, 0x2832e, 0x8f21, 0x4883, 0x2858b, 0x2858d, 0x48a7, 0x9066, 0x906c, 0x28590, 0x48a8, 0x286ec, 0x2876f, 0x2876b, 0x28771, 0x28778, 0x90f6, 0x48e0, 0x48df, 0x48fe, 0x48fc, 0x48ff, 0x48fd, 0x289ad, 0x492c, 0x92ec, 0x92ba, 0x92e3, 0x92bd, 0x499d, 0x28d2f, 0x95b4, 0x28f78, 0x4a40, 0x291f8, 0x29219, 0x4a5f, 0x2929b, 0x29294, 0x29297, 0x29299, 0x293a6, 0x293a4, 0x293a7, 0x97d1, 0x29495, 0x4ac0, 0x9823, 0x29490, 0x4ac1, 0x4ac6, 0x2949b, 0x295c4, 0x4b04, 0x4b05, 0x295c6, 0x990b, 0x4b3e, 0x4b3d, 0x4b40, 0x4b3f, 0x296e3, 0x4b42, 0x296df, 0x296dd, 0x296e7, 0x4b84, 0x4b82, 0x4b7f, 0x4b85, 0x29a2d, 0x29a2c, 0x4bcc, 0x9ab2, 0x4bcb, 0x4bcd, 0x29a34, 0x29a38, 0x9adb, 0x29b1d, 0x4bf5, 0x29b1a, 0x29b1b, 0x29b14,
+		0x9af0, 0x29b17, 0x29c21, 0x4c20, 0x4c21, 0x29caa, 0x29ca1, 0x4c37, 0x4c3e, 0x9b73, 0x4c3d, 0x9b6e, 0x29d63, 0x29d79, 0x29d60, 0x9b65, 0x29d62, 0x4c3c, 0x29d61, 0x4c38, 0x9b6a, 0x29d70, 0x9b6d, 0x29d6a, 0x4c3b, 0x29fc8, 0x4cb0, 0x29fc5, 0x29fbe, 0x29fc2, 0x4cad, 0x4cb2, 0x4cb8, 0x9d0b, 0x29fc7, 0x4caf, 0x29fb0, 0x29fca, 0x4d1a, 0x9e76, 0x4d20, 0x4d21, 0x4d30, 0x9ea8, 0x4d2f, 0x2a30d, 0x2a307, 0x2a396, 0x2a3ef, 0x4d5f, 0x4d60, 0x2a41e, 0x2a41d, 0x9f11, 0x2a590, 0x203eb, 0x348a, 0x5119, 0x349c, 0x203ee, 0x349a, 0x203ef, 0x203ec, 0x207fa, 0x350c, 0x350b, 0x350d, 0x5292, 0x20904, 0x20fd3, 0x35fe, 0x20fd1, 0x20ffa, 0x35ff, 0x35fb, 0x35fc, 0x3609, 0x20fc8, 0x3600, 0x20fd5, 0x5675, 0x20fcd, 0x20fd2, 0x20ffb, 0x2122a, 0x21488, 0x21461, 0x3671, 0x21463, 0x21462, 0x596f, 0x20057, 0x218c8, 0x21898,
+		0x372b, 0x21ac1, 0x21f3f, 0x21f41, 0x3814, 0x3811, 0x3812, 0x22152, 0x3863, 0x2215e, 0x386e, 0x389a, 0x222c7, 0x389b, 0x22370, 0x22427, 0x38c8, 0x22471, 0x224b0, 0x38f6, 0x22842, 0x22843, 0x61a5, 0x398c, 0x3997, 0x39a2, 0x61a0, 0x22857, 0x2289d, 0x22d89, 0x22d8d, 0x22d8b, 0x22d80, 0x22d92, 0x22d8a, 0x22dc8, 0x3a54, 0x22d8f, 0x22d93, 0x22d91, 0x3aa8, 0x22ff2, 0x22fde, 0x3aa9, 0x230c8, 0x65b4, 0x65d8, 0x66c2, 0x3b18, 0x3b17, 0x3b1d, 0x232a7, 0x232a1, 0x3b31, 0x2368c, 0x2367f, 0x3bd8, 0x3bd5, 0x2367b, 0x23695, 0x23699, 0x3be1, 0x2368e, 0x3bd4, 0x236da, 0x2368a, 0x2369c, 0x3be3, 0x2367e, 0x3c44, 0x3c45, 0x2391a, 0x23a55, 0x3c76, 0x3c75, 0x23a60, 0x6ba8, 0x3c88, 0x3caa, 0x23bfb, 0x3cab, 0x3cac, 0x23bfd, 0x3d57, 0x6f83, 0x3d60, 0x3d5d, 0x3d6b, 0x23fd0, 0x3d63, 0x3d67, 0x23fc5, 0x3d5e, 0x23fd2,
+		0x24011, 0x6fc5, 0x71cd, 0x3df9, 0x3df3, 0x243ad, 0x243a4, 0x243a9, 0x243b0, 0x2439d, 0x3df6, 0x24598, 0x729c, 0x3e51, 0x3e53, 0x3e52, 0x24892, 0x3e9b, 0x2488a, 0x3e9c, 0x2488d, 0x24896, 0x3ef7, 0x7499, 0x748f, 0x7491, 0x24a45, 0x24a4b, 0x24b0f, 0x24b16, 0x3f3b, 0x24b8a, 0x3f3e, 0x3f3d, 0x24cc9, 0x3f69, 0x24cc8, 0x24cca, 0x24e64, 0x3fc3, 0x3fc4, 0x3fc7, 0x24e5d, 0x24e63, 0x7639, 0x3fc6, 0x762e, 0x3fc8, 0x24e88, 0x24f3a, 0x24f39, 0x769f, 0x76a0, 0x3fe6, 0x3ff8, 0x25017, 0x25093, 0x4007, 0x25095, 0x4064, 0x4068, 0x25276, 0x7794, 0x4065, 0x77ae, 0x25280, 0x2527b, 0x4069, 0x25248, 0x252a8, 0x25288, 0x2538b, 0x2538a, 0x2538c, 0x253fc, 0x40da, 0x40e0, 0x78e6, 0x25558, 0x40de, 0x25551, 0x25555, 0x25553, 0x411c, 0x411d, 0x411a, 0x256dc, 0x417b, 0x417a, 0x417c, 0x2585c, 0x4178, 0x4177, 0x25865,
+		0x2f95a, 0x41b1, 0x259ee, 0x41b2, 0x41b0, 0x259f0, 0x7abc, 0x259f2, 0x4236, 0x25c35, 0x422e, 0x25c22, 0x7bd6, 0x25c20, 0x4234, 0x25c2d, 0x25c28, 0x25c26, 0x25c3c, 0x422a, 0x25c38, 0x4233, 0x422d, 0x422f, 0x4231, 0x422b, 0x4232, 0x25c2a, 0x4235, 0x25c1a, 0x25c30, 0x25be3, 0x25c19, 0x25ee9, 0x25ee7, 0x7ccf, 0x260d6, 0x260c4, 0x4308, 0x26087, 0x4306, 0x260c7, 0x260d9, 0x7e18, 0x260df, 0x434d, 0x4361, 0x2630a, 0x26301, 0x263b1, 0x4378, 0x263c1, 0x4394, 0x4395, 0x26476, 0x2650d, 0x2650e, 0x43c1, 0x43c3, 0x806d, 0x26807, 0x4439, 0x26809, 0x26816, 0x443a, 0x443b, 0x4435, 0x4436, 0x2680e, 0x443c, 0x8190, 0x26806, 0x2691e, 0x2694a, 0x4458, 0x447c, 0x447d, 0x448d, 0x448c, 0x26ea6, 0x26e91, 0x453b, 0x453f, 0x26eb8, 0x26e96, 0x4532, 0x452d, 0x26ec5, 0x452f, 0x4539, 0x452e, 0x453a, 0x26f0a, 0x4536,
+		0x4531, 0x453e, 0x4538, 0x8552, 0x4534, 0x26eb2, 0x4541, 0x26eb7, 0x26eb4, 0x26e89, 0x26e8d, 0x4530, 0x26e87, 0x26ec2, 0x26e9c, 0x26e92, 0x4543, 0x26eca, 0x8550, 0x26e8a, 0x271e0, 0x4598, 0x271e6, 0x271e5, 0x87a0, 0x273a5, 0x273b3, 0x8786, 0x45da, 0x45d7, 0x273b5, 0x273af, 0x8795, 0x273b0, 0x273cc, 0x878c, 0x273a2, 0x273be, 0x273c6, 0x273ac, 0x273ae, 0x4618, 0x8860, 0x2f9c3, 0x27708, 0x27705, 0x4652, 0x8928, 0x276fe, 0x464e, 0x8920, 0x27700, 0x464f, 0x4650, 0x27864, 0x27868, 0x89a8, 0x4686, 0x4687, 0x4689, 0x2786a, 0x27869, 0x2790d, 0x27912, 0x46a2, 0x46a3, 0x2790f, 0x46a1, 0x27a67, 0x27a79, 0x27a72, 0x27a66, 0x27a7c, 0x46f9, 0x46fd, 0x27a68, 0x27a85, 0x27a76, 0x27a74, 0x27a71, 0x27a64, 0x473e, 0x473c, 0x27bf8, 0x474d, 0x27c6c, 0x474e, 0x27ceb, 0x4781, 0x4783, 0x4782, 0x27db8, 0x4780, 0x4788,
+		0x27f2d, 0x27f2e, 0x27f28, 0x27f29, 0x27f31, 0x47b9, 0x27f2f, 0x27f2a, 0x8e3a, 0x280a4, 0x280b7, 0x280bf, 0x4811, 0x480d, 0x4810, 0x4813, 0x28263, 0x483c, 0x4862, 0x4863, 0x28367, 0x285be, 0x2f9e0, 0x28795, 0x2879c, 0x4903, 0x4906, 0x4902, 0x4901, 0x28871, 0x28872, 0x4905, 0x2886f, 0x9194, 0x9311, 0x289f1, 0x9337, 0x4936, 0x289e8, 0x4935, 0x9343, 0x2f9ea, 0x49a1, 0x49a3, 0x28d49, 0x28d64, 0x49a0, 0x28d48, 0x49ea, 0x96a6, 0x49e8, 0x28f2c, 0x28fe1, 0x28fef, 0x290c0, 0x4a21, 0x4a1b, 0x290d7, 0x290d4, 0x4a49, 0x4a48, 0x292ad, 0x9795, 0x4a62, 0x4a61, 0x4a64, 0x4a60, 0x4a63, 0x292ae, 0x292ac, 0x9796, 0x4a66, 0x4aac, 0x4aab, 0x29427, 0x294ae, 0x4ac3, 0x294b0, 0x4ac4, 0x9825, 0x294c0, 0x294c4, 0x4b08, 0x4b09, 0x4b0a, 0x4b06, 0x4b07, 0x4b41, 0x29707, 0x4b45, 0x296fd, 0x4b43, 0x4b44, 0x9926,
+		0x9934, 0x4b47, 0x2fa04, 0x2981b, 0x2981c, 0x4b71, 0x298b2, 0x298b4, 0x298b7, 0x298b1, 0x298b3, 0x298ae, 0x4b8b, 0x4bd4, 0x4bd3, 0x4bd1, 0x9aba, 0x4bd2, 0x29a3f, 0x29b36, 0x4bf7, 0x4bf8, 0x29b31, 0x29b3b, 0x29b35, 0x29b3a, 0x29b37, 0x29c22, 0x29c36, 0x29c32, 0x4c22, 0x29cc0, 0x4c45, 0x4c41, 0x9b81, 0x4c40, 0x9b8a, 0x29d85, 0x9b7f, 0x4c42, 0x29d89, 0x4cc1, 0x4cc5, 0x29fe1, 0x4cbb, 0x4cb9, 0x4cbd, 0x4cc9, 0x2a00a, 0x9d11, 0x2a009, 0x4cbf, 0x4cc7, 0x29fe8, 0x4cc3, 0x4d24, 0x4d31, 0x4d33, 0x2a318, 0x4d32, 0x4d34, 0x4d52, 0x4d61, 0x9ed9, 0x4d7a, 0x4d82, 0x9f3c, 0x2a5c5, 0x5123, 0x2040d, 0x2040c, 0x20421, 0x512c, 0x20616, 0x2080e, 0x350f, 0x5295, 0x20817, 0x3523, 0x3525, 0x21048, 0x3606, 0x3608, 0x5688, 0x21055, 0x21049, 0x2104c, 0x568b, 0x2104f, 0x2103d, 0x367a, 0x3677, 0x2148a, 0x214a8,
+		0x2168a, 0x372e, 0x218cb, 0x372f, 0x218d5, 0x221d4, 0x381b, 0x3813, 0x21f5c, 0x21f75, 0x3866, 0x22161, 0x3865, 0x21696, 0x38f7, 0x2258a, 0x228d0, 0x61e1, 0x61d7, 0x22849, 0x228cf, 0x399c, 0x22dd6, 0x22d7f, 0x3a53, 0x22dd2, 0x22dcf, 0x23006, 0x23009, 0x3aba, 0x65a3, 0x3b22, 0x66d3, 0x23706, 0x236f1, 0x23704, 0x6a8b, 0x236fa, 0x236f4, 0x3beb, 0x3bdd, 0x236f9, 0x3bef, 0x2368b, 0x23703, 0x3c47, 0x3c46, 0x239a2, 0x3c78, 0x6bac, 0x3c89, 0x23c0c, 0x23c0b, 0x3d68, 0x3d76, 0x3d74, 0x3d79, 0x24024, 0x3d7a, 0x3d77, 0x2f914, 0x3d71, 0x24025, 0x3d72, 0x243f6, 0x3dff, 0x243fb, 0x3e05, 0x24532, 0x245fb, 0x3e54, 0x246f2, 0x246f3, 0x3e9e, 0x3e9f, 0x7374, 0x2489c, 0x24a72, 0x3efa, 0x24a74, 0x3f44, 0x3f3f, 0x3f40, 0x24b86, 0x3f42, 0x24b8e, 0x24b90, 0x3f51, 0x24bcd, 0x24bf7, 0x24cd6, 0x24e84, 0x24e95, 0x24e8a,
+		0x7640, 0x3fca, 0x24e97, 0x7641, 0x3fce, 0x3fc9, 0x24fa0, 0x24fa1, 0x25022, 0x250a6, 0x250a4, 0x4009, 0x76e8, 0x406c, 0x406e, 0x4070, 0x406d, 0x406b, 0x4071, 0x4072, 0x252af, 0x252b0, 0x252bd, 0x408c, 0x40e4, 0x25576, 0x40e1, 0x2557b, 0x25579, 0x78f6, 0x40e7, 0x7900, 0x40e2, 0x411f, 0x256ee, 0x256f1, 0x417e, 0x25894, 0x4180, 0x7a59, 0x7a55, 0x25891, 0x41b9, 0x41b7, 0x41b8, 0x25a10, 0x41ba, 0x7af4, 0x25aaf, 0x41d3, 0x423f, 0x7c04, 0x4245, 0x4241, 0x7c15, 0x4242, 0x4243, 0x423b, 0x4238, 0x25c7b, 0x25c77, 0x423a, 0x7bf5, 0x25c80, 0x25cc6, 0x423c, 0x25c7c, 0x25c90, 0x423e, 0x25ca3, 0x25c7d, 0x25cbd, 0x429e, 0x429f, 0x42a1, 0x25f13, 0x429b, 0x4312, 0x26111, 0x26144, 0x2610d, 0x4318, 0x430c, 0x26314, 0x4362, 0x263b8, 0x437a, 0x263b7, 0x26483, 0x43ae, 0x43af, 0x26514, 0x43ad, 0x2651c,
+		0x43c4, 0x43c7, 0x43c6, 0x43c5, 0x265d4, 0x265d5, 0x81c1, 0x4440, 0x26843, 0x26842, 0x443f, 0x4441, 0x2f98a, 0x26841, 0x2694e, 0x447f, 0x26a87, 0x4486, 0x4481, 0x4480, 0x448e, 0x454a, 0x26f39, 0x4547, 0x26f8f, 0x26f2a, 0x454b, 0x4546, 0x454e, 0x857d, 0x26f2b, 0x85a5, 0x26f50, 0x4548, 0x26f6e, 0x4545, 0x26f3b, 0x26f53, 0x26f5f, 0x26f2f, 0x26f30, 0x26f38, 0x26f4c, 0x271f1, 0x45db, 0x45e7, 0x45e4, 0x27407, 0x27416, 0x45e1, 0x27414, 0x45e9, 0x273fb, 0x45e5, 0x45e0, 0x45e3, 0x273f8, 0x27410, 0x2741d, 0x273ff, 0x2740b, 0x27404, 0x45ea, 0x273fe, 0x275ff, 0x893a, 0x4654, 0x4658, 0x465c, 0x27723, 0x4655, 0x468b, 0x468c, 0x46a6, 0x46a5, 0x2792a, 0x27aa0, 0x27a98, 0x27a9c, 0x46ff, 0x27a9e, 0x27aa6, 0x27ab5, 0x27ab0, 0x4730, 0x4740, 0x4741, 0x27cf9, 0x27cf5, 0x4786, 0x27dd2, 0x47bf, 0x47bd, 0x27f50,
+		0x27f4e, 0x27f4b, 0x27f52, 0x27f4d, 0x47be, 0x4819, 0x280e4, 0x481c, 0x280da, 0x481b, 0x4817, 0x4818, 0x8e51, 0x280df, 0x280e8, 0x483d, 0x486a, 0x4866, 0x28370, 0x28372, 0x4867, 0x28377, 0x4868, 0x48ad, 0x48ae, 0x285e6, 0x287aa, 0x48d6, 0x4909, 0x2888c, 0x9198, 0x28889, 0x490c, 0x490a, 0x28a32, 0x493b, 0x493a, 0x9384, 0x9381, 0x28a7a, 0x936f, 0x28c9e, 0x28ca0, 0x28d70, 0x49af, 0x49aa, 0x49ab, 0x28d6e, 0x28d66, 0x49b1, 0x28d65, 0x49ac, 0x49ec, 0x28f7f, 0x28fe0, 0x4a01, 0x28fff, 0x290f6, 0x290f7, 0x290f5, 0x4a23, 0x290fc, 0x4a24, 0x4a1e, 0x290f9, 0x4a4a, 0x4a65, 0x4a6a, 0x292ca, 0x292c3, 0x292c6, 0x4a69, 0x293b8, 0x293bd, 0x4a95, 0x293bc, 0x29405, 0x294e0, 0x294e9, 0x9842, 0x294df, 0x294ec, 0x4acc, 0x294e5, 0x294de, 0x2fa00, 0x4acf, 0x294f0, 0x294e3, 0x4b0f, 0x295ec, 0x4b0e, 0x4b0b, 0x4b10,
+		0x4b0d, 0x4b0c, 0x295f2, 0x295ef, 0x295e9, 0x296fb, 0x4b46, 0x4b48, 0x9937, 0x4b49, 0x29737, 0x298de, 0x4b91, 0x4b8e, 0x298da, 0x4bd8, 0x4bd6, 0x29a4b, 0x4bda, 0x29a49, 0x4bd7, 0x29ad5, 0x9aff, 0x4bf9, 0x29b52, 0x29b47, 0x4bfc, 0x29b48, 0x29ccc, 0x29cd0, 0x9ba9, 0x4c4a, 0x9ba7, 0x4c4e, 0x9bb3, 0x9bac, 0x9bb0, 0x29d86, 0x29da7, 0x29da3, 0x9b9c, 0x29db6, 0x29dad, 0x2a013, 0x9d3c, 0x9d1c, 0x9d3a, 0x4cd3, 0x4ccd, 0x4cd1, 0x2a017, 0x2a022, 0x9d32, 0x9d34, 0x2a049, 0x2a026, 0x2fa0c, 0x2a03c, 0x2a028, 0x2a2a8, 0x9ec7, 0x2a3fb, 0x4d62, 0x2a432, 0x2a4d6, 0x2a548, 0x4d83, 0x9f3f, 0x2a5c6, 0x4d92, 0x349f, 0x34a0, 0x20425, 0x20426, 0x20424, 0x20433, 0x20570, 0x3527, 0x20b90, 0x360b, 0x21095, 0x210a1, 0x21092, 0x2108f, 0x2109f, 0x21096, 0x21098, 0x2109d, 0x210a0, 0x368d, 0x21697, 0x218eb, 0x3770, 0x21f87,
+		0x5eeb, 0x22432, 0x399a, 0x399f, 0x399d, 0x228d8, 0x228fb, 0x22906, 0x399b, 0x228d1, 0x61d5, 0x2f8b0, 0x3a60, 0x3a64, 0x3a69, 0x3a63, 0x3a67, 0x3a62, 0x22df6, 0x22e04, 0x22dfe, 0x6502, 0x22dff, 0x22df7, 0x23018, 0x3aac, 0x23014, 0x230cd, 0x23120, 0x232de, 0x3b26, 0x3b23, 0x3b25, 0x23745, 0x23753, 0x3bf8, 0x2374b, 0x23755, 0x23741, 0x3bf7, 0x3bfb, 0x3bfa, 0x2375c, 0x23754, 0x23a71, 0x23ad0, 0x3cb0, 0x3caf, 0x23c53, 0x24029, 0x2407e, 0x3d7e, 0x24079, 0x3d7d, 0x3d80, 0x24070, 0x2406a, 0x3d7f, 0x3d86, 0x24085, 0x24064, 0x7012, 0x24078, 0x3d81, 0x24432, 0x24427, 0x2442f, 0x3e24, 0x3e58, 0x3e57, 0x3ea0, 0x248ba, 0x3efe, 0x24a71, 0x24a8c, 0x3f15, 0x24b1a, 0x3f47, 0x3f46, 0x24b98, 0x24ce4, 0x3f6b, 0x3f6c, 0x7585, 0x7654, 0x24ec2, 0x3fcc, 0x24eba, 0x7655, 0x24ec8, 0x3fcb, 0x76a7, 0x76a8, 0x3ff9,
+		0x250b9, 0x250b8, 0x250a5, 0x252e2, 0x4078, 0x407a, 0x4075, 0x252d9, 0x4076, 0x4077, 0x252ac, 0x252dd, 0x40ea, 0x40ee, 0x40ed, 0x2559d, 0x40ec, 0x790f, 0x25711, 0x25703, 0x4184, 0x4185, 0x4183, 0x258ee, 0x41bc, 0x41bd, 0x41d4, 0x25ca4, 0x25cd8, 0x25cdd, 0x25cde, 0x4255, 0x25ce7, 0x4250, 0x424c, 0x4248, 0x25cd4, 0x4253, 0x25cce, 0x4257, 0x4254, 0x424e, 0x424a, 0x4251, 0x25cf1, 0x25cd3, 0x4249, 0x424b, 0x4263, 0x25cca, 0x25ce9, 0x42a7, 0x42a6, 0x42a4, 0x25f1a, 0x25f41, 0x2f968, 0x7ce4, 0x7ce5, 0x2613d, 0x26140, 0x7e65, 0x7e4e, 0x4317, 0x26143, 0x4316, 0x2613f, 0x2613c, 0x4363, 0x26321, 0x26320, 0x7f82, 0x263c6, 0x437b, 0x437c, 0x263d1, 0x263ca, 0x2648e, 0x26491, 0x43b0, 0x802d, 0x265e3, 0x26888, 0x4442, 0x26870, 0x4444, 0x2699d, 0x26a9d, 0x26a91, 0x26a98, 0x26a99, 0x4488, 0x448f, 0x4553,
+		0x455b, 0x26fbf, 0x4559, 0x26fae, 0x26fb1, 0x26fcc, 0x27004, 0x85ca, 0x27016, 0x26fcd, 0x4554, 0x85bc, 0x26fe0, 0x26fcb, 0x26fd4, 0x26fc9, 0x271ff, 0x4599, 0x271fd, 0x27457, 0x27452, 0x27450, 0x27445, 0x2744d, 0x45f1, 0x27453, 0x45ef, 0x27482, 0x27444, 0x275ce, 0x275cf, 0x275d2, 0x27602, 0x2774f, 0x27758, 0x4662, 0x27743, 0x4663, 0x27752, 0x4660, 0x4661, 0x465f, 0x27749, 0x27753, 0x2774d, 0x468d, 0x27884, 0x468e, 0x27883, 0x27932, 0x27ae2, 0x4709, 0x27ae4, 0x27ad3, 0x4705, 0x27ad5, 0x27add, 0x4703, 0x4706, 0x27aec, 0x27ae6, 0x27ad2, 0x27afa, 0x27ada, 0x4731, 0x27c06, 0x27c04, 0x474f, 0x27c7b, 0x27d04, 0x27d02, 0x4766, 0x8cff, 0x47c4, 0x27f60, 0x47c3, 0x47c1, 0x47c5, 0x28107, 0x28105, 0x2810c, 0x4821, 0x2815a, 0x481f, 0x4822, 0x2811a, 0x4827, 0x4820, 0x2838a, 0x486d, 0x486c, 0x486b, 0x486f, 0x4870,
+		0x2838c, 0x28609, 0x288a6, 0x91a6, 0x288ac, 0x288a9, 0x4942, 0x28a8b, 0x93b6, 0x28a8c, 0x4944, 0x4940, 0x28a88, 0x28a89, 0x493f, 0x28a93, 0x93ab, 0x498b, 0x28d83, 0x28f4f, 0x4a25, 0x4a28, 0x29120, 0x9721, 0x29236, 0x292e2, 0x4a75, 0x4a72, 0x292ee, 0x4a6f, 0x292e7, 0x292e9, 0x4a76, 0x4a71, 0x97a7, 0x292df, 0x4a97, 0x293c7, 0x4ad7, 0x29509, 0x4ad6, 0x29501, 0x4ad8, 0x4adc, 0x4adb, 0x4ad4, 0x983e, 0x4b13, 0x4b11, 0x4b14, 0x29605, 0x2960c, 0x29778, 0x2975d, 0x4b51, 0x4b50, 0x4b53, 0x4b54, 0x4b52, 0x2975e, 0x29760, 0x29767, 0x4b6d, 0x2fa05, 0x298f4, 0x4b95, 0x4b99, 0x298f1, 0x4b9a, 0x298fa, 0x4b93, 0x4b97, 0x298f8, 0x298f9, 0x29909, 0x298fd, 0x298f7, 0x4bdc, 0x29a59, 0x4bfd, 0x29b60, 0x29b68, 0x4bfe, 0x4c00, 0x4c02, 0x4c01, 0x4c03, 0x29b6a, 0x29c14, 0x29c3e, 0x29ce4, 0x4c27, 0x4c26, 0x4c24,
+		0x4c4c, 0x9bbc, 0x4c50, 0x4c55, 0x4c53, 0x9bb7, 0x4c52, 0x29dd2, 0x4c57, 0x9bbe, 0x4c58, 0x4cd6, 0x2a058, 0x2a050, 0x4cd4, 0x2a05c, 0x4cda, 0x4cd9, 0x28119, 0x9d62, 0x4cd5, 0x4ce4, 0x2fa0e, 0x4cdc, 0x4d1b, 0x9e8f, 0x4d37, 0x4d36, 0x4d4b, 0x9ecb, 0x4d66, 0x4d76, 0x2a4df, 0x4d7e, 0x4d7d, 0x4d7f, 0x4d84, 0x4d8b, 0x2a5d9, 0x4d94, 0x34a1, 0x3511, 0x20966, 0x3610, 0x56a9, 0x210e1, 0x210ef, 0x210e8, 0x21233, 0x5913, 0x218fe, 0x3732, 0x5bf4, 0x21aef, 0x21aec, 0x21cec, 0x21f9c, 0x3820, 0x21f99, 0x22170, 0x2216e, 0x389d, 0x22441, 0x61ec, 0x61ef, 0x22902, 0x22901, 0x39a5, 0x22a1b, 0x22e23, 0x22e25, 0x22e24, 0x22e26, 0x22e33, 0x22e2f, 0x2308f, 0x23356, 0x3c02, 0x3bfe, 0x3c01, 0x6ad6, 0x3c03, 0x3bff, 0x23799, 0x3c04, 0x2f8ed, 0x3c4a, 0x23ad9, 0x3d87, 0x240b7, 0x3d84, 0x240b6, 0x3d85, 0x7209,
+		0x24454, 0x24457, 0x2447a, 0x3e59, 0x7379, 0x24a8e, 0x74c6, 0x24a90, 0x24ab9, 0x3f04, 0x3f49, 0x3f48, 0x24cef, 0x3f6d, 0x3fd2, 0x3fd3, 0x24ee2, 0x3fd1, 0x24ed6, 0x24ed8, 0x24eda, 0x24ede, 0x3fe7, 0x400a, 0x77c3, 0x25308, 0x25304, 0x2530a, 0x2530b, 0x25302, 0x40f0, 0x255aa, 0x255c1, 0x791f, 0x258d7, 0x258d9, 0x7a65, 0x258de, 0x258da, 0x41be, 0x41bf, 0x25a2a, 0x7ac6, 0x7c3a, 0x25d31, 0x7c36, 0x25d2b, 0x25d2c, 0x25d29, 0x25d2e, 0x425e, 0x25d27, 0x25d28, 0x425b, 0x25d5f, 0x25d30, 0x25d24, 0x7ceb, 0x42ab, 0x25f3a, 0x42ac, 0x25f39, 0x25f40, 0x26180, 0x26184, 0x431f, 0x431d, 0x26175, 0x26176, 0x431c, 0x2617c, 0x431e, 0x26178, 0x2619b, 0x2617e, 0x2625a, 0x4364, 0x2632c, 0x7f84, 0x2649c, 0x26847, 0x2688a, 0x4448, 0x4447, 0x26927, 0x2704a, 0x455e, 0x4561, 0x27027, 0x85e0, 0x85f3, 0x2702e, 0x27026, 0x27208,
+		0x45f7, 0x274bd, 0x27496, 0x45f4, 0x45f8, 0x881e, 0x274be, 0x2748e, 0x27774, 0x4664, 0x27780, 0x4692, 0x468f, 0x4690, 0x89b4, 0x4693, 0x46a8, 0x2793c, 0x2793d, 0x46a9, 0x2793a, 0x27942, 0x89f9, 0x27b24, 0x27b06, 0x8b44, 0x470e, 0x27b15, 0x470f, 0x27b02, 0x27b0c, 0x470b, 0x27b08, 0x27b0a, 0x27b05, 0x27b1c, 0x4710, 0x4751, 0x27c82, 0x4750, 0x27c86, 0x4763, 0x27e03, 0x27f7b, 0x47c7, 0x27f7a, 0x8e71, 0x4824, 0x4826, 0x8e6e, 0x28180, 0x8e79, 0x28158, 0x8ec4, 0x4874, 0x4873, 0x4872, 0x283aa, 0x283ab, 0x28436, 0x2844a, 0x48b1, 0x908c, 0x287d6, 0x490e, 0x4911, 0x4910, 0x490f, 0x4912, 0x4949, 0x93c9, 0x494f, 0x494d, 0x28b03, 0x28acf, 0x4955, 0x28d9f, 0x28da2, 0x2902a, 0x2902f, 0x29144, 0x29140, 0x4a2c, 0x4a2b, 0x29311, 0x2930f, 0x4a79, 0x2930d, 0x29307, 0x29303, 0x4a7a, 0x97b0, 0x4a99, 0x4a9a,
+		0x29435, 0x4aae, 0x4aaf, 0x29504, 0x4ae4, 0x4ae1, 0x4ade, 0x4ae6, 0x4adf, 0x29526, 0x4ae7, 0x4ae2, 0x4ae0, 0x2951e, 0x4ae5, 0x985a, 0x2960e, 0x4b18, 0x2961d, 0x2961e, 0x2961f, 0x4b56, 0x29788, 0x9946, 0x2978d, 0x29791, 0x29780, 0x4b6e, 0x29854, 0x29855, 0x4b9d, 0x4ba0, 0x4b9c, 0x29925, 0x2991a, 0x4ba1, 0x4ba2, 0x4b9f, 0x4bdf, 0x4bde, 0x9ac3, 0x4bea, 0x29b88, 0x4c06, 0x29b7a, 0x4c04, 0x9b0f, 0x29b80, 0x29d1e, 0x29dfc, 0x29e25, 0x4c5f, 0x9bf4, 0x9bfa, 0x4c5c, 0x29e0b, 0x4c5e, 0x29e06, 0x29e04, 0x9bdd, 0x4c59, 0x29df9, 0x29e00, 0x4c64, 0x4c5d, 0x4c62, 0x29e02, 0x4c65, 0x29e07, 0x9bed, 0x4c5b, 0x9bef, 0x2a0b5, 0x4cdd, 0x2a087, 0x4cdf, 0x2a093, 0x2fa0f, 0x4ce2, 0x2a0be, 0x2a266, 0x4d27, 0x2a2c7, 0x2a2cf, 0x9e96, 0x4d3a, 0x4d3c, 0x2a33d, 0x4d39, 0x2a33c, 0x2a347, 0x4d3d, 0x4d3b, 0x9eb3,
+		0x4d4c, 0x2a3c3, 0x4d68, 0x9ee2, 0x2a51b, 0x4d80, 0x4d85, 0x2a5c9, 0x4d95, 0x2a5dd, 0x4d96, 0x2a693, 0x9f8f, 0x2043d, 0x34a4, 0x3512, 0x56b1, 0x3625, 0x214e6, 0x5b41, 0x3737, 0x21af7, 0x21fb0, 0x21fae, 0x0, 0x3868, 0x3867, 0x389e, 0x2259f, 0x2259e, 0x22930, 0x39aa, 0x2294f, 0x39a9, 0x39a4, 0x22927, 0x22951, 0x3a71, 0x3a6f, 0x22e51, 0x22e54, 0x22e56, 0x23027, 0x3aad, 0x23024, 0x6af6, 0x3c0c, 0x6af2, 0x3c0b, 0x237ec, 0x237c4, 0x3c0f, 0x3c79, 0x240f8, 0x240f6, 0x240f7, 0x240ed, 0x3d8d, 0x3d8f, 0x240f4, 0x240ef, 0x3d8e, 0x3e0c, 0x2447f, 0x244a2, 0x2447e, 0x3ea6, 0x248c5, 0x3ea3, 0x3ea4, 0x3ea5, 0x7588, 0x3f6e, 0x24ef1, 0x24ef2, 0x3ffa, 0x2502f, 0x407c, 0x407e, 0x407b, 0x407d, 0x25323, 0x25329, 0x408d, 0x40f4, 0x40f3, 0x2571b, 0x258eb, 0x4189, 0x258ea, 0x25a33, 0x41c0, 0x25d63, 0x4265,
+		0x25d92, 0x25d65, 0x42ad, 0x4325, 0x261a0, 0x2f970, 0x2625e, 0x43c9, 0x268aa, 0x444a, 0x268a9, 0x8267, 0x4489, 0x2709c, 0x4566, 0x4570, 0x27092, 0x456d, 0x4569, 0x4567, 0x27086, 0x4572, 0x860e, 0x456e, 0x27083, 0x459c, 0x45fc, 0x45fd, 0x4604, 0x45ff, 0x2f9c2, 0x45fe, 0x4600, 0x274ce, 0x4666, 0x4669, 0x27793, 0x46aa, 0x46ab, 0x4717, 0x27b2e, 0x27b27, 0x27b28, 0x4715, 0x8b5e, 0x4712, 0x8d0e, 0x27e18, 0x27e16, 0x27f8d, 0x47ca, 0x27f8e, 0x47c9, 0x47cb, 0x27f90, 0x27f8f, 0x28181, 0x4829, 0x4828, 0x2818a, 0x2818c, 0x2828d, 0x4840, 0x4875, 0x4876, 0x283b2, 0x4888, 0x287d9, 0x91b6, 0x4957, 0x9401, 0x28b0d, 0x495f, 0x28b13, 0x941d, 0x4958, 0x495b, 0x28b1b, 0x942f, 0x28dac, 0x49b3, 0x28db3, 0x49ef, 0x2915e, 0x4a30, 0x29160, 0x29168, 0x29163, 0x29169, 0x29167, 0x4a41, 0x4a4b, 0x29328, 0x4a7d,
+		0x2932e, 0x29330, 0x4a7c, 0x29326, 0x29331, 0x29341, 0x97e0, 0x293da, 0x97db, 0x2f9fa, 0x2940b, 0x9861, 0x29534, 0x29533, 0x4ae8, 0x4aea, 0x4ae9, 0x29539, 0x2953b, 0x29540, 0x29630, 0x4b1b, 0x29632, 0x29637, 0x4b55, 0x994a, 0x4b59, 0x4b58, 0x29781, 0x2979f, 0x297a7, 0x4ba4, 0x4ba3, 0x29944, 0x29947, 0x29948, 0x2993d, 0x9a33, 0x4ba7, 0x29949, 0x4be0, 0x29a80, 0x29ba0, 0x29b9d, 0x4c08, 0x4c0a, 0x4c09, 0x29b9c, 0x29c47, 0x29d07, 0x4c71, 0x9c0f, 0x4c6c, 0x29e49, 0x9c11, 0x29e44, 0x9c03, 0x9c01, 0x4c6e, 0x29edf, 0x9c16, 0x29e4c, 0x0, 0x29e4f, 0x4ce0, 0x4cee, 0x2a0c1, 0x4ceb, 0x2a0b9, 0x2a0cb, 0x2a0cf, 0x2a0c4, 0x9d93, 0x4cea, 0x4cef, 0x4ce7, 0x2a0ca, 0x2a0c3, 0x2a2d0, 0x2a351, 0x4d48, 0x4d49, 0x2a3a8, 0x2a3c7, 0x2a3c6, 0x4d4d, 0x2a3ca, 0x2a402, 0x4d55, 0x2a45d, 0x2a45a, 0x4d6a, 0x4d6c, 0x2a459,
+		0x4d6b, 0x2a4cc, 0x2a51c, 0x2a5cc, 0x4d98, 0x4d99, 0x4d97, 0x2a5e8, 0x2a5ec, 0x2a5ea, 0x2a6ad, 0x2a6b0, 0x2045f, 0x20458, 0x20457, 0x535b, 0x20b93, 0x3616, 0x2113b, 0x56bf, 0x21134, 0x214f2, 0x3739, 0x21afd, 0x21c1d, 0x3825, 0x5dce, 0x22304, 0x22448, 0x22958, 0x22e4f, 0x3a74, 0x22e6e, 0x22e72, 0x3aae, 0x2302e, 0x237fc, 0x237f4, 0x23c36, 0x2410e, 0x3d92, 0x3d94, 0x24114, 0x3d95, 0x24119, 0x3e0d, 0x244a6, 0x3e25, 0x24ac9, 0x24ac0, 0x24acc, 0x24b1b, 0x24baa, 0x24ba8, 0x24f03, 0x3fd5, 0x3fd6, 0x76ac, 0x3fe8, 0x25035, 0x407f, 0x77d2, 0x40f5, 0x40f6, 0x40f7, 0x255d9, 0x4124, 0x418d, 0x418a, 0x25a3f, 0x25a3d, 0x426c, 0x4266, 0x426a, 0x25d8b, 0x4267, 0x426d, 0x4268, 0x7c52, 0x25d68, 0x25d8a, 0x25f58, 0x25f57, 0x261ce, 0x261bc, 0x261c0, 0x261c1, 0x261bf, 0x261ab, 0x2625f, 0x4365, 0x264a6, 0x439a, 0x26529,
+		0x43b1, 0x444b, 0x444d, 0x444c, 0x444e, 0x268b8, 0x4573, 0x4575, 0x270dd, 0x270d6, 0x2f9b1, 0x270d5, 0x270e7, 0x270d8, 0x274ec, 0x4603, 0x27500, 0x27507, 0x274fd, 0x274f1, 0x274ff, 0x277aa, 0x277b0, 0x27b48, 0x471e, 0x27b53, 0x8b73, 0x27b4d, 0x4719, 0x471c, 0x471a, 0x471d, 0x8b76, 0x27b43, 0x27b50, 0x4743, 0x4752, 0x27c8c, 0x27e27, 0x4795, 0x27f99, 0x47cc, 0x281b1, 0x482b, 0x281b0, 0x281aa, 0x281ac, 0x482a, 0x8ec7, 0x4877, 0x283c8, 0x283ca, 0x28642, 0x287e0, 0x288e7, 0x288e8, 0x288e6, 0x4913, 0x4914, 0x9434, 0x28b5b, 0x28b56, 0x495d, 0x28b5a, 0x4960, 0x943e, 0x4962, 0x28cb8, 0x28dc5, 0x49b2, 0x49f0, 0x29048, 0x2917d, 0x2917c, 0x29181, 0x29182, 0x29162, 0x4a4c, 0x29345, 0x4a82, 0x97bc, 0x4a81, 0x4a9b, 0x293e3, 0x4aa4, 0x4aee, 0x4aec, 0x29550, 0x4aed, 0x2954f, 0x4af0, 0x4aef, 0x29639, 0x4b1d,
+		0x2963a, 0x4b60, 0x4b5e, 0x4b5d, 0x297c1, 0x2994e, 0x2996e, 0x4bb1, 0x4bab, 0x4bac, 0x4bad, 0x29971, 0x4bae, 0x29a8c, 0x29a89, 0x4be2, 0x29ae5, 0x29bb3, 0x29bb6, 0x29bb4, 0x29c4d, 0x9b39, 0x29d13, 0x29e78, 0x9c2a, 0x4c7b, 0x9c26, 0x4c78, 0x4c75, 0x9c27, 0x29e72, 0x4cf2, 0x4cf4, 0x4cf3, 0x9dc0, 0x9dc9, 0x2a275, 0x2a2da, 0x2a2d7, 0x4d3f, 0x4d3e, 0x4d40, 0x4d4e, 0x4d57, 0x4d59, 0x4d58, 0x2fa16, 0x2a469, 0x2a467, 0x4d6e, 0x2a466, 0x2a46f, 0x2a471, 0x9eec, 0x2a4cf, 0x2a523, 0x4d81, 0x4d86, 0x2a5a5, 0x4d8f, 0x2a5fe, 0x2a5fb, 0x2a5fd, 0x9f68, 0x4d9b, 0x4db1, 0x4db3, 0x2116d, 0x373a, 0x21b05, 0x21c20, 0x3827, 0x21fc8, 0x21fc9, 0x386a, 0x39ac, 0x23127, 0x3c18, 0x2381e, 0x23936, 0x3c4c, 0x2413d, 0x3d96, 0x248d7, 0x3f4a, 0x24bad, 0x24d00, 0x4081, 0x2533a, 0x4083, 0x40f9, 0x40f8, 0x25726, 0x418e,
+		0x418f, 0x41c1, 0x25db7, 0x25db6, 0x25dc0, 0x4270, 0x25d9b, 0x4271, 0x261d8, 0x432a, 0x432d, 0x437d, 0x8032, 0x8031, 0x268c0, 0x444f, 0x268bf, 0x26abf, 0x4490, 0x27120, 0x270d0, 0x2710e, 0x4579, 0x2711d, 0x2711e, 0x27115, 0x27114, 0x2751e, 0x2752c, 0x4605, 0x277b9, 0x277b8, 0x277b6, 0x2789e, 0x2789c, 0x2794d, 0x89fd, 0x27b6e, 0x27b60, 0x4721, 0x27b64, 0x27b62, 0x261e5, 0x4732, 0x27fa3, 0x47cd, 0x47cf, 0x283d2, 0x283d5, 0x908e, 0x4916, 0x4915, 0x49b5, 0x4a08, 0x29055, 0x4a32, 0x29193, 0x4a33, 0x4a34, 0x4a3c, 0x29356, 0x97c2, 0x293e9, 0x4a9c, 0x29445, 0x4af4, 0x4af2, 0x2967c, 0x4b62, 0x297d3, 0x4b61, 0x4b64, 0x4bb5, 0x9a4b, 0x4bb4, 0x2998e, 0x29a97, 0x4be3, 0x29a9b, 0x29a99, 0x9b1c, 0x4c0e, 0x29bcf, 0x9b1b, 0x29c59, 0x4c2c, 0x4c2b, 0x29d20, 0x29d23, 0x29d2a, 0x4c85, 0x4c81, 0x4c7e, 0x4c83,
+		0x4c80, 0x29eb0, 0x9c42, 0x2a12f, 0x9dd4, 0x4cfb, 0x4cf7, 0x2a132, 0x2a143, 0x2a13f, 0x2a139, 0x4cf8, 0x2a130, 0x2a2dd, 0x2a3da, 0x2a3db, 0x4d5a, 0x2a484, 0x2a47f, 0x2a472, 0x2a480, 0x2a4ee, 0x4d78, 0x2a52a, 0x2a522, 0x2a571, 0x2a5cd, 0x4d9d, 0x4d9c, 0x2a60f, 0x2a618, 0x2046a, 0x34a9, 0x34bf, 0x56d0, 0x56cf, 0x21b0c, 0x5dda, 0x225a6, 0x3a77, 0x3a76, 0x23037, 0x3abb, 0x66ea, 0x23ae2, 0x3d9b, 0x244bc, 0x3e0f, 0x3e5b, 0x24ad5, 0x3f4c, 0x3f6f, 0x3fd9, 0x24f12, 0x4082, 0x2534b, 0x25341, 0x253a1, 0x255ec, 0x4274, 0x4272, 0x25dd4, 0x25dd8, 0x25dd9, 0x4273, 0x25dda, 0x25f6c, 0x25f6d, 0x42b1, 0x432e, 0x261e7, 0x261eb, 0x261ec, 0x26262, 0x434e, 0x2652b, 0x2660d, 0x268cd, 0x26ac3, 0x2713f, 0x2713c, 0x2713e, 0x2713d, 0x2713a, 0x27138, 0x27544, 0x27545, 0x460b, 0x27548, 0x27550, 0x466c, 0x8b89, 0x27b78, 0x27b79,
+		0x478b, 0x27e3e, 0x47d0, 0x482d, 0x28654, 0x48e4, 0x4971, 0x28bb9, 0x9458, 0x496f, 0x2905f, 0x4a87, 0x4aa5, 0x29572, 0x29575, 0x4b1e, 0x4b65, 0x4bb9, 0x4bb7, 0x4bb8, 0x4be4, 0x29aa3, 0x29aa5, 0x29bdc, 0x29bdd, 0x29c5a, 0x4c8c, 0x4c89, 0x4c8a, 0x29edb, 0x29edc, 0x4c8b, 0x2a1ab, 0x2a184, 0x2a176, 0x4d01, 0x4cfe, 0x9de7, 0x4d03, 0x4d06, 0x2a183, 0x9dea, 0x9df1, 0x2a27f, 0x4d1d, 0x4d43, 0x2a373, 0x2a3ad, 0x2a3b0, 0x4d4f, 0x2a40f, 0x2a40c, 0x4d5b, 0x4d70, 0x2a579, 0x4d88, 0x2a577, 0x2a57a, 0x4d89, 0x9f44, 0x2a632, 0x2a627, 0x2a62a, 0x2a62c, 0x9f6d, 0x2a628, 0x2a629, 0x2a638, 0x2082f, 0x2117b, 0x21b0d, 0x5dd9, 0x21fd6, 0x21fd5, 0x22ea1, 0x2384c, 0x3d9e, 0x3d9f, 0x3ea7, 0x3f4b, 0x3fdb, 0x3fda, 0x24fc0, 0x77d6, 0x408e, 0x4276, 0x25df4, 0x4330, 0x432f, 0x261f0, 0x4366, 0x2633f, 0x457e, 0x2755d,
+		0x27572, 0x27562, 0x883a, 0x27566, 0x8975, 0x466f, 0x27b88, 0x47d1, 0x482f, 0x281e8, 0x281e4, 0x48b2, 0x4918, 0x4917, 0x288ff, 0x4976, 0x291af, 0x291ae, 0x4a4f, 0x4a89, 0x293f2, 0x29448, 0x29581, 0x2957e, 0x4af5, 0x4b1f, 0x29652, 0x297ef, 0x9a5d, 0x4be5, 0x29aad, 0x29be6, 0x4c10, 0x29bed, 0x4c0f, 0x29be9, 0x29c61, 0x29c60, 0x29d33, 0x4c2f, 0x4c30, 0x9c64, 0x29f0b, 0x29f08, 0x4c93, 0x4c94, 0x29f07, 0x4d07, 0x4d09, 0x4d08, 0x2a1ca, 0x4d0b, 0x2a1c6, 0x9e0a, 0x2a284, 0x2a2eb, 0x2a37d, 0x4d50, 0x4d71, 0x2a49b, 0x2a4a2, 0x2a4a1, 0x2a4a0, 0x2a49c, 0x4d7b, 0x4d7c, 0x2a580, 0x9f73, 0x2a640, 0x4da1, 0x2a639, 0x2a63c, 0x4da0, 0x4da2, 0x208ca, 0x361b, 0x21189, 0x3682, 0x2303c, 0x23940, 0x24163, 0x24169, 0x3fe9, 0x25353, 0x4084, 0x77e1, 0x25917, 0x2591a, 0x25e00, 0x42b3, 0x4334, 0x4333, 0x4580, 0x2756f,
+		0x278ab, 0x46ad, 0x27b91, 0x4744, 0x4755, 0x27fb1, 0x47d2, 0x281ef, 0x28df1, 0x28df2, 0x291b7, 0x291b5, 0x4a8a, 0x29586, 0x2965a, 0x4b67, 0x299c6, 0x299cb, 0x4be6, 0x29ab2, 0x4c13, 0x29bf3, 0x9b2d, 0x29f27, 0x4c97, 0x9e0c, 0x2a1d5, 0x2a1d8, 0x4d0c, 0x2a1ec, 0x2a287, 0x2a2f2, 0x4d46, 0x4d5c, 0x4d74, 0x4d72, 0x2a4ad, 0x2a4b0, 0x2a4fd, 0x9f1f, 0x2a587, 0x2a64a, 0x4da4, 0x4da3, 0x2a64e, 0x2a649, 0x2a651, 0x2a64d, 0x4db4, 0x20472, 0x3536, 0x21b16, 0x2386d, 0x23941, 0x3cb2, 0x24171, 0x24174, 0x3f16, 0x7c70, 0x4277, 0x25f7c, 0x457f, 0x2718a, 0x27956, 0x487d, 0x9479, 0x28bfa, 0x974a, 0x4a8c, 0x2965b, 0x4b68, 0x4bbe, 0x4c15, 0x2a1f5, 0x2a1f0, 0x2a2f3, 0x2a37f, 0x2a413, 0x4d75, 0x4da5, 0x21b19, 0x22985, 0x22eb0, 0x24177, 0x255ff, 0x4278, 0x4335, 0x7e9d, 0x4582, 0x27187, 0x4583, 0x2718b, 0x4671, 0x27b9e,
+		0x487e, 0x4a8e, 0x2958f, 0x9960, 0x4b69, 0x29839, 0x299d4, 0x29af1, 0x29c02, 0x29c6b, 0x29d40, 0x4c9a, 0x4c9b, 0x2a210, 0x2a4be, 0x2a4b9, 0x4d90, 0x2a664, 0x9f9e, 0x24ae9, 0x27190, 0x4586, 0x4585, 0x2721c, 0x460e, 0x27592, 0x278ae, 0x27ba3, 0x4919, 0x291c9, 0x4bc0, 0x299d8, 0x29d44, 0x2a224, 0x9ef8, 0x9f3a, 0x9f7d, 0x2a670, 0x2a6d3, 0x400d, 0x4c16, 0x2a4c3, 0x4da9, 0x4daa, 0x4085, 0x25e21, 0x26aca, 0x2759c, 0x29c73, 0x2a386, 0x2a5c1, 0x29c09, 0x9f96, 0x2a6d5, 0x4bc2, 0x4c31, 0x4d11, 0x4dab, 0x4c9c, 0x291d4, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 5
+		0x200d1, 0x200cb, 0x200c9, 0x2010c, 0x20000, 0x20087, 0x2010d, 0x2010f, 0x2f81d, 0x22398, 0x200d2, 0x20111, 0x2007e, 0x20ad3, 0x21552, 0x21cfe, 0x225a9, 0x23942, 0x20009, 0x200d6, 0x204dd, 0x204dc, 0x20502, 0x20573, 0x20676, 0x206a7, 0x206a8, 0x20833, 0x208cc, 0x20a0d, 0x20a2c, 0x20b1b, 0x355a, 0x20b1d, 0x20b99, 0x2123e, 0x21d2d, 0x21d2f, 0x22399, 0x233b3, 0x239b6, 0x23b1b, 0x244ef, 0x248e9, 0x20014, 0x20094, 0x200e1, 0x20506, 0x2054a, 0x20544, 0x20631, 0x2067a, 0x206ad, 0x206ae, 0x206b1, 0x20838, 0x20837, 0x2097d, 0x2097e, 0x20a2d, 0x20a32, 0x20b23, 0x20b26, 0x2f83a, 0x20ba0, 0x216a8, 0x2193f, 0x219bc, 0x21bc4, 0x21d3b, 0x22019, 0x22018, 0x221b3, 0x223aa, 0x224be, 0x225ac, 0x22a25, 0x22a66, 0x22a6b, 0x23943, 0x239b5, 0x23aec, 0x23c76, 0x3cbc, 0x24614, 0x215dc, 0x2471c, 0x2471d, 0x264d0, 0x26612, 0x26af3, 0x28669, 0x49b9, 0x28e11,
+		0x28e15, 0x28e10, 0x28e13, 0x200a4, 0x200a5, 0x201d0, 0x201ba, 0x201cb, 0x2057b, 0x20686, 0x206bb, 0x206ba, 0x206c3, 0x206b9, 0x206c0, 0x34de, 0x20839, 0x2083b, 0x20841, 0x208d1, 0x208cf, 0x208d2, 0x2092e, 0x2096e, 0x2098c, 0x20988, 0x20a35, 0x3543, 0x20a3a, 0x20a3b, 0x20a38, 0x20a39, 0x20b2e, 0x20b2c, 0x20bc8, 0x20bb5, 0x20bad, 0x20bb0, 0x20bab, 0x211b3, 0x211a9, 0x216bb, 0x216b9, 0x21b5a, 0x21bcd, 0x21c2b, 0x21d05, 0x37ac, 0x37aa, 0x21d51, 0x21d4b, 0x21fea, 0x21fed, 0x22036, 0x22062, 0x22056, 0x22055, 0x22064, 0x22058, 0x5e07, 0x221da, 0x221d8, 0x223ad, 0x223ab, 0x224c0, 0x224c3, 0x225b3, 0x225b7, 0x225ba, 0x5fcb, 0x38fe, 0x225c7, 0x225b5, 0x2298e, 0x22a73, 0x22a6c, 0x22a74, 0x2f8b4, 0x22a72, 0x22a71, 0x22a82, 0x22a70, 0x2313f, 0x233ba, 0x233b8, 0x239b7, 0x24723, 0x24721, 0x2472b, 0x24726, 0x24722, 0x24d25, 0x250e4, 0x25415,
+		0x264e4, 0x26633, 0x2662a, 0x26632, 0x26629, 0x26afb, 0x2795b, 0x2795c, 0x27c28, 0x2866f, 0x2866d, 0x2866b, 0x2866e, 0x28e16, 0x20022, 0x20116, 0x20148, 0x201d8, 0x20200, 0x3441, 0x20480, 0x204e4, 0x34b4, 0x204e7, 0x2050c, 0x20553, 0x205b7, 0x20ae8, 0x206d1, 0x206e5, 0x206d8, 0x206d6, 0x206da, 0x206d9, 0x206d5, 0x206e6, 0x206d4, 0x206d3, 0x20844, 0x208d6, 0x208d7, 0x20913, 0x0, 0x20970, 0x20992, 0x209db, 0x209da, 0x20a41, 0x20a43, 0x20b33, 0x20bf6, 0x20bd4, 0x20bdd, 0x20bd0, 0x20c04, 0x20bd9, 0x20bdb, 0x20c05, 0x20bd3, 0x20be1, 0x21280, 0x21277, 0x21281, 0x21282, 0x21283, 0x2f85c, 0x21561, 0x21560, 0x216d8, 0x216d6, 0x216d7, 0x24605, 0x2194d, 0x21948, 0x21bd6, 0x21bcf, 0x21bd9, 0x21bd8, 0x21c3d, 0x37b3, 0x21d54, 0x21d5b, 0x21d5c, 0x37b4, 0x21d59, 0x21d58, 0x21d5a, 0x21d55, 0x21d56, 0x21d82, 0x21ff0, 0x2201c, 0x2206a, 0x22069,
+		0x22071, 0x22067, 0x2206c, 0x2206e, 0x22079, 0x2218b, 0x221b8, 0x221e7, 0x221e8, 0x221e4, 0x221e6, 0x221e5, 0x221f0, 0x22383, 0x223b7, 0x223bb, 0x223b9, 0x223c5, 0x22453, 0x224c4, 0x224c6, 0x224cb, 0x225c8, 0x2f89f, 0x225c9, 0x225db, 0x22994, 0x22a95, 0x22a88, 0x22a8d, 0x22eec, 0x22eee, 0x22ef1, 0x2312e, 0x23149, 0x2335f, 0x233d1, 0x233cb, 0x23885, 0x3c1d, 0x2394a, 0x23947, 0x2394b, 0x239bb, 0x239c4, 0x3c7c, 0x23b02, 0x23c82, 0x23c91, 0x23c8f, 0x23c92, 0x2418f, 0x24191, 0x241b4, 0x24192, 0x244f4, 0x245aa, 0x24735, 0x24737, 0x2473d, 0x2473c, 0x2472f, 0x24730, 0x248f8, 0x248ef, 0x24b26, 0x3f55, 0x24d26, 0x24d27, 0x24f41, 0x24fc6, 0x24fc7, 0x250eb, 0x250e8, 0x250ea, 0x2541a, 0x25419, 0x4126, 0x25923, 0x25924, 0x25e25, 0x26635, 0x26634, 0x26a07, 0x2721d, 0x277e1, 0x27bab, 0x28460, 0x28677, 0x2867d, 0x49bc, 0x28e1e, 0x28e1a, 0x28e1d,
+		0x2006b, 0x2012d, 0x2014c, 0x2022a, 0x344a, 0x20215, 0x2022c, 0x20206, 0x20208, 0x2020a, 0x344e, 0x20556, 0x20584, 0x34c9, 0x205c6, 0x206f5, 0x20709, 0x206f8, 0x206e8, 0x206fb, 0x206e9, 0x206f6, 0x52b7, 0x52b8, 0x20852, 0x52b6, 0x52ba, 0x20854, 0x20851, 0x20939, 0x2093a, 0x20998, 0x20a4e, 0x357b, 0x20c36, 0x20c0c, 0x20c10, 0x20c32, 0x20c11, 0x20c18, 0x20c1c, 0x20c1a, 0x20c19, 0x20c0b, 0x20c0f, 0x20c1d, 0x20c21, 0x20c13, 0x20c1b, 0x20c15, 0x3620, 0x211d1, 0x211c8, 0x2128d, 0x212b5, 0x3689, 0x3695, 0x215f8, 0x216e0, 0x216e1, 0x36be, 0x216de, 0x216fe, 0x216dc, 0x21701, 0x216fd, 0x21700, 0x219d7, 0x219d6, 0x219d9, 0x21b65, 0x21bd2, 0x21bdc, 0x21be1, 0x21bde, 0x21c42, 0x21c4b, 0x37c3, 0x21d8e, 0x21d91, 0x21d8b, 0x21d90, 0x21d88, 0x21d89, 0x21d84, 0x21daa, 0x2208d, 0x2208f, 0x2207d, 0x2207f, 0x2207b, 0x22080, 0x2207e, 0x22083,
+		0x22081, 0x22086, 0x2208a, 0x221bd, 0x221be, 0x3877, 0x221f4, 0x221f3, 0x22202, 0x221f6, 0x221f5, 0x221f2, 0x22204, 0x2233d, 0x223c6, 0x22489, 0x224d4, 0x224d6, 0x224d2, 0x225fa, 0x22606, 0x2260c, 0x2260a, 0x2260f, 0x22a2f, 0x39d4, 0x22ab7, 0x22abe, 0x22abc, 0x22ae2, 0x22f06, 0x22ef7, 0x22f07, 0x22ef6, 0x23b35, 0x2315f, 0x23165, 0x23164, 0x23161, 0x2315a, 0x2315d, 0x2f8d7, 0x233d7, 0x233d5, 0x233de, 0x233e1, 0x233e0, 0x233d9, 0x3b4c, 0x233da, 0x233df, 0x233f6, 0x23403, 0x3c20, 0x23893, 0x2388b, 0x23891, 0x23892, 0x2388a, 0x23889, 0x2388f, 0x23956, 0x23959, 0x239ca, 0x239cc, 0x3c5b, 0x239d0, 0x239cd, 0x239d6, 0x239cb, 0x23a83, 0x23a82, 0x23b06, 0x23b2a, 0x23b2c, 0x23b2b, 0x23b29, 0x23cbd, 0x23cb3, 0x23cbe, 0x23cc0, 0x23cb5, 0x23cb6, 0x2419d, 0x2419f, 0x24620, 0x3e2d, 0x2461e, 0x2461c, 0x24632, 0x2461f, 0x2462b, 0x24621, 0x24626,
+		0x24624, 0x2461d, 0x24627, 0x2475b, 0x24759, 0x24780, 0x24755, 0x24750, 0x2475a, 0x24756, 0x24763, 0x24752, 0x24758, 0x3e63, 0x24739, 0x248f9, 0x24b28, 0x3f18, 0x24bbc, 0x24bd6, 0x24c05, 0x24c20, 0x3f74, 0x24d33, 0x24f46, 0x24f42, 0x24f43, 0x24f47, 0x24fcb, 0x25042, 0x25045, 0x250fd, 0x250f4, 0x250f9, 0x250fa, 0x2535e, 0x2541d, 0x4128, 0x25758, 0x25755, 0x25a57, 0x25a58, 0x25ad9, 0x25adc, 0x25adb, 0x25e27, 0x25f85, 0x25f87, 0x25f8a, 0x2628b, 0x2634f, 0x26530, 0x2652f, 0x26668, 0x26649, 0x43da, 0x26648, 0x2663f, 0x2664b, 0x2665c, 0x26646, 0x26640, 0x26a0d, 0x26b1b, 0x26b1d, 0x26b17, 0x44a4, 0x26b22, 0x275a7, 0x27608, 0x27806, 0x2847d, 0x488e, 0x28463, 0x28464, 0x2847f, 0x2846b, 0x2847c, 0x28468, 0x28465, 0x2846a, 0x28698, 0x28699, 0x286ae, 0x28e2b, 0x28e2d, 0x28e31, 0x28e2e, 0x28e2f, 0x2006d, 0x20131, 0x2022d, 0x20239, 0x20238,
+		0x345b, 0x2023a, 0x20235, 0x20262, 0x204ea, 0x204eb, 0x20515, 0x205cc, 0x205c8, 0x205cd, 0x205db, 0x205cb, 0x20711, 0x20726, 0x20862, 0x2085e, 0x20861, 0x20941, 0x2093f, 0x20975, 0x23965, 0x20b49, 0x209eb, 0x20a1a, 0x20a57, 0x20c6b, 0x20ca9, 0x20c54, 0x20c62, 0x20caa, 0x20c58, 0x20c72, 0x20c63, 0x20c73, 0x20c8b, 0x20c8c, 0x20c5c, 0x20c51, 0x20c16, 0x20c60, 0x20c61, 0x20c5e, 0x20c5d, 0x20c64, 0x20c53, 0x20c5f, 0x20c5b, 0x20c6e, 0x20c55, 0x20c52, 0x20c68, 0x211d2, 0x211d6, 0x211d3, 0x212b8, 0x212bb, 0x212eb, 0x212e8, 0x212b6, 0x212ea, 0x212e7, 0x212e9, 0x21591, 0x21610, 0x2160e, 0x2160f, 0x2170b, 0x21703, 0x2172b, 0x21709, 0x21728, 0x2172c, 0x21725, 0x21727, 0x2170f, 0x219e9, 0x219ec, 0x3753, 0x21b27, 0x21b73, 0x21be2, 0x21be6, 0x2f876, 0x21c61, 0x21c51, 0x21d12, 0x21d0e, 0x21dad, 0x21daf, 0x21db1, 0x21dd3, 0x22094, 0x22092, 0x22090,
+		0x2209d, 0x22093, 0x22095, 0x22098, 0x22097, 0x3880, 0x2f88a, 0x22207, 0x2220b, 0x22208, 0x223d9, 0x223d5, 0x224d7, 0x224df, 0x38dd, 0x224de, 0x224e3, 0x224e2, 0x38de, 0x22647, 0x3922, 0x2263d, 0x22638, 0x22a44, 0x22a3a, 0x22ab8, 0x22b2a, 0x22b00, 0x22af3, 0x22af2, 0x22aeb, 0x22afa, 0x22aed, 0x22aec, 0x22aff, 0x22afb, 0x6306, 0x22b4e, 0x22aef, 0x22af7, 0x22f10, 0x22f11, 0x22f0c, 0x22f0b, 0x230dd, 0x23130, 0x3adb, 0x2317a, 0x23177, 0x23328, 0x23327, 0x23365, 0x2340e, 0x2340b, 0x23441, 0x23413, 0x2340f, 0x23412, 0x23430, 0x238a0, 0x238a3, 0x2389e, 0x23897, 0x238a1, 0x2389d, 0x2389b, 0x23963, 0x239e3, 0x239e4, 0x239e6, 0x239e7, 0x239dd, 0x6b85, 0x239ec, 0x239e5, 0x239f1, 0x23b3b, 0x23b39, 0x23b3a, 0x23b3c, 0x23b3d, 0x23b47, 0x23b3f, 0x23b44, 0x23c48, 0x23c49, 0x23c60, 0x23ce3, 0x23ce9, 0x23cf0, 0x3cda, 0x3cdb, 0x23cf2, 0x23ced,
+		0x23cec, 0x23ce6, 0x23d16, 0x3cd7, 0x241bc, 0x241be, 0x241c0, 0x241e0, 0x24500, 0x24502, 0x244fe, 0x2455e, 0x245b3, 0x245b7, 0x2463a, 0x24639, 0x2463e, 0x24638, 0x2463d, 0x2464f, 0x2476e, 0x2475f, 0x24781, 0x24782, 0x24771, 0x2477b, 0x24779, 0x24777, 0x24773, 0x2476f, 0x24797, 0x2477e, 0x248fc, 0x24911, 0x24932, 0x24931, 0x24910, 0x24aec, 0x24aeb, 0x24b2c, 0x24b2d, 0x24bda, 0x24c2e, 0x24c2c, 0x24c2a, 0x24c33, 0x24c4b, 0x24d18, 0x24d42, 0x24d3b, 0x24d3f, 0x24d41, 0x24d43, 0x24d3c, 0x24f4c, 0x24f4a, 0x24f49, 0x24f56, 0x24fcf, 0x25047, 0x25048, 0x25046, 0x2511b, 0x25111, 0x25114, 0x2511d, 0x25117, 0x2511e, 0x2510b, 0x2511c, 0x25112, 0x25116, 0x2510d, 0x25115, 0x25113, 0x25118, 0x401a, 0x253ac, 0x25444, 0x2542c, 0x25445, 0x25766, 0x2576e, 0x25765, 0x25768, 0x25784, 0x25938, 0x2593b, 0x2593a, 0x2593f, 0x25adf, 0x25add, 0x25ade, 0x25ae2,
+		0x41d6, 0x25e2c, 0x25e2f, 0x25f8f, 0x25f90, 0x25f8c, 0x25f93, 0x26225, 0x26288, 0x262a7, 0x263f7, 0x264bb, 0x26538, 0x26539, 0x26537, 0x26614, 0x2666e, 0x2667b, 0x43de, 0x2666c, 0x2667f, 0x26678, 0x2667a, 0x2666f, 0x43e5, 0x268fe, 0x26b48, 0x26b4b, 0x26b41, 0x26b49, 0x26b3b, 0x2722d, 0x275e3, 0x2761a, 0x2760d, 0x27613, 0x27611, 0x2761c, 0x4672, 0x278b5, 0x46af, 0x27c29, 0x27ca1, 0x27d24, 0x27fbb, 0x282a1, 0x28483, 0x2848d, 0x28487, 0x28485, 0x2848a, 0x284a3, 0x28489, 0x28484, 0x284a2, 0x286b1, 0x286b0, 0x286af, 0x286b6, 0x286c9, 0x286ca, 0x286c8, 0x286b4, 0x28c59, 0x28cc9, 0x28e41, 0x28e4c, 0x49c4, 0x28e45, 0x28e5a, 0x200b9, 0x20294, 0x2026e, 0x202a2, 0x20267, 0x3463, 0x202a3, 0x20276, 0x2026c, 0x20264, 0x202a5, 0x20230, 0x202a6, 0x20269, 0x202a4, 0x20490, 0x2055f, 0x20560, 0x205da, 0x205d8, 0x205dd, 0x34ec, 0x20759, 0x2075b,
+		0x2075a, 0x20739, 0x20734, 0x20744, 0x20733, 0x2073c, 0x20758, 0x2076a, 0x2086f, 0x20872, 0x20870, 0x20871, 0x2086e, 0x208f0, 0x208eb, 0x20949, 0x2094c, 0x209f5, 0x20a20, 0x20a6a, 0x20a72, 0x20b4b, 0x20b5d, 0x20c50, 0x20cf9, 0x20cfa, 0x20cfb, 0x20d23, 0x20cb7, 0x20d22, 0x20cb5, 0x20cc4, 0x20cb4, 0x20cef, 0x3598, 0x20ccd, 0x20cf0, 0x20cb8, 0x20ccb, 0x20cf1, 0x20cba, 0x20d03, 0x20cf8, 0x20cb6, 0x20cbc, 0x20cb3, 0x20d21, 0x20cf7, 0x20cc2, 0x20cbe, 0x20cc9, 0x20cbd, 0x20cce, 0x20cb9, 0x20cc7, 0x20cca, 0x20ccc, 0x20d26, 0x211e4, 0x211e0, 0x211f0, 0x212ec, 0x21324, 0x21318, 0x2132f, 0x21325, 0x21528, 0x21522, 0x2156a, 0x20157, 0x21594, 0x21592, 0x2159c, 0x21622, 0x21630, 0x2161d, 0x21623, 0x21735, 0x21733, 0x2172f, 0x2174d, 0x21731, 0x2175b, 0x2175a, 0x21732, 0x2175c, 0x21759, 0x2175d, 0x21969, 0x2196d, 0x22232, 0x3758, 0x21a0a, 0x21a20,
+		0x21a1f, 0x21a0d, 0x21be8, 0x21bec, 0x21c77, 0x21c70, 0x21c71, 0x21df8, 0x21dd6, 0x21dd9, 0x21dde, 0x21dd5, 0x21de1, 0x37d3, 0x21de2, 0x21ddd, 0x21de0, 0x21e0e, 0x21e08, 0x21ddc, 0x21e0a, 0x21e0c, 0x220b4, 0x220b1, 0x220b6, 0x220bc, 0x2221d, 0x22224, 0x22219, 0x2221b, 0x22222, 0x2221a, 0x2221c, 0x22221, 0x223e6, 0x223e4, 0x223e7, 0x223ea, 0x38e2, 0x2f89b, 0x224f0, 0x224f5, 0x224ef, 0x22642, 0x2266e, 0x2263c, 0x22697, 0x2268c, 0x22681, 0x22671, 0x226ce, 0x226ba, 0x2267a, 0x2267e, 0x3954, 0x22672, 0x226bb, 0x392f, 0x22673, 0x22682, 0x39b6, 0x229ba, 0x229b8, 0x22a4b, 0x22a48, 0x22b2b, 0x22af4, 0x22b2c, 0x22b42, 0x22b48, 0x22b44, 0x22b45, 0x22b3c, 0x22b37, 0x22b33, 0x22b41, 0x22b32, 0x22b87, 0x22f27, 0x22f23, 0x22f2d, 0x22f1f, 0x22f2c, 0x22f28, 0x23075, 0x23074, 0x230e7, 0x23132, 0x23131, 0x23199, 0x23196, 0x23198, 0x2319d, 0x23192,
+		0x23194, 0x23195, 0x23197, 0x266b9, 0x3b35, 0x23411, 0x2343b, 0x23479, 0x23442, 0x23443, 0x3b5d, 0x23478, 0x238ac, 0x3c29, 0x238b1, 0x238b4, 0x238b3, 0x238af, 0x238aa, 0x238b2, 0x23968, 0x239f2, 0x239fe, 0x239f8, 0x239f9, 0x239ff, 0x239f5, 0x239f7, 0x239fd, 0x23a00, 0x23a88, 0x23b6e, 0x23b5d, 0x23b63, 0x23b60, 0x23c61, 0x23d37, 0x23d71, 0x23d23, 0x23d22, 0x23d1f, 0x23d25, 0x241fd, 0x24211, 0x24223, 0x241f0, 0x241ef, 0x2450e, 0x2450c, 0x245ba, 0x3e1f, 0x24652, 0x24655, 0x24653, 0x24654, 0x24651, 0x24658, 0x247b0, 0x2479f, 0x3e72, 0x247a1, 0x2479a, 0x247be, 0x247a0, 0x24937, 0x3f0b, 0x24af1, 0x24af2, 0x24b34, 0x24b37, 0x24b35, 0x2238c, 0x24bdf, 0x24c4c, 0x24c4e, 0x24c64, 0x24c63, 0x24c36, 0x24d58, 0x24d68, 0x24d5b, 0x24d5f, 0x24d59, 0x24d65, 0x24d60, 0x24d5e, 0x24fd5, 0x24fd6, 0x2504e, 0x25052, 0x25135, 0x25139, 0x25158, 0x25141,
+		0x25157, 0x25138, 0x2513d, 0x25132, 0x25144, 0x2f944, 0x25363, 0x25362, 0x253b8, 0x253b0, 0x253b1, 0x2544d, 0x25463, 0x25470, 0x2544b, 0x2544f, 0x2544e, 0x25453, 0x25447, 0x40a2, 0x25454, 0x25452, 0x25459, 0x2547e, 0x2562b, 0x2562e, 0x2577f, 0x2577e, 0x25781, 0x25783, 0x25947, 0x25954, 0x2594d, 0x2594c, 0x2594b, 0x25957, 0x25a65, 0x25a64, 0x25a61, 0x25a62, 0x25af0, 0x25af3, 0x25af9, 0x25af4, 0x25af5, 0x25aef, 0x25af8, 0x25afc, 0x25af7, 0x25afd, 0x25b17, 0x25e34, 0x25e36, 0x25e38, 0x25faf, 0x25fae, 0x25fac, 0x25fa2, 0x26228, 0x22ec2, 0x2629c, 0x262b3, 0x26290, 0x26406, 0x26404, 0x2640b, 0x26405, 0x26540, 0x2654f, 0x266ab, 0x2669d, 0x266b8, 0x266b2, 0x266d2, 0x266cf, 0x266a2, 0x43ec, 0x266a5, 0x266a9, 0x266a7, 0x266d0, 0x26902, 0x269cf, 0x269d0, 0x26a16, 0x26a19, 0x26b75, 0x26b71, 0x26b91, 0x26b72, 0x26b8e, 0x26b6d, 0x26b88, 0x26b73,
+		0x44bb, 0x26b81, 0x26b76, 0x26b7b, 0x26b7f, 0x26b90, 0x26b78, 0x2724b, 0x27248, 0x2725b, 0x27251, 0x2723d, 0x27240, 0x27246, 0x2724d, 0x2723b, 0x2724a, 0x27241, 0x2723e, 0x2724e, 0x275ac, 0x275e6, 0x275e9, 0x27629, 0x4621, 0x27633, 0x2762e, 0x2761e, 0x461f, 0x2f9c5, 0x27622, 0x27627, 0x277e7, 0x2780b, 0x27966, 0x27bad, 0x27bae, 0x8c38, 0x27c2b, 0x27c2a, 0x27d2c, 0x27d2d, 0x4791, 0x4796, 0x27e8f, 0x27e93, 0x27fc6, 0x27fc5, 0x28215, 0x28213, 0x282b1, 0x282a9, 0x8ece, 0x284a8, 0x284ab, 0x284be, 0x284ac, 0x284a9, 0x284a6, 0x284c1, 0x286cc, 0x286d1, 0x286d3, 0x286e4, 0x286cb, 0x286e1, 0x286d2, 0x286e3, 0x286cf, 0x286d0, 0x286e5, 0x2870e, 0x28ccc, 0x28cca, 0x28ccb, 0x28e5c, 0x28e61, 0x28e5d, 0x28e59, 0x29077, 0x29684, 0x20041, 0x200fd, 0x2011a, 0x20163, 0x202b6, 0x202b2, 0x202e9, 0x202e7, 0x202d6, 0x20330, 0x202ec, 0x202dd, 0x202e8,
+		0x202b0, 0x202b5, 0x202e1, 0x20498, 0x20499, 0x34bc, 0x20593, 0x205e5, 0x34d8, 0x20760, 0x2075e, 0x34f4, 0x2087b, 0x208f1, 0x208f3, 0x20a7f, 0x2f838, 0x20b57, 0x20b58, 0x20d39, 0x20da7, 0x20d35, 0x20d60, 0x20d51, 0x20d62, 0x20d42, 0x20d3c, 0x20d43, 0x20dab, 0x20da9, 0x20db4, 0x20d79, 0x20d6a, 0x20d3b, 0x20daa, 0x20d33, 0x20d37, 0x20d27, 0x20da8, 0x20d70, 0x20d2f, 0x20d36, 0x20db5, 0x20d30, 0x20d3a, 0x20d38, 0x20db1, 0x20dac, 0x20d2e, 0x20d75, 0x20db0, 0x211f7, 0x21200, 0x211f1, 0x211f3, 0x21360, 0x21361, 0x21332, 0x2132d, 0x2134a, 0x2132a, 0x21567, 0x215a2, 0x215a3, 0x2159e, 0x215a4, 0x21644, 0x21632, 0x2179b, 0x21798, 0x21799, 0x2179a, 0x21766, 0x21762, 0x2176b, 0x24562, 0x21767, 0x21a3b, 0x21a23, 0x21a29, 0x21b7e, 0x21bf3, 0x3785, 0x21bf5, 0x3783, 0x21c80, 0x21c7e, 0x21c7c, 0x21c7f, 0x21c7d, 0x21c81, 0x21e25, 0x21e29, 0x21e17,
+		0x21e63, 0x21e67, 0x21e65, 0x21e2a, 0x21e68, 0x21e26, 0x21e64, 0x21e1b, 0x21e62, 0x21e69, 0x21ffe, 0x22044, 0x22042, 0x220b7, 0x220d2, 0x220d4, 0x220d7, 0x220dc, 0x220d1, 0x220d5, 0x220cd, 0x220d8, 0x2219a, 0x22242, 0x22235, 0x22258, 0x22234, 0x2223c, 0x2223b, 0x2223e, 0x2223d, 0x2225a, 0x22241, 0x22238, 0x22245, 0x22233, 0x22326, 0x38ba, 0x223f7, 0x223ef, 0x223f0, 0x223ee, 0x2249d, 0x22506, 0x22511, 0x22508, 0x22502, 0x2250a, 0x22507, 0x22691, 0x2268e, 0x22685, 0x226e8, 0x22717, 0x226d4, 0x3969, 0x226c4, 0x226d5, 0x3945, 0x226d8, 0x22680, 0x226de, 0x22742, 0x229c7, 0x229bf, 0x22b8d, 0x22b89, 0x22b90, 0x22b9a, 0x22bc8, 0x22b92, 0x22ba1, 0x22b84, 0x22f57, 0x22f47, 0x22f77, 0x22f3a, 0x22f50, 0x22f42, 0x22f43, 0x22fa3, 0x23077, 0x231b2, 0x231e2, 0x231bd, 0x23374, 0x23482, 0x234b8, 0x3b6c, 0x234ba, 0x234b9, 0x234bb, 0x23487, 0x2f8e4,
+		0x23483, 0x234b7, 0x20043, 0x238ce, 0x238ba, 0x23973, 0x23a09, 0x23a0a, 0x23a0b, 0x23a07, 0x23a05, 0x23a0e, 0x23a97, 0x23b77, 0x23b7b, 0x23b7a, 0x23b74, 0x23b79, 0x23d86, 0x23df5, 0x23da5, 0x23d9d, 0x23dbe, 0x23d96, 0x23de1, 0x23da4, 0x23da1, 0x23df6, 0x3d04, 0x23d8d, 0x23d8b, 0x23d78, 0x23d98, 0x23e0a, 0x2425d, 0x24227, 0x2425f, 0x245c0, 0x245c1, 0x2466d, 0x2465e, 0x2468a, 0x24662, 0x24664, 0x3e3a, 0x247c0, 0x247ca, 0x247d1, 0x3e79, 0x247c7, 0x247ce, 0x7309, 0x247c5, 0x24936, 0x24957, 0x24977, 0x24af6, 0x24af7, 0x24b55, 0x24b44, 0x24b3e, 0x24b42, 0x24b52, 0x24be1, 0x24c08, 0x24c68, 0x24c67, 0x3f5d, 0x2f937, 0x24d7e, 0x24d93, 0x24d79, 0x24d81, 0x24d7d, 0x24d7b, 0x24d94, 0x3f8a, 0x24f66, 0x24fd9, 0x24fe0, 0x2f93e, 0x2505f, 0x2515f, 0x2515e, 0x25168, 0x4027, 0x25188, 0x2516a, 0x2516c, 0x25166, 0x25167, 0x25189, 0x25160, 0x25185,
+		0x2032a, 0x25368, 0x253b9, 0x253ba, 0x253be, 0x2546f, 0x25480, 0x25474, 0x25481, 0x2547a, 0x2549c, 0x25473, 0x25482, 0x2547f, 0x254a7, 0x25479, 0x25478, 0x2547d, 0x254a8, 0x2547c, 0x25668, 0x2564e, 0x2579e, 0x4146, 0x4140, 0x25798, 0x413f, 0x25785, 0x2579c, 0x257c5, 0x2579a, 0x25968, 0x25965, 0x25967, 0x25961, 0x25960, 0x419d, 0x41cb, 0x25a7b, 0x41e1, 0x25b19, 0x25b0e, 0x25b31, 0x25b12, 0x25b10, 0x25b15, 0x25b3f, 0x25b1d, 0x25b1e, 0x25e4d, 0x25e48, 0x25e45, 0x25e42, 0x25e49, 0x25e4a, 0x25e47, 0x427f, 0x25e4c, 0x25fcd, 0x25fd5, 0x25fc4, 0x25fca, 0x25fc3, 0x4346, 0x26229, 0x262b4, 0x2636d, 0x26366, 0x2636a, 0x26415, 0x264d3, 0x264ec, 0x26555, 0x441a, 0x266d6, 0x266d8, 0x26751, 0x266e0, 0x26703, 0x26704, 0x266da, 0x266ea, 0x26702, 0x266d3, 0x266e4, 0x266e5, 0x2693b, 0x26966, 0x26a2c, 0x26a21, 0x26a26, 0x26ad4, 0x26ad5, 0x26ad9,
+		0x26bd5, 0x26beb, 0x26bc5, 0x44d3, 0x26c27, 0x26bf0, 0x26bb9, 0x26c18, 0x26bee, 0x44d0, 0x26bb7, 0x26bbb, 0x26bef, 0x26bb8, 0x26bdf, 0x26bda, 0x26be3, 0x26bc9, 0x26bec, 0x271a4, 0x458e, 0x271a3, 0x2725e, 0x27261, 0x27262, 0x27263, 0x2728e, 0x27269, 0x2726b, 0x27285, 0x2728d, 0x27264, 0x2726d, 0x2764c, 0x27649, 0x27647, 0x27648, 0x27642, 0x27645, 0x2765b, 0x2765d, 0x27646, 0x27813, 0x2781a, 0x2780e, 0x2780f, 0x2781b, 0x27812, 0x278c3, 0x278be, 0x278bd, 0x27979, 0x2797c, 0x46c3, 0x46b6, 0x27980, 0x27975, 0x2799b, 0x8a2f, 0x27974, 0x46c0, 0x46b8, 0x22041, 0x22494, 0x27c30, 0x27ca7, 0x27ca5, 0x27ca4, 0x27d3a, 0x27e5b, 0x27e9f, 0x27ea8, 0x27eb3, 0x27ea1, 0x27ea7, 0x27eb2, 0x27e9d, 0x27ea3, 0x27ea2, 0x27fef, 0x27fd9, 0x47d9, 0x27fd5, 0x27fdd, 0x27fda, 0x27fee, 0x27fd7, 0x2821a, 0x28219, 0x28218, 0x282b3, 0x282bf, 0x282bb, 0x282c0,
+		0x282b9, 0x282b8, 0x282d5, 0x28411, 0x28410, 0x284c3, 0x284c2, 0x284e9, 0x284cd, 0x284e5, 0x284ca, 0x284c7, 0x284e8, 0x286f1, 0x2870c, 0x286ef, 0x286ee, 0x286f3, 0x2870d, 0x286f6, 0x286f0, 0x48ec, 0x287f5, 0x287f4, 0x287f8, 0x287fe, 0x28911, 0x28922, 0x28942, 0x28c5c, 0x28c5a, 0x28c5d, 0x28cd1, 0x28cd0, 0x28e75, 0x28e7b, 0x28f89, 0x2944d, 0x29689, 0x29692, 0x2968c, 0x29688, 0x29867, 0x29868, 0x20321, 0x202f2, 0x20309, 0x202f8, 0x202f0, 0x202f3, 0x202f5, 0x202fb, 0x202f7, 0x202ef, 0x2030b, 0x204a2, 0x205f5, 0x205f3, 0x205f4, 0x205f2, 0x20698, 0x20768, 0x20780, 0x20785, 0x2078e, 0x2078f, 0x202f4, 0x20786, 0x207a4, 0x20886, 0x20889, 0x20887, 0x20885, 0x52dc, 0x20888, 0x20aff, 0x20b66, 0x35cc, 0x20dc3, 0x35a2, 0x20dc4, 0x20e3b, 0x20de7, 0x20df8, 0x20dfb, 0x35b6, 0x20dbe, 0x20dc6, 0x35c5, 0x35c6, 0x20dfc, 0x20dc0, 0x20e33, 0x20dc9,
+		0x20dfe, 0x20dda, 0x20dcc, 0x20dbb, 0x20dfd, 0x20ddf, 0x20dcd, 0x20dc2, 0x20dc8, 0x20e32, 0x20e2d, 0x20dd2, 0x20e31, 0x20dd3, 0x20e2e, 0x20dcf, 0x211ff, 0x21209, 0x211fc, 0x21372, 0x213aa, 0x213ab, 0x213a7, 0x2137b, 0x21376, 0x213a8, 0x213ac, 0x3683, 0x5921, 0x215aa, 0x20a03, 0x215ac, 0x215ad, 0x215ab, 0x21650, 0x21658, 0x217dd, 0x36f8, 0x217ae, 0x217a2, 0x217ab, 0x217e3, 0x217af, 0x217e0, 0x217e4, 0x36f6, 0x217a7, 0x217aa, 0x217e2, 0x217e1, 0x217df, 0x217ad, 0x217e5, 0x217d1, 0x217ac, 0x217d5, 0x217a3, 0x2179f, 0x21979, 0x21976, 0x21a43, 0x21a3d, 0x21a47, 0x21a44, 0x21a3f, 0x21a5b, 0x21b7f, 0x21c9a, 0x21c9c, 0x379b, 0x21d1e, 0x21e88, 0x21e6a, 0x21e76, 0x21e72, 0x21e70, 0x21e7e, 0x21e6c, 0x21e6f, 0x21e75, 0x21e77, 0x21e78, 0x21e8a, 0x21e79, 0x21e94, 0x22001, 0x22105, 0x220f1, 0x220ee, 0x220ef, 0x22104, 0x5e3f, 0x220fa, 0x22107,
+		0x220f4, 0x220f5, 0x2219c, 0x2226d, 0x22269, 0x22266, 0x22262, 0x22246, 0x388d, 0x22265, 0x2225d, 0x2225e, 0x2225f, 0x2238e, 0x22402, 0x22406, 0x22404, 0x22403, 0x22462, 0x22527, 0x22521, 0x22520, 0x22524, 0x22529, 0x22531, 0x22523, 0x22522, 0x226dc, 0x2274d, 0x226e5, 0x226d3, 0x226ee, 0x226e6, 0x3956, 0x22736, 0x22740, 0x2283f, 0x2273d, 0x22744, 0x395b, 0x2275f, 0x22729, 0x22749, 0x2278a, 0x2272a, 0x22787, 0x2274c, 0x22731, 0x22748, 0x2272b, 0x2273b, 0x22741, 0x226c9, 0x22734, 0x22753, 0x22735, 0x22747, 0x22738, 0x229d1, 0x229d2, 0x22a51, 0x22a55, 0x22a52, 0x22a53, 0x22b8f, 0x22bf4, 0x22c47, 0x22c0d, 0x22c1c, 0x22bfb, 0x22c19, 0x22bf7, 0x22bf9, 0x22bf5, 0x22be9, 0x22c0a, 0x22bee, 0x22c0b, 0x22bfd, 0x22c06, 0x22c02, 0x22c16, 0x22c18, 0x22bf0, 0x22ed4, 0x22f66, 0x22f6a, 0x22f75, 0x22f76, 0x22f80, 0x22f6d, 0x3a96, 0x22f69, 0x22f67,
+		0x22f68, 0x22f5d, 0x230a4, 0x230fe, 0x230fd, 0x231ec, 0x231e9, 0x23221, 0x231eb, 0x231e7, 0x231f2, 0x23220, 0x2337a, 0x2352d, 0x2352e, 0x234ca, 0x2352f, 0x234c8, 0x3b7e, 0x234cb, 0x234c3, 0x23484, 0x234d2, 0x234c6, 0x3b81, 0x234cf, 0x234d5, 0x234d4, 0x234dd, 0x2352b, 0x235a3, 0x234db, 0x2352c, 0x23513, 0x3c35, 0x238d1, 0x238cc, 0x238d5, 0x238c9, 0x238cf, 0x238d2, 0x2397b, 0x2397c, 0x23a23, 0x23a1d, 0x23a1c, 0x23a1e, 0x23a22, 0x23a24, 0x23a20, 0x23a18, 0x23a21, 0x23b88, 0x23b8a, 0x23b94, 0x23b8f, 0x23b8e, 0x23b90, 0x3c9b, 0x23ba7, 0x23c64, 0x3d00, 0x23e18, 0x23e14, 0x23e1d, 0x23e05, 0x23dff, 0x23e1b, 0x23e1a, 0x23e19, 0x23e03, 0x23e0e, 0x23e47, 0x23e04, 0x23e5a, 0x23e07, 0x23e20, 0x2426a, 0x24266, 0x2427a, 0x24265, 0x242af, 0x245cd, 0x245cf, 0x245d1, 0x245ce, 0x2465f, 0x24677, 0x24674, 0x24688, 0x24675, 0x24689, 0x24672, 0x247c6,
+		0x247ea, 0x247e0, 0x24807, 0x247e6, 0x247e5, 0x247dd, 0x247e3, 0x247da, 0x249ae, 0x2497e, 0x2497d, 0x2497f, 0x3ed3, 0x249d9, 0x24afa, 0x24afb, 0x24b4d, 0x24b54, 0x24b51, 0x24b50, 0x24d98, 0x24d95, 0x24d9d, 0x24dc1, 0x24d9a, 0x24d9b, 0x3f9f, 0x24d9c, 0x24dd7, 0x24da4, 0x24d96, 0x24da0, 0x24f31, 0x24fe9, 0x2506e, 0x2519a, 0x251a5, 0x25194, 0x251a6, 0x25199, 0x2518b, 0x25198, 0x25191, 0x2518c, 0x251a1, 0x251a3, 0x251c6, 0x2536f, 0x2536d, 0x2536a, 0x2536c, 0x25371, 0x2536e, 0x253df, 0x254ac, 0x254aa, 0x40b1, 0x254b5, 0x254ad, 0x254b2, 0x254b3, 0x254ab, 0x254b6, 0x254c7, 0x254c6, 0x2566c, 0x25670, 0x257b3, 0x414b, 0x2598c, 0x25981, 0x25983, 0x2597d, 0x25985, 0x25990, 0x259a2, 0x2598d, 0x2598f, 0x2597e, 0x2598a, 0x2597f, 0x25976, 0x25987, 0x25978, 0x25a80, 0x25a82, 0x25a81, 0x25a83, 0x25b5a, 0x25b34, 0x25b2c, 0x25b2a, 0x25b40, 0x25b2d,
+		0x25b33, 0x25b2b, 0x25b4b, 0x7b3f, 0x25b3c, 0x25e5a, 0x25e77, 0x25e60, 0x25ffa, 0x25ff9, 0x25ffc, 0x42d6, 0x25ff5, 0x25fe8, 0x26009, 0x25fe7, 0x25fe6, 0x25fe9, 0x26232, 0x2622c, 0x262bd, 0x262bc, 0x2637a, 0x2637e, 0x26379, 0x4389, 0x26423, 0x26430, 0x26428, 0x264d4, 0x26ace, 0x264f1, 0x264f0, 0x26560, 0x26565, 0x266a8, 0x2670a, 0x2671b, 0x26712, 0x26707, 0x2674f, 0x2670e, 0x26752, 0x26716, 0x4400, 0x26753, 0x26754, 0x2f984, 0x26756, 0x2696d, 0x26972, 0x26a2f, 0x26c2a, 0x44dc, 0x26c63, 0x26c84, 0x26caf, 0x26c45, 0x26c70, 0x26c56, 0x26c16, 0x26c3d, 0x26c38, 0x26c6f, 0x26c77, 0x26c2e, 0x26c31, 0x26c4c, 0x26c5f, 0x26c75, 0x26c39, 0x26c3a, 0x26c2c, 0x26c2d, 0x26c3f, 0x26c2f, 0x26c30, 0x26c3e, 0x83d0, 0x4590, 0x271b2, 0x272b7, 0x2728f, 0x45b1, 0x27296, 0x2729e, 0x27297, 0x27295, 0x272a5, 0x27298, 0x86e7, 0x272a3, 0x2729a, 0x272ad,
+		0x272a6, 0x272b6, 0x27299, 0x272af, 0x272ac, 0x45aa, 0x2729f, 0x27294, 0x275eb, 0x2766f, 0x2766d, 0x2767e, 0x2766c, 0x27661, 0x27663, 0x2766b, 0x2765e, 0x2763e, 0x27664, 0x276ad, 0x467d, 0x27820, 0x278d1, 0x278d5, 0x279ae, 0x279af, 0x2799f, 0x279a8, 0x279ca, 0x27be1, 0x27c39, 0x27c38, 0x27c3b, 0x27cad, 0x27cb5, 0x27caf, 0x27cb1, 0x27cae, 0x27cb4, 0x27cb0, 0x27d4b, 0x27d51, 0x27d4c, 0x4769, 0x27d60, 0x27ebe, 0x27eb9, 0x27eb7, 0x27eb6, 0x47a1, 0x27ebd, 0x27eb8, 0x27ec1, 0x27ff7, 0x27ff3, 0x27ff4, 0x27ffb, 0x27ffd, 0x27ff5, 0x47e7, 0x27ffa, 0x28014, 0x47ec, 0x27ff2, 0x27ffc, 0x28000, 0x47df, 0x2822b, 0x28229, 0x2822e, 0x4833, 0x282d7, 0x282db, 0x282d9, 0x282d8, 0x282e6, 0x282d6, 0x282d1, 0x282e7, 0x282d0, 0x282dc, 0x284ed, 0x2851a, 0x28519, 0x28516, 0x284ec, 0x284ef, 0x284ee, 0x284f4, 0x2852f, 0x28730, 0x28719, 0x28718, 0x28711,
+		0x28716, 0x28712, 0x28710, 0x28714, 0x2880c, 0x2880d, 0x28910, 0x28929, 0x28928, 0x28c65, 0x28c66, 0x28cde, 0x28cdf, 0x28ce0, 0x28cd9, 0x28cdb, 0x28cd8, 0x28ce3, 0x28cd7, 0x28ea6, 0x28ea7, 0x28ebf, 0x28ea8, 0x28e9f, 0x28ea1, 0x28ea2, 0x28ead, 0x28ea3, 0x28ea0, 0x29083, 0x29085, 0x29082, 0x29084, 0x291ee, 0x2925d, 0x29392, 0x29455, 0x29453, 0x2f9fc, 0x29451, 0x29452, 0x29450, 0x2959d, 0x29687, 0x29697, 0x2969b, 0x2969d, 0x29869, 0x29a11, 0x4bee, 0x29c7a, 0x4c32, 0x23244, 0x200c6, 0x20340, 0x2032e, 0x20368, 0x2032d, 0x20334, 0x20332, 0x20369, 0x20331, 0x2036a, 0x2033e, 0x2036f, 0x20375, 0x204f6, 0x20520, 0x20528, 0x20567, 0x205fb, 0x205fe, 0x205fc, 0x205fd, 0x205ff, 0x205fa, 0x207ac, 0x207af, 0x207b1, 0x34fc, 0x207bf, 0x207be, 0x207a7, 0x207aa, 0x20894, 0x20893, 0x20899, 0x352a, 0x208fa, 0x20956, 0x20a22, 0x20a91, 0x20a92, 0x20b08,
+		0x20b06, 0x20dd6, 0x20e37, 0x20e4b, 0x20ece, 0x20ed0, 0x20e39, 0x20e64, 0x20ecf, 0x20e46, 0x20e66, 0x20e56, 0x20e43, 0x20e4a, 0x20e58, 0x20e65, 0x20e5a, 0x20e5b, 0x20e55, 0x20e50, 0x20e4c, 0x20ecd, 0x20e51, 0x20e47, 0x35d3, 0x20e53, 0x20e62, 0x20ecc, 0x35d7, 0x20e7f, 0x20e4d, 0x2120a, 0x2120c, 0x2120f, 0x213bb, 0x213b9, 0x213eb, 0x213ae, 0x213c1, 0x213ea, 0x213be, 0x213bf, 0x213ba, 0x213b7, 0x213b3, 0x213e9, 0x2156e, 0x215af, 0x215b3, 0x215b2, 0x21668, 0x21669, 0x2166a, 0x217de, 0x217eb, 0x21811, 0x217ed, 0x217e9, 0x217f7, 0x217f4, 0x217ea, 0x21812, 0x217e8, 0x21813, 0x2198e, 0x21986, 0x21a63, 0x21a65, 0x21b99, 0x21cc2, 0x21cae, 0x21cb0, 0x21cad, 0x21eb4, 0x21ed1, 0x21ead, 0x37f3, 0x21ebd, 0x21eb7, 0x21eab, 0x21eaf, 0x21ec1, 0x21eb5, 0x2210f, 0x2210e, 0x2211c, 0x22113, 0x2210a, 0x2211e, 0x22114, 0x2211a, 0x221cb, 0x22287, 0x22293,
+		0x3891, 0x22284, 0x22285, 0x22292, 0x22280, 0x2232b, 0x22363, 0x2240a, 0x38c0, 0x22466, 0x22534, 0x22533, 0x2254d, 0x22789, 0x22757, 0x6117, 0x2274a, 0x3963, 0x22739, 0x2273f, 0x22790, 0x2278f, 0x227b2, 0x2279f, 0x22795, 0x227a1, 0x2279c, 0x2278e, 0x227a0, 0x227ae, 0x22796, 0x227ca, 0x227ac, 0x3970, 0x227a6, 0x22797, 0x22793, 0x203b6, 0x229d6, 0x229e0, 0x229d8, 0x22a56, 0x22c6e, 0x22c7e, 0x22c74, 0x22c77, 0x22c80, 0x22c7a, 0x22c6b, 0x22c5f, 0x22c76, 0x22cb0, 0x22c63, 0x22f92, 0x22f82, 0x22f9d, 0x22f94, 0x22f83, 0x22f8b, 0x22f84, 0x23057, 0x23084, 0x230b5, 0x23109, 0x231e6, 0x3b02, 0x23225, 0x23251, 0x2333e, 0x2f8da, 0x23385, 0x2353f, 0x23542, 0x23538, 0x2353a, 0x2354e, 0x23545, 0x23539, 0x23537, 0x23544, 0x2f8e7, 0x235a4, 0x2353d, 0x23541, 0x238df, 0x238de, 0x238ca, 0x238e9, 0x238e2, 0x238e0, 0x23989, 0x23987, 0x23a32, 0x23a33,
+		0x23aac, 0x23af9, 0x23b0d, 0x23bb9, 0x23e5e, 0x23e6a, 0x3d35, 0x23e81, 0x23e69, 0x23eac, 0x23e80, 0x23e75, 0x23e71, 0x23e76, 0x23e6f, 0x242b6, 0x24303, 0x242bf, 0x24301, 0x242b2, 0x242c4, 0x245db, 0x245dc, 0x245d8, 0x29672, 0x246b5, 0x246a8, 0x2468f, 0x2469e, 0x2468e, 0x247de, 0x247e2, 0x24820, 0x2481d, 0x2481a, 0x24819, 0x2482b, 0x24818, 0x24810, 0x2480e, 0x24824, 0x2481f, 0x24813, 0x24835, 0x24983, 0x3ee0, 0x249d7, 0x24b02, 0x24b03, 0x24b5c, 0x24b5f, 0x24bc7, 0x24c9a, 0x24c97, 0x24ca3, 0x24df7, 0x24dc6, 0x24dcc, 0x24dd6, 0x24dcd, 0x24df9, 0x24dd9, 0x24dd8, 0x3f9a, 0x24dd1, 0x24dce, 0x24dd5, 0x3fa3, 0x24dd4, 0x24df8, 0x24f7a, 0x24f79, 0x24f7f, 0x24ff3, 0x24ff5, 0x24ff6, 0x24ff4, 0x24ff7, 0x4005, 0x25079, 0x2519c, 0x251df, 0x251f5, 0x251ff, 0x251d1, 0x251da, 0x251cf, 0x251cd, 0x251de, 0x251d8, 0x23aad, 0x251e0, 0x251fe, 0x2519e,
+		0x25375, 0x25373, 0x25372, 0x253d8, 0x254d0, 0x254d6, 0x254d7, 0x254ca, 0x254cd, 0x2568a, 0x25689, 0x2568b, 0x257d3, 0x257da, 0x257ce, 0x257d2, 0x415e, 0x257d4, 0x257d7, 0x257d5, 0x257fb, 0x257cd, 0x257fa, 0x257d6, 0x41a6, 0x2599b, 0x2599e, 0x2599d, 0x259a4, 0x2599f, 0x259a5, 0x259a8, 0x25a8f, 0x25a8c, 0x25a95, 0x25b60, 0x25b55, 0x25b5c, 0x25b6f, 0x25b51, 0x25b61, 0x25ba7, 0x25b5e, 0x25b62, 0x25b54, 0x25b6e, 0x25b52, 0x25b58, 0x25b6d, 0x25b5f, 0x25b6c, 0x25b50, 0x25b5d, 0x25b8e, 0x25e72, 0x25e78, 0x25e75, 0x25e76, 0x25e7c, 0x25e7e, 0x25e7d, 0x26015, 0x26014, 0x2601d, 0x2601c, 0x26023, 0x26057, 0x262c8, 0x435d, 0x262c9, 0x262c5, 0x26387, 0x2638a, 0x26394, 0x26439, 0x26440, 0x2643e, 0x26438, 0x264d6, 0x43b8, 0x26577, 0x26578, 0x2657d, 0x266dd, 0x2675e, 0x26777, 0x267d4, 0x26766, 0x2675f, 0x26773, 0x26772, 0x26797, 0x2675a, 0x26796,
+		0x2675d, 0x26765, 0x26770, 0x26775, 0x26774, 0x2675c, 0x26760, 0x4434, 0x2676d, 0x26941, 0x26a3c, 0x26a3e, 0x446f, 0x26a49, 0x26be4, 0x26d0c, 0x26d15, 0x26d0e, 0x26cd4, 0x26cc7, 0x26d14, 0x26cdb, 0x26d5f, 0x26ced, 0x26d09, 0x26ccf, 0x26cff, 0x26d1a, 0x26cf5, 0x26cee, 0x26ce5, 0x26d0d, 0x26cdf, 0x26cec, 0x26cd7, 0x26d2c, 0x26ccd, 0x26ce3, 0x26d00, 0x26ceb, 0x26cfd, 0x26d0f, 0x26d2d, 0x26d50, 0x26cfe, 0x26cc4, 0x26ce1, 0x26cf9, 0x271bb, 0x271bc, 0x4594, 0x4593, 0x271ba, 0x272d0, 0x272d6, 0x272ce, 0x272e1, 0x272d5, 0x272cf, 0x272d3, 0x8714, 0x272d4, 0x275b5, 0x27690, 0x276a1, 0x27681, 0x27685, 0x2766e, 0x27688, 0x27682, 0x27686, 0x2768f, 0x27689, 0x27680, 0x27684, 0x88d1, 0x27829, 0x2782b, 0x2782a, 0x27830, 0x278e2, 0x278e3, 0x278e1, 0x279d5, 0x279c4, 0x279c9, 0x279e0, 0x279df, 0x279cb, 0x279dd, 0x279e8, 0x279d4, 0x27a1c, 0x27bb5,
+		0x27bb6, 0x27be5, 0x27c45, 0x27c49, 0x27c40, 0x27c46, 0x27c44, 0x27c4a, 0x27cc9, 0x27cc4, 0x27cc2, 0x27d68, 0x8ccb, 0x27d82, 0x27d6b, 0x27d67, 0x27d6c, 0x27d6d, 0x27d71, 0x4792, 0x27ed7, 0x27ed2, 0x27ed9, 0x47aa, 0x27ebc, 0x27edd, 0x27ed6, 0x47a7, 0x27ed8, 0x28020, 0x28016, 0x28018, 0x28015, 0x28019, 0x28027, 0x28050, 0x2801d, 0x2802c, 0x2801c, 0x28029, 0x2802b, 0x28024, 0x47ef, 0x28028, 0x28239, 0x282f2, 0x282f0, 0x8eed, 0x282ed, 0x282ef, 0x282ea, 0x28301, 0x284bc, 0x2855c, 0x2855b, 0x2855a, 0x28526, 0x28743, 0x28733, 0x2873a, 0x28737, 0x28736, 0x2873c, 0x28734, 0x2874a, 0x2873b, 0x28735, 0x2873d, 0x28740, 0x2873e, 0x28717, 0x28826, 0x28824, 0x28810, 0x28836, 0x28825, 0x4922, 0x28966, 0x28c7f, 0x28c7a, 0x28cfb, 0x28cfd, 0x28cfc, 0x28cfa, 0x28ed6, 0x28ed2, 0x28ec8, 0x28ec2, 0x28ec7, 0x28ecd, 0x28ec1, 0x28ec6, 0x28ecc, 0x28fa1,
+		0x29095, 0x29094, 0x29097, 0x29096, 0x29093, 0x290a1, 0x29209, 0x29208, 0x29267, 0x29265, 0x29262, 0x29261, 0x29397, 0x29401, 0x29419, 0x29418, 0x2945c, 0x29467, 0x2946c, 0x4ab5, 0x2f9fd, 0x29466, 0x29461, 0x2946e, 0x2945d, 0x2945a, 0x295a2, 0x295a9, 0x295a5, 0x295a6, 0x295b2, 0x295a3, 0x296ac, 0x296aa, 0x296af, 0x296ab, 0x296b1, 0x296c6, 0x296ad, 0x296b9, 0x29870, 0x4b75, 0x29c0c, 0x29c81, 0x29c83, 0x29c7e, 0x29d51, 0x29f99, 0x29f94, 0x29f95, 0x2a2fe, 0x2a418, 0x20105, 0x20372, 0x3482, 0x20399, 0x204ad, 0x20606, 0x207c2, 0x207cb, 0x207d5, 0x207d2, 0x207cc, 0x207d7, 0x207c5, 0x207ca, 0x52ec, 0x208a2, 0x208a3, 0x208a1, 0x52e8, 0x208a6, 0x208a4, 0x208ff, 0x20900, 0x3535, 0x20a06, 0x20a25, 0x20a9f, 0x20b7f, 0x20eea, 0x20eeb, 0x20ede, 0x20f18, 0x20ee0, 0x20ee8, 0x20edf, 0x20ef1, 0x20eec, 0x20f1a, 0x20ee6, 0x20ee1, 0x20f5d, 0x20077,
+		0x20f1e, 0x35f0, 0x20f80, 0x21217, 0x2141d, 0x213f7, 0x213ef, 0x213ee, 0x213ed, 0x213f5, 0x2141e, 0x215bd, 0x257ff, 0x215be, 0x2166d, 0x21676, 0x2184c, 0x21819, 0x21848, 0x2181e, 0x2184a, 0x21849, 0x21826, 0x21995, 0x21a85, 0x21a7e, 0x21a97, 0x21a7d, 0x21b42, 0x21b9e, 0x21c0c, 0x21c0b, 0x21c0e, 0x21ccf, 0x21ed8, 0x21ee0, 0x21ee8, 0x21ee9, 0x21ee2, 0x21ee1, 0x21eda, 0x21ee3, 0x21ed9, 0x21ef0, 0x21ee7, 0x21ee4, 0x22123, 0x22131, 0x22133, 0x2210c, 0x269e0, 0x22126, 0x22124, 0x22132, 0x22127, 0x22121, 0x22130, 0x221a2, 0x221cc, 0x22296, 0x2f88f, 0x22298, 0x2229c, 0x2229b, 0x2236b, 0x22412, 0x22414, 0x2255a, 0x22554, 0x22551, 0x2255e, 0x22558, 0x38f0, 0x22559, 0x2279a, 0x227f2, 0x227ad, 0x22820, 0x227f1, 0x227f0, 0x227f3, 0x227f4, 0x227e9, 0x227fe, 0x2282e, 0x227e8, 0x227e7, 0x227ff, 0x227f5, 0x229e5, 0x229e7, 0x229e6, 0x229e9, 0x22a58,
+		0x22c6c, 0x22cd9, 0x3a3e, 0x22ccb, 0x3a39, 0x22cbe, 0x22cb5, 0x22cd1, 0x22cc2, 0x22cb6, 0x22cd3, 0x2f8c2, 0x22eda, 0x3aa2, 0x22fae, 0x3aa1, 0x22fac, 0x22fa9, 0x22fba, 0x22fa7, 0x22fb3, 0x22fbd, 0x22fb1, 0x2310e, 0x23111, 0x2310f, 0x23252, 0x2326a, 0x23254, 0x23255, 0x23253, 0x23257, 0x2f8d0, 0x2325b, 0x23258, 0x23259, 0x3b3b, 0x235b8, 0x235b5, 0x235bf, 0x235c1, 0x235bb, 0x3bb7, 0x235c3, 0x235ad, 0x235a8, 0x235ae, 0x235aa, 0x235d0, 0x235b1, 0x235bc, 0x235b7, 0x235b0, 0x235fa, 0x235fb, 0x235b9, 0x23907, 0x238fc, 0x238ff, 0x238f2, 0x238f8, 0x238f4, 0x3c57, 0x3c70, 0x23aae, 0x23b0f, 0x23b10, 0x29ac1, 0x23bc7, 0x23bc6, 0x23bce, 0x3ca7, 0x23bdc, 0x23ed5, 0x23ed3, 0x23f20, 0x23ee2, 0x23ef1, 0x23edb, 0x23eea, 0x23eeb, 0x23ee1, 0x23eff, 0x23ed6, 0x23ee0, 0x23ed7, 0x23ee5, 0x3d54, 0x2431b, 0x24310, 0x2430f, 0x2430e, 0x24339, 0x2430a,
+		0x24314, 0x2430c, 0x24523, 0x2455a, 0x245e2, 0x24693, 0x246b0, 0x246ab, 0x246bd, 0x246b1, 0x2468d, 0x246ad, 0x246aa, 0x246ac, 0x246bc, 0x246c9, 0x24847, 0x24862, 0x24840, 0x24844, 0x2483f, 0x24843, 0x2483d, 0x24860, 0x2485f, 0x2483a, 0x24842, 0x2485e, 0x2485d, 0x249bc, 0x249e0, 0x249ee, 0x249eb, 0x24a0d, 0x24b09, 0x24b0c, 0x24b69, 0x24b74, 0x24b67, 0x3f31, 0x7527, 0x24cad, 0x24dff, 0x24e16, 0x24e08, 0x24e09, 0x24e00, 0x24dfc, 0x24e13, 0x24e14, 0x24e0a, 0x2983e, 0x24e29, 0x24e17, 0x24e3b, 0x24dfb, 0x3fbf, 0x24e0e, 0x3fe4, 0x25008, 0x2521b, 0x2521a, 0x25219, 0x25202, 0x404a, 0x25217, 0x25207, 0x25213, 0x25209, 0x2520a, 0x25212, 0x252aa, 0x25238, 0x2537b, 0x2537c, 0x2537a, 0x253ea, 0x253eb, 0x253ef, 0x253f0, 0x25509, 0x25502, 0x25501, 0x2552b, 0x25507, 0x40cf, 0x25528, 0x254fb, 0x2552a, 0x254fe, 0x25504, 0x25529, 0x2550a, 0x798c,
+		0x256c2, 0x7991, 0x256a6, 0x4114, 0x22fc6, 0x21c07, 0x2580a, 0x257fd, 0x25806, 0x2580d, 0x25809, 0x2580b, 0x25800, 0x25805, 0x2583d, 0x259d5, 0x259bf, 0x259ba, 0x259c7, 0x25a98, 0x25a9a, 0x420d, 0x25ba4, 0x25b9c, 0x25b9d, 0x25ba2, 0x25b96, 0x25b95, 0x25ba5, 0x25baa, 0x25bad, 0x25b9a, 0x25ba3, 0x25b97, 0x25b90, 0x25baf, 0x25b8d, 0x4201, 0x25ba8, 0x25bee, 0x25ea3, 0x25e99, 0x25ea1, 0x25e9c, 0x25ea4, 0x25ecb, 0x26056, 0x26048, 0x26059, 0x2604a, 0x2605c, 0x42f1, 0x2604f, 0x2604d, 0x2605d, 0x2623a, 0x262e3, 0x262e1, 0x262de, 0x26397, 0x2639a, 0x26448, 0x2644a, 0x2644c, 0x2644e, 0x2644f, 0x2644b, 0x2644d, 0x26447, 0x26451, 0x264da, 0x264f9, 0x264fc, 0x264fb, 0x264fd, 0x264f8, 0x264fa, 0x2658a, 0x2658e, 0x26588, 0x26589, 0x2658d, 0x26590, 0x2f97e, 0x2671c, 0x267ab, 0x267a4, 0x267a8, 0x267ad, 0x2679f, 0x2679a, 0x267b0, 0x267a5, 0x267d5,
+		0x267a2, 0x267b2, 0x2679d, 0x267a1, 0x26915, 0x26942, 0x26973, 0x26984, 0x269dd, 0x26a5c, 0x26a4c, 0x4476, 0x26a4b, 0x26c67, 0x26da8, 0x26d7f, 0x26d8d, 0x26d8b, 0x26df7, 0x26da9, 0x26df4, 0x26d9a, 0x26d8c, 0x26d95, 0x26d7e, 0x26d77, 0x450a, 0x4503, 0x26d71, 0x26dfd, 0x26d88, 0x26d99, 0x26d6d, 0x26dd4, 0x26d91, 0x26d7d, 0x26d63, 0x26d75, 0x26df9, 0x26d8e, 0x26d74, 0x26dd9, 0x26d66, 0x26dfa, 0x26df5, 0x8660, 0x271c1, 0x271c9, 0x27303, 0x2732c, 0x27334, 0x27317, 0x27309, 0x27304, 0x27306, 0x2731a, 0x2730e, 0x27327, 0x27305, 0x27307, 0x27319, 0x27314, 0x2730c, 0x2731d, 0x27322, 0x27323, 0x275bb, 0x275bc, 0x275ba, 0x276a9, 0x276b5, 0x276ac, 0x276aa, 0x276af, 0x276b7, 0x4635, 0x4636, 0x2783d, 0x27845, 0x27848, 0x2783c, 0x2783e, 0x278ec, 0x278ed, 0x278e9, 0x27a0a, 0x27a0b, 0x279f8, 0x27a04, 0x279f6, 0x279f9, 0x27c53, 0x27c52, 0x27c60,
+		0x27c63, 0x27cce, 0x27ccd, 0x27cd0, 0x27ccc, 0x27d87, 0x27d8d, 0x27d9d, 0x4773, 0x27e63, 0x27ef4, 0x27ef1, 0x27eff, 0x27ef5, 0x27efc, 0x27ef2, 0x27ef6, 0x47b1, 0x27efa, 0x47af, 0x2802e, 0x28045, 0x28043, 0x28051, 0x2804c, 0x28073, 0x28047, 0x2804b, 0x2804f, 0x47f4, 0x28044, 0x2804d, 0x2804e, 0x2804a, 0x28041, 0x28052, 0x2809f, 0x28054, 0x28059, 0x280a2, 0x2824b, 0x28248, 0x2830d, 0x2831f, 0x28308, 0x2830e, 0x2831d, 0x28304, 0x28418, 0x2841b, 0x28566, 0x28562, 0x28583, 0x28567, 0x28762, 0x2874f, 0x2874e, 0x28767, 0x28753, 0x2874d, 0x2874c, 0x28768, 0x28751, 0x48dc, 0x28758, 0x2875b, 0x28750, 0x2876a, 0x28839, 0x28838, 0x28982, 0x28984, 0x28986, 0x28c80, 0x28c86, 0x28d25, 0x28d11, 0x4999, 0x28d0e, 0x28d15, 0x28d0f, 0x28d18, 0x28d0d, 0x28d13, 0x28d16, 0x28d19, 0x28f08, 0x28ef7, 0x28efb, 0x28efc, 0x28fb4, 0x28fbe, 0x28fc1, 0x290a6,
+		0x290a5, 0x290a2, 0x290a4, 0x29212, 0x2920e, 0x2927a, 0x29278, 0x2927d, 0x2927e, 0x29281, 0x29283, 0x29280, 0x29282, 0x2939b, 0x29399, 0x2939c, 0x2939a, 0x293a1, 0x2941a, 0x2941c, 0x2948a, 0x29479, 0x29473, 0x29475, 0x2947c, 0x29478, 0x29460, 0x29483, 0x29470, 0x4ab6, 0x4abd, 0x29487, 0x29484, 0x2947b, 0x2947e, 0x29474, 0x295b9, 0x295b8, 0x295b7, 0x295b5, 0x296cc, 0x296ce, 0x296d1, 0x9904, 0x296cb, 0x999b, 0x2987e, 0x2987a, 0x29877, 0x2988c, 0x298e1, 0x29a20, 0x29a1e, 0x29ac2, 0x29b0f, 0x29b00, 0x4bf4, 0x29b10, 0x29c0d, 0x29c0e, 0x29c8e, 0x29c8d, 0x29c8a, 0x29c8b, 0x29c8c, 0x29c8f, 0x29d57, 0x4c35, 0x29d55, 0x29d5b, 0x29fac, 0x29fa7, 0x29fa0, 0x29f9e, 0x4caa, 0x2a28d, 0x4d1f, 0x2a393, 0x2a394, 0x2a392, 0x20181, 0x203d6, 0x203c3, 0x203e4, 0x203e2, 0x20409, 0x203bf, 0x203c8, 0x203c7, 0x204b5, 0x204b2, 0x2060c, 0x2060b, 0x2060a,
+		0x20605, 0x207d3, 0x207e6, 0x207e9, 0x207f0, 0x207ea, 0x207e7, 0x208b1, 0x208b3, 0x208b4, 0x208b0, 0x2095c, 0x2095d, 0x2095e, 0x209c0, 0x20aaf, 0x3550, 0x20b86, 0x20b83, 0x20b84, 0x20f5c, 0x20f70, 0x20f8c, 0x20f7b, 0x20f66, 0x20f79, 0x20f63, 0x20fc5, 0x20f6b, 0x20f6d, 0x20f72, 0x20f69, 0x20f75, 0x20f89, 0x20fc6, 0x20fa5, 0x203e7, 0x20f6a, 0x20f97, 0x2145a, 0x2145c, 0x21459, 0x21424, 0x21425, 0x21430, 0x21458, 0x21431, 0x21576, 0x215c1, 0x21685, 0x2167d, 0x21865, 0x21887, 0x21852, 0x21854, 0x2188a, 0x21850, 0x21886, 0x2184f, 0x21868, 0x2199d, 0x21aa0, 0x21aba, 0x21abd, 0x21ab8, 0x21ba6, 0x377b, 0x21cde, 0x21cd8, 0x21cd1, 0x21f13, 0x3809, 0x21f0e, 0x21f1b, 0x21f3a, 0x3807, 0x21f1c, 0x21f12, 0x21f16, 0x21f1a, 0x21448, 0x2214b, 0x22137, 0x22136, 0x22138, 0x2213a, 0x22149, 0x2213c, 0x2214a, 0x222b1, 0x222c2, 0x222b5, 0x222c4, 0x222b6,
+		0x2241e, 0x2241f, 0x224af, 0x2256b, 0x2256f, 0x22792, 0x227e4, 0x2282c, 0x227ef, 0x6184, 0x2284f, 0x22852, 0x22850, 0x2283a, 0x22837, 0x22847, 0x22864, 0x22840, 0x2283c, 0x22845, 0x22841, 0x229f3, 0x39bb, 0x22cc1, 0x22cb4, 0x3a4b, 0x22d2a, 0x22d22, 0x22d29, 0x3a4d, 0x22d3e, 0x22d3c, 0x22d30, 0x22fc7, 0x22fd5, 0x22fd6, 0x22fd3, 0x22fce, 0x22fc8, 0x2305c, 0x2305f, 0x23062, 0x3ac2, 0x230bd, 0x230bf, 0x2325c, 0x232b6, 0x3b1a, 0x2329d, 0x2327f, 0x3b12, 0x23294, 0x23281, 0x23347, 0x23346, 0x3b3c, 0x23394, 0x23675, 0x2361a, 0x2362a, 0x23632, 0x23617, 0x23623, 0x23674, 0x3bc3, 0x23676, 0x2362e, 0x23625, 0x23620, 0x23671, 0x23616, 0x23670, 0x2362c, 0x2362f, 0x2361f, 0x23664, 0x3bc0, 0x23908, 0x23914, 0x2390a, 0x2390b, 0x23a4f, 0x23a59, 0x23a54, 0x23a51, 0x23ab6, 0x23bec, 0x23bed, 0x23bea, 0x23eee, 0x23f5a, 0x23f73, 0x23f65, 0x23f61,
+		0x23f55, 0x23f6b, 0x23f64, 0x23f5b, 0x23f4c, 0x23f6f, 0x23f84, 0x23f70, 0x2435d, 0x24357, 0x24366, 0x24353, 0x24398, 0x24397, 0x24528, 0x245eb, 0x245ea, 0x245e8, 0x245ec, 0x245ef, 0x246d1, 0x246ca, 0x729f, 0x246cf, 0x246cd, 0x246ce, 0x24870, 0x24867, 0x24873, 0x2486d, 0x24876, 0x24879, 0x2486a, 0x2488b, 0x24872, 0x24871, 0x2486e, 0x2487a, 0x24a16, 0x24a39, 0x24a3b, 0x24b10, 0x24b96, 0x24b7b, 0x24b7c, 0x24b7d, 0x24cbe, 0x24e5c, 0x24e38, 0x24e39, 0x24e2e, 0x24e34, 0x24e32, 0x24e5b, 0x24e33, 0x24e3c, 0x3fe5, 0x2500e, 0x2500f, 0x25010, 0x25087, 0x2525b, 0x25253, 0x405f, 0x2525c, 0x2523f, 0x25259, 0x2524a, 0x25244, 0x2524c, 0x25240, 0x2525f, 0x2525e, 0x2524e, 0x25254, 0x25243, 0x25287, 0x25382, 0x25385, 0x25531, 0x25547, 0x2552e, 0x2552f, 0x25548, 0x25534, 0x4118, 0x2059f, 0x25832, 0x25836, 0x25833, 0x25831, 0x25840, 0x25841, 0x259dd,
+		0x259d2, 0x259d9, 0x41ad, 0x259df, 0x259db, 0x259d8, 0x259d3, 0x259de, 0x259e0, 0x259d4, 0x259d7, 0x259da, 0x25aa7, 0x25bfe, 0x25bdd, 0x25c40, 0x25be2, 0x421e, 0x25bd6, 0x25bde, 0x25bef, 0x4227, 0x25beb, 0x25bea, 0x25be4, 0x25bd1, 0x4218, 0x25bec, 0x25bfa, 0x25bd9, 0x25bf3, 0x25be1, 0x25bdc, 0x25be5, 0x25bdf, 0x25bd4, 0x4220, 0x25c05, 0x25ed6, 0x25ed5, 0x25ed0, 0x25ec8, 0x25ec4, 0x25ec9, 0x25ecd, 0x25ed2, 0x25ecc, 0x25eca, 0x260a0, 0x26092, 0x42f4, 0x4302, 0x2608b, 0x260a1, 0x26095, 0x26088, 0x26086, 0x2608d, 0x26085, 0x26091, 0x26089, 0x260a2, 0x262f0, 0x262f3, 0x262f4, 0x262ef, 0x26306, 0x263a2, 0x2645e, 0x26463, 0x26460, 0x2645b, 0x2647b, 0x26458, 0x26459, 0x2645d, 0x26505, 0x26506, 0x26502, 0x26503, 0x26504, 0x265a2, 0x265a7, 0x265a6, 0x265a4, 0x265ac, 0x265a9, 0x267e7, 0x26801, 0x267df, 0x267d9, 0x267e3, 0x2680f, 0x2691a,
+		0x26945, 0x26948, 0x2697b, 0x26985, 0x26986, 0x269fe, 0x269ff, 0x26a64, 0x26a71, 0x26a72, 0x26a61, 0x26a62, 0x26a6c, 0x26a60, 0x26a5d, 0x26a63, 0x26a67, 0x26e01, 0x26e2a, 0x26e30, 0x26e06, 0x26e7d, 0x26e22, 0x26eb9, 0x26e0a, 0x26e10, 0x26d8f, 0x26e80, 0x26e13, 0x26e2e, 0x26e18, 0x26e17, 0x26e1a, 0x26e14, 0x26e47, 0x26e63, 0x26e38, 0x4524, 0x26e7f, 0x26e21, 0x26e37, 0x26e31, 0x26e1b, 0x26ea5, 0x26f6c, 0x271d3, 0x27388, 0x2736e, 0x2735c, 0x27374, 0x27354, 0x27383, 0x8770, 0x27365, 0x27360, 0x27370, 0x2736f, 0x2736d, 0x27372, 0x2736c, 0x27376, 0x27375, 0x27359, 0x273c7, 0x275c1, 0x275f9, 0x276e3, 0x276e7, 0x276d6, 0x276cf, 0x276da, 0x276e9, 0x276d2, 0x27855, 0x2784f, 0x2784d, 0x27851, 0x27856, 0x278f9, 0x278f8, 0x27906, 0x27903, 0x27909, 0x278f7, 0x278f5, 0x27a47, 0x27a45, 0x27a3e, 0x27a46, 0x27a29, 0x27a34, 0x27a3f, 0x27a44, 0x27a35,
+		0x27a5a, 0x27a2a, 0x27a33, 0x27a37, 0x27a43, 0x27a39, 0x27a30, 0x27a5d, 0x27a32, 0x27a27, 0x27bbd, 0x27bba, 0x27bbc, 0x27bf1, 0x27bf0, 0x27c61, 0x27c5c, 0x27c58, 0x27c5d, 0x27cda, 0x27cd9, 0x27cdd, 0x27cdc, 0x27cde, 0x477d, 0x27da0, 0x27da2, 0x27f0f, 0x27f13, 0x27f12, 0x27f11, 0x27f14, 0x27f19, 0x27f0e, 0x27f17, 0x27f21, 0x27f20, 0x27f16, 0x28078, 0x8e37, 0x28087, 0x28079, 0x28080, 0x28077, 0x28081, 0x28046, 0x2807a, 0x2809c, 0x28083, 0x28084, 0x280ad, 0x2809d, 0x2809e, 0x28255, 0x28336, 0x2832b, 0x28327, 0x28346, 0x2832c, 0x28345, 0x28333, 0x2832d, 0x28334, 0x28322, 0x4864, 0x2841f, 0x285b3, 0x2858c, 0x2858f, 0x285af, 0x285ad, 0x2858e, 0x285ac, 0x285b0, 0x285b1, 0x285ae, 0x28599, 0x286eb, 0x28774, 0x28775, 0x2878e, 0x2876d, 0x28770, 0x2878c, 0x2884f, 0x28851, 0x28858, 0x2884c, 0x2884e, 0x28915, 0x289be, 0x289db, 0x289b3, 0x289ae,
+		0x28c87, 0x28c8a, 0x28c88, 0x28c8b, 0x28c8c, 0x28d44, 0x28d2d, 0x28d2a, 0x28d31, 0x28d2c, 0x28d45, 0x28d30, 0x28d29, 0x28d46, 0x28ef4, 0x28f14, 0x28f10, 0x28f0f, 0x28f12, 0x28f0b, 0x28f0c, 0x28f0a, 0x28f13, 0x28f0e, 0x28fd9, 0x28fd0, 0x9703, 0x290c1, 0x290bf, 0x290bd, 0x290bc, 0x290ba, 0x290bb, 0x290d1, 0x290be, 0x290d0, 0x290b9, 0x2921a, 0x2921c, 0x2921b, 0x29291, 0x29296, 0x2929f, 0x2929c, 0x2929a, 0x2929d, 0x293ad, 0x293a5, 0x293ae, 0x29403, 0x29426, 0x29420, 0x29423, 0x29424, 0x29421, 0x29428, 0x29425, 0x2941e, 0x29494, 0x29493, 0x2948f, 0x2949a, 0x294ad, 0x4ac2, 0x295ca, 0x295c5, 0x296bb, 0x296e1, 0x296ea, 0x296e4, 0x296ed, 0x296e6, 0x296e0, 0x296e8, 0x296e5, 0x2981a, 0x29894, 0x29896, 0x2989e, 0x29895, 0x298a1, 0x2988e, 0x2989b, 0x29892, 0x29897, 0x29899, 0x29893, 0x29a32, 0x29a2f, 0x29a2e, 0x29a33, 0x29b1c, 0x29b1e, 0x29b11,
+		0x29b20, 0x29b1f, 0x29b19, 0x29b16, 0x29cac, 0x29cb9, 0x29cb3, 0x29ca6, 0x29ca2, 0x29ca9, 0x29ca7, 0x29d6c, 0x29d6d, 0x29d69, 0x29d80, 0x29d66, 0x29d65, 0x29d71, 0x29d6b, 0x29fbd, 0x29fc1, 0x29fd3, 0x29fb6, 0x4cac, 0x29fb9, 0x29fd4, 0x29fb7, 0x29fdb, 0x29fb8, 0x29fc0, 0x29fbc, 0x29fd5, 0x29fbf, 0x29fc3, 0x29fc9, 0x2a258, 0x2a290, 0x2a295, 0x2a297, 0x2a309, 0x2a308, 0x2a306, 0x2a305, 0x2a310, 0x2a397, 0x2a395, 0x2a3ee, 0x2a41f, 0x2fa1a, 0x21b4b, 0x2018e, 0x203e8, 0x203f0, 0x203f4, 0x20406, 0x203ed, 0x349b, 0x203e9, 0x20400, 0x204b8, 0x2056d, 0x20804, 0x20801, 0x20803, 0x20802, 0x52f6, 0x3526, 0x20962, 0x20aba, 0x20b13, 0x20fde, 0x20fd7, 0x20fe4, 0x20fce, 0x20fe3, 0x20fdd, 0x2103a, 0x20fdb, 0x20fd6, 0x20fe0, 0x20fd4, 0x20fcb, 0x20fe1, 0x20fc9, 0x20fdf, 0x2103c, 0x20ffc, 0x20fcf, 0x21479, 0x21476, 0x21478, 0x21687, 0x2189c, 0x218b1,
+		0x218c1, 0x2189a, 0x21892, 0x41af, 0x218c2, 0x21896, 0x218c0, 0x21891, 0x21895, 0x219a2, 0x21ac3, 0x21ac0, 0x21ac2, 0x20b8a, 0x21bb0, 0x21c16, 0x21f46, 0x21f4a, 0x21f3e, 0x21f45, 0x21f42, 0x3815, 0x21f5b, 0x21f44, 0x22004, 0x24022, 0x22155, 0x22157, 0x22151, 0x2214e, 0x2215a, 0x222c6, 0x222c3, 0x389c, 0x222c5, 0x222cc, 0x22371, 0x22426, 0x224b2, 0x2257a, 0x22584, 0x2257b, 0x22874, 0x2288c, 0x22851, 0x22848, 0x2288d, 0x22892, 0x22898, 0x22893, 0x228b0, 0x2284e, 0x22896, 0x22897, 0x2289c, 0x228ca, 0x22d33, 0x22d83, 0x22d84, 0x22d9a, 0x22d8c, 0x64cc, 0x22d99, 0x22ee0, 0x22fe4, 0x22ff8, 0x22fed, 0x22ff3, 0x22ff4, 0x22ff5, 0x22ffd, 0x23119, 0x2329e, 0x232c4, 0x2329f, 0x2339b, 0x2339f, 0x2339a, 0x236aa, 0x2369d, 0x23692, 0x236a2, 0x236af, 0x236eb, 0x236a0, 0x236a1, 0x23694, 0x23698, 0x2368f, 0x23687, 0x23684, 0x236a9, 0x2367c, 0x23918,
+		0x23a5f, 0x23a62, 0x23a61, 0x23ac0, 0x23b15, 0x23bfc, 0x23bf9, 0x23fc8, 0x23fc9, 0x23fde, 0x23fca, 0x23fe2, 0x3d64, 0x3d6e, 0x24004, 0x23fcc, 0x23fdd, 0x23fe4, 0x23fd3, 0x23fc7, 0x23fc6, 0x3d5f, 0x24037, 0x243d8, 0x243ee, 0x243b2, 0x243a3, 0x243b3, 0x243ed, 0x243f8, 0x24531, 0x2455b, 0x24566, 0x24569, 0x24596, 0x2459b, 0x245f7, 0x245f3, 0x245f4, 0x245f5, 0x246e2, 0x246e7, 0x246e5, 0x246e9, 0x246e6, 0x246e3, 0x24874, 0x2487f, 0x2488f, 0x24890, 0x24897, 0x248a3, 0x2488e, 0x24898, 0x2488c, 0x24a42, 0x24a44, 0x24a69, 0x24a43, 0x24a68, 0x3ef6, 0x24b8d, 0x24b88, 0x24b8b, 0x24b89, 0x24ccc, 0x24d1f, 0x24e80, 0x24e5e, 0x24e67, 0x24e68, 0x24e65, 0x764a, 0x24e74, 0x24e69, 0x24e61, 0x24e62, 0x24e6c, 0x24e93, 0x24e86, 0x24f9d, 0x24f9b, 0x2501b, 0x25016, 0x25019, 0x25014, 0x25018, 0x25015, 0x25099, 0x25098, 0x2525d, 0x25289, 0x2527a, 0x2527d,
+		0x2524b, 0x4073, 0x25278, 0x2527f, 0x25277, 0x2527e, 0x25279, 0x252ab, 0x2527c, 0x25274, 0x25275, 0x252a7, 0x2538d, 0x2554e, 0x25566, 0x25561, 0x2554d, 0x2554f, 0x40dd, 0x25554, 0x2557c, 0x256da, 0x256de, 0x256d8, 0x256dd, 0x256df, 0x25866, 0x25862, 0x2585f, 0x25864, 0x25863, 0x25860, 0x25888, 0x2586a, 0x25867, 0x25887, 0x2583f, 0x2586c, 0x2586e, 0x258ad, 0x259f1, 0x259f4, 0x259f6, 0x259f5, 0x259f8, 0x259fb, 0x259ec, 0x259ef, 0x259ed, 0x7ab9, 0x259f7, 0x259f9, 0x259fd, 0x25ab1, 0x25c36, 0x25c1b, 0x25c32, 0x25c42, 0x25c4d, 0x25c1f, 0x25c21, 0x25c1c, 0x25c31, 0x25c2e, 0x25c47, 0x25c3b, 0x25c41, 0x25c18, 0x25c39, 0x25c1d, 0x25c27, 0x25c23, 0x7bd7, 0x25eee, 0x25ee8, 0x25ee5, 0x25eef, 0x25ee4, 0x25eec, 0x25ef0, 0x260d7, 0x260d8, 0x260d4, 0x260ca, 0x260d2, 0x260cb, 0x260d3, 0x260e6, 0x260e2, 0x26249, 0x26248, 0x262ff, 0x26309, 0x263b0,
+		0x263af, 0x2647c, 0x26478, 0x2647a, 0x26472, 0x26479, 0x2647e, 0x2650c, 0x2650b, 0x265cd, 0x265be, 0x265bc, 0x265bf, 0x265c0, 0x265bd, 0x26838, 0x26808, 0x26805, 0x2683b, 0x26810, 0x2680c, 0x2680d, 0x26804, 0x2683a, 0x26813, 0x26837, 0x26839, 0x2691f, 0x2694b, 0x269ee, 0x26a75, 0x26a78, 0x26a7c, 0x26a74, 0x26a76, 0x26aea, 0x26aeb, 0x26dfb, 0x26e19, 0x26ea7, 0x26e8c, 0x26edc, 0x26e98, 0x26ebe, 0x26e9e, 0x26f0f, 0x26e9f, 0x26edd, 0x26e93, 0x26ebb, 0x26eb6, 0x26e90, 0x26ea1, 0x26ebd, 0x26ede, 0x26e3a, 0x26f22, 0x26e97, 0x26e94, 0x26ec3, 0x26e8e, 0x26ea8, 0x26e99, 0x26ead, 0x26e9b, 0x26ea2, 0x26f21, 0x26eac, 0x26f0e, 0x26f31, 0x271e1, 0x271de, 0x271df, 0x271dc, 0x271dd, 0x271ec, 0x271e7, 0x27369, 0x273b8, 0x273a1, 0x273a8, 0x273ba, 0x273c2, 0x273a6, 0x273a4, 0x273a3, 0x273ab, 0x273bc, 0x273b7, 0x273bf, 0x273ad, 0x273b1, 0x273ca, 0x273c4,
+		0x273b9, 0x275c8, 0x275c6, 0x275c7, 0x2770a, 0x2770d, 0x276fb, 0x27703, 0x27702, 0x276fc, 0x276f9, 0x276f8, 0x2786e, 0x27863, 0x27862, 0x27861, 0x2786b, 0x4688, 0x2786f, 0x27866, 0x2786c, 0x2790e, 0x27915, 0x27916, 0x27910, 0x27917, 0x27911, 0x27a6e, 0x27a6c, 0x27a87, 0x27a83, 0x27a63, 0x27adc, 0x27bc0, 0x27bf7, 0x27bfa, 0x27c70, 0x27c6a, 0x27c68, 0x27c69, 0x27c84, 0x27cec, 0x27ce7, 0x27cee, 0x27dba, 0x27db2, 0x27db5, 0x27dcb, 0x2080b, 0x27dd0, 0x27e6d, 0x27e6c, 0x47bb, 0x27f45, 0x27f46, 0x27f34, 0x27f2c, 0x27f35, 0x27f44, 0x47ba, 0x27f76, 0x280b1, 0x280aa, 0x280a1, 0x280b2, 0x4812, 0x280a6, 0x280b5, 0x280b4, 0x280b8, 0x280af, 0x280b0, 0x280a3, 0x28262, 0x28264, 0x28356, 0x28351, 0x2834f, 0x28368, 0x2834c, 0x28350, 0x4861, 0x28348, 0x2834a, 0x28421, 0x285e3, 0x285b9, 0x285de, 0x285b7, 0x285e1, 0x285b6, 0x285b5, 0x285df, 0x48e1,
+		0x28797, 0x2879a, 0x2879b, 0x28798, 0x28792, 0x28793, 0x287d7, 0x28773, 0x2886b, 0x28874, 0x28878, 0x2886d, 0x28918, 0x289e9, 0x289f5, 0x289ea, 0x28a2e, 0x289e7, 0x289fe, 0x289e5, 0x28a36, 0x289f0, 0x289e6, 0x28a2c, 0x28c89, 0x28c95, 0x28c93, 0x28d4d, 0x28d4a, 0x28d4f, 0x28d50, 0x28d4b, 0x28f2a, 0x28f2b, 0x28f2f, 0x28f2e, 0x28f7c, 0x28fed, 0x28fe2, 0x290e0, 0x290dc, 0x290da, 0x290d6, 0x290f4, 0x290d9, 0x290d5, 0x29222, 0x29221, 0x29224, 0x29225, 0x29226, 0x29223, 0x292aa, 0x292af, 0x292b0, 0x292ab, 0x293af, 0x293b7, 0x293b5, 0x293b2, 0x293b3, 0x2942b, 0x294d8, 0x294c2, 0x294af, 0x294bc, 0x294b8, 0x294be, 0x294b7, 0x294b4, 0x294bf, 0x294b3, 0x294b1, 0x294bb, 0x294bd, 0x294d6, 0x294dd, 0x295d8, 0x295d3, 0x295d5, 0x295e3, 0x295e2, 0x295d9, 0x295de, 0x295df, 0x295da, 0x295d4, 0x296f3, 0x296e2, 0x2970d, 0x29701, 0x29705, 0x2971a, 0x29703,
+		0x2971f, 0x29716, 0x296fa, 0x296fc, 0x2970a, 0x999f, 0x298bc, 0x298ca, 0x298b6, 0x298c7, 0x298bf, 0x4b89, 0x298b9, 0x4b8c, 0x298b0, 0x298b8, 0x298bd, 0x29891, 0x298bb, 0x298be, 0x29a3e, 0x29a3d, 0x29b38, 0x29b3d, 0x29b39, 0x29b33, 0x29c33, 0x29cbb, 0x29cc6, 0x29cc5, 0x29cc7, 0x29ccb, 0x29ca8, 0x29cc8, 0x29cbe, 0x29cc1, 0x29cbd, 0x29d82, 0x29d9e, 0x29d81, 0x29d84, 0x9b8d, 0x29d96, 0x29d8e, 0x29d88, 0x29d87, 0x29fe0, 0x2a00d, 0x29fdf, 0x29fe4, 0x29fe2, 0x29fdd, 0x29fec, 0x29fde, 0x29fe7, 0x29fea, 0x29fe3, 0x2a25c, 0x9e77, 0x2a25d, 0x2a29c, 0x2a31d, 0x2a39d, 0x2a39e, 0x2a39b, 0x2a3b5, 0x2a3b9, 0x2a3b6, 0x2a3f3, 0x2a3f2, 0x2a3f4, 0x2a426, 0x2a427, 0x2a425, 0x2a506, 0x2a50b, 0x2a53a, 0x2a53c, 0x2040a, 0x2040b, 0x204bf, 0x204fd, 0x20618, 0x291df, 0x25390, 0x2069e, 0x207fc, 0x20810, 0x2080f, 0x2080d, 0x208b9, 0x208b7, 0x208ba, 0x2090a,
+		0x21041, 0x2108b, 0x21046, 0x21053, 0x210e2, 0x2103f, 0x20f7c, 0x2104b, 0x2104e, 0x2108a, 0x21047, 0x21493, 0x27dce, 0x2148c, 0x214af, 0x214c9, 0x215c6, 0x215c8, 0x21691, 0x218cf, 0x218d4, 0x218ce, 0x21add, 0x21ad4, 0x21f49, 0x21f63, 0x21f5d, 0x381a, 0x21f67, 0x21fbb, 0x21f60, 0x21f80, 0x2215f, 0x22160, 0x222da, 0x222d2, 0x222dd, 0x2258e, 0x22588, 0x2256c, 0x2289e, 0x228c9, 0x228a4, 0x3998, 0x228d9, 0x228d2, 0x228da, 0x228dd, 0x228ce, 0x228fc, 0x22a14, 0x22a60, 0x22dd7, 0x22dcb, 0x22dcd, 0x22dd5, 0x22ee7, 0x23000, 0x2300a, 0x2300f, 0x23002, 0x23001, 0x2311d, 0x232ca, 0x232cb, 0x232cd, 0x2334f, 0x2339c, 0x23680, 0x2370d, 0x23702, 0x3bf1, 0x23707, 0x236f7, 0x236f8, 0x236fd, 0x23724, 0x236fb, 0x23739, 0x2373a, 0x23922, 0x6ba9, 0x23a6a, 0x23a6d, 0x23a74, 0x23c0e, 0x23fdf, 0x2402b, 0x23fe3, 0x24026, 0x2402a, 0x24023, 0x24035, 0x2404a,
+		0x243fd, 0x24400, 0x2441e, 0x243ff, 0x2459e, 0x245fa, 0x246f1, 0x24895, 0x248a8, 0x248a6, 0x24a6e, 0x24a83, 0x24a6d, 0x24b8f, 0x3f6a, 0x24cdf, 0x24cd5, 0x24ce0, 0x24cd3, 0x24cd8, 0x24e8c, 0x764b, 0x24e94, 0x24e96, 0x24e85, 0x24e8f, 0x24ea9, 0x24e8e, 0x24e90, 0x24eb8, 0x24ec3, 0x24ebb, 0x24fa5, 0x24fa2, 0x76a2, 0x24fa3, 0x250b5, 0x250ac, 0x250a8, 0x252ad, 0x252b8, 0x252b4, 0x252ae, 0x252b6, 0x4485, 0x252c1, 0x252bf, 0x25392, 0x25400, 0x25401, 0x25575, 0x25572, 0x25578, 0x25570, 0x2557e, 0x2557d, 0x2557f, 0x256ef, 0x2586d, 0x2588e, 0x2588f, 0x2588a, 0x2588d, 0x25895, 0x2588b, 0x2588c, 0x258b0, 0x25893, 0x259fc, 0x25a15, 0x25a0e, 0x25a18, 0x25a11, 0x25a0d, 0x25abb, 0x25ab3, 0x4239, 0x25ca1, 0x25c9a, 0x25c84, 0x25ca0, 0x25c86, 0x25c9d, 0x25caa, 0x25c78, 0x25c8d, 0x25c8a, 0x25ca6, 0x25c7a, 0x25c97, 0x25c88, 0x25c8e, 0x423d, 0x4244,
+		0x25c76, 0x25c81, 0x25c85, 0x25c75, 0x25ca8, 0x25c8f, 0x25c91, 0x25ca2, 0x25c9c, 0x25c89, 0x25c7f, 0x25c96, 0x25c79, 0x25c9f, 0x25ca7, 0x4246, 0x25c87, 0x25c9b, 0x25ca5, 0x25c8b, 0x25cc9, 0x25eff, 0x25f03, 0x25f00, 0x25f02, 0x25f04, 0x25f05, 0x25ee6, 0x25f1f, 0x26114, 0x430e, 0x2610c, 0x2610f, 0x26119, 0x2610b, 0x26113, 0x260d5, 0x4310, 0x2611c, 0x26250, 0x2624e, 0x26312, 0x263b9, 0x26485, 0x26488, 0x26490, 0x26513, 0x265d0, 0x265d1, 0x265d2, 0x265d7, 0x265d6, 0x265e5, 0x26846, 0x2686b, 0x26845, 0x2683f, 0x2683e, 0x443e, 0x2686a, 0x26868, 0x2684c, 0x26923, 0x26922, 0x26997, 0x26991, 0x25ab5, 0x26998, 0x2699c, 0x26a89, 0x4484, 0x26a8b, 0x26a8a, 0x26a8d, 0x26a8e, 0x26a88, 0x26f4e, 0x26f44, 0x26f37, 0x26f75, 0x26f54, 0x26f76, 0x26f34, 0x26f6b, 0x26f32, 0x26f57, 0x26f52, 0x26f45, 0x4549, 0x26eb1, 0x26f4b, 0x26f47, 0x26f33, 0x26f40,
+		0x26f3c, 0x26f43, 0x26f4f, 0x26f55, 0x26f41, 0x26fb2, 0x26fc0, 0x27434, 0x273f9, 0x27419, 0x2740d, 0x273fa, 0x2741e, 0x2741f, 0x2740e, 0x27440, 0x27408, 0x45df, 0x27413, 0x2744c, 0x45f0, 0x275cc, 0x275cb, 0x27724, 0x27725, 0x2773d, 0x27720, 0x27727, 0x27726, 0x2771d, 0x2771e, 0x27732, 0x2776c, 0x27759, 0x468a, 0x2787a, 0x27879, 0x2791b, 0x2792e, 0x27923, 0x27920, 0x2791f, 0x27ab6, 0x27ab9, 0x27aa1, 0x27aa3, 0x27aa8, 0x27aaf, 0x27a9a, 0x27a99, 0x27aa2, 0x27a9d, 0x4702, 0x27aab, 0x27bc9, 0x27bfe, 0x27c00, 0x27bfc, 0x27c07, 0x27c75, 0x27c72, 0x27c73, 0x27c74, 0x27cfa, 0x27cfc, 0x27cf8, 0x27cf6, 0x27cfb, 0x27dcd, 0x27dd1, 0x27dcf, 0x27e74, 0x27e72, 0x27e73, 0x27f54, 0x27f51, 0x47c0, 0x27f53, 0x27f49, 0x27f4c, 0x27f4a, 0x27f4f, 0x27f56, 0x28100, 0x280d5, 0x280d7, 0x280ff, 0x280d9, 0x280e3, 0x280d3, 0x4815, 0x280d8, 0x280d4, 0x280de,
+		0x28271, 0x28374, 0x28388, 0x2837f, 0x2836b, 0x28387, 0x28379, 0x28378, 0x28389, 0x28380, 0x28376, 0x28429, 0x28428, 0x2842f, 0x285e5, 0x28604, 0x28603, 0x285f0, 0x285e8, 0x285ea, 0x285f1, 0x28601, 0x28602, 0x287a9, 0x287ab, 0x287b7, 0x287b6, 0x48e2, 0x288a0, 0x2888e, 0x28886, 0x28887, 0x28885, 0x2888b, 0x28888, 0x28890, 0x2891a, 0x28934, 0x28a37, 0x28a2f, 0x28a30, 0x28a39, 0x28a34, 0x28a33, 0x28a85, 0x28a84, 0x28a3a, 0x28c9f, 0x28d69, 0x28d6c, 0x28d6a, 0x28d67, 0x28d6b, 0x28f3f, 0x28f40, 0x2900b, 0x290d2, 0x290f8, 0x29101, 0x290fa, 0x29116, 0x2f9f6, 0x2922c, 0x2922d, 0x2922f, 0x2922e, 0x292c1, 0x292f5, 0x292c4, 0x292de, 0x292cc, 0x292d2, 0x292c2, 0x292cd, 0x292cf, 0x292dd, 0x292c8, 0x293ba, 0x293be, 0x293bf, 0x2942e, 0x29430, 0x294e7, 0x294ee, 0x294e8, 0x294e6, 0x294e2, 0x294e4, 0x294fb, 0x294fc, 0x294ea, 0x295ed, 0x295f6, 0x295f3,
+		0x295f4, 0x295f1, 0x295f7, 0x295f5, 0x295ea, 0x295eb, 0x29700, 0x2972c, 0x29712, 0x2973d, 0x29733, 0x4b4d, 0x29730, 0x29740, 0x29731, 0x29757, 0x4b4a, 0x29735, 0x29732, 0x2972d, 0x29736, 0x29738, 0x29762, 0x2975f, 0x2978a, 0x298d6, 0x298d8, 0x298dd, 0x298e4, 0x298e3, 0x29a4d, 0x29ad7, 0x29b4a, 0x29b4c, 0x29b50, 0x29b4b, 0x29b4e, 0x29b4f, 0x29c39, 0x29cd2, 0x29ccd, 0x29cd3, 0x29ce1, 0x29cce, 0x29cd5, 0x29cdd, 0x29cd4, 0x29ccf, 0x29dcb, 0x29da6, 0x29dc2, 0x29da5, 0x29da9, 0x29da2, 0x29da4, 0x29db0, 0x29dcc, 0x29daf, 0x29dbf, 0x2a024, 0x2a01a, 0x2a014, 0x2a03a, 0x2a020, 0x2a01b, 0x2a021, 0x2a025, 0x2a01e, 0x2a03f, 0x2a040, 0x2a018, 0x2a02c, 0x2a015, 0x2a02d, 0x2a01f, 0x2a029, 0x2a04b, 0x2a2b3, 0x2a2a5, 0x2a2a7, 0x2a2ab, 0x2a2a6, 0x2a2aa, 0x2a322, 0x2a323, 0x2a3a1, 0x2a3a3, 0x2a3a0, 0x2a3bd, 0x2a3ba, 0x2a3fa, 0x2a3f8, 0x2a3fc, 0x2a3f6,
+		0x2a434, 0x2a443, 0x4d63, 0x2a4d4, 0x2a50d, 0x2a543, 0x2a541, 0x2a53d, 0x2a540, 0x2a53e, 0x2a53f, 0x2a546, 0x4d93, 0x2a5d4, 0x20435, 0x20436, 0x20432, 0x2043a, 0x204c8, 0x20536, 0x2061e, 0x2061d, 0x2061f, 0x2081c, 0x2081d, 0x20820, 0x350e, 0x208c0, 0x2109a, 0x21093, 0x210dd, 0x360a, 0x210a3, 0x210a9, 0x2109c, 0x2109b, 0x360d, 0x21097, 0x214b1, 0x214ca, 0x214b3, 0x214cd, 0x218ea, 0x218ee, 0x218ef, 0x21adf, 0x21b50, 0x21bbb, 0x21f88, 0x21f89, 0x21f8c, 0x21f85, 0x21f5e, 0x21f94, 0x21f95, 0x2216b, 0x2216a, 0x22169, 0x222e3, 0x222f1, 0x22437, 0x22433, 0x22591, 0x2258f, 0x22597, 0x228d4, 0x228de, 0x228d3, 0x228e0, 0x22943, 0x2290b, 0x228ff, 0x22904, 0x22907, 0x22df9, 0x22dfa, 0x22dfb, 0x22df8, 0x23012, 0x23010, 0x2311f, 0x232df, 0x232e3, 0x23352, 0x233a9, 0x23765, 0x23787, 0x23742, 0x23752, 0x2374c, 0x2369f, 0x23701, 0x23748, 0x2374f,
+		0x2377e, 0x2374d, 0x23758, 0x23747, 0x2375e, 0x23749, 0x2374e, 0x2375d, 0x2375a, 0x23786, 0x23751, 0x23929, 0x239a6, 0x239a7, 0x23a70, 0x23a6f, 0x23ad3, 0x23ad2, 0x23c28, 0x23c1b, 0x23c1a, 0x23c1c, 0x23c21, 0x24032, 0x24066, 0x2407d, 0x24073, 0x2407f, 0x24065, 0x24080, 0x24061, 0x24075, 0x2406e, 0x24067, 0x24071, 0x2406c, 0x24063, 0x24062, 0x24083, 0x240b2, 0x24081, 0x2406f, 0x2406b, 0x24082, 0x2408a, 0x24429, 0x24430, 0x24431, 0x24597, 0x24600, 0x245ff, 0x248b6, 0x248b5, 0x248c3, 0x24a8d, 0x24b18, 0x24b9c, 0x24b9a, 0x24b99, 0x24eb7, 0x24ec4, 0x24ec6, 0x24ec7, 0x24ebc, 0x24ec0, 0x24ec5, 0x24ecd, 0x24ec1, 0x24ebe, 0x24fa7, 0x24fb8, 0x25023, 0x4074, 0x252e8, 0x252de, 0x252e3, 0x252ef, 0x252dc, 0x252e4, 0x252e1, 0x252e5, 0x25395, 0x25394, 0x25393, 0x2538e, 0x25598, 0x2559c, 0x25599, 0x255a0, 0x2559e, 0x25704, 0x258d3, 0x258b4, 0x258bb,
+		0x258b7, 0x258b8, 0x258bd, 0x258b6, 0x258b9, 0x258b5, 0x25a22, 0x25a21, 0x25ac3, 0x25abc, 0x25cff, 0x25ce5, 0x25cf7, 0x25cd2, 0x25d00, 0x25cdb, 0x25cf0, 0x25ce2, 0x25ccd, 0x4256, 0x25ce1, 0x25cf2, 0x25cdc, 0x25ccc, 0x25cd6, 0x25cf3, 0x25cfa, 0x25cf6, 0x25cec, 0x25cea, 0x25ce3, 0x25cd0, 0x425a, 0x25c95, 0x25cd5, 0x25cf4, 0x25ccb, 0x25cda, 0x25cc8, 0x25cdf, 0x25cf5, 0x25ccf, 0x25cc7, 0x25cd7, 0x25f24, 0x42a3, 0x25f1c, 0x25f21, 0x25f1e, 0x25f18, 0x25f1b, 0x42a8, 0x26146, 0x2614b, 0x26148, 0x26147, 0x26167, 0x26154, 0x26315, 0x26322, 0x263c5, 0x26329, 0x263c7, 0x2648d, 0x2651b, 0x26520, 0x265e2, 0x265e7, 0x265e8, 0x265e1, 0x26872, 0x2687b, 0x26874, 0x26871, 0x26879, 0x26875, 0x26890, 0x26877, 0x2687d, 0x2694f, 0x26950, 0x269a3, 0x269a2, 0x269f4, 0x26a94, 0x26a9a, 0x26a9b, 0x26aa7, 0x26a97, 0x26a95, 0x26a92, 0x26a9c, 0x26a96, 0x26fb6,
+		0x26fb8, 0x26fb0, 0x27018, 0x26fc5, 0x26fb5, 0x26fc2, 0x27006, 0x27019, 0x26fb9, 0x27015, 0x26fd6, 0x26fac, 0x453c, 0x26fc6, 0x26fb3, 0x26fc3, 0x26fca, 0x26fcf, 0x26fbd, 0x26fce, 0x27014, 0x26fba, 0x2701a, 0x26fc1, 0x26fbb, 0x459b, 0x459a, 0x27201, 0x271fc, 0x2745a, 0x27454, 0x27461, 0x2745c, 0x27455, 0x2744a, 0x2744b, 0x27451, 0x27469, 0x2746b, 0x87f1, 0x27466, 0x27458, 0x2745d, 0x27467, 0x27456, 0x27488, 0x27464, 0x2744e, 0x27757, 0x2775a, 0x27751, 0x2774a, 0x2774b, 0x465e, 0x27747, 0x2776f, 0x2776a, 0x2776b, 0x27746, 0x2776d, 0x27754, 0x2776e, 0x2774c, 0x27878, 0x27886, 0x27882, 0x46a7, 0x27ae1, 0x27ae5, 0x27adb, 0x27ade, 0x27ad7, 0x27c03, 0x27c7c, 0x27c7e, 0x27d05, 0x27d07, 0x27de6, 0x27de1, 0x27dfb, 0x27de5, 0x27de7, 0x27ddf, 0x27dff, 0x27e76, 0x27f63, 0x27f66, 0x27f65, 0x27f5e, 0x27f64, 0x27f6b, 0x27f5f, 0x27f67, 0x27f68,
+		0x28108, 0x28109, 0x28117, 0x28115, 0x2811b, 0x2810b, 0x28128, 0x2810e, 0x28118, 0x28153, 0x28145, 0x2810d, 0x2810a, 0x28113, 0x2814a, 0x28279, 0x283a1, 0x2838d, 0x283a2, 0x28390, 0x486e, 0x28431, 0x28430, 0x2861f, 0x28619, 0x2860c, 0x2861e, 0x2861d, 0x28607, 0x28766, 0x287c5, 0x287ba, 0x287bd, 0x287c2, 0x287c3, 0x287bf, 0x490d, 0x288a1, 0x288a2, 0x288a8, 0x288a3, 0x288aa, 0x288af, 0x288b9, 0x28937, 0x28a8f, 0x4943, 0x28a8e, 0x28a87, 0x28a8a, 0x28a92, 0x28a97, 0x28a9f, 0x28b05, 0x28ca9, 0x28ca7, 0x28d8a, 0x28d82, 0x28d85, 0x28d8b, 0x28d89, 0x28d81, 0x28d80, 0x28d87, 0x28d86, 0x28f4d, 0x2901c, 0x2901f, 0x29121, 0x2911d, 0x29122, 0x290fe, 0x2911b, 0x2913a, 0x29137, 0x29117, 0x29138, 0x29126, 0x29118, 0x29234, 0x29235, 0x29232, 0x4a73, 0x292e1, 0x292fd, 0x292e3, 0x292e8, 0x292f9, 0x292ff, 0x292fe, 0x4a74, 0x292e0, 0x29300, 0x4a70,
+		0x292ec, 0x292e4, 0x292ef, 0x292fa, 0x293ca, 0x29431, 0x29432, 0x29434, 0x29441, 0x29500, 0x29506, 0x29508, 0x29505, 0x29503, 0x2950b, 0x29502, 0x2950a, 0x295f0, 0x29613, 0x2960a, 0x2960f, 0x29611, 0x29608, 0x2960b, 0x29612, 0x2960d, 0x2975b, 0x29763, 0x29761, 0x29768, 0x2975a, 0x2984e, 0x2984d, 0x29850, 0x298fc, 0x29912, 0x298f5, 0x2991e, 0x298f0, 0x298f3, 0x298f2, 0x29901, 0x298ef, 0x298ee, 0x29916, 0x298f6, 0x298fb, 0x2991c, 0x4bdb, 0x29a5d, 0x29a60, 0x29a66, 0x29a5f, 0x29a61, 0x29a5b, 0x29a62, 0x29a57, 0x29b69, 0x29b6b, 0x29b61, 0x29b77, 0x29b5f, 0x29b63, 0x29b62, 0x29b65, 0x29cef, 0x29ce2, 0x29d00, 0x29ce8, 0x29cf2, 0x29ce7, 0x4c29, 0x29ce5, 0x29dd5, 0x29dd0, 0x29dda, 0x29dd3, 0x4c51, 0x29dd9, 0x29dcf, 0x29dd6, 0x29dd8, 0x29df5, 0x29dce, 0x29dd7, 0x29df4, 0x29dcd, 0x29e01, 0x9bba, 0x2a052, 0x2a055, 0x2a05a, 0x2a04d, 0x2a054,
+		0x2a053, 0x2a05e, 0x2a067, 0x2a065, 0x2a04e, 0x4cdb, 0x2a04f, 0x2a061, 0x2a06e, 0x2a051, 0x2a05b, 0x2a263, 0x2a2b7, 0x2a2b6, 0x2a2c3, 0x2a2bb, 0x2a2b5, 0x2a32e, 0x2a330, 0x2a333, 0x2a331, 0x2a3a4, 0x2a3c1, 0x2a3bb, 0x2a3be, 0x2a400, 0x2a3ff, 0x2a440, 0x4d65, 0x2a43f, 0x2a444, 0x4d64, 0x4d67, 0x2a4db, 0x2a50f, 0x2a511, 0x2a54e, 0x2a54d, 0x2a54b, 0x2a54c, 0x2a595, 0x2043b, 0x20445, 0x20444, 0x2043e, 0x2043c, 0x2043f, 0x204cc, 0x204ce, 0x20622, 0x20623, 0x2069f, 0x208c5, 0x208c4, 0x210e9, 0x21099, 0x3611, 0x210e6, 0x210e7, 0x210f7, 0x214d2, 0x3733, 0x218fd, 0x2190c, 0x2190b, 0x21ced, 0x3821, 0x3822, 0x21f9b, 0x2200a, 0x2216f, 0x22175, 0x222f2, 0x222f8, 0x2243e, 0x22924, 0x2290e, 0x22916, 0x22918, 0x22910, 0x22931, 0x22944, 0x39a6, 0x22929, 0x2292f, 0x2294b, 0x22936, 0x39a3, 0x22e34, 0x22e00, 0x22e2b, 0x23020, 0x23021, 0x2301e,
+		0x2301d, 0x232f5, 0x232f2, 0x232f6, 0x23790, 0x2379d, 0x2379c, 0x23792, 0x23794, 0x237d1, 0x23793, 0x237b7, 0x23797, 0x237b0, 0x2379f, 0x237c9, 0x2392d, 0x2392c, 0x23a77, 0x23c2c, 0x240c0, 0x240b9, 0x24453, 0x24540, 0x24702, 0x246fb, 0x3ea1, 0x24a8b, 0x24aae, 0x24aab, 0x24ba1, 0x2a2cd, 0x24cea, 0x24edd, 0x24edc, 0x24ed9, 0x24fab, 0x24fac, 0x24fad, 0x24fb2, 0x2502c, 0x2502b, 0x250c2, 0x25300, 0x2530d, 0x25306, 0x252ff, 0x25303, 0x25301, 0x25310, 0x2530f, 0x25305, 0x25398, 0x25397, 0x25396, 0x25399, 0x255ac, 0x255ab, 0x25712, 0x258d8, 0x258df, 0x258d6, 0x25a2d, 0x25a32, 0x25d3f, 0x25d2f, 0x25d26, 0x25d3a, 0x25d39, 0x425f, 0x25d3b, 0x25d35, 0x25d2a, 0x25d21, 0x25d38, 0x25d37, 0x25d34, 0x425c, 0x25d22, 0x25d36, 0x25d44, 0x25f45, 0x25f3b, 0x25f36, 0x25f42, 0x2617a, 0x26186, 0x2618b, 0x2617f, 0x26181, 0x2632a, 0x263d5, 0x2649f, 0x2649d,
+		0x26526, 0x265f4, 0x265f5, 0x26815, 0x2688e, 0x2688f, 0x26926, 0x269a7, 0x269af, 0x26aad, 0x26aac, 0x26aab, 0x26aaa, 0x2702f, 0x27021, 0x27023, 0x270a3, 0x27049, 0x2703a, 0x27048, 0x2702d, 0x27025, 0x27029, 0x27032, 0x27034, 0x27024, 0x2702c, 0x2704b, 0x2703b, 0x27020, 0x27028, 0x27498, 0x45f5, 0x27497, 0x8804, 0x2749d, 0x274a8, 0x275d5, 0x27777, 0x27778, 0x27772, 0x27773, 0x27802, 0x2793b, 0x27afe, 0x27b0b, 0x27aff, 0x27b07, 0x4711, 0x470c, 0x27b30, 0x27bcd, 0x27bcf, 0x27c10, 0x27c0a, 0x27c83, 0x27d15, 0x27d0e, 0x27d0c, 0x27e02, 0x27dfe, 0x27e05, 0x27e15, 0x27e08, 0x27f7f, 0x27f77, 0x27f7c, 0x27f82, 0x27f7e, 0x27f78, 0x27f7d, 0x27f79, 0x27f81, 0x2814b, 0x28163, 0x28164, 0x28156, 0x28154, 0x2814e, 0x28110, 0x2814f, 0x28157, 0x2815e, 0x28151, 0x2816a, 0x28169, 0x283ad, 0x283a4, 0x283ac, 0x283a9, 0x283ae, 0x2044c, 0x28650, 0x28635,
+		0x28632, 0x287d8, 0x287d1, 0x287cf, 0x287be, 0x288d5, 0x288c1, 0x288c6, 0x288c3, 0x288c2, 0x288c0, 0x288c5, 0x288c7, 0x288bf, 0x288c4, 0x288d4, 0x28a90, 0x28ad2, 0x494e, 0x28ad4, 0x28caf, 0x28cae, 0x28cb2, 0x28cad, 0x28d9c, 0x28da0, 0x28db6, 0x28f3d, 0x28f56, 0x28f82, 0x29028, 0x2902b, 0x2913c, 0x2913e, 0x2913f, 0x29142, 0x2913d, 0x29141, 0x2913b, 0x29149, 0x29143, 0x29239, 0x29238, 0x29322, 0x29308, 0x2930c, 0x29306, 0x29313, 0x29304, 0x29320, 0x2931d, 0x29305, 0x2930a, 0x293d6, 0x293d7, 0x29436, 0x29437, 0x29523, 0x29522, 0x29520, 0x2951a, 0x2951d, 0x2961c, 0x29620, 0x29677, 0x2977f, 0x2978b, 0x2977d, 0x29799, 0x29784, 0x29789, 0x29785, 0x29783, 0x29786, 0x2979e, 0x29853, 0x29917, 0x4b94, 0x29919, 0x29920, 0x2991f, 0x29923, 0x29918, 0x29921, 0x29929, 0x29924, 0x29926, 0x29a5e, 0x29a6f, 0x29a6e, 0x29a74, 0x29a72, 0x29a73, 0x29b7d,
+		0x29b7e, 0x29b85, 0x29b7f, 0x29b84, 0x29c44, 0x29cf5, 0x29cf8, 0x29d03, 0x29cf6, 0x29e28, 0x4c63, 0x29e39, 0x29dfb, 0x29e0e, 0x29dfd, 0x29e1f, 0x4c61, 0x29e03, 0x9bd8, 0x29e10, 0x4c5a, 0x29e0d, 0x29e27, 0x29e41, 0x2a072, 0x2a078, 0x2a080, 0x4ce3, 0x2a085, 0x2a07b, 0x9d7c, 0x2a07d, 0x2a091, 0x2a088, 0x2a0aa, 0x2a08d, 0x2a089, 0x2a095, 0x2a09b, 0x2a08c, 0x2a09e, 0x2a07c, 0x2a086, 0x2a084, 0x2a268, 0x2a2c5, 0x2a2c4, 0x2a2c9, 0x2a2c6, 0x2a342, 0x2a345, 0x2a341, 0x2a344, 0x2a3a6, 0x2a3c2, 0x2a442, 0x2a449, 0x2a448, 0x2a44a, 0x2a44c, 0x2a44b, 0x2a517, 0x2a518, 0x2a515, 0x2a552, 0x2a554, 0x2a553, 0x2a59a, 0x2a59b, 0x2a5dc, 0x2a5e4, 0x2a691, 0x4daf, 0x2a6ba, 0x20451, 0x2045b, 0x204cf, 0x23305, 0x21113, 0x21115, 0x21114, 0x21123, 0x3613, 0x21111, 0x21112, 0x3680, 0x214f0, 0x2169b, 0x21912, 0x21916, 0x21917, 0x219b5, 0x21cf4, 0x21fb1,
+		0x22179, 0x222ff, 0x222fc, 0x222fb, 0x2243f, 0x22444, 0x2259d, 0x22932, 0x2294c, 0x2292b, 0x2295a, 0x22a1f, 0x22e01, 0x22e2d, 0x22e27, 0x22e59, 0x22e5a, 0x22e4d, 0x22e58, 0x23023, 0x23025, 0x2302b, 0x232ff, 0x233ae, 0x237cb, 0x237ca, 0x237d0, 0x237ce, 0x237cc, 0x237d8, 0x237c6, 0x237d2, 0x237cf, 0x237c8, 0x23b17, 0x4db0, 0x240c4, 0x29240, 0x240e9, 0x240f2, 0x240fc, 0x240ea, 0x240eb, 0x240fd, 0x24478, 0x24477, 0x24473, 0x2449e, 0x24479, 0x245a2, 0x24603, 0x24704, 0x24705, 0x248ce, 0x24abf, 0x24ef6, 0x24ef7, 0x25031, 0x25030, 0x250c9, 0x250c7, 0x2531c, 0x25328, 0x2531a, 0x2531e, 0x2531b, 0x2531f, 0x255bf, 0x255bb, 0x255bc, 0x255c0, 0x2571a, 0x258ef, 0x258ec, 0x258e9, 0x258f0, 0x258fe, 0x25a34, 0x25d6a, 0x25d95, 0x25d6c, 0x25d72, 0x25d67, 0x25d60, 0x25d6b, 0x25d5e, 0x25d6f, 0x25d66, 0x7c46, 0x25d62, 0x25d5d, 0x25d5c, 0x2f965, 0x25f50,
+		0x2619f, 0x4323, 0x2619e, 0x261a6, 0x26335, 0x26338, 0x26336, 0x2633a, 0x263dc, 0x2687c, 0x268ab, 0x4449, 0x268a8, 0x268a7, 0x2692b, 0x2692c, 0x26928, 0x2092b, 0x269a9, 0x269aa, 0x269ab, 0x269f8, 0x26ab1, 0x26af0, 0x26aef, 0x270a8, 0x2708b, 0x27094, 0x2709e, 0x2708f, 0x27088, 0x2707e, 0x27081, 0x27030, 0x2709b, 0x27082, 0x27090, 0x27085, 0x2707f, 0x270a9, 0x270de, 0x2720d, 0x45fa, 0x274cf, 0x274cb, 0x274d8, 0x274dd, 0x274d3, 0x274d0, 0x274d5, 0x274d6, 0x275d6, 0x27792, 0x27795, 0x2a5cb, 0x2778d, 0x2779b, 0x2779d, 0x2778f, 0x2779e, 0x277a6, 0x27896, 0x27892, 0x27b16, 0x27b2a, 0x27b29, 0x27b2c, 0x27c15, 0x27c12, 0x27c11, 0x27c13, 0x27c88, 0x27c8b, 0x27c8a, 0x27c87, 0x27d17, 0x27d16, 0x27d1a, 0x27e19, 0x27e17, 0x27f91, 0x27f94, 0x2818b, 0x28190, 0x2818f, 0x28186, 0x28183, 0x2818e, 0x28187, 0x281a8, 0x28185, 0x281a6, 0x28182, 0x281a7,
+		0x283b9, 0x283b7, 0x283b4, 0x283b6, 0x283b3, 0x283c6, 0x2863c, 0x28640, 0x28638, 0x28791, 0x287a6, 0x287da, 0x288da, 0x288d8, 0x288d9, 0x288db, 0x288d7, 0x28b16, 0x28b12, 0x28b1f, 0x4959, 0x28b14, 0x28b1a, 0x28b10, 0x28cb3, 0x28dae, 0x28dc1, 0x28db0, 0x28daf, 0x28db1, 0x28dad, 0x28db2, 0x28dc4, 0x2903c, 0x2903f, 0x29161, 0x29166, 0x2923c, 0x2923b, 0x2932c, 0x4a7e, 0x2932a, 0x2933e, 0x2932f, 0x29332, 0x29327, 0x29329, 0x29340, 0x293df, 0x293de, 0x2943c, 0x2943b, 0x2943e, 0x29521, 0x29546, 0x2953c, 0x29536, 0x29538, 0x29535, 0x29631, 0x29636, 0x2962d, 0x29633, 0x2962f, 0x2962e, 0x29635, 0x297ac, 0x297a9, 0x297a6, 0x4ba8, 0x2994c, 0x29943, 0x29941, 0x2994f, 0x29942, 0x29951, 0x4ba9, 0x29940, 0x29950, 0x29945, 0x2994a, 0x2994b, 0x29a83, 0x29a82, 0x29a81, 0x29ae0, 0x29b98, 0x29b9f, 0x29b9b, 0x29b9a, 0x29b99, 0x29b96, 0x29bae, 0x29b9e,
+		0x29d09, 0x29d0d, 0x29e4e, 0x29e4a, 0x29e4d, 0x29e40, 0x29e3e, 0x29e48, 0x29e42, 0x29e62, 0x29e45, 0x29e51, 0x2a0c6, 0x2a0d0, 0x2a0c0, 0x2a0b7, 0x2a0c2, 0x2a0bc, 0x2a0c5, 0x2a0dc, 0x2a0db, 0x2a0d2, 0x2a0c7, 0x2a0b6, 0x2a0c9, 0x2a0cc, 0x2a0d1, 0x2a0cd, 0x2a0da, 0x2a0ba, 0x2a0d3, 0x2a0ce, 0x2a0f6, 0x2a0bd, 0x2a0dd, 0x2a0c8, 0x2a10d, 0x2a135, 0x2a271, 0x2a26e, 0x2a26f, 0x2a2d6, 0x2a34c, 0x2a34f, 0x2a354, 0x2a353, 0x2a3c9, 0x2a3c8, 0x2a403, 0x4d54, 0x2a404, 0x4d56, 0x2a457, 0x2a452, 0x2a453, 0x4d6d, 0x2a456, 0x2a45c, 0x2a455, 0x2a564, 0x2a55d, 0x2a55e, 0x2a5a2, 0x2a5a3, 0x4d8e, 0x2a5e7, 0x2a5e6, 0x2a698, 0x2a6ac, 0x2a6af, 0x2a6ae, 0x20459, 0x205a9, 0x2082a, 0x208c7, 0x21141, 0x21137, 0x21135, 0x21133, 0x21139, 0x21132, 0x214ff, 0x21501, 0x214f8, 0x2191b, 0x21919, 0x21bbf, 0x21fbc, 0x21fbe, 0x21fbd, 0x2217d, 0x22446, 0x22447, 0x225a4,
+		0x22a21, 0x22a62, 0x22e86, 0x3a72, 0x2302f, 0x23031, 0x3c14, 0x237f8, 0x237f5, 0x237f9, 0x237f2, 0x237fa, 0x237f3, 0x23814, 0x237fd, 0x2380f, 0x2380e, 0x23801, 0x23937, 0x23935, 0x23934, 0x23931, 0x23a7a, 0x23a7b, 0x23c37, 0x2412b, 0x240fb, 0x24116, 0x24113, 0x3d93, 0x24111, 0x2410f, 0x2411b, 0x24138, 0x244a4, 0x24709, 0x24707, 0x2470b, 0x248d3, 0x248d1, 0x248d8, 0x24b1d, 0x24ba9, 0x24bd0, 0x27215, 0x24f02, 0x24f05, 0x24f01, 0x24f00, 0x2532e, 0x25330, 0x2532f, 0x25331, 0x255d2, 0x255d3, 0x25902, 0x25a40, 0x25a42, 0x25a3b, 0x25ace, 0x25d98, 0x25d8f, 0x25d94, 0x25d91, 0x426f, 0x25dba, 0x25d90, 0x25d86, 0x25d9a, 0x25d8c, 0x25d93, 0x25d87, 0x25d88, 0x25d97, 0x25d8d, 0x25d9c, 0x25dbd, 0x25f3c, 0x25f59, 0x42b0, 0x261d1, 0x261bb, 0x261be, 0x2625d, 0x263e2, 0x26605, 0x268ba, 0x352e, 0x268f4, 0x269b2, 0x269f9, 0x26ab7, 0x26ab6, 0x27089,
+		0x270f9, 0x270d9, 0x270e8, 0x270d4, 0x270db, 0x270e2, 0x270df, 0x270d1, 0x270e9, 0x270ea, 0x27213, 0x27211, 0x274fa, 0x274f8, 0x274f4, 0x274fb, 0x2750e, 0x27502, 0x2750f, 0x27790, 0x277ad, 0x277a9, 0x27948, 0x27b5a, 0x27b4f, 0x27b4e, 0x27b55, 0x27b54, 0x27b4a, 0x27bd5, 0x27c18, 0x27c8d, 0x27d1d, 0x27d19, 0x27e26, 0x27e28, 0x27e2b, 0x27e7d, 0x27fa0, 0x27f9a, 0x27f9b, 0x281b5, 0x281ad, 0x281b2, 0x28294, 0x283c9, 0x2864e, 0x2864f, 0x28644, 0x28652, 0x288e9, 0x28939, 0x4969, 0x957e, 0x28dc7, 0x28dd3, 0x28dc6, 0x28dc3, 0x49b4, 0x28dd2, 0x28f66, 0x2917f, 0x29180, 0x29184, 0x29185, 0x291e3, 0x4a4d, 0x29241, 0x29244, 0x29243, 0x2934e, 0x2934f, 0x4a83, 0x29349, 0x2934a, 0x2934b, 0x29343, 0x293e0, 0x293e5, 0x293e1, 0x293e6, 0x293e2, 0x2940c, 0x29440, 0x29549, 0x2954a, 0x29554, 0x2954c, 0x29555, 0x29556, 0x2963b, 0x2963d, 0x297a4, 0x297a8,
+		0x297c3, 0x297bf, 0x297c8, 0x297c2, 0x297ca, 0x297cc, 0x297c9, 0x297be, 0x297cd, 0x297c7, 0x297c5, 0x2985d, 0x4bb0, 0x2996c, 0x2996b, 0x29970, 0x2996d, 0x2996f, 0x29989, 0x29984, 0x29a8d, 0x29a8a, 0x29a8e, 0x29a91, 0x29bb5, 0x29bb1, 0x29baf, 0x29bb9, 0x29bb7, 0x29bb0, 0x4c0c, 0x29c17, 0x4c18, 0x29c4f, 0x29d19, 0x29d10, 0x29d18, 0x29d11, 0x29d1c, 0x29d12, 0x29e76, 0x29e71, 0x9c20, 0x29e7a, 0x29e7f, 0x9c22, 0x29e73, 0x29eab, 0x9c1e, 0x29e77, 0x29e74, 0x29e7e, 0x29e9b, 0x29e84, 0x29e7c, 0x2a129, 0x2a12b, 0x2a10e, 0x2a100, 0x2a10b, 0x2a0fe, 0x2a0fa, 0x2a117, 0x2a0ff, 0x2a10c, 0x2a10f, 0x4cf5, 0x2a102, 0x2a101, 0x2a0fc, 0x2a149, 0x2a106, 0x2a112, 0x2a113, 0x2a278, 0x2a35b, 0x2a362, 0x2a35f, 0x2a35d, 0x2a3ab, 0x2a3d5, 0x2a3d4, 0x2a3d3, 0x2a407, 0x2a46c, 0x2a470, 0x2a46e, 0x2a468, 0x2a46d, 0x2a477, 0x2a46a, 0x2a4ce, 0x2a4ec, 0x2a569,
+		0x2a568, 0x2a5a6, 0x2a5a9, 0x2a5aa, 0x2a600, 0x2a60d, 0x2a5f8, 0x2a5fc, 0x2a60a, 0x2a5f7, 0x2a601, 0x2a6b6, 0x2a6bb, 0x2a6b7, 0x2a6b9, 0x2a6ca, 0x20469, 0x20625, 0x21159, 0x21507, 0x21509, 0x21922, 0x21b07, 0x21b04, 0x2330f, 0x21cf8, 0x21fc7, 0x21fd1, 0x2217e, 0x22308, 0x2244a, 0x2f898, 0x224b9, 0x22e88, 0x22e91, 0x22e84, 0x22e73, 0x22e89, 0x22e85, 0x23033, 0x23313, 0x2381d, 0x2381f, 0x2381c, 0x23820, 0x2381a, 0x2381b, 0x23939, 0x239af, 0x23ae5, 0x23c3c, 0x24137, 0x2413a, 0x244bb, 0x3e0e, 0x24710, 0x2470d, 0x24baf, 0x24c11, 0x3fd8, 0x24f0d, 0x24f0c, 0x24f0b, 0x250d4, 0x2533d, 0x2533e, 0x2533b, 0x25343, 0x25340, 0x25346, 0x25411, 0x255dd, 0x255df, 0x255ea, 0x2574a, 0x25906, 0x25dc1, 0x25db9, 0x25dc6, 0x25db8, 0x25dbb, 0x25dc8, 0x25dc5, 0x25dbf, 0x25dc7, 0x25dc4, 0x25f65, 0x25f67, 0x261d7, 0x261db, 0x261d4, 0x261d6, 0x263e8, 0x2660a,
+		0x26abd, 0x26abe, 0x2711a, 0x2710d, 0x2710f, 0x2711b, 0x27110, 0x27111, 0x27113, 0x27218, 0x27520, 0x2751f, 0x27523, 0x2751d, 0x27537, 0x27525, 0x27524, 0x2752a, 0x27527, 0x27533, 0x27528, 0x27534, 0x277ba, 0x8970, 0x2789d, 0x2794c, 0x27b5c, 0x27b6a, 0x27b5d, 0x27b65, 0x27b63, 0x27b5e, 0x27c19, 0x27c97, 0x27e3f, 0x27e33, 0x27e32, 0x27fa1, 0x27fa5, 0x27fa4, 0x27fa2, 0x281c1, 0x482c, 0x281c7, 0x281c4, 0x281c6, 0x281c5, 0x281d4, 0x281ca, 0x28653, 0x288f1, 0x28921, 0x496e, 0x28bb7, 0x28b92, 0x28dd4, 0x28f44, 0x29198, 0x2919b, 0x29191, 0x29195, 0x2919a, 0x29192, 0x29353, 0x29357, 0x4a86, 0x2935c, 0x2935d, 0x29364, 0x293ea, 0x293ed, 0x29442, 0x29443, 0x29564, 0x29561, 0x29560, 0x2967d, 0x297d4, 0x297d5, 0x297d9, 0x29987, 0x29999, 0x2998c, 0x2998a, 0x2998f, 0x4bb3, 0x2998b, 0x29982, 0x2999b, 0x4beb, 0x29bc8, 0x29bc4, 0x29bcc, 0x29bc7,
+		0x29bc3, 0x29bc6, 0x29bcb, 0x29bca, 0x29bc9, 0x29bcd, 0x29c53, 0x29d21, 0x29d29, 0x29d1d, 0x29d24, 0x29d28, 0x9c43, 0x29eb1, 0x29eb2, 0x29eb5, 0x29ed6, 0x29eaf, 0x29eca, 0x29eb8, 0x2fa12, 0x2fa11, 0x2a145, 0x2a147, 0x2a134, 0x2a16e, 0x2a142, 0x2a131, 0x2a12e, 0x2a156, 0x2a138, 0x2a137, 0x2a14b, 0x2a12d, 0x2a133, 0x2a136, 0x9de0, 0x2a148, 0x2a2dc, 0x4d42, 0x2a366, 0x2a36d, 0x2a363, 0x2a364, 0x4d41, 0x2a367, 0x2a3d9, 0x2a40b, 0x2a47e, 0x2a48b, 0x2a526, 0x2a52c, 0x2a529, 0x2a56f, 0x2a56b, 0x2a56d, 0x2a56e, 0x2a61c, 0x2a611, 0x2a610, 0x2a624, 0x2a612, 0x2a615, 0x2a617, 0x4dac, 0x2046f, 0x205ab, 0x2082e, 0x208c9, 0x20b96, 0x21185, 0x216a0, 0x2192b, 0x2230d, 0x23036, 0x0, 0x3b2f, 0x3b2e, 0x233b1, 0x2384a, 0x23837, 0x2383c, 0x23838, 0x2383a, 0x2383e, 0x23849, 0x3c4d, 0x3c7b, 0x23a80, 0x23a7f, 0x23ae3, 0x23c3f, 0x24152, 0x2414e,
+		0x2414a, 0x2414b, 0x244d5, 0x24ad8, 0x24b20, 0x24bb3, 0x24bb1, 0x24bb0, 0x24f17, 0x250d9, 0x25349, 0x25413, 0x255ed, 0x255eb, 0x255ee, 0x2590a, 0x2590b, 0x25a4a, 0x25dd5, 0x25dd7, 0x25dde, 0x25ddc, 0x25dee, 0x25f70, 0x42b2, 0x261d9, 0x263d7, 0x268cb, 0x268c7, 0x269fc, 0x2713b, 0x27139, 0x2714b, 0x27143, 0x27140, 0x27146, 0x2754d, 0x27543, 0x27547, 0x2754b, 0x27555, 0x27552, 0x27b5f, 0x4722, 0x27b7c, 0x27b7b, 0x27fa8, 0x27fa9, 0x281de, 0x281d7, 0x281dd, 0x281d6, 0x281d8, 0x2829b, 0x283e0, 0x283e8, 0x283e6, 0x288f8, 0x288fb, 0x28bbb, 0x9459, 0x28bb8, 0x4970, 0x28cc1, 0x28cc0, 0x291a4, 0x291ab, 0x2924d, 0x29365, 0x29367, 0x2936a, 0x29366, 0x29369, 0x29573, 0x29580, 0x2956f, 0x29571, 0x297e4, 0x297e6, 0x297e7, 0x299a0, 0x299a4, 0x29aa2, 0x29aa7, 0x29aa4, 0x29bde, 0x29bdb, 0x29c58, 0x29c5c, 0x29d2f, 0x29d2e, 0x29edd, 0x29ee4, 0x29ed8,
+		0x29ee7, 0x29eda, 0x29e75, 0x4c95, 0x2a179, 0x2a180, 0x2a17f, 0x2a17c, 0x2a175, 0x2a17b, 0x2a182, 0x4d00, 0x2a189, 0x2a174, 0x2a17d, 0x2a17a, 0x2a186, 0x2a1a8, 0x2a172, 0x4d02, 0x2a18b, 0x2a191, 0x2a1b3, 0x2a181, 0x2a282, 0x2a2e1, 0x2a2e3, 0x2a2e2, 0x2a376, 0x2a374, 0x2a372, 0x2a375, 0x2a40e, 0x2a40d, 0x2a494, 0x2a492, 0x2a493, 0x2a491, 0x2a48f, 0x2a495, 0x2a4d0, 0x2a4f7, 0x2a576, 0x2a5af, 0x4d9f, 0x2a626, 0x2a625, 0x2a62d, 0x2a6a0, 0x2a6c3, 0x204d7, 0x20acc, 0x21179, 0x2117a, 0x21515, 0x21fdb, 0x22185, 0x22970, 0x2297b, 0x22ea5, 0x22e9f, 0x23317, 0x233b2, 0x23839, 0x23840, 0x2384e, 0x2393e, 0x23ae8, 0x23ae7, 0x24166, 0x24161, 0x244da, 0x24714, 0x248df, 0x24f18, 0x25039, 0x2503a, 0x25730, 0x25732, 0x2590e, 0x25a4c, 0x25de8, 0x25df1, 0x25deb, 0x25dec, 0x25f74, 0x25f73, 0x261f1, 0x26342, 0x2633e, 0x26341, 0x2652c, 0x268d2, 0x269bb,
+		0x27158, 0x27157, 0x27156, 0x2715a, 0x457d, 0x27561, 0x27568, 0x27565, 0x2755f, 0x27564, 0x2755e, 0x2755b, 0x27567, 0x277c3, 0x278a2, 0x27953, 0x27b7d, 0x27c20, 0x27fae, 0x27faf, 0x27fb0, 0x281e7, 0x281e6, 0x281e9, 0x283f1, 0x283eb, 0x283ea, 0x283e9, 0x28663, 0x28902, 0x288fe, 0x28bde, 0x28cc2, 0x28de6, 0x28f5d, 0x291ad, 0x29375, 0x29372, 0x29377, 0x4af6, 0x29651, 0x297e8, 0x297ed, 0x297ee, 0x299b9, 0x299a1, 0x299b6, 0x29aae, 0x29be8, 0x4c12, 0x29c1e, 0x29d31, 0x29d32, 0x4c91, 0x29f0e, 0x29f12, 0x29f09, 0x29f05, 0x4c90, 0x29f03, 0x29f1f, 0x29f0d, 0x29f0c, 0x29f04, 0x29f0a, 0x2a1c2, 0x2a1bf, 0x2a1c9, 0x2a1b2, 0x2a1c1, 0x2a1af, 0x2a1b4, 0x2a1b0, 0x2a1b6, 0x2a1b7, 0x2a1bb, 0x2a1b1, 0x2a2f0, 0x2a378, 0x2a37a, 0x2a379, 0x2a3e4, 0x2a3e6, 0x2a49f, 0x2a49d, 0x2a498, 0x2a499, 0x2a4f9, 0x2a530, 0x2a582, 0x2a581, 0x2a5b3, 0x2a57f, 0x2a63a,
+		0x2a63e, 0x2a648, 0x21186, 0x21936, 0x21b13, 0x21c22, 0x21fdd, 0x225a7, 0x2297d, 0x3a79, 0x2331c, 0x23865, 0x23860, 0x23867, 0x3c4e, 0x23b1a, 0x24ae3, 0x25734, 0x25918, 0x25a52, 0x25dfc, 0x25f79, 0x25f78, 0x25f76, 0x261fa, 0x261f8, 0x2652d, 0x268d6, 0x269bd, 0x269bf, 0x269be, 0x27144, 0x27170, 0x27162, 0x2716e, 0x2756e, 0x2757c, 0x27574, 0x27578, 0x27570, 0x27579, 0x27571, 0x277cc, 0x278a7, 0x278a6, 0x27b93, 0x27c21, 0x27c9c, 0x2829d, 0x283f4, 0x283f3, 0x28df0, 0x291b8, 0x291b6, 0x291bd, 0x29373, 0x29382, 0x29587, 0x29656, 0x29659, 0x297f6, 0x299c9, 0x299c5, 0x299c7, 0x299ca, 0x299c2, 0x299c4, 0x29bf2, 0x29bf0, 0x29d3b, 0x29d3a, 0x29f26, 0x29f28, 0x29f34, 0x29f2d, 0x2a1d7, 0x2a1d2, 0x2a1d6, 0x2a1dc, 0x2a1d3, 0x2a1d1, 0x2a286, 0x4d1e, 0x2a2ef, 0x2a2ee, 0x2a3e8, 0x2a4ac, 0x2a4a9, 0x2a4aa, 0x2a4ab, 0x2a4fb, 0x2a533, 0x2a588, 0x2a5b6,
+		0x2a5b7, 0x2a5d0, 0x2a5cf, 0x2a64f, 0x2a659, 0x2a64c, 0x21b18, 0x21fe0, 0x23869, 0x24173, 0x24172, 0x24ae8, 0x255fb, 0x2591c, 0x25e09, 0x25e0a, 0x25e08, 0x25f7d, 0x25f7f, 0x26267, 0x2717a, 0x2717b, 0x277d1, 0x2f9cb, 0x27b9d, 0x27c9d, 0x281fc, 0x281fb, 0x281fd, 0x283f5, 0x283f6, 0x28c0f, 0x28c1d, 0x291c7, 0x291c1, 0x291bf, 0x29254, 0x2937f, 0x293f5, 0x2958a, 0x2958c, 0x2965c, 0x29865, 0x299cf, 0x299d0, 0x29ab8, 0x29bfa, 0x29c66, 0x29f40, 0x29f41, 0x9c72, 0x2a1f7, 0x2a1f3, 0x2a1ef, 0x2a1f4, 0x2a1ed, 0x2a1f2, 0x2a1f1, 0x2a1f9, 0x2a4b4, 0x2a4fc, 0x2a5bc, 0x2a65e, 0x2a65b, 0x2a65f, 0x2a65d, 0x2a6cf, 0x2a6ce, 0x2193a, 0x21fe1, 0x22315, 0x22314, 0x24178, 0x24179, 0x244e6, 0x24bb8, 0x24f22, 0x255fe, 0x268db, 0x26a06, 0x26ac8, 0x27189, 0x27586, 0x278ad, 0x27b99, 0x27bd8, 0x27c23, 0x27d23, 0x27fb5, 0x283f9, 0x28907, 0x28c1e, 0x28dfb, 0x28f72,
+		0x28f73, 0x29387, 0x29449, 0x2965d, 0x297f8, 0x29c03, 0x29f4e, 0x29f4d, 0x29f50, 0x29f55, 0x2a209, 0x2a207, 0x2a20c, 0x2a203, 0x2a206, 0x2a20b, 0x2a20a, 0x2a289, 0x2a4bb, 0x2a4ff, 0x2a536, 0x2a58b, 0x2a666, 0x2a669, 0x2a667, 0x2a6c2, 0x2a6d2, 0x21fe2, 0x3828, 0x22988, 0x2335b, 0x23876, 0x2417d, 0x245a6, 0x2535a, 0x25e17, 0x268dc, 0x2718f, 0x27594, 0x27595, 0x27fb6, 0x28204, 0x287ee, 0x2938a, 0x293f9, 0x29c07, 0x29c1f, 0x29c2a, 0x29d45, 0x29f5c, 0x29f5b, 0x29f61, 0x29f5d, 0x2a218, 0x2a21f, 0x2a383, 0x2a416, 0x2a414, 0x2a4bf, 0x2a4c0, 0x2a673, 0x2a6c0, 0x21517, 0x22989, 0x2591e, 0x2591f, 0x25a54, 0x25e18, 0x26205, 0x27195, 0x27598, 0x2759b, 0x27959, 0x28208, 0x28401, 0x291ce, 0x293fa, 0x29abe, 0x29d47, 0x29f6a, 0x29f69, 0x29f68, 0x29f67, 0x2a225, 0x2a228, 0x2a4c4, 0x2a537, 0x2a58d, 0x2a58c, 0x4d91, 0x2f920, 0x25e22, 0x25f82, 0x277d9,
+		0x28c38, 0x293fb, 0x299e1, 0x29c72, 0x29d48, 0x29f6c, 0x29f70, 0x2a231, 0x2a230, 0x2a679, 0x25e23, 0x268f8, 0x29c74, 0x29f73, 0x2a234, 0x2a387, 0x2a3b2, 0x2a5c2, 0x2a67d, 0x21fe5, 0x2938c, 0x29f77, 0x29f75, 0x2a238, 0x2a23a, 0x2a683, 0x2a681, 0x24180, 0x27199, 0x4587, 0x2944a, 0x29d4a, 0x2a23c, 0x2a6c8, 0x25604, 0x278af, 0x2a689, 0x2a2fa, 0x29661, 0x2a23f, 0x27193, 0x2a4c9, 0x277de, 0x29391, 0x2a68e, 0x2a68d, 0x27198, 0x269c5, 0x2a6a5, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 6
+		0x2f802, 0x20062, 0x3405, 0x20088, 0x200d0, 0x200cf, 0x2011e, 0x2011f, 0x20120, 0x2090e, 0x2092c, 0x20061, 0x20004, 0x20005, 0x20003, 0x3404, 0x2f801, 0x2007d, 0x2008d, 0x201a3, 0x201a4, 0x204db, 0x20500, 0x2f81e, 0x206a3, 0x20832, 0x353f, 0x20ad4, 0x20ad5, 0x21c23, 0x2232c, 0x2237a, 0x20503, 0x20007, 0x20008, 0x2008f, 0x2009c, 0x200d5, 0x20112, 0x20113, 0x20114, 0x20121, 0x201a8, 0x201a6, 0x20476, 0x20501, 0x2053d, 0x2f814, 0x2053e, 0x20629, 0x20677, 0x20678, 0x206a6, 0x206a9, 0x206ac, 0x20834, 0x20504, 0x2097b, 0x20a10, 0x2062f, 0x20ad7, 0x20b1c, 0x20b1f, 0x20b1a, 0x2f836, 0x20b1e, 0x20b9e, 0x20b9a, 0x211a0, 0x211a1, 0x215d2, 0x215d3, 0x219b9, 0x21b1d, 0x21b55, 0x21cff, 0x21fe7, 0x382a, 0x22011, 0x22013, 0x22012, 0x221af, 0x221b1, 0x2f890, 0x2232d, 0x2237b, 0x2239d, 0x2239a, 0x38a7, 0x2239f, 0x223a0, 0x2239c, 0x224bd, 0x38fa,
+		0x225aa, 0x2306c, 0x23091, 0x23c74, 0x2456a, 0x2f929, 0x25605, 0x2626b, 0x2626a, 0x200e0, 0x20012, 0x3400, 0x2007f, 0x200a0, 0x20099, 0x20095, 0x2009e, 0x200df, 0x200de, 0x201af, 0x201ad, 0x201b0, 0x204df, 0x20543, 0x20541, 0x20548, 0x20575, 0x2f81a, 0x20632, 0x2067b, 0x20679, 0x206b3, 0x34db, 0x20ba7, 0x206b2, 0x206b0, 0x206b4, 0x2f829, 0x208cd, 0x208ce, 0x2f82b, 0x20910, 0x20980, 0x209d3, 0x20a30, 0x20098, 0x20a2f, 0x20a31, 0x20ada, 0x20ad9, 0x20b27, 0x20b28, 0x20b21, 0x20b25, 0x20ba6, 0x20ba2, 0x20ba1, 0x20ba4, 0x2f839, 0x20baa, 0x20ba5, 0x20ba3, 0x20064, 0x2123f, 0x21244, 0x2151b, 0x21553, 0x21584, 0x21586, 0x215d5, 0x2f860, 0x2193e, 0x21940, 0x21bca, 0x21bc9, 0x21c26, 0x21d00, 0x21d31, 0x21d3c, 0x21d30, 0x21d33, 0x21d32, 0x21d38, 0x22034, 0x22053, 0x22054, 0x221b4, 0x221b2, 0x2232f, 0x223a5, 0x223a7, 0x223a4, 0x223a8, 0x223a9,
+		0x223a2, 0x223a3, 0x223a6, 0x22481, 0x22480, 0x225ad, 0x22a24, 0x22a65, 0x22a68, 0x22a69, 0x2313c, 0x2313b, 0x2335d, 0x23944, 0x24182, 0x244f1, 0x2456b, 0x2471b, 0x248eb, 0x24bba, 0x24bd3, 0x24d13, 0x25922, 0x26af4, 0x2820f, 0x28210, 0x20507, 0x29c0b, 0x29c0a, 0x20019, 0x2001a, 0x20018, 0x20066, 0x20082, 0x200a8, 0x200e5, 0x200e7, 0x200e4, 0x20115, 0x20123, 0x20124, 0x20143, 0x201c3, 0x201bc, 0x201bb, 0x3438, 0x201b7, 0x201b9, 0x201cf, 0x2f80d, 0x2047a, 0x2047b, 0x204e1, 0x204e0, 0x20509, 0x2054d, 0x2f816, 0x2054b, 0x20579, 0x205b1, 0x20636, 0x20635, 0x20637, 0x20638, 0x2067f, 0x20680, 0x20681, 0x26953, 0x2067e, 0x206cd, 0x206bf, 0x206be, 0x206c2, 0x206b8, 0x206c1, 0x6530, 0x20931, 0x2092f, 0x2096f, 0x20982, 0x353a, 0x20987, 0x20986, 0x2098d, 0x20994, 0x209d7, 0x209d4, 0x209d8, 0x20a16, 0x20a14, 0x20a2e, 0x20a36, 0x20a37, 0x21fee,
+		0x20ae0, 0x20ae5, 0x20adf, 0x20b2b, 0x20b29, 0x20b2d, 0x20b2f, 0x20bb7, 0x20bc9, 0x20bc1, 0x20bca, 0x20bb2, 0x20bac, 0x20bae, 0x211b2, 0x211a8, 0x211b0, 0x211a7, 0x2f851, 0x21252, 0x2124a, 0x21556, 0x21555, 0x2155f, 0x2155e, 0x21588, 0x2f85d, 0x215dd, 0x215e2, 0x215e1, 0x215df, 0x215e0, 0x216af, 0x216b1, 0x216ba, 0x21945, 0x21943, 0x219bd, 0x219c8, 0x2f86c, 0x219be, 0x219bf, 0x2f874, 0x21b59, 0x21c29, 0x21c2c, 0x21c2f, 0x21c2d, 0x21c2e, 0x21d04, 0x21d02, 0x21d01, 0x21d40, 0x21d4a, 0x21d3f, 0x21d4f, 0x21d41, 0x21d4e, 0x21feb, 0x21fec, 0x200ac, 0x2205b, 0x22063, 0x2205a, 0x22059, 0x221dc, 0x221db, 0x221d9, 0x221e0, 0x221dd, 0x20020, 0x223b0, 0x223b1, 0x223b2, 0x223b6, 0x22451, 0x22452, 0x22450, 0x22484, 0x38c9, 0x22483, 0x225c3, 0x225b8, 0x225b9, 0x225b2, 0x22990, 0x22a26, 0x22a29, 0x22a6e, 0x23140, 0x2313e, 0x23141, 0x2335e, 0x233bb,
+		0x233bc, 0x233b9, 0x2f8ef, 0x23880, 0x3c4f, 0x23945, 0x239ba, 0x239bd, 0x23c56, 0x23c55, 0x23c75, 0x23c77, 0x23c81, 0x2f8fa, 0x23c83, 0x23c8b, 0x23c84, 0x24186, 0x24183, 0x2418b, 0x2456d, 0x24616, 0x24728, 0x248ea, 0x24bd4, 0x24c03, 0x24d15, 0x24f3d, 0x24f3e, 0x25416, 0x2f94c, 0x25606, 0x2574d, 0x26222, 0x26273, 0x2626d, 0x2626e, 0x264b1, 0x2054e, 0x2662d, 0x43cd, 0x268f9, 0x26952, 0x26b00, 0x26afc, 0x26afa, 0x4492, 0x26af7, 0x26af8, 0x2f990, 0x2f9c4, 0x27c27, 0x28211, 0x200ae, 0x28450, 0x2844f, 0x2844d, 0x28671, 0x2f9e2, 0x28672, 0x28e18, 0x20a44, 0x20021, 0x20067, 0x200af, 0x200b0, 0x20029, 0x20117, 0x20122, 0x2f803, 0x20025, 0x20128, 0x201ff, 0x201d9, 0x201db, 0x201de, 0x201f2, 0x201fe, 0x201f4, 0x201dd, 0x3445, 0x2f804, 0x20201, 0x201dc, 0x2047d, 0x20481, 0x2047e, 0x2047f, 0x204e5, 0x2050f, 0x34b5, 0x2050a, 0x34b6, 0x2050b,
+		0x20551, 0x20552, 0x20550, 0x205b8, 0x2063d, 0x2063e, 0x2063c, 0x20687, 0x206d7, 0x206db, 0x206cf, 0x206e0, 0x2084c, 0x20842, 0x208d4, 0x20911, 0x20914, 0x20915, 0x20912, 0x20933, 0x20935, 0x3537, 0x20971, 0x3530, 0x20991, 0x20993, 0x20995, 0x209dc, 0x20a42, 0x20a40, 0x253a6, 0x20ae6, 0x2128f, 0x20b32, 0x2f837, 0x20b31, 0x20bf5, 0x20bcd, 0x20bde, 0x20bd5, 0x20bda, 0x20bee, 0x20bec, 0x20bcf, 0x20bce, 0x2f83d, 0x20bcc, 0x20bf3, 0x211c1, 0x211c4, 0x211c6, 0x211b4, 0x211c3, 0x211b8, 0x211bb, 0x211b7, 0x211bc, 0x211bf, 0x211c7, 0x211be, 0x21260, 0x2125d, 0x21276, 0x2126c, 0x21275, 0x2125b, 0x21271, 0x21273, 0x21261, 0x2125a, 0x21262, 0x21272, 0x2125f, 0x21258, 0x2151e, 0x21558, 0x2158b, 0x2158a, 0x215f4, 0x215f0, 0x216d2, 0x216cd, 0x216ce, 0x216bc, 0x216d0, 0x21946, 0x21947, 0x373e, 0x374d, 0x3751, 0x219ce, 0x219cf, 0x219cc, 0x219cb,
+		0x2f872, 0x21b60, 0x21b5f, 0x21b5d, 0x21bd4, 0x21c3a, 0x21c38, 0x21c3c, 0x21c36, 0x21d08, 0x21d7e, 0x21d5e, 0x21d6e, 0x21d53, 0x21d70, 0x21d57, 0x21d5d, 0x21d63, 0x21d7a, 0x21d79, 0x21d93, 0x2f881, 0x2201d, 0x22068, 0x22073, 0x22074, 0x22066, 0x2206f, 0x22075, 0x2207a, 0x22070, 0x2206d, 0x22065, 0x2218a, 0x221ed, 0x221e9, 0x221e3, 0x221ef, 0x3873, 0x221ea, 0x22331, 0x2f891, 0x22338, 0x26b20, 0x22335, 0x2233a, 0x22381, 0x22380, 0x223b8, 0x223c1, 0x223ba, 0x22488, 0x224cf, 0x224ca, 0x224c7, 0x224c5, 0x224c8, 0x224d0, 0x224c9, 0x225bb, 0x225b6, 0x225bd, 0x2f89d, 0x225b4, 0x225c1, 0x225d1, 0x225d2, 0x225cc, 0x225d4, 0x225cb, 0x225ce, 0x225d5, 0x225d8, 0x22995, 0x22993, 0x22999, 0x22996, 0x39be, 0x22a89, 0x22a94, 0x22a91, 0x22a8f, 0x22a84, 0x22a97, 0x22a86, 0x22a85, 0x22a8c, 0x22ef2, 0x22eed, 0x22eef, 0x230d9, 0x23146, 0x23147, 0x2314b,
+		0x23150, 0x2314a, 0x2314e, 0x2314c, 0x23148, 0x23363, 0x23361, 0x23362, 0x23360, 0x233c3, 0x233c5, 0x233c1, 0x233d0, 0x2f8dc, 0x23882, 0x3c1e, 0x23886, 0x23884, 0x2394c, 0x23948, 0x239b8, 0x239bf, 0x239c1, 0x239c6, 0x239c5, 0x239c7, 0x239c0, 0x239c3, 0x23aed, 0x23b03, 0x23b22, 0x23b21, 0x23c9f, 0x23c90, 0x23c9e, 0x3cc8, 0x23ca0, 0x23c94, 0x23c95, 0x23ca1, 0x3cc3, 0x3cc7, 0x2419a, 0x24190, 0x244f2, 0x244f3, 0x2456e, 0x245ab, 0x245a9, 0x2461a, 0x24618, 0x2461b, 0x24760, 0x2474c, 0x24742, 0x24733, 0x2473f, 0x2473e, 0x24738, 0x24743, 0x24746, 0x24736, 0x248ee, 0x248f5, 0x248f4, 0x3f56, 0x24c1b, 0x3540, 0x24d2b, 0x24d29, 0x24d2a, 0x24d2e, 0x2503f, 0x250e6, 0x253a7, 0x253a8, 0x25418, 0x25608, 0x25750, 0x25753, 0x25a56, 0x25a55, 0x2626f, 0x2627f, 0x264b3, 0x2663a, 0x26638, 0x26637, 0x26636, 0x20554, 0x2f980, 0x268dd, 0x268fc, 0x268fa,
+		0x26933, 0x26b04, 0x26b07, 0x26b08, 0x26b06, 0x26b09, 0x2f991, 0x2f993, 0x2721e, 0x2f9d2, 0x2840b, 0x28443, 0x28452, 0x28458, 0x2845e, 0x28668, 0x2868d, 0x28682, 0x2867b, 0x28c58, 0x49bd, 0x2f800, 0x2002c, 0x2002e, 0x2160b, 0x2002d, 0x20069, 0x200b2, 0x200b6, 0x200a9, 0x200ed, 0x20119, 0x2014f, 0x20216, 0x20203, 0x2020e, 0x20204, 0x20207, 0x20210, 0x20227, 0x20228, 0x2020d, 0x20229, 0x2020f, 0x20222, 0x20211, 0x20485, 0x20484, 0x20483, 0x2048a, 0x20486, 0x20510, 0x20558, 0x2055a, 0x2070c, 0x205c4, 0x205c5, 0x20647, 0x20649, 0x2064a, 0x2064b, 0x221bb, 0x2068d, 0x2068b, 0x20707, 0x20706, 0x2f820, 0x206ef, 0x206f0, 0x206ed, 0x206f9, 0x20702, 0x206e7, 0x206f4, 0x206f7, 0x206f1, 0x20853, 0x20855, 0x351a, 0x208da, 0x208d9, 0x352c, 0x20938, 0x20999, 0x209a2, 0x2099c, 0x2099a, 0x2099b, 0x209e5, 0x209e3, 0x209e1, 0x209e0, 0x209e2, 0x209e4,
+		0x0, 0x209e8, 0x2f82f, 0x20a13, 0x20a56, 0x20a4b, 0x20a4a, 0x20a4d, 0x20a4c, 0x3549, 0x20aea, 0x20ae9, 0x20aeb, 0x20aec, 0x20b3a, 0x20b3c, 0x20b39, 0x20b3b, 0x20c14, 0x20c0e, 0x20c35, 0x20c0a, 0x20c3f, 0x20c38, 0x357c, 0x20c4e, 0x20c17, 0x2f83f, 0x20c1e, 0x211b6, 0x211ce, 0x211ca, 0x211cb, 0x211cc, 0x211c9, 0x212b4, 0x212ac, 0x21288, 0x21286, 0x2128c, 0x21293, 0x2128a, 0x212a4, 0x21287, 0x212a5, 0x212a6, 0x21285, 0x212a3, 0x2128e, 0x2151f, 0x21563, 0x2158d, 0x215fa, 0x215fb, 0x21609, 0x215fe, 0x215ff, 0x21602, 0x21604, 0x215fd, 0x21605, 0x216f9, 0x216f7, 0x216db, 0x216da, 0x21951, 0x21950, 0x21957, 0x219e2, 0x219d8, 0x219da, 0x219dc, 0x21b20, 0x21b1f, 0x21b69, 0x21bdd, 0x21c40, 0x21c41, 0x21c43, 0x21d0d, 0x21d0c, 0x21d87, 0x21da9, 0x21d92, 0x21d85, 0x37bf, 0x21d8c, 0x21da2, 0x21d8f, 0x21d8a, 0x37ba, 0x21d8d, 0x21ff1, 0x22037,
+		0x2208b, 0x22087, 0x22082, 0x2218e, 0x221bc, 0x221f8, 0x221fa, 0x221eb, 0x221f9, 0x221fc, 0x22205, 0x2231b, 0x2231d, 0x2231e, 0x2f892, 0x2233c, 0x2233e, 0x22384, 0x223c8, 0x223c9, 0x223d3, 0x223c7, 0x223d2, 0x22455, 0x2f896, 0x2248c, 0x2248a, 0x224d5, 0x224d3, 0x38d8, 0x224d8, 0x225d0, 0x225ee, 0x225f1, 0x225fb, 0x225d3, 0x225ec, 0x225cd, 0x22615, 0x22626, 0x2260e, 0x22627, 0x2262a, 0x22600, 0x22604, 0x22628, 0x22608, 0x22614, 0x2299b, 0x39b2, 0x229a1, 0x2299d, 0x229a0, 0x2299e, 0x229a2, 0x2299c, 0x22a32, 0x22a34, 0x22a93, 0x22a96, 0x22a90, 0x22ab4, 0x22ab5, 0x2f8b6, 0x22ab6, 0x22ac2, 0x2f8b5, 0x22aba, 0x22a92, 0x22efb, 0x22f05, 0x22ef3, 0x22efe, 0x22efd, 0x2455d, 0x22efa, 0x23043, 0x23095, 0x23094, 0x3ac4, 0x2316f, 0x23173, 0x2315c, 0x2315b, 0x23166, 0x23174, 0x23163, 0x23167, 0x23160, 0x23162, 0x23171, 0x23172, 0x2315e, 0x23323,
+		0x23326, 0x23364, 0x2f8d6, 0x233f2, 0x23401, 0x233dd, 0x233dc, 0x233db, 0x233f3, 0x233fd, 0x233d8, 0x233f8, 0x233fb, 0x23883, 0x2388c, 0x2388e, 0x23949, 0x23954, 0x23952, 0x2394e, 0x23958, 0x23951, 0x23955, 0x23953, 0x23957, 0x23950, 0x2394f, 0x2394d, 0x239d2, 0x239d3, 0x239c9, 0x239d4, 0x23aef, 0x2f8f8, 0x200b1, 0x23b05, 0x23b04, 0x23b2e, 0x23b2d, 0x23c45, 0x23c5b, 0x23c5a, 0x23c59, 0x23c9d, 0x23c8e, 0x23cbc, 0x23cdd, 0x23cba, 0x2f8fd, 0x23cd8, 0x23ccb, 0x23cd9, 0x23cda, 0x23cc4, 0x23cb8, 0x23cbf, 0x23cdb, 0x23cc1, 0x2f8fc, 0x23cc5, 0x2f919, 0x241b1, 0x241af, 0x2f918, 0x244f7, 0x244f6, 0x244f8, 0x24550, 0x24551, 0x24571, 0x24570, 0x245b0, 0x245af, 0x245ae, 0x245ad, 0x24625, 0x2462c, 0x24622, 0x24633, 0x24634, 0x3e2b, 0x2473a, 0x24740, 0x24768, 0x3e61, 0x24764, 0x2475c, 0x24757, 0x24753, 0x2474f, 0x24751, 0x24754, 0x24766, 0x72dd,
+		0x3eb2, 0x2490d, 0x24908, 0x2f92b, 0x248fa, 0x24aea, 0x24b2a, 0x24bbb, 0x24bd5, 0x24bd7, 0x21da4, 0x200f0, 0x200b5, 0x24c06, 0x24c28, 0x24c22, 0x24c21, 0x22456, 0x24c23, 0x211cd, 0x24d30, 0x24d34, 0x24fc9, 0x24fca, 0x24fc8, 0x2f93d, 0x25044, 0x250f3, 0x2f941, 0x250f8, 0x250fc, 0x250f6, 0x250fb, 0x250f7, 0x25100, 0x25102, 0x2f940, 0x2f94d, 0x25424, 0x2560d, 0x4129, 0x25762, 0x25930, 0x2592b, 0x2592a, 0x4192, 0x2592d, 0x25ada, 0x25e28, 0x25f86, 0x26277, 0x2627c, 0x26350, 0x2634e, 0x263f2, 0x264b2, 0x264ce, 0x264cf, 0x264e5, 0x23407, 0x26535, 0x26531, 0x26613, 0x2662b, 0x2662c, 0x26645, 0x26660, 0x26641, 0x26658, 0x26647, 0x2665b, 0x26650, 0x2f982, 0x2665f, 0x2664a, 0x26656, 0x26643, 0x26644, 0x26651, 0x268fd, 0x26934, 0x26935, 0x26954, 0x26955, 0x26a0c, 0x26a0b, 0x26a0a, 0x26af9, 0x26b35, 0x26b24, 0x26b13, 0x26b1c, 0x26b69, 0x26b25,
+		0x26b21, 0x2f995, 0x2f996, 0x2f998, 0x27220, 0x275a9, 0x275a8, 0x275de, 0x27609, 0x2760a, 0x2760b, 0x277e3, 0x27807, 0x278b2, 0x278b3, 0x2795d, 0x27e58, 0x27e86, 0x27e87, 0x27fb9, 0x27fb8, 0x2840c, 0x28444, 0x28466, 0x28480, 0x28475, 0x2846d, 0x2847e, 0x28467, 0x28481, 0x28477, 0x286a8, 0x286a2, 0x286a5, 0x2869b, 0x28c57, 0x28e0f, 0x28e32, 0x28e2c, 0x28e33, 0x291e7, 0x2006e, 0x200b7, 0x200f6, 0x200f2, 0x200f3, 0x20517, 0x20130, 0x20154, 0x20153, 0x20252, 0x2023d, 0x2023c, 0x20234, 0x20233, 0x20254, 0x2022f, 0x3458, 0x20255, 0x2023f, 0x20237, 0x20256, 0x20261, 0x20258, 0x2f806, 0x2023b, 0x20232, 0x2022e, 0x2023e, 0x2003b, 0x2048c, 0x2048d, 0x20513, 0x20516, 0x20512, 0x2055b, 0x2058a, 0x205d7, 0x205ce, 0x20650, 0x20651, 0x2064f, 0x2068e, 0x2068f, 0x206f2, 0x2f821, 0x20728, 0x2071a, 0x20725, 0x2071d, 0x20714, 0x20720, 0x2071f, 0x2071b,
+		0x20717, 0x20715, 0x2071c, 0x20713, 0x2f825, 0x20860, 0x351c, 0x20866, 0x208e3, 0x208de, 0x208e0, 0x208e1, 0x2091a, 0x2091b, 0x20918, 0x2091c, 0x20919, 0x20942, 0x20940, 0x2093e, 0x20944, 0x20974, 0x20943, 0x2f82d, 0x209f2, 0x20a1b, 0x20a19, 0x20a1e, 0x2f830, 0x20a5e, 0x20a66, 0x20a5d, 0x20a5a, 0x20a5f, 0x20a60, 0x20a5b, 0x20a5c, 0x20a59, 0x20af0, 0x20af1, 0x20b43, 0x20b40, 0x20b42, 0x20b41, 0x20b3f, 0x20c57, 0x20c69, 0x20c6f, 0x20cab, 0x20c71, 0x20c93, 0x20c56, 0x20c90, 0x20c6d, 0x20c6c, 0x20c70, 0x20c66, 0x20c67, 0x211d7, 0x211b5, 0x211db, 0x211d8, 0x211d5, 0x211d4, 0x212ba, 0x212b7, 0x212be, 0x212b9, 0x3637, 0x212e0, 0x212dd, 0x212de, 0x24918, 0x212d8, 0x212bd, 0x212db, 0x2151d, 0x21525, 0x21721, 0x2170c, 0x21729, 0x2172d, 0x2171b, 0x2170e, 0x2171f, 0x21704, 0x2171c, 0x21705, 0x21706, 0x21720, 0x2170d, 0x2172a, 0x21723, 0x21711,
+		0x2195c, 0x21966, 0x2195b, 0x2194f, 0x2195e, 0x2195d, 0x219f1, 0x219ea, 0x219f4, 0x219eb, 0x219f0, 0x219fa, 0x219fb, 0x21b28, 0x21b2c, 0x21b6b, 0x21b2a, 0x21b6a, 0x21b6f, 0x21bdb, 0x21be0, 0x24fd1, 0x21be3, 0x21be5, 0x21be7, 0x21c4d, 0x21c55, 0x21c54, 0x21c53, 0x21c52, 0x21c4e, 0x21c60, 0x221c0, 0x21d0f, 0x21d11, 0x21d13, 0x21dc6, 0x21db3, 0x21dc7, 0x21dd2, 0x21db8, 0x21dac, 0x21dae, 0x21dcf, 0x21dc5, 0x2f87b, 0x21dcc, 0x21dab, 0x21dc9, 0x21db9, 0x21ffb, 0x21ff8, 0x21ff7, 0x21ff9, 0x22020, 0x2203b, 0x22039, 0x2209e, 0x2209a, 0x220aa, 0x220ab, 0x220af, 0x22096, 0x220a9, 0x220a6, 0x22091, 0x3843, 0x220ae, 0x2209f, 0x220ac, 0x220a0, 0x22192, 0x22191, 0x221bf, 0x22217, 0x2220a, 0x2220c, 0x2234a, 0x22346, 0x22334, 0x22345, 0x22343, 0x22344, 0x2f893, 0x22387, 0x22386, 0x2238a, 0x223da, 0x223d8, 0x38b4, 0x20518, 0x20134, 0x223d6, 0x223d4,
+		0x22454, 0x22459, 0x2245a, 0x22457, 0x38e0, 0x224dd, 0x224e9, 0x224e0, 0x22605, 0x22612, 0x22613, 0x22607, 0x22616, 0x22623, 0x22602, 0x2f8a1, 0x2264a, 0x22636, 0x22640, 0x22656, 0x22643, 0x2f8a0, 0x2264b, 0x22646, 0x2263e, 0x22649, 0x229a7, 0x229b6, 0x229a6, 0x229a8, 0x229ac, 0x229a9, 0x229ab, 0x22a38, 0x22a37, 0x22a39, 0x22a41, 0x22a3e, 0x22ac0, 0x22abb, 0x22abf, 0x22abd, 0x22afe, 0x22b1e, 0x22aee, 0x22afc, 0x2f8b7, 0x22af9, 0x22b06, 0x22ae4, 0x22ae9, 0x22ae5, 0x22b03, 0x22afd, 0x22b49, 0x22ebe, 0x22f0a, 0x25626, 0x22f18, 0x22f0d, 0x22f0f, 0x22f0e, 0x22f20, 0x23071, 0x23099, 0x230e2, 0x230df, 0x230de, 0x23178, 0x23179, 0x3ae4, 0x2317c, 0x2317d, 0x23184, 0x2318b, 0x2318a, 0x2f8d2, 0x23189, 0x2332c, 0x23329, 0x2336c, 0x6804, 0x23409, 0x23408, 0x2340c, 0x2340d, 0x23410, 0x3c26, 0x2389f, 0x23898, 0x238a2, 0x2389a, 0x239d5, 0x239e2,
+		0x239f0, 0x239ea, 0x239eb, 0x239ed, 0x239e8, 0x239e0, 0x23a85, 0x23a86, 0x23af0, 0x21e46, 0x23b45, 0x23b38, 0x23b3e, 0x23b42, 0x23b40, 0x23c47, 0x23c5c, 0x23c5e, 0x23cb4, 0x23cc2, 0x23d14, 0x23ce5, 0x23d15, 0x23d11, 0x2f8ff, 0x23cee, 0x23ce7, 0x23cf5, 0x23cf4, 0x23ce8, 0x23d01, 0x23cef, 0x23cf1, 0x2f900, 0x241db, 0x241bf, 0x241da, 0x241c7, 0x241dd, 0x2f91a, 0x241eb, 0x241e1, 0x241c1, 0x241bd, 0x24507, 0x244fd, 0x24501, 0x2450a, 0x24503, 0x24572, 0x24574, 0x24575, 0x245b2, 0x245b1, 0x245b4, 0x2464c, 0x24642, 0x24640, 0x24649, 0x2463c, 0x2464d, 0x2464a, 0x2463b, 0x24761, 0x24774, 0x2f926, 0x2477f, 0x2477a, 0x24788, 0x2477c, 0x3e6f, 0x24770, 0x2490f, 0x24928, 0x24913, 0x2492a, 0x24aed, 0x24aef, 0x24b2e, 0x24bc1, 0x24bdd, 0x20b47, 0x24c2d, 0x24c2b, 0x24c35, 0x24c2f, 0x24c31, 0x24c34, 0x2f935, 0x24c30, 0x24d3a, 0x24d39, 0x24d37, 0x24d4b,
+		0x24d54, 0x24d4d, 0x24d51, 0x24d47, 0x24f27, 0x24f50, 0x24f4d, 0x24f4e, 0x24f54, 0x24fd2, 0x24fce, 0x2504b, 0x25049, 0x25105, 0x2510f, 0x25119, 0x2f943, 0x25106, 0x2510c, 0x25129, 0x25104, 0x25108, 0x25125, 0x401c, 0x25103, 0x25127, 0x25110, 0x2510a, 0x2f942, 0x26b5d, 0x253aa, 0x253ab, 0x253a9, 0x25441, 0x2543a, 0x2543c, 0x2542b, 0x25449, 0x25615, 0x4100, 0x25616, 0x2f953, 0x25631, 0x23c4a, 0x2573c, 0x2577c, 0x2576d, 0x2576a, 0x25763, 0x2576b, 0x2577b, 0x25764, 0x2577a, 0x25769, 0x2576f, 0x25937, 0x25935, 0x2593c, 0x25936, 0x2593d, 0x2593e, 0x25ae1, 0x25ae0, 0x25e2d, 0x25e2b, 0x25f8d, 0x25f92, 0x42b6, 0x25f8e, 0x2f96b, 0x25f91, 0x25f96, 0x2627b, 0x26278, 0x26281, 0x26296, 0x262a3, 0x26295, 0x26297, 0x26354, 0x26352, 0x263f5, 0x26400, 0x263fb, 0x263f4, 0x263f6, 0x264e8, 0x264ea, 0x2663e, 0x2663d, 0x2663b, 0x26680, 0x43e4, 0x26688,
+		0x26681, 0x26682, 0x266ce, 0x26683, 0x26677, 0x26679, 0x26673, 0x2666d, 0x26670, 0x2668d, 0x26675, 0x2668c, 0x2666a, 0x26691, 0x26689, 0x2668e, 0x212dc, 0x268ff, 0x26959, 0x269c7, 0x26a12, 0x26a14, 0x26a13, 0x26acb, 0x26b42, 0x44ad, 0x26b3d, 0x82ff, 0x26b40, 0x26b44, 0x26b62, 0x26b43, 0x26b3f, 0x26b45, 0x26b3c, 0x2f997, 0x26b46, 0x26b47, 0x2f9b3, 0x2719e, 0x2719d, 0x2722c, 0x27229, 0x2722f, 0x2722e, 0x27230, 0x275e1, 0x275e2, 0x2760e, 0x27619, 0x27612, 0x278b4, 0x27bda, 0x27d26, 0x27e59, 0x27e5a, 0x27e88, 0x27fc0, 0x27fba, 0x282a0, 0x2f9dd, 0x282a2, 0x28486, 0x8fec, 0x2849d, 0x28488, 0x2848f, 0x2848e, 0x2849b, 0x286c1, 0x286c3, 0x286c4, 0x28696, 0x286c7, 0x286c6, 0x286bf, 0x48c3, 0x2890f, 0x28e55, 0x28e42, 0x28e46, 0x28e4b, 0x28e43, 0x28e53, 0x28e48, 0x28e49, 0x28e4a, 0x28e4e, 0x24bde, 0x20691, 0x2944b, 0x29680, 0x29681, 0x29683,
+		0x2028a, 0x29682, 0x22342, 0x20922, 0x20070, 0x20071, 0x200bc, 0x21d18, 0x200be, 0x200ba, 0x200f8, 0x20137, 0x20135, 0x20afc, 0x20156, 0x20159, 0x2015e, 0x2026d, 0x20291, 0x20292, 0x20268, 0x20266, 0x20273, 0x3467, 0x20296, 0x2028b, 0x2028c, 0x20496, 0x204ee, 0x2051c, 0x2051a, 0x20519, 0x2051b, 0x2055d, 0x2055e, 0x2f818, 0x205dc, 0x205e2, 0x20652, 0x20692, 0x20730, 0x20752, 0x20742, 0x20741, 0x20745, 0x20737, 0x20740, 0x2073f, 0x2073d, 0x20738, 0x2073a, 0x20749, 0x2086b, 0x20878, 0x20879, 0x2f826, 0x2086c, 0x208e9, 0x208e6, 0x208e5, 0x208ea, 0x208e7, 0x208e8, 0x2091f, 0x2094b, 0x2094a, 0x20953, 0x20976, 0x209a3, 0x209a4, 0x209f6, 0x209f3, 0x209f9, 0x209f7, 0x209fc, 0x20a1d, 0x20a6d, 0x20a71, 0x20a6c, 0x20a6e, 0x20a70, 0x20a6f, 0x20a67, 0x20a68, 0x20afa, 0x20af9, 0x20b4e, 0x53de, 0x20b4d, 0x20b4f, 0x20b4a, 0x20b4c, 0x3596, 0x2f842,
+		0x20cee, 0x20cb2, 0x20cc0, 0x20cc1, 0x20cf4, 0x20cc8, 0x20cc5, 0x20cc6, 0x20cad, 0x211e2, 0x211ea, 0x211e3, 0x211e1, 0x212f7, 0x21301, 0x21312, 0x212f6, 0x212f1, 0x2131f, 0x212ee, 0x2f852, 0x212f3, 0x21315, 0x21316, 0x21317, 0x212f8, 0x21319, 0x212f2, 0x212f4, 0x212f5, 0x21313, 0x21306, 0x21526, 0x21524, 0x2155a, 0x22ec8, 0x21597, 0x21595, 0x2159a, 0x2161f, 0x20afb, 0x21629, 0x21620, 0x2f862, 0x2f863, 0x2174c, 0x21730, 0x21738, 0x2173d, 0x21751, 0x2174f, 0x2174a, 0x21734, 0x21736, 0x5a30, 0x2196a, 0x21968, 0x21a1c, 0x21a0e, 0x21a1e, 0x3759, 0x21a09, 0x21a08, 0x21a13, 0x21a01, 0x21a0f, 0x21a14, 0x21a06, 0x21a07, 0x5bb2, 0x2f873, 0x3776, 0x21b79, 0x21bea, 0x21bed, 0x21be9, 0x21bee, 0x21c68, 0x21c64, 0x21c67, 0x21c72, 0x21c62, 0x21c74, 0x21c79, 0x21d19, 0x21d17, 0x21d15, 0x21d16, 0x21de6, 0x262a4, 0x21dee, 0x37d2, 0x21ddf, 0x21de4,
+		0x21dda, 0x21dea, 0x21ded, 0x21de3, 0x21de9, 0x21ffd, 0x20657, 0x22021, 0x220c6, 0x220b8, 0x220cb, 0x2f885, 0x220bd, 0x220b5, 0x220bb, 0x220bf, 0x220be, 0x220b2, 0x220c1, 0x220c2, 0x22199, 0x221c6, 0x2222c, 0x2222d, 0x22225, 0x2221e, 0x2221f, 0x22223, 0x22350, 0x2234e, 0x2234d, 0x22352, 0x223e9, 0x223ec, 0x223e8, 0x22458, 0x2245c, 0x2245b, 0x2248f, 0x23972, 0x224f6, 0x22500, 0x224fc, 0x224f8, 0x224ea, 0x224fe, 0x224f7, 0x224fd, 0x22670, 0x22662, 0x22644, 0x3920, 0x2264d, 0x2264c, 0x2263f, 0x22666, 0x22635, 0x3929, 0x22634, 0x2268d, 0x22684, 0x3938, 0x22686, 0x22689, 0x22687, 0x22683, 0x22675, 0x22679, 0x226af, 0x226b0, 0x229b7, 0x229bb, 0x229b9, 0x22a46, 0x22a47, 0x22a45, 0x22aea, 0x22af6, 0x22b68, 0x22b39, 0x2f8b9, 0x22b3d, 0x22b3b, 0x22b4d, 0x22b30, 0x22b4a, 0x22b3e, 0x22b40, 0x22b4c, 0x22b47, 0x22b38, 0x22b52, 0x22b3a, 0x22b53,
+		0x22ec4, 0x22ec1, 0x22f1c, 0x22f1d, 0x22f2a, 0x22f1e, 0x22f2f, 0x22f22, 0x22f2e, 0x22f25, 0x3a89, 0x3ab0, 0x2304a, 0x2304b, 0x23076, 0x3abf, 0x230e8, 0x230ef, 0x230e9, 0x3ac5, 0x230ea, 0x2f8cc, 0x3aea, 0x2319b, 0x2319e, 0x23193, 0x231a7, 0x2319c, 0x231a0, 0x2319a, 0x231ab, 0x231be, 0x231a9, 0x2332d, 0x2336e, 0x23444, 0x2343d, 0x2343a, 0x23468, 0x2343c, 0x2346a, 0x2f8e0, 0x23438, 0x23465, 0x23439, 0x2346d, 0x23436, 0x2f8e3, 0x2343e, 0x2347e, 0x23437, 0x23899, 0x238a9, 0x238ad, 0x238a7, 0x238a8, 0x23966, 0x23969, 0x2396d, 0x23967, 0x2396b, 0x2396a, 0x239ee, 0x23a01, 0x23a03, 0x239f4, 0x239fb, 0x3c37, 0x239f6, 0x239fc, 0x23af4, 0x23b08, 0x23b0a, 0x23b09, 0x23b6d, 0x23b62, 0x23b41, 0x23b5e, 0x23b5c, 0x23cf3, 0x23d26, 0x3ce4, 0x23d39, 0x2f904, 0x23d6c, 0x23d2b, 0x23d2e, 0x23d3b, 0x23d5e, 0x23dfb, 0x23d27, 0x23d24, 0x23d69, 0x23d30,
+		0x2f905, 0x23d62, 0x23d38, 0x23d35, 0x23d2a, 0x23d2c, 0x23d68, 0x23d31, 0x23d2f, 0x23d2d, 0x23d3a, 0x23d36, 0x2f903, 0x23d21, 0x23d3c, 0x23d20, 0x23d64, 0x23d3e, 0x3ce8, 0x241f7, 0x24212, 0x241f1, 0x241f5, 0x24222, 0x241f2, 0x241df, 0x24215, 0x24216, 0x2457a, 0x24576, 0x245be, 0x3e20, 0x245bd, 0x24609, 0x24608, 0x24657, 0x247a3, 0x247bf, 0x247b8, 0x247af, 0x2479c, 0x247a5, 0x24772, 0x24775, 0x2479d, 0x24799, 0x247b9, 0x2494e, 0x24939, 0x2493b, 0x24935, 0x2493c, 0x24955, 0x24af0, 0x24af3, 0x24af4, 0x24b3b, 0x24b3c, 0x24b3a, 0x24b36, 0x24c07, 0x20ceb, 0x24c55, 0x24c50, 0x24c4f, 0x24c52, 0x24c56, 0x200bd, 0x24c32, 0x24d63, 0x24d6b, 0x24d66, 0x24d57, 0x24d5d, 0x3f86, 0x24d6d, 0x24d61, 0x24d69, 0x24d5a, 0x24d5c, 0x24d62, 0x24f2a, 0x24f29, 0x24f58, 0x24f5a, 0x24fd7, 0x24fdb, 0x24fdc, 0x24fdd, 0x24fd8, 0x25054, 0x2505b, 0x2505c, 0x25053,
+		0x2504f, 0x25056, 0x25050, 0x2505a, 0x2506b, 0x25136, 0x25153, 0x2513a, 0x2513c, 0x2513e, 0x25149, 0x25140, 0x2f946, 0x2f947, 0x25364, 0x25365, 0x253b5, 0x253b6, 0x253b2, 0x25448, 0x2544a, 0x25472, 0x25469, 0x2545a, 0x2544c, 0x2562c, 0x25630, 0x2564b, 0x25649, 0x25642, 0x25644, 0x2564a, 0x2564f, 0x25792, 0x2f957, 0x25797, 0x25780, 0x25782, 0x25786, 0x25953, 0x2595e, 0x25952, 0x2595b, 0x2594e, 0x25a6d, 0x25a6e, 0x25afa, 0x25af6, 0x25afb, 0x25e33, 0x25e3d, 0x25e37, 0x25e3e, 0x25e35, 0x25f9a, 0x25fab, 0x25fa6, 0x25fb0, 0x25f99, 0x25fa0, 0x25f9e, 0x25fa8, 0x25fa1, 0x25faa, 0x25fad, 0x25fbb, 0x25f9c, 0x25fa5, 0x200b3, 0x26227, 0x2628d, 0x2628e, 0x2628f, 0x26292, 0x4356, 0x26291, 0x262ad, 0x26357, 0x26358, 0x2635e, 0x2635d, 0x2635c, 0x2635b, 0x4367, 0x2640a, 0x26403, 0x2640e, 0x264b8, 0x2654d, 0x2653f, 0x26547, 0x2654b, 0x2654a, 0x2653d,
+		0x8038, 0x26541, 0x2654c, 0x8081, 0x26662, 0x26669, 0x26661, 0x43d1, 0x266aa, 0x266a6, 0x266ac, 0x266bd, 0x266bb, 0x266a4, 0x266ba, 0x2669a, 0x43eb, 0x266a1, 0x266c1, 0x268e0, 0x2690a, 0x26903, 0x26906, 0x26939, 0x2693a, 0x26936, 0x2695b, 0x2695f, 0x2695e, 0x26967, 0x26961, 0x26962, 0x2695d, 0x269ca, 0x269cc, 0x269ce, 0x26a18, 0x26a1d, 0x26a1f, 0x26a20, 0x26a17, 0x26a15, 0x26b1f, 0x26b27, 0x26b26, 0x21e10, 0x26b8b, 0x26bae, 0x26b8a, 0x2f999, 0x26b92, 0x26b89, 0x26b87, 0x44b6, 0x26b8f, 0x26b84, 0x26b83, 0x26b8c, 0x26b93, 0x26b8d, 0x26b98, 0x26b7d, 0x26b7e, 0x26bd2, 0x26b80, 0x26b99, 0x271ac, 0x27250, 0x27255, 0x27242, 0x2723f, 0x2723c, 0x45a3, 0x2724c, 0x27249, 0x27257, 0x27258, 0x2724f, 0x2725c, 0x27247, 0x2f9b7, 0x275ab, 0x4610, 0x275af, 0x275ad, 0x275e8, 0x275e7, 0x27630, 0x27626, 0x2762f, 0x27628, 0x2762b, 0x2761d, 0x2762d,
+		0x27620, 0x2762a, 0x2762c, 0x27635, 0x27621, 0x27623, 0x27624, 0x27636, 0x27637, 0x277e9, 0x278ba, 0x278b8, 0x2f9cc, 0x2796d, 0x2796a, 0x27968, 0x27969, 0x2796b, 0x27961, 0x27bdc, 0x27bdb, 0x27c2d, 0x27c2c, 0x27ca2, 0x27d2b, 0x27d32, 0x2f9d7, 0x27e94, 0x27e92, 0x27e90, 0x27fc9, 0x27fc4, 0x27fc1, 0x27fc3, 0x27fcd, 0x28214, 0x282a7, 0x282af, 0x282aa, 0x2840d, 0x28454, 0x2845b, 0x28461, 0x284ae, 0x284b3, 0x284c0, 0x284c4, 0x284bf, 0x284cb, 0x284ad, 0x284a7, 0x284a4, 0x284bd, 0x284af, 0x284b2, 0x284a5, 0x286e7, 0x286e0, 0x286ce, 0x286de, 0x286d5, 0x286df, 0x286d9, 0x287f2, 0x28923, 0x28940, 0x491b, 0x2893e, 0x4987, 0x28ccf, 0x20b54, 0x28e5b, 0x28e58, 0x28e62, 0x28e5f, 0x28e67, 0x28e63, 0x28e5e, 0x28e60, 0x28f85, 0x28f86, 0x28f87, 0x291e8, 0x291e6, 0x29671, 0x29685, 0x2fa02, 0x29686, 0x29810, 0x209f8, 0x200bf, 0x20b61, 0x200fe, 0x200fc,
+		0x20139, 0x20161, 0x20160, 0x202e2, 0x202ea, 0x202e3, 0x202b4, 0x202ae, 0x202be, 0x202b8, 0x202a8, 0x202aa, 0x202a9, 0x202b3, 0x202d5, 0x202ad, 0x202b9, 0x202bb, 0x202b1, 0x202c2, 0x2f808, 0x202eb, 0x202ba, 0x202d2, 0x202d4, 0x204f1, 0x2051d, 0x2f812, 0x20562, 0x2058c, 0x205e6, 0x205e7, 0x2065a, 0x20658, 0x20659, 0x20696, 0x20697, 0x20761, 0x20767, 0x20771, 0x20765, 0x2077d, 0x2077e, 0x2087d, 0x20884, 0x2087c, 0x2087e, 0x2087f, 0x20880, 0x208ef, 0x208f4, 0x2091e, 0x2094e, 0x209fb, 0x209fa, 0x209fd, 0x2f831, 0x2f832, 0x20a79, 0x20a7c, 0x20a7d, 0x20a84, 0x20a7b, 0x20a78, 0x3557, 0x20b5e, 0x20b5a, 0x20b5c, 0x20b59, 0x20b55, 0x20b63, 0x20b56, 0x20b5f, 0x20b60, 0x20b5b, 0x20d4a, 0x20d65, 0x20db3, 0x20d2c, 0x20d77, 0x20d3d, 0x20d52, 0x20d61, 0x20d2a, 0x20d3e, 0x20d34, 0x20d29, 0x20db2, 0x20dad, 0x20d40, 0x20d53, 0x2f844, 0x20d3f, 0x20d41,
+		0x20d72, 0x211f6, 0x211f5, 0x211f4, 0x211f2, 0x211f9, 0x21327, 0x21354, 0x21355, 0x2132e, 0x2f854, 0x2f853, 0x2132c, 0x21338, 0x21339, 0x21331, 0x2134f, 0x21373, 0x21330, 0x2132b, 0x21351, 0x2152c, 0x2155b, 0x2155c, 0x21568, 0x2156c, 0x2156b, 0x21569, 0x2159f, 0x21638, 0x2163c, 0x2163a, 0x21635, 0x369d, 0x21639, 0x21636, 0x2163b, 0x21760, 0x21761, 0x21763, 0x21764, 0x21794, 0x21793, 0x2175e, 0x21768, 0x2176a, 0x21765, 0x2f866, 0x21790, 0x2175f, 0x21772, 0x2f865, 0x21a3c, 0x21a27, 0x21a24, 0x21a26, 0x21a25, 0x375f, 0x21a28, 0x21a36, 0x21b31, 0x21b30, 0x21b34, 0x21b81, 0x21b7d, 0x21b82, 0x21b80, 0x3779, 0x202d3, 0x21bf2, 0x21c66, 0x21c8c, 0x21c7b, 0x21c83, 0x3798, 0x21c8e, 0x21c7a, 0x21c92, 0x21c91, 0x21c82, 0x21d1b, 0x21d1c, 0x21e27, 0x21e21, 0x37dc, 0x5d2b, 0x21e43, 0x37df, 0x21e18, 0x21e7b, 0x21e1a, 0x21e4b, 0x21e4a, 0x21e4d,
+		0x21e4f, 0x21e19, 0x21e35, 0x21e13, 0x21e52, 0x21e14, 0x21e1e, 0x21e2c, 0x21e20, 0x21e22, 0x21e12, 0x21e1f, 0x22000, 0x22023, 0x22040, 0x22043, 0x220e4, 0x220db, 0x220ea, 0x220dd, 0x220cc, 0x220d9, 0x220e8, 0x220f6, 0x220e3, 0x220d3, 0x220da, 0x220d6, 0x220e7, 0x2223a, 0x2223f, 0x22240, 0x22248, 0x22259, 0x22237, 0x22244, 0x2f88c, 0x2f88b, 0x22255, 0x22239, 0x22354, 0x22355, 0x22356, 0x22357, 0x22358, 0x22359, 0x2238d, 0x223f2, 0x223f8, 0x223f5, 0x223f6, 0x223fc, 0x223fe, 0x223f1, 0x223fd, 0x2245e, 0x22496, 0x22497, 0x2249c, 0x2249b, 0x22495, 0x2f89a, 0x2251a, 0x22509, 0x22504, 0x2250e, 0x2251c, 0x22518, 0x2250d, 0x22510, 0x2250c, 0x22503, 0x2267b, 0x226a6, 0x22677, 0x22688, 0x22674, 0x226da, 0x22676, 0x22678, 0x2268a, 0x2268f, 0x2267d, 0x22690, 0x226ed, 0x226d9, 0x226d0, 0x2271a, 0x226d7, 0x226e2, 0x226e1, 0x226c5, 0x226e0, 0x226ca,
+		0x22725, 0x226cc, 0x2f8b3, 0x229c6, 0x229c1, 0x22a4d, 0x22b4b, 0x22b64, 0x22b95, 0x22b99, 0x2f8bc, 0x22b94, 0x22ba2, 0x22bae, 0x22b9e, 0x22ba7, 0x22b86, 0x39fd, 0x22ba4, 0x22b91, 0x22b93, 0x2f8bb, 0x22b88, 0x22ecd, 0x22eca, 0x22f3f, 0x22f40, 0x22f46, 0x22f41, 0x22f45, 0x22f58, 0x22f3b, 0x22f48, 0x2304e, 0x23052, 0x2304f, 0x2307b, 0x2307a, 0x230a0, 0x2309f, 0x230fb, 0x230f7, 0x231b8, 0x231b9, 0x231bb, 0x231b7, 0x3af4, 0x231ba, 0x3aef, 0x231da, 0x231b5, 0x231bf, 0x231bc, 0x231c0, 0x2f8d3, 0x2f8d9, 0x23375, 0x23379, 0x23376, 0x23435, 0x23440, 0x234c0, 0x23481, 0x234ad, 0x234af, 0x234ac, 0x2348f, 0x234a8, 0x234aa, 0x234a9, 0x23488, 0x2347f, 0x23480, 0x234bc, 0x238ae, 0x238bb, 0x238bd, 0x3c31, 0x23978, 0x23974, 0x23a0c, 0x23a11, 0x23a08, 0x23a06, 0x23a10, 0x23a8f, 0x23a90, 0x23a8d, 0x23a8e, 0x23a96, 0x23a95, 0x23b0b, 0x23b0c, 0x23b7c,
+		0x23b73, 0x23b75, 0x23b76, 0x23b7d, 0x23b78, 0x23b71, 0x23c4b, 0x23c4e, 0x23d33, 0x23d32, 0x3d03, 0x23d91, 0x23de7, 0x23de9, 0x23da2, 0x23d94, 0x23d87, 0x23da3, 0x23ddd, 0x23d7b, 0x23d83, 0x23d81, 0x23ddf, 0x23d7c, 0x23de4, 0x23de2, 0x23d93, 0x23d7d, 0x23dbf, 0x23d9b, 0x23d8e, 0x23d9f, 0x3d09, 0x23d8c, 0x23d7f, 0x23d9c, 0x23d84, 0x23d42, 0x23de6, 0x24251, 0x2424a, 0x24264, 0x24225, 0x2422f, 0x2422e, 0x2422b, 0x24228, 0x24232, 0x2422d, 0x24231, 0x24239, 0x2422c, 0x24261, 0x24511, 0x24510, 0x24512, 0x24553, 0x24555, 0x2457b, 0x24581, 0x2457d, 0x2457c, 0x245c2, 0x245c5, 0x2f922, 0x245c4, 0x2f923, 0x2466b, 0x24668, 0x3e3e, 0x2465c, 0x2465d, 0x2466a, 0x2f924, 0x24c76, 0x24776, 0x3e77, 0x247c4, 0x247cb, 0x247c8, 0x247d4, 0x247d5, 0x247c9, 0x247d7, 0x3e7b, 0x24978, 0x2495a, 0x2495b, 0x2495c, 0x24956, 0x24958, 0x24971, 0x269d4, 0x24b40,
+		0x2f933, 0x24b3f, 0x24b43, 0x24b41, 0x24be2, 0x24be0, 0x24be3, 0x24c66, 0x24c73, 0x24c6c, 0x24c71, 0x24c6a, 0x24c6d, 0x24c6e, 0x24c6b, 0x24d8c, 0x24d77, 0x2f93a, 0x24d7f, 0x24d89, 0x24d7a, 0x24d85, 0x24d78, 0x24d8a, 0x24d86, 0x24f2c, 0x24f67, 0x24f5b, 0x24fe5, 0x24fe1, 0x25061, 0x25069, 0x2506a, 0x25165, 0x2516d, 0x25163, 0x25186, 0x2515c, 0x25162, 0x2f948, 0x25179, 0x25169, 0x25170, 0x25176, 0x2515d, 0x25187, 0x2516e, 0x25171, 0x2517c, 0x25173, 0x2515b, 0x2516b, 0x253bf, 0x253c1, 0x253bd, 0x253c9, 0x253bc, 0x253c2, 0x253c0, 0x25492, 0x254a9, 0x2548f, 0x25476, 0x2547b, 0x25475, 0x254a4, 0x25664, 0x2573d, 0x257af, 0x413a, 0x2f958, 0x2579d, 0x25799, 0x257b1, 0x25963, 0x25962, 0x25964, 0x25969, 0x41a0, 0x25a75, 0x25a73, 0x25a71, 0x25a74, 0x25b0c, 0x25b16, 0x41e5, 0x25b11, 0x25b1f, 0x25b1a, 0x25b0d, 0x25b1b, 0x25b13, 0x25e4e, 0x25e55,
+		0x25e50, 0x427d, 0x25fd2, 0x25fd3, 0x25fd1, 0x25ff1, 0x25fdc, 0x25fc8, 0x25fcc, 0x25fd0, 0x25fcf, 0x25fdf, 0x2622b, 0x2622e, 0x2622d, 0x2629d, 0x2629e, 0x2629f, 0x262a9, 0x262a0, 0x26298, 0x262a1, 0x262ab, 0x262af, 0x2636e, 0x2635f, 0x2635a, 0x436d, 0x26365, 0x26368, 0x26372, 0x26417, 0x26416, 0x26418, 0x26419, 0x2641a, 0x26422, 0x2641b, 0x2641c, 0x264be, 0x264ee, 0x2655a, 0x26550, 0x26558, 0x26554, 0x26557, 0x26556, 0x26615, 0x2669b, 0x26693, 0x26692, 0x26672, 0x26698, 0x26699, 0x266df, 0x43f8, 0x266d5, 0x8158, 0x266d4, 0x266f3, 0x266f4, 0x266e7, 0x266e1, 0x266e6, 0x266eb, 0x266ec, 0x202db, 0x4450, 0x2690d, 0x2690c, 0x21b2f, 0x26968, 0x26965, 0x2696b, 0x26969, 0x269d1, 0x269d3, 0x26a27, 0x26a28, 0x4468, 0x4467, 0x26a24, 0x26ad7, 0x26bca, 0x26bc7, 0x2f99e, 0x26bd8, 0x26be1, 0x26bc6, 0x26bf8, 0x26bc3, 0x26bf4, 0x26c17, 0x26bea,
+		0x26bcb, 0x26b86, 0x26bc4, 0x26bd9, 0x26c19, 0x26bc2, 0x26be2, 0x44cf, 0x26bde, 0x26bed, 0x2f99c, 0x44cd, 0x26c1d, 0x26bdd, 0x26bdb, 0x26be8, 0x26be9, 0x26bbf, 0x26be0, 0x26bd1, 0x26bdc, 0x26bce, 0x26c1e, 0x26bcc, 0x26bf2, 0x26bf3, 0x2f99f, 0x2f9a2, 0x2f9a3, 0x2f9a1, 0x271ab, 0x271aa, 0x271a7, 0x271a9, 0x27288, 0x27275, 0x27260, 0x2726c, 0x27273, 0x45ab, 0x2726e, 0x27274, 0x27276, 0x27268, 0x27277, 0x27286, 0x275ea, 0x27651, 0x27650, 0x27658, 0x2764d, 0x2764f, 0x2764e, 0x2765c, 0x27652, 0x27644, 0x2764a, 0x2764b, 0x277ea, 0x27810, 0x27811, 0x278cd, 0x278bf, 0x278c4, 0x278d0, 0x278ce, 0x278c0, 0x278c2, 0x278cf, 0x278c9, 0x278bb, 0x27997, 0x27992, 0x2796f, 0x2797e, 0x2799a, 0x46c1, 0x27986, 0x27973, 0x8a24, 0x27977, 0x2798f, 0x27970, 0x27981, 0x27982, 0x27999, 0x2797d, 0x2797f, 0x2797b, 0x27987, 0x27bb2, 0x27c2f, 0x27c34, 0x27c2e,
+		0x27c32, 0x4747, 0x27caa, 0x4757, 0x27ca9, 0x27d38, 0x27d36, 0x27d37, 0x27d47, 0x27d33, 0x27d39, 0x27d35, 0x27d44, 0x27ea5, 0x27ea6, 0x27e9e, 0x27fe3, 0x27fdf, 0x27fd3, 0x27ff1, 0x27fe4, 0x27fe0, 0x27fd6, 0x8dc3, 0x27fe6, 0x27fd8, 0x27fde, 0x27fdb, 0x27fdc, 0x2821b, 0x2821f, 0x2821d, 0x2821c, 0x2821e, 0x28220, 0x28221, 0x484e, 0x282b6, 0x282bc, 0x282c6, 0x282c7, 0x282ba, 0x282be, 0x282bd, 0x282b5, 0x282b4, 0x2840f, 0x28462, 0x284eb, 0x284d9, 0x284e4, 0x284d7, 0x284d8, 0x284d6, 0x284ce, 0x284dd, 0x284e7, 0x284d2, 0x284c5, 0x284c9, 0x284db, 0x28692, 0x2868a, 0x2868b, 0x28689, 0x28708, 0x28703, 0x28706, 0x28705, 0x286f5, 0x2870b, 0x286fb, 0x287fc, 0x28801, 0x28802, 0x287fa, 0x28808, 0x287f7, 0x28800, 0x287ff, 0x28806, 0x287f6, 0x287fb, 0x2880a, 0x28925, 0x28943, 0x28944, 0x28c64, 0x28c62, 0x28c5e, 0x28c5b, 0x28cd6, 0x20072, 0x200c2,
+		0x28e77, 0x28e82, 0x28e7c, 0x28e7d, 0x28e86, 0x28e81, 0x28e84, 0x28e76, 0x28e83, 0x28e7f, 0x28e7e, 0x28f88, 0x28f8d, 0x28f8b, 0x28f91, 0x28f8f, 0x2907c, 0x29079, 0x2907a, 0x291ea, 0x2944c, 0x29599, 0x202d1, 0x2968b, 0x2968d, 0x2968a, 0x2968e, 0x29811, 0x22256, 0x2fa15, 0x20074, 0x200c3, 0x200c4, 0x2011b, 0x2015f, 0x2016a, 0x20169, 0x2016b, 0x2030c, 0x202f6, 0x202ed, 0x20329, 0x202fe, 0x202f1, 0x20317, 0x202ff, 0x202ee, 0x202fd, 0x2031c, 0x202fc, 0x20300, 0x20320, 0x3477, 0x202f9, 0x20367, 0x20308, 0x2049e, 0x204f3, 0x20525, 0x20527, 0x2051f, 0x20565, 0x20563, 0x20594, 0x20597, 0x205f1, 0x2065f, 0x20662, 0x51f2, 0x20660, 0x2f822, 0x2078c, 0x20782, 0x20790, 0x2078b, 0x2078d, 0x20781, 0x2079d, 0x2088e, 0x2088f, 0x20892, 0x20923, 0x20952, 0x2f82e, 0x20a00, 0x20a01, 0x20a02, 0x20a1f, 0x20a8c, 0x20a89, 0x20a8b, 0x20a88, 0x20a8d, 0x20a8f,
+		0x26385, 0x20b00, 0x20b05, 0x20b01, 0x20b68, 0x20b6e, 0x20b67, 0x20b75, 0x55b6, 0x2f845, 0x20dd7, 0x2f846, 0x20048, 0x20dd4, 0x20dd8, 0x2f847, 0x2f848, 0x20dba, 0x2f849, 0x20ddb, 0x20dbf, 0x20e35, 0x20dbc, 0x20dd9, 0x35c4, 0x20ddd, 0x20e00, 0x20dd5, 0x20e30, 0x20dbd, 0x20ddc, 0x211fd, 0x211fe, 0x21207, 0x24517, 0x2136f, 0x21369, 0x21370, 0x21367, 0x213a9, 0x21395, 0x21390, 0x2136c, 0x21397, 0x21371, 0x3652, 0x21374, 0x2136d, 0x2138e, 0x2152f, 0x2a31b, 0x215a9, 0x2164e, 0x2f85f, 0x2165a, 0x21648, 0x21655, 0x2164c, 0x21649, 0x2164f, 0x2164a, 0x217d6, 0x217a0, 0x217a9, 0x2f867, 0x2179d, 0x217d4, 0x217a4, 0x217a8, 0x217a6, 0x217e6, 0x2197d, 0x21977, 0x2197a, 0x21a41, 0x21a49, 0x21a59, 0x21a45, 0x21a48, 0x21a40, 0x21b8e, 0x21b95, 0x21b90, 0x21bf7, 0x21bf8, 0x21bf6, 0x21bfb, 0x21c9e, 0x21c9d, 0x21c99, 0x2f877, 0x21ca3, 0x21ca9, 0x21c98,
+		0x21ca0, 0x21c96, 0x21c94, 0x21c95, 0x21c9f, 0x21ca1, 0x21d21, 0x21d1d, 0x21d1f, 0x21e6d, 0x2f87c, 0x21e9a, 0x21e92, 0x21e7a, 0x21e7d, 0x21ea1, 0x21e9d, 0x21e99, 0x21e6b, 0x21e6e, 0x2f87d, 0x22045, 0x2f884, 0x220fb, 0x220eb, 0x220f2, 0x220f9, 0x2f886, 0x220f8, 0x220ed, 0x22101, 0x220f7, 0x22106, 0x2219b, 0x221ca, 0x386b, 0x5ec3, 0x2226b, 0x2226c, 0x22274, 0x22267, 0x2225b, 0x22260, 0x22276, 0x22263, 0x22261, 0x22328, 0x2235b, 0x2235e, 0x22360, 0x2235d, 0x2235c, 0x223f4, 0x22400, 0x22408, 0x22407, 0x22401, 0x22405, 0x22464, 0x22463, 0x2249e, 0x224a0, 0x224a1, 0x2249f, 0x22526, 0x2252d, 0x22528, 0x2251d, 0x226ec, 0x226e3, 0x226eb, 0x22716, 0x226c8, 0x2661b, 0x226e9, 0x226e4, 0x22724, 0x226d1, 0x3941, 0x226dd, 0x226c2, 0x226cb, 0x226c7, 0x226e7, 0x3950, 0x226ea, 0x2274b, 0x2f8a5, 0x22760, 0x2277d, 0x2273e, 0x2f8a4, 0x22752, 0x2274e,
+		0x2273c, 0x22732, 0x22730, 0x22723, 0x229ca, 0x229cb, 0x229c9, 0x229c8, 0x229cd, 0x22b98, 0x22ba0, 0x22b9f, 0x22b9c, 0x22ba3, 0x22b97, 0x2f8be, 0x22bf1, 0x22c09, 0x22c03, 0x22bea, 0x22c45, 0x22bed, 0x22c05, 0x22c1a, 0x22c15, 0x22c01, 0x22bec, 0x22c0e, 0x22c17, 0x22c42, 0x22c12, 0x22c10, 0x22bef, 0x22bff, 0x22c00, 0x22c0c, 0x22c0f, 0x22c04, 0x22c08, 0x22c14, 0x22c43, 0x2f8bd, 0x22c1b, 0x22c11, 0x22c13, 0x22ecf, 0x22ece, 0x22f6f, 0x22f6e, 0x22f7a, 0x22f70, 0x22f64, 0x22f5e, 0x22f6c, 0x2f8c9, 0x22f5b, 0x22f61, 0x22f65, 0x2307f, 0x23080, 0x2307c, 0x230a7, 0x230a6, 0x230a1, 0x3ac0, 0x230a8, 0x230a3, 0x230a2, 0x230ad, 0x230a5, 0x23101, 0x230ff, 0x230fc, 0x23100, 0x23135, 0x231ee, 0x231ef, 0x231f6, 0x231e8, 0x231ea, 0x231e3, 0x2321f, 0x3afc, 0x231e4, 0x231fa, 0x231f1, 0x231fb, 0x2f8d4, 0x2333d, 0x2333c, 0x3479, 0x23378, 0x23377, 0x440b,
+		0x234d1, 0x234c7, 0x234df, 0x234d0, 0x234e0, 0x234d6, 0x234d8, 0x23516, 0x2350e, 0x234d9, 0x2350f, 0x23511, 0x234cd, 0x23489, 0x234ce, 0x23514, 0x234da, 0x23512, 0x234d3, 0x234c2, 0x234e1, 0x234e9, 0x234ea, 0x234de, 0x23515, 0x238d9, 0x238d6, 0x238cd, 0x238d0, 0x238d3, 0x2f8f0, 0x23982, 0x23985, 0x2397f, 0x2397d, 0x23981, 0x23983, 0x23984, 0x284a0, 0x23a28, 0x23a0f, 0x23a17, 0x23a1a, 0x23a25, 0x2f8f7, 0x23a9b, 0x23a99, 0x23b92, 0x23b8c, 0x23b95, 0x23b8d, 0x23ba3, 0x23b93, 0x23b91, 0x23ddb, 0x23d99, 0x23d9a, 0x23e08, 0x23e4c, 0x23e0d, 0x23e01, 0x23e4e, 0x23e02, 0x23e4d, 0x23e21, 0x23dfc, 0x2f909, 0x23d8a, 0x2f908, 0x23d95, 0x23e11, 0x23e12, 0x23e46, 0x23e1c, 0x23e49, 0x23e0c, 0x3d1e, 0x23e13, 0x23e16, 0x23dfd, 0x23e0f, 0x23e1f, 0x24230, 0x2426e, 0x2426b, 0x2429b, 0x2427b, 0x24263, 0x24297, 0x2426d, 0x2429c, 0x24298, 0x2426f, 0x24267,
+		0x24269, 0x24515, 0x24563, 0x24586, 0x2458a, 0x24587, 0x24588, 0x24585, 0x24589, 0x245ca, 0x245c7, 0x245cb, 0x245cc, 0x245c9, 0x2460d, 0x24683, 0x24684, 0x24678, 0x24682, 0x24673, 0x24679, 0x2468c, 0x247d0, 0x247cf, 0x247d2, 0x247d9, 0x247cc, 0x247eb, 0x247fd, 0x247ec, 0x247e8, 0x247f8, 0x247fa, 0x2f927, 0x3e85, 0x247e1, 0x247fb, 0x248e7, 0x2f92e, 0x249a5, 0x24991, 0x249a6, 0x2497c, 0x24992, 0x249a2, 0x249a0, 0x24afc, 0x24afe, 0x24b57, 0x24b53, 0x24b58, 0x24be7, 0x24c8e, 0x2f938, 0x24c83, 0x24c8b, 0x24c84, 0x3f97, 0x24da2, 0x24db7, 0x24da9, 0x24da5, 0x24d9f, 0x24daa, 0x24d97, 0x24da1, 0x24d9e, 0x24dab, 0x24d99, 0x24da3, 0x24f30, 0x24f32, 0x24f2f, 0x24f70, 0x24f6c, 0x24f6f, 0x24fe8, 0x24fee, 0x24fea, 0x2506d, 0x25076, 0x25070, 0x25071, 0x2506c, 0x251eb, 0x251b5, 0x25196, 0x25190, 0x2518d, 0x2f949, 0x251a2, 0x251b0, 0x25192, 0x251a0,
+		0x25193, 0x251c3, 0x2518e, 0x251b6, 0x2519d, 0x25195, 0x251b3, 0x251a4, 0x25370, 0x253d4, 0x4093, 0x253cf, 0x20170, 0x25494, 0x7861, 0x254c0, 0x254b4, 0x254c1, 0x40b2, 0x254bd, 0x254af, 0x25677, 0x25678, 0x2566b, 0x2566d, 0x2566e, 0x25672, 0x2566f, 0x25671, 0x230ab, 0x2568c, 0x2573f, 0x257b6, 0x257b7, 0x7a06, 0x257bb, 0x257b8, 0x2079c, 0x257b9, 0x7a01, 0x2598b, 0x2597a, 0x25984, 0x25988, 0x25991, 0x25979, 0x2598e, 0x25980, 0x25982, 0x2597c, 0x41a4, 0x25a84, 0x25a7f, 0x41f2, 0x25b3b, 0x25b71, 0x25b3d, 0x25b30, 0x41f1, 0x25b3e, 0x25b38, 0x25e5e, 0x25e64, 0x4281, 0x25e5d, 0x25e6d, 0x25e4f, 0x25e62, 0x25e5f, 0x25fec, 0x25ff2, 0x25ff4, 0x25ff7, 0x25ff6, 0x26007, 0x25fed, 0x25fea, 0x25ff0, 0x25ff8, 0x25ff3, 0x42dc, 0x2f972, 0x26231, 0x2622f, 0x262b6, 0x262ae, 0x262aa, 0x262bf, 0x262cc, 0x262c7, 0x26c83, 0x26380, 0x2637b, 0x2637f,
+		0x2637d, 0x26383, 0x2f978, 0x26446, 0x2642d, 0x26425, 0x26426, 0x2642c, 0x26437, 0x26431, 0x26433, 0x26432, 0x26427, 0x2642a, 0x2642e, 0x2642f, 0x264c0, 0x26571, 0x26561, 0x26562, 0x26566, 0x26618, 0x266c5, 0x266c3, 0x266c4, 0x266c2, 0x266ae, 0x26710, 0x26708, 0x2671f, 0x2673a, 0x2673b, 0x26736, 0x2670c, 0x26706, 0x2672a, 0x26757, 0x26750, 0x26720, 0x2f985, 0x2672c, 0x26721, 0x2670b, 0x26719, 0x26735, 0x26718, 0x2670f, 0x26713, 0x26755, 0x26739, 0x2671a, 0x440c, 0x26717, 0x268e4, 0x268e9, 0x2458b, 0x26910, 0x26912, 0x2693f, 0x2696e, 0x24518, 0x2f98c, 0x269d6, 0x269d5, 0x269d7, 0x20b02, 0x446d, 0x26a31, 0x26a30, 0x26a3a, 0x26b85, 0x26b6b, 0x26c48, 0x26c4b, 0x26c37, 0x26c7b, 0x26c6c, 0x26c85, 0x26c65, 0x26c36, 0x26c86, 0x26c34, 0x26c68, 0x26c5a, 0x26c58, 0x26c72, 0x26c6a, 0x26bd3, 0x26c3c, 0x26c33, 0x26c3b, 0x26c4d, 0x26c4f, 0x26c7c,
+		0x26cb0, 0x26c5b, 0x26c55, 0x26c64, 0x26c6b, 0x26c53, 0x44e6, 0x26c57, 0x26c5e, 0x26c6d, 0x26c35, 0x26c69, 0x26c59, 0x26c66, 0x26c50, 0x26c51, 0x26c5c, 0x26d5d, 0x26c87, 0x26c78, 0x26c49, 0x26c4e, 0x26bf1, 0x26c73, 0x26c88, 0x26bcf, 0x2f9a4, 0x271b1, 0x271b3, 0x2729c, 0x272a4, 0x27290, 0x272b8, 0x272a0, 0x2729d, 0x272a8, 0x272a9, 0x2f9b9, 0x272b1, 0x27293, 0x2729b, 0x272a2, 0x272a1, 0x272b0, 0x272a7, 0x275b3, 0x8849, 0x275b4, 0x275f1, 0x275ed, 0x275ec, 0x27668, 0x27675, 0x2766a, 0x27662, 0x27667, 0x27660, 0x27677, 0x2765f, 0x27679, 0x27823, 0x27821, 0x2781c, 0x8999, 0x2781f, 0x2781e, 0x278d6, 0x278d3, 0x278d9, 0x278d7, 0x278d4, 0x278dc, 0x278d8, 0x279a3, 0x46d1, 0x279be, 0x279a9, 0x279a1, 0x279a0, 0x279ab, 0x279a2, 0x279ba, 0x279c2, 0x2799c, 0x279bb, 0x279aa, 0x279ac, 0x279a5, 0x279c1, 0x472c, 0x27be2, 0x27c36, 0x27c3d, 0x27c3a,
+		0x22461, 0x27c48, 0x27c3c, 0x27cb7, 0x27cac, 0x27cb3, 0x27cb6, 0x27cb2, 0x476e, 0x27d5d, 0x27d49, 0x27d4e, 0x27d4f, 0x27d4d, 0x27d5c, 0x27e5d, 0x27ebb, 0x27ece, 0x27ebf, 0x27eba, 0x27ec3, 0x47e5, 0x28006, 0x27ff8, 0x27ffe, 0x28013, 0x27ff6, 0x47e6, 0x28234, 0x2822d, 0x2822a, 0x28235, 0x2822c, 0x282d4, 0x282da, 0x282d3, 0x282d2, 0x282ce, 0x282cf, 0x489f, 0x284fd, 0x2850b, 0x284fe, 0x284f8, 0x284f7, 0x28517, 0x284fa, 0x284f9, 0x28500, 0x284f5, 0x284f1, 0x28503, 0x28505, 0x2851c, 0x286ac, 0x2869a, 0x286ad, 0x286a0, 0x2871b, 0x28725, 0x28724, 0x28726, 0x28727, 0x28728, 0x2872a, 0x2871a, 0x2872e, 0x28715, 0x28815, 0x28814, 0x28817, 0x28818, 0x2880f, 0x28813, 0x2880b, 0x28822, 0x2137a, 0x28926, 0x921f, 0x28951, 0x2894d, 0x2894e, 0x2894a, 0x2894b, 0x2894c, 0x28950, 0x28962, 0x2894f, 0x28952, 0x28c68, 0x28c69, 0x28ce5, 0x28cf0, 0x28ce1,
+		0x28ce2, 0x2f9f2, 0x28ea5, 0x28ea9, 0x28ea4, 0x28eaf, 0x28eac, 0x28eae, 0x28eaa, 0x28f95, 0x28f99, 0x28f93, 0x28f9d, 0x28f98, 0x28f9c, 0x28f97, 0x4a0d, 0x29089, 0x29087, 0x291d7, 0x291ed, 0x291f1, 0x291f0, 0x29205, 0x29203, 0x29204, 0x2925f, 0x29457, 0x2959c, 0x295a1, 0x2959a, 0x2968f, 0x29693, 0x2969e, 0x296a3, 0x29698, 0x29699, 0x29695, 0x2986e, 0x2986a, 0x29af4, 0x29af7, 0x29c79, 0x29f8f, 0x29f91, 0x2a00b, 0x2a502, 0x20084, 0x20104, 0x20180, 0x2032f, 0x2033f, 0x2033b, 0x20362, 0x20344, 0x20333, 0x2035f, 0x2032b, 0x2f809, 0x20339, 0x20336, 0x20348, 0x20335, 0x2036c, 0x20358, 0x2033a, 0x204a8, 0x204f4, 0x20529, 0x2059a, 0x20600, 0x207bb, 0x207bd, 0x207b7, 0x207b2, 0x34f8, 0x207a8, 0x207ab, 0x207a6, 0x207bc, 0x20897, 0x20896, 0x208f8, 0x5313, 0x20951, 0x3533, 0x209b5, 0x209b4, 0x209b6, 0x353c, 0x209b7, 0x20a05, 0x20a23, 0x20a95,
+		0x20a98, 0x354f, 0x20a94, 0x20a93, 0x20b07, 0x20b73, 0x20b71, 0x20b72, 0x20b78, 0x20e5f, 0x20e6a, 0x20e67, 0x20e6b, 0x20e69, 0x20e8e, 0x20e49, 0x20e80, 0x35dc, 0x20e44, 0x20e8f, 0x20e45, 0x2f84c, 0x20e4f, 0x20e63, 0x20e36, 0x20e48, 0x20e5c, 0x20e93, 0x20e61, 0x20e60, 0x20e4e, 0x20e5e, 0x20e3f, 0x20ea4, 0x55e0, 0x20e68, 0x2120b, 0x21211, 0x2120d, 0x2120e, 0x213c2, 0x2f857, 0x213b0, 0x213c3, 0x213c8, 0x21365, 0x213d7, 0x213bd, 0x213b8, 0x213b6, 0x213c7, 0x213bc, 0x213b1, 0x213af, 0x2f85b, 0x21535, 0x21530, 0x2155d, 0x20b76, 0x2156f, 0x215b0, 0x2f85e, 0x215b4, 0x2165c, 0x2165d, 0x21808, 0x217ee, 0x2180b, 0x217f2, 0x217fd, 0x217f1, 0x21810, 0x2f868, 0x21814, 0x2198b, 0x2198c, 0x21985, 0x21a7a, 0x21a6c, 0x21a60, 0x21a67, 0x21a66, 0x21a6a, 0x21a5f, 0x21a6d, 0x21a68, 0x21a64, 0x21b3a, 0x21b3b, 0x21b39, 0x21c02, 0x21c04, 0x21c03, 0x21cb4,
+		0x21cb3, 0x21cbb, 0x21cac, 0x21cb6, 0x21cb1, 0x21caf, 0x21cb5, 0x21cbe, 0x21cb2, 0x21d24, 0x21eb6, 0x21eb9, 0x21eac, 0x21eb0, 0x21ed7, 0x21ebb, 0x21efe, 0x21ecb, 0x2f87f, 0x37f5, 0x21eb3, 0x21ebe, 0x21ecd, 0x21ebc, 0x21eba, 0x21ec7, 0x22116, 0x2211b, 0x22117, 0x22115, 0x2219f, 0x2f88e, 0x22282, 0x22283, 0x2228e, 0x2226a, 0x22289, 0x22294, 0x22286, 0x22290, 0x22362, 0x22390, 0x2240c, 0x2240f, 0x38c3, 0x224a5, 0x224a7, 0x224a6, 0x224a4, 0x22535, 0x22538, 0x22536, 0x22543, 0x22547, 0x22537, 0x22743, 0x227a2, 0x22751, 0x22772, 0x2276d, 0x2272f, 0x22754, 0x2276e, 0x22755, 0x22737, 0x2274f, 0x22750, 0x6139, 0x2279e, 0x2f8a8, 0x227b3, 0x227a7, 0x3971, 0x227f9, 0x22791, 0x2f8a7, 0x227b6, 0x227dd, 0x22799, 0x229df, 0x229d5, 0x247f5, 0x22c28, 0x22c40, 0x22c71, 0x22c98, 0x22c95, 0x22c65, 0x22c78, 0x22c7f, 0x22c60, 0x22c7c, 0x22c96, 0x22c6a,
+		0x22c79, 0x22c73, 0x22c72, 0x22c7b, 0x22c70, 0x22ed5, 0x22ed7, 0x22f8f, 0x22f89, 0x22f9e, 0x22f87, 0x22fa0, 0x22f8d, 0x22f88, 0x22f7f, 0x22f8c, 0x22f93, 0x23059, 0x295b0, 0x230b1, 0x251f4, 0x230af, 0x230b2, 0x23108, 0x2310a, 0x23136, 0x23137, 0x2f8cf, 0x3b01, 0x23236, 0x23229, 0x2324a, 0x23226, 0x23228, 0x23224, 0x2322a, 0x23248, 0x23344, 0x23384, 0x2338c, 0x234c4, 0x234dc, 0x23587, 0x23553, 0x2357f, 0x23531, 0x23551, 0x2354b, 0x23552, 0x23580, 0x235a5, 0x23581, 0x23543, 0x23534, 0x23536, 0x23532, 0x23548, 0x23549, 0x2353c, 0x2354d, 0x2354a, 0x2f8e6, 0x2358a, 0x23546, 0x2353e, 0x23583, 0x23550, 0x235b4, 0x238f0, 0x238e4, 0x238e3, 0x238e5, 0x238e6, 0x238e7, 0x238e1, 0x238ef, 0x238e8, 0x238dd, 0x23903, 0x23988, 0x23a26, 0x23a16, 0x23a3b, 0x23a2f, 0x23a39, 0x23a34, 0x2f8f4, 0x23a35, 0x23a31, 0x23a38, 0x20b81, 0x23aaa, 0x23aa3, 0x23aa4,
+		0x23aa0, 0x23aa1, 0x23aa9, 0x23aa5, 0x23baf, 0x23bb1, 0x23bab, 0x23bae, 0x23bb0, 0x23bb3, 0x23bac, 0x23ba9, 0x23bb2, 0x23ba8, 0x23bb4, 0x23bc2, 0x23c4f, 0x23c66, 0x23e1e, 0x23e15, 0x23e10, 0x23e7f, 0x23e7e, 0x23e60, 0x23ecc, 0x23eb2, 0x23e62, 0x23e8d, 0x23e8e, 0x23e77, 0x23e7c, 0x23e8f, 0x23e5d, 0x23e6d, 0x23e63, 0x23eaf, 0x23e90, 0x23e7d, 0x23e7a, 0x23e06, 0x2f90b, 0x23e68, 0x23eb4, 0x23e78, 0x23eb1, 0x6ed7, 0x2f90c, 0x3d41, 0x242e8, 0x242e9, 0x242c0, 0x3dd7, 0x242ea, 0x242b7, 0x242ba, 0x242b5, 0x2f91d, 0x242b4, 0x242bc, 0x242c6, 0x242b8, 0x242bd, 0x242c2, 0x2434d, 0x242f0, 0x242c7, 0x242c1, 0x242c3, 0x242f1, 0x242ec, 0x3de2, 0x2451c, 0x24520, 0x24558, 0x24565, 0x24564, 0x2458c, 0x2458d, 0x245d9, 0x245e0, 0x24610, 0x24694, 0x24692, 0x24696, 0x24695, 0x246bf, 0x246a0, 0x3e45, 0x247f4, 0x247f6, 0x247dc, 0x733d, 0x24816, 0x24815,
+		0x2481c, 0x2480f, 0x2482c, 0x24814, 0x24825, 0x24817, 0x24812, 0x2481e, 0x24980, 0x249a8, 0x249af, 0x249d6, 0x249e2, 0x249b4, 0x249b3, 0x249b0, 0x249b2, 0x249a1, 0x24b60, 0x24b66, 0x24b61, 0x24b4e, 0x24b5d, 0x24b63, 0x24be6, 0x24bef, 0x24bec, 0x24c98, 0x24ca7, 0x24c94, 0x24c95, 0x24c91, 0x24c9d, 0x24c99, 0x24c9b, 0x24c9c, 0x24d1d, 0x24d1c, 0x24dd0, 0x24de0, 0x24dcb, 0x24ddb, 0x24dda, 0x24dc2, 0x24dd3, 0x24de5, 0x24f7d, 0x24f7b, 0x24fff, 0x3ff2, 0x24ff9, 0x25077, 0x2507c, 0x25078, 0x2507b, 0x2507a, 0x251d2, 0x251cb, 0x251c9, 0x251ce, 0x251e4, 0x251ca, 0x251d0, 0x22fa5, 0x4049, 0x251d9, 0x251ee, 0x251dd, 0x25200, 0x251e1, 0x253de, 0x253e2, 0x253da, 0x254ce, 0x2f94f, 0x254cf, 0x254da, 0x254d1, 0x254d4, 0x254ed, 0x254cb, 0x254d5, 0x254f1, 0x2569c, 0x25688, 0x25741, 0x257d0, 0x257f7, 0x257cf, 0x257d1, 0x257db, 0x257de, 0x257f8, 0x257dc,
+		0x257d9, 0x259a0, 0x259b2, 0x259a1, 0x259aa, 0x259a9, 0x41a9, 0x259a6, 0x2599c, 0x259b5, 0x259a7, 0x25a8e, 0x25a90, 0x25a91, 0x25b85, 0x25b5b, 0x25b70, 0x25b64, 0x25b67, 0x25b63, 0x25b77, 0x25b68, 0x25b65, 0x25b6a, 0x25b78, 0x25b66, 0x25e88, 0x25e9e, 0x25e74, 0x25e7a, 0x25e79, 0x25e8b, 0x25e7f, 0x26013, 0x2601e, 0x26017, 0x2601a, 0x26022, 0x26043, 0x26019, 0x2601f, 0x26027, 0x26012, 0x26024, 0x26025, 0x2035e, 0x26235, 0x26234, 0x262d1, 0x262c4, 0x262ca, 0x262c6, 0x262cb, 0x262cd, 0x262e2, 0x26389, 0x2638b, 0x26386, 0x26388, 0x2638d, 0x2643b, 0x2643c, 0x2643d, 0x264f5, 0x26579, 0x26575, 0x26582, 0x2657f, 0x26585, 0x26576, 0x2657c, 0x2657e, 0x2657b, 0x26580, 0x2657a, 0x22548, 0x43cb, 0x2661d, 0x266f6, 0x266f7, 0x266f9, 0x26763, 0x2676c, 0x2676e, 0x26714, 0x440d, 0x26767, 0x2676f, 0x26769, 0x26776, 0x26795, 0x26771, 0x26761, 0x26778,
+		0x2676b, 0x26785, 0x26784, 0x26914, 0x26976, 0x259b6, 0x26975, 0x26974, 0x269e2, 0x26a3f, 0x26a44, 0x26a3d, 0x26a47, 0x26a48, 0x26adb, 0x26adc, 0x26add, 0x26be5, 0x26be6, 0x26cc2, 0x26d2e, 0x26d1d, 0x26cf8, 0x26cf0, 0x26cf6, 0x26cc5, 0x26cc6, 0x26cfc, 0x26d52, 0x26d2f, 0x26d10, 0x26cf3, 0x26cd2, 0x26cea, 0x26cdc, 0x26d1b, 0x26cfb, 0x26cc3, 0x26d16, 0x26d07, 0x26cc9, 0x26cd8, 0x26d30, 0x26d13, 0x26d31, 0x26cfa, 0x26cf2, 0x26de3, 0x26cd5, 0x26d01, 0x26cf1, 0x26d1c, 0x26cd6, 0x26d08, 0x26d0b, 0x26d17, 0x26d20, 0x26cca, 0x26d32, 0x26d05, 0x26ce4, 0x26cce, 0x26d33, 0x26d02, 0x26d19, 0x26d1e, 0x26cd3, 0x26cf7, 0x26ce8, 0x26d1f, 0x26cf4, 0x26dd8, 0x271bf, 0x271be, 0x271bd, 0x272dc, 0x272dd, 0x272ab, 0x272c9, 0x272c8, 0x272df, 0x272d9, 0x272db, 0x272cc, 0x272e0, 0x272ef, 0x272f3, 0x272ae, 0x27301, 0x275b7, 0x275b9, 0x275b6, 0x275b8, 0x275f6,
+		0x275f3, 0x275f5, 0x275f2, 0x27691, 0x2769d, 0x2769b, 0x27692, 0x2768d, 0x2769e, 0x2768c, 0x4631, 0x2f9c7, 0x27695, 0x2f9c6, 0x2768a, 0x2768e, 0x2769c, 0x277ef, 0x2782d, 0x27852, 0x27835, 0x27828, 0x2782e, 0x278e5, 0x279ea, 0x279f1, 0x279eb, 0x279d8, 0x279d0, 0x279f3, 0x279db, 0x279ce, 0x46d5, 0x279da, 0x279d7, 0x279e1, 0x279f2, 0x279c8, 0x279d9, 0x279de, 0x279d1, 0x279e7, 0x279cf, 0x27bb7, 0x27c47, 0x27c42, 0x27c43, 0x2f9d3, 0x27cc3, 0x27cc1, 0x27cc7, 0x27d64, 0x27d6a, 0x27d66, 0x27d50, 0x27d6e, 0x27d65, 0x238ec, 0x27d7f, 0x2f9d5, 0x27d9a, 0x27d69, 0x27d72, 0x27d6f, 0x27d7d, 0x27d70, 0x27e60, 0x27ec2, 0x27ee7, 0x27ed1, 0x27eeb, 0x27ed4, 0x27edc, 0x27edb, 0x28037, 0x28025, 0x2801f, 0x2801e, 0x28021, 0x2801b, 0x28017, 0x28022, 0x2802a, 0x2801a, 0x2802d, 0x28023, 0x28026, 0x28036, 0x27fff, 0x2823a, 0x28240, 0x28242, 0x28238, 0x2823b,
+		0x2823c, 0x28243, 0x282e8, 0x4856, 0x282f9, 0x282eb, 0x282f1, 0x282e9, 0x282ec, 0x28415, 0x28447, 0x28446, 0x28445, 0x28524, 0x28556, 0x28521, 0x28527, 0x2854d, 0x28531, 0x2851e, 0x2852c, 0x2854f, 0x2852b, 0x28553, 0x28551, 0x28554, 0x28529, 0x28550, 0x2851f, 0x28532, 0x2852a, 0x2851d, 0x28528, 0x2852e, 0x2852d, 0x286bc, 0x286bb, 0x286bd, 0x2f9e4, 0x28747, 0x28741, 0x2f9e5, 0x28749, 0x48f4, 0x2882e, 0x28827, 0x2896a, 0x2897b, 0x28973, 0x28975, 0x28969, 0x28979, 0x28972, 0x28c76, 0x28c77, 0x28c74, 0x28c73, 0x28d02, 0x28cfe, 0x28d01, 0x28cf9, 0x28cf4, 0x28ed3, 0x28ed5, 0x28ed8, 0x28ec3, 0x28eca, 0x28ed0, 0x28ecb, 0x28ece, 0x28ec5, 0x28ee6, 0x28ec4, 0x28ec0, 0x28ed4, 0x28ee8, 0x28f76, 0x28fa2, 0x28fae, 0x28fa8, 0x28fa3, 0x28fa7, 0x28f96, 0x28fa9, 0x28fa5, 0x28faf, 0x28fa4, 0x28fab, 0x28faa, 0x28fa6, 0x28fa0, 0x29098, 0x291db, 0x291f6,
+		0x291f5, 0x2920c, 0x2920a, 0x9775, 0x29268, 0x29263, 0x29266, 0x4a58, 0x29264, 0x2926a, 0x29269, 0x29395, 0x29402, 0x2946a, 0x2945e, 0x29468, 0x29469, 0x29465, 0x2f9fe, 0x295a7, 0x295ae, 0x295a8, 0x295b3, 0x2969c, 0x296a9, 0x296b6, 0x296b3, 0x296b2, 0x2fa03, 0x296b8, 0x296c0, 0x296bf, 0x296ba, 0x296a8, 0x2983c, 0x29872, 0x29871, 0x29875, 0x29873, 0x29a17, 0x29a15, 0x29a16, 0x29a1b, 0x29afa, 0x29af9, 0x29c2c, 0x29c85, 0x29c82, 0x29c7f, 0x29c7d, 0x29c88, 0x242f3, 0x29d4e, 0x29d50, 0x29f93, 0x29f97, 0x29f98, 0x29f9b, 0x29f9c, 0x29f96, 0x2a28b, 0x2a2fd, 0x2a2fc, 0x2fa17, 0x2398b, 0x20108, 0x20107, 0x20373, 0x203a2, 0x203af, 0x20382, 0x2037b, 0x20374, 0x203b0, 0x20376, 0x203b9, 0x2039e, 0x203b1, 0x203a1, 0x203b2, 0x2036e, 0x2f80b, 0x3487, 0x20378, 0x2037a, 0x20383, 0x2039a, 0x204f8, 0x20531, 0x20569, 0x20568, 0x2059c, 0x20604, 0x20699,
+		0x207c7, 0x207c6, 0x207dc, 0x207c4, 0x207d8, 0x207d4, 0x207df, 0x207d1, 0x207d0, 0x207d6, 0x207cf, 0x208ad, 0x208af, 0x208a7, 0x208fd, 0x2095b, 0x2095a, 0x20a07, 0x20a9d, 0x20a9b, 0x20aab, 0x20aa4, 0x20a9c, 0x20a9e, 0x20aa5, 0x20aaa, 0x20aa6, 0x20b0e, 0x20b7e, 0x20b7c, 0x20eee, 0x20ed5, 0x20ee9, 0x20e42, 0x20ee2, 0x20f23, 0x20ed9, 0x20ed4, 0x20ee3, 0x20f15, 0x20eef, 0x20ef0, 0x20ed6, 0x20edd, 0x20ef6, 0x20f1c, 0x20ed8, 0x20edb, 0x20eda, 0x20eed, 0x21411, 0x21215, 0x21218, 0x2121a, 0x2121f, 0x21216, 0x2f84d, 0x21219, 0x2f84b, 0x213f0, 0x21409, 0x2141b, 0x27be7, 0x213f6, 0x213f4, 0x213b5, 0x21410, 0x213f2, 0x21415, 0x213f3, 0x213f8, 0x21539, 0x2153b, 0x21536, 0x2140e, 0x21572, 0x21574, 0x215b9, 0x215b7, 0x215b8, 0x21672, 0x2166b, 0x2181d, 0x21837, 0x21822, 0x21843, 0x2184d, 0x21838, 0x2185b, 0x21879, 0x2181b, 0x217f3, 0x21991, 0x21a7b,
+		0x21a94, 0x2f86f, 0x21a96, 0x21a7f, 0x21a8f, 0x21a84, 0x21a7c, 0x21a8e, 0x21a90, 0x21a98, 0x21a83, 0x21a80, 0x21a93, 0x21a82, 0x0, 0x21b3d, 0x21b41, 0x21ba1, 0x21b9f, 0x21c0a, 0x21c0d, 0x21cc8, 0x21cc9, 0x21cc7, 0x21ccd, 0x21d25, 0x21eb1, 0x21edc, 0x2f880, 0x21ee5, 0x21ef4, 0x21ebf, 0x21edb, 0x21eea, 0x21ef2, 0x37fa, 0x21ef1, 0x21eed, 0x21ee6, 0x22002, 0x2f882, 0x22125, 0x22118, 0x2211f, 0x22120, 0x221cf, 0x2229d, 0x22299, 0x222a8, 0x22368, 0x22366, 0x22367, 0x22391, 0x22413, 0x22415, 0x2241d, 0x22416, 0x22419, 0x2246b, 0x22468, 0x2246a, 0x2246d, 0x22469, 0x224aa, 0x22557, 0x22552, 0x22550, 0x2255f, 0x22567, 0x2254f, 0x38f2, 0x2255b, 0x2255c, 0x2255d, 0x2281f, 0x2279d, 0x227b1, 0x227b0, 0x22794, 0x227c3, 0x227af, 0x227a8, 0x227dc, 0x22798, 0x227c4, 0x227a4, 0x227ab, 0x227aa, 0x227a5, 0x22821, 0x227eb, 0x227e6, 0x227f7, 0x227f8,
+		0x227fc, 0x227fa, 0x227e0, 0x2f8aa, 0x227f6, 0x2f8a9, 0x227e1, 0x229ec, 0x229e2, 0x229e4, 0x229f9, 0x22c6f, 0x23a4c, 0x22cbb, 0x22ce1, 0x22d00, 0x22cd8, 0x3a2f, 0x22cd6, 0x22ce2, 0x22cc3, 0x22cb3, 0x22cd2, 0x2f8c1, 0x22cce, 0x22cd0, 0x22cd5, 0x22cb9, 0x22cba, 0x22ccf, 0x22cbd, 0x22edb, 0x22faa, 0x22fad, 0x22fb8, 0x22fb6, 0x22fb5, 0x22faf, 0x22fb4, 0x22fb7, 0x22fa8, 0x22fb9, 0x22fbe, 0x23082, 0x230bc, 0x230b8, 0x230b6, 0x230b9, 0x3ac1, 0x23110, 0x23227, 0x23269, 0x23270, 0x23256, 0x2326b, 0x2327a, 0x2326c, 0x2326d, 0x2f8d5, 0x267c1, 0x2338d, 0x23390, 0x235b6, 0x23610, 0x23612, 0x235ba, 0x235bd, 0x23605, 0x235c2, 0x23607, 0x235f5, 0x2f8e9, 0x235af, 0x235f4, 0x235f7, 0x235f8, 0x23611, 0x238f6, 0x238f5, 0x238fb, 0x23901, 0x23900, 0x23902, 0x238fe, 0x238fa, 0x238fd, 0x3c40, 0x23a37, 0x23a49, 0x23a4b, 0x23a46, 0x23a47, 0x23ab3, 0x23ab2,
+		0x23ab0, 0x23ab7, 0x23b11, 0x23bcc, 0x23bdf, 0x23bd3, 0x23bd5, 0x23bdb, 0x23bc5, 0x23bc8, 0x23bc9, 0x23be2, 0x23bca, 0x23bd1, 0x23bd2, 0x23bdd, 0x23e6c, 0x23e73, 0x23f21, 0x23ef0, 0x23f1f, 0x23f3b, 0x23f22, 0x23f23, 0x23ee8, 0x23edd, 0x3d3f, 0x23f1b, 0x23eed, 0x2f90e, 0x23ef2, 0x3d46, 0x23edc, 0x23ee9, 0x23f1d, 0x23eda, 0x23ee6, 0x24313, 0x24315, 0x24316, 0x2433c, 0x2430b, 0x2431c, 0x2433a, 0x2433d, 0x2439a, 0x2431d, 0x24309, 0x24308, 0x2433b, 0x24522, 0x24526, 0x24525, 0x24524, 0x2039b, 0x2458f, 0x24590, 0x245e6, 0x245e3, 0x245e5, 0x24611, 0x2f925, 0x246ae, 0x246be, 0x246b4, 0x246b3, 0x246af, 0x24691, 0x246c2, 0x246b6, 0x246b2, 0x24857, 0x2483b, 0x24858, 0x24851, 0x24841, 0x24839, 0x3e8f, 0x24859, 0x24845, 0x24861, 0x248e8, 0x249fa, 0x249ea, 0x249ef, 0x249f2, 0x249f0, 0x24b08, 0x24b70, 0x24b6a, 0x24b73, 0x24b68, 0x24bc8, 0x24bf2,
+		0x20b7b, 0x24cae, 0x24cab, 0x24cb5, 0x24caf, 0x24cb2, 0x24cb6, 0x24cb0, 0x24d1e, 0x24e03, 0x24e06, 0x24e1f, 0x3fac, 0x24e0f, 0x24e02, 0x24e19, 0x24e18, 0x24e22, 0x24e15, 0x24e07, 0x24e0d, 0x24e24, 0x24e0c, 0x24e1e, 0x24f89, 0x24f8a, 0x2500a, 0x2500b, 0x25007, 0x25004, 0x25009, 0x25084, 0x25083, 0x25218, 0x25214, 0x25205, 0x25216, 0x2520e, 0x25211, 0x25208, 0x2520b, 0x25215, 0x25085, 0x25237, 0x2522a, 0x2520d, 0x2520f, 0x2537e, 0x25376, 0x25377, 0x253ec, 0x254fc, 0x25508, 0x254ff, 0x25503, 0x25510, 0x25505, 0x25506, 0x254fa, 0x256c7, 0x256c0, 0x256c3, 0x256a7, 0x256a8, 0x256ab, 0x256c1, 0x256aa, 0x256c8, 0x25743, 0x25802, 0x2580e, 0x25801, 0x257fe, 0x25803, 0x4168, 0x25822, 0x25821, 0x25807, 0x25808, 0x2580c, 0x259ca, 0x259bc, 0x259be, 0x259bd, 0x2f95c, 0x259bb, 0x259b9, 0x41ab, 0x259c5, 0x25a99, 0x25b6b, 0x25b93, 0x25b94, 0x25ba9,
+		0x25ba0, 0x25ba6, 0x2f961, 0x25bab, 0x25b9e, 0x25b9b, 0x25b91, 0x25b99, 0x25eb6, 0x25eb8, 0x25e9a, 0x4291, 0x25e98, 0x25e9b, 0x25eb3, 0x25ea2, 0x222aa, 0x25ea0, 0x25e9f, 0x2605b, 0x26070, 0x26054, 0x26071, 0x26065, 0x26051, 0x2609d, 0x26061, 0x2605a, 0x26074, 0x2604c, 0x2604b, 0x2605e, 0x26058, 0x26053, 0x26052, 0x2623b, 0x26239, 0x262d6, 0x262e7, 0x262d7, 0x262d8, 0x262d9, 0x262da, 0x262db, 0x262dc, 0x262e0, 0x262e4, 0x262dd, 0x262f5, 0x262f1, 0x26398, 0x2639d, 0x26399, 0x26450, 0x26449, 0x7fe4, 0x26462, 0x264d7, 0x26501, 0x264f7, 0x2f97d, 0x2658c, 0x2659c, 0x8088, 0x2661f, 0x2661e, 0x2673d, 0x2673f, 0x26711, 0x26759, 0x2673e, 0x26758, 0x26800, 0x2679e, 0x267b6, 0x267aa, 0x267af, 0x267ac, 0x4430, 0x267c0, 0x267a9, 0x20b10, 0x268ee, 0x26977, 0x26979, 0x2697a, 0x2697d, 0x2697f, 0x26983, 0x26978, 0x2697e, 0x269e4, 0x269e6, 0x269e5,
+		0x445d, 0x26a4e, 0x26a59, 0x4475, 0x2f98e, 0x26a4f, 0x26a4a, 0x26ae3, 0x26ade, 0x26ae2, 0x26c74, 0x26cac, 0x26c61, 0x26c62, 0x26c76, 0x26c7a, 0x26c79, 0x26c60, 0x26d64, 0x26e81, 0x26ddf, 0x26d84, 0x26d8a, 0x26d92, 0x26d79, 0x26dde, 0x26d98, 0x26d6c, 0x26de1, 0x2f9a5, 0x26d7c, 0x26d72, 0x26d81, 0x26de0, 0x26d65, 0x44ff, 0x26d6a, 0x26d97, 0x26daa, 0x26dd3, 0x26dab, 0x26d6e, 0x26dac, 0x26d76, 0x26d7b, 0x26dad, 0x2f9a6, 0x26d94, 0x26dd7, 0x26d70, 0x26dd5, 0x26df1, 0x26d7a, 0x26d68, 0x26d96, 0x450b, 0x26d73, 0x26dae, 0x26ddd, 0x26dda, 0x26daf, 0x26db0, 0x26ddb, 0x26d62, 0x26df8, 0x271c2, 0x271c7, 0x271c8, 0x271c5, 0x271c3, 0x271c6, 0x272de, 0x45c8, 0x27311, 0x27315, 0x27328, 0x27321, 0x2732d, 0x27351, 0x2732b, 0x27316, 0x27324, 0x27335, 0x2731f, 0x27312, 0x27310, 0x27380, 0x2733b, 0x27329, 0x2732a, 0x2731b, 0x27318, 0x27320, 0x2733f,
+		0x2731c, 0x27326, 0x2730b, 0x275be, 0x275c4, 0x275bd, 0x275fa, 0x275fb, 0x276b1, 0x276b2, 0x276b0, 0x276b9, 0x276a6, 0x276bd, 0x276b6, 0x276b8, 0x276b4, 0x276b3, 0x276a7, 0x276ae, 0x276bc, 0x277f2, 0x277f3, 0x277f4, 0x2783b, 0x27840, 0x27846, 0x278f0, 0x278ee, 0x278e8, 0x278f1, 0x278eb, 0x278ef, 0x279fc, 0x27a20, 0x27a09, 0x27a06, 0x27a03, 0x2f9cf, 0x27a19, 0x27a24, 0x27a1b, 0x27a1d, 0x279fd, 0x27a1e, 0x279f4, 0x27a01, 0x27a08, 0x27a05, 0x27a23, 0x279ff, 0x27bea, 0x27c4d, 0x27c4e, 0x27c56, 0x27c57, 0x27c51, 0x27c55, 0x27c54, 0x27ccb, 0x27cd4, 0x27cd1, 0x27ccf, 0x27cd2, 0x27cca, 0x27cd6, 0x27d8b, 0x27d88, 0x27d85, 0x27d89, 0x21a9b, 0x27dbb, 0x27d8c, 0x4774, 0x27d99, 0x27d8a, 0x27eec, 0x27eef, 0x27ef9, 0x27f09, 0x27ef8, 0x27ef3, 0x27f00, 0x27f1d, 0x47ac, 0x27efd, 0x28048, 0x2805c, 0x28055, 0x2805e, 0x28049, 0x28063, 0x28060, 0x28053,
+		0x28062, 0x28040, 0x28249, 0x2824a, 0x2824c, 0x2824d, 0x2830a, 0x28306, 0x2832f, 0x28321, 0x28307, 0x28309, 0x28302, 0x28316, 0x28303, 0x2830b, 0x2830f, 0x28560, 0x28568, 0x2855e, 0x2855d, 0x28563, 0x2855f, 0x28564, 0x28578, 0x28561, 0x28569, 0x28565, 0x286da, 0x286e6, 0x286db, 0x286dc, 0x28739, 0x28757, 0x28755, 0x28765, 0x28761, 0x28754, 0x28845, 0x28841, 0x2883e, 0x28837, 0x28912, 0x28913, 0x2892c, 0x28996, 0x2f9e9, 0x2899c, 0x2899d, 0x28985, 0x2f9e8, 0x2899f, 0x289a3, 0x28c82, 0x28c83, 0x2f9ee, 0x28d1d, 0x28d14, 0x28d1f, 0x28d20, 0x28e47, 0x28e80, 0x28ec9, 0x28ef9, 0x28f06, 0x28ef0, 0x28ef8, 0x28eef, 0x28efd, 0x28ef1, 0x28efe, 0x28fb8, 0x28fc0, 0x28fc3, 0x28fb5, 0x28fb6, 0x28fc9, 0x2f9f3, 0x28fbd, 0x28fba, 0x28fbf, 0x28fb3, 0x28fc6, 0x28fb2, 0x28fbc, 0x28fb7, 0x28fb9, 0x28fc8, 0x290b5, 0x290b3, 0x4a16, 0x290ac, 0x290a9, 0x290ad,
+		0x29211, 0x2920d, 0x29216, 0x29289, 0x2927c, 0x2928b, 0x2927b, 0x29288, 0x29284, 0x2939d, 0x29398, 0x29488, 0x29486, 0x29482, 0x2948b, 0x2f9ff, 0x29471, 0x29472, 0x29481, 0x2948c, 0x29480, 0x29489, 0x295bb, 0x295c1, 0x295be, 0x2fa01, 0x295bd, 0x296c1, 0x296b4, 0x296b7, 0x296c8, 0x4b3b, 0x296d3, 0x296d0, 0x296b0, 0x296ca, 0x296cd, 0x29815, 0x29819, 0x29817, 0x2983f, 0x29840, 0x29844, 0x2987c, 0x29878, 0x29879, 0x29888, 0x2fa06, 0x29889, 0x2987d, 0x29a23, 0x29a24, 0x29a26, 0x29a22, 0x29a27, 0x29a1f, 0x29ac9, 0x29ac3, 0x29b0a, 0x29b0b, 0x29b04, 0x4bef, 0x29b03, 0x29b01, 0x29b09, 0x29c0f, 0x29c2e, 0x29c2d, 0x29c91, 0x29c99, 0x29c9e, 0x29c90, 0x29c94, 0x29c9d, 0x29c98, 0x29c9b, 0x29c9c, 0x29c9a, 0x29d54, 0x29f9d, 0x29f9f, 0x29fa3, 0x29fa4, 0x29fa5, 0x2a302, 0x2a417, 0x2a41b, 0x2a419, 0x2a4d1, 0x21537, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+	{ // plane 7
+		0x20055, 0x20182, 0x20189, 0x20187, 0x203be, 0x203bd, 0x203ca, 0x203d4, 0x203bc, 0x203c4, 0x203c1, 0x203c2, 0x203d7, 0x20370, 0x203ba, 0x203e3, 0x203bb, 0x204b1, 0x204b6, 0x204b0, 0x2056c, 0x2060d, 0x20607, 0x207f1, 0x207f5, 0x207ed, 0x207ef, 0x207eb, 0x207ec, 0x207ee, 0x20807, 0x209c4, 0x20a08, 0x20ab3, 0x20aad, 0x20ab0, 0x20b11, 0x2018a, 0x20f83, 0x20f7a, 0x20f82, 0x20f68, 0x20f71, 0x20fb0, 0x20faf, 0x35f6, 0x20f6c, 0x20f6f, 0x20f62, 0x20f65, 0x20f81, 0x20f7f, 0x20f64, 0x20f73, 0x20f6e, 0x20f67, 0x20f74, 0x20f7d, 0x20f78, 0x20f85, 0x20faa, 0x20f88, 0x20fa6, 0x2f84e, 0x20f8a, 0x20f84, 0x5655, 0x21224, 0x21222, 0x21223, 0x21432, 0x2145b, 0x2144f, 0x3667, 0x21426, 0x2143e, 0x21442, 0x21447, 0x21422, 0x2069b, 0x2144a, 0x2141f, 0x21449, 0x2142b, 0x21433, 0x2f858, 0x2142a, 0x21428, 0x2153d, 0x2153c, 0x215bf, 0x21679, 0x21680, 0x2167c,
+		0x2167a, 0x21678, 0x21882, 0x2185c, 0x2185a, 0x2184b, 0x2187c, 0x2187f, 0x2181f, 0x21889, 0x2188b, 0x21857, 0x2187e, 0x2187d, 0x21853, 0x21a9f, 0x21abc, 0x21aa5, 0x21a86, 0x21adc, 0x21aa4, 0x21ab6, 0x21aa2, 0x21ab4, 0x21b48, 0x21b46, 0x21ba7, 0x21c14, 0x21c12, 0x21c11, 0x21ce1, 0x21cd0, 0x21d26, 0x21d28, 0x21d29, 0x21f2f, 0x21f0d, 0x21f25, 0x21f14, 0x21f3c, 0x21f0b, 0x21f11, 0x21f30, 0x21f18, 0x21f19, 0x21f36, 0x21f17, 0x21f10, 0x21f15, 0x22003, 0x22029, 0x2204c, 0x2213f, 0x2213e, 0x22134, 0x22142, 0x22135, 0x207f3, 0x221cd, 0x221d1, 0x221d2, 0x222ad, 0x222ba, 0x222c1, 0x222bb, 0x222b4, 0x222bc, 0x2236c, 0x22392, 0x22421, 0x22424, 0x23f19, 0x224ad, 0x224ae, 0x224ab, 0x224ac, 0x22570, 0x22576, 0x22571, 0x22577, 0x617f, 0x227ec, 0x2281c, 0x227fb, 0x227ee, 0x228e2, 0x227fd, 0x2281e, 0x2283b, 0x2284a, 0x2f8ae, 0x3992, 0x2287d, 0x22856,
+		0x22844, 0x2284b, 0x229fa, 0x229e8, 0x229f8, 0x229ff, 0x229f7, 0x229f6, 0x229fe, 0x22a07, 0x22a5d, 0x22cd4, 0x2f8c3, 0x22cc8, 0x22cc5, 0x2f8c0, 0x22cc9, 0x22d4d, 0x22d20, 0x22d36, 0x22d35, 0x22d88, 0x22d25, 0x22d21, 0x22d26, 0x3a45, 0x22d3f, 0x22d3b, 0x22d24, 0x22d43, 0x22d4e, 0x22d4f, 0x22d44, 0x22d40, 0x22d41, 0x22d39, 0x22d2b, 0x22edd, 0x22ede, 0x22fcd, 0x22fcb, 0x22fdb, 0x22fd8, 0x22fd9, 0x22fd2, 0x22fca, 0x22fd1, 0x22fd4, 0x22ff9, 0x22fd0, 0x2305e, 0x2305d, 0x2308a, 0x23114, 0x23283, 0x23284, 0x2329b, 0x23282, 0x2327d, 0x2327c, 0x23299, 0x2327e, 0x2329a, 0x23295, 0x2327b, 0x23286, 0x23348, 0x23392, 0x235b2, 0x23642, 0x23672, 0x23615, 0x23630, 0x23643, 0x2365b, 0x2361d, 0x23673, 0x2362d, 0x3bc9, 0x23621, 0x2361c, 0x2f8ea, 0x23629, 0x2361e, 0x23633, 0x23910, 0x2390e, 0x2f8f1, 0x2390d, 0x23911, 0x23912, 0x23917, 0x23909, 0x2390f,
+		0x23998, 0x23994, 0x23996, 0x23999, 0x23995, 0x23997, 0x23a50, 0x23a52, 0x23a4d, 0x23a57, 0x23a53, 0x23a56, 0x23ab8, 0x23b12, 0x23bf1, 0x23bf0, 0x23bee, 0x23bef, 0x23be5, 0x23beb, 0x23be7, 0x23bf2, 0x23be6, 0x23bf7, 0x23c09, 0x23c6b, 0x23c6a, 0x23e79, 0x23ecd, 0x23eef, 0x23ee4, 0x23ee3, 0x23f6c, 0x23f67, 0x23f62, 0x23f58, 0x23f69, 0x23fab, 0x23f57, 0x23fad, 0x23f54, 0x23fae, 0x23f76, 0x23f49, 0x23f45, 0x23f4f, 0x2f90f, 0x23f50, 0x23f7d, 0x23f44, 0x23f66, 0x23f48, 0x23fa3, 0x23f51, 0x23f53, 0x2f910, 0x23f60, 0x23f47, 0x23f5e, 0x23f85, 0x23fac, 0x23f6d, 0x23f75, 0x23fa8, 0x3d55, 0x23f43, 0x23f4e, 0x23f5c, 0x23f56, 0x23f6e, 0x23f63, 0x23f6a, 0x24356, 0x24312, 0x2435c, 0x24358, 0x24355, 0x2437e, 0x243a8, 0x24311, 0x24359, 0x2435a, 0x24367, 0x24354, 0x2435b, 0x243f0, 0x24529, 0x2452c, 0x2452a, 0x24527, 0x24567, 0x24568, 0x24593, 0x24595,
+		0x2459a, 0x245ee, 0x245f1, 0x245ed, 0x245e9, 0x246cb, 0x246df, 0x246db, 0x2483c, 0x2484e, 0x2486c, 0x2487b, 0x2487c, 0x24883, 0x2486b, 0x24866, 0x24863, 0x248a0, 0x24885, 0x24868, 0x248a2, 0x249ec, 0x3ee8, 0x249e7, 0x24a10, 0x24a18, 0x24a3a, 0x24a1a, 0x24a38, 0x24a41, 0x24a1b, 0x24a14, 0x24a1c, 0x24a1d, 0x24b12, 0x24b0d, 0x24b07, 0x24b7e, 0x24b7f, 0x20ab9, 0x24b80, 0x24b7a, 0x24bf3, 0x24cb9, 0x24cbb, 0x24cba, 0x24cbd, 0x24cb7, 0x24cbf, 0x24d21, 0x24e3a, 0x24e3d, 0x24e36, 0x24e49, 0x24e81, 0x3fb6, 0x24e3f, 0x24e4d, 0x24e3e, 0x3fbd, 0x24e30, 0x24e4f, 0x24f37, 0x24f98, 0x24f99, 0x25011, 0x2500d, 0x25088, 0x2508a, 0x2521c, 0x25255, 0x25256, 0x25262, 0x25257, 0x25242, 0x25210, 0x2524d, 0x2525a, 0x25266, 0x25246, 0x2287e, 0x2524f, 0x262f9, 0x25387, 0x25384, 0x253f4, 0x253f5, 0x253f2, 0x253f7, 0x25545, 0x2f950, 0x25549, 0x25535, 0x254fd,
+		0x25530, 0x25543, 0x25546, 0x40d6, 0x25533, 0x256c6, 0x2f956, 0x256d4, 0x271d8, 0x256e0, 0x20fa7, 0x25744, 0x20398, 0x25854, 0x2583e, 0x25835, 0x2583a, 0x4171, 0x25834, 0x25839, 0x25846, 0x2585a, 0x2585b, 0x2583c, 0x259dc, 0x259d6, 0x259d0, 0x2f95e, 0x25aac, 0x2f95d, 0x25aa5, 0x25bf4, 0x25c24, 0x25be0, 0x25bfd, 0x25bf2, 0x25bfc, 0x25c02, 0x25bf1, 0x25bff, 0x25bd2, 0x25bd8, 0x25bd3, 0x25bf9, 0x2f963, 0x25bed, 0x25bf7, 0x25bf0, 0x25bd7, 0x25c09, 0x25bf5, 0x25ec2, 0x25ec5, 0x25ecf, 0x4298, 0x25ec1, 0x25ec6, 0x25ed4, 0x25ece, 0x2609b, 0x26099, 0x2608f, 0x260b7, 0x26082, 0x26093, 0x260b4, 0x260c1, 0x26090, 0x2609a, 0x26094, 0x2609c, 0x260b5, 0x2f96f, 0x2f96e, 0x2609f, 0x2608e, 0x2609e, 0x2623c, 0x26247, 0x26241, 0x262eb, 0x262ec, 0x262ed, 0x262ee, 0x262df, 0x262f2, 0x2630d, 0x2630e, 0x20186, 0x263a9, 0x263a4, 0x263a5, 0x2645f, 0x2645a,
+		0x26466, 0x26468, 0x2646a, 0x26464, 0x26465, 0x264c1, 0x264d8, 0x2f97b, 0x264d9, 0x26509, 0x265aa, 0x265a3, 0x265b9, 0x259e2, 0x265a5, 0x265ab, 0x26620, 0x26789, 0x2678b, 0x267db, 0x267e4, 0x267ef, 0x267e2, 0x267e9, 0x267f0, 0x267f3, 0x267dd, 0x2681e, 0x2691d, 0x26946, 0x2697c, 0x26980, 0x26981, 0x26982, 0x26987, 0x26988, 0x2698a, 0x2698c, 0x269e7, 0x269eb, 0x269ea, 0x26a68, 0x26a6b, 0x26a69, 0x26a66, 0x26a6a, 0x26a65, 0x26ae5, 0x26ae4, 0x26ae6, 0x26d18, 0x26e24, 0x26e48, 0x26e2f, 0x26e7e, 0x26d89, 0x26e1e, 0x26e49, 0x26e26, 0x26e09, 0x26e34, 0x26e2d, 0x26e4a, 0x26e15, 0x2f9a9, 0x26e33, 0x26e28, 0x26e27, 0x26e20, 0x26e2b, 0x26e29, 0x26e6a, 0x26e0b, 0x26e0e, 0x26e1c, 0x26dff, 0x2f9aa, 0x26e35, 0x26e11, 0x26e4b, 0x26e4c, 0x26e1f, 0x26e0f, 0x26e39, 0x26e68, 0x26e1d, 0x26e08, 0x26e0c, 0x26e0d, 0x26e62, 0x26eaf, 0x26e32, 0x26e2c, 0x26e64,
+		0x26e04, 0x26e16, 0x26e4d, 0x26e07, 0x26eae, 0x271d4, 0x271d1, 0x271d5, 0x271d0, 0x27371, 0x2735d, 0x2735b, 0x2737a, 0x2f9bd, 0x2737f, 0x27379, 0x27367, 0x2739e, 0x27394, 0x27364, 0x2735e, 0x27381, 0x2735a, 0x27357, 0x2737c, 0x27398, 0x27366, 0x2737e, 0x27382, 0x27368, 0x2735f, 0x27363, 0x27397, 0x45cd, 0x27353, 0x2737b, 0x2737d, 0x27378, 0x27406, 0x275c0, 0x275c2, 0x275c3, 0x276dc, 0x276e6, 0x276ec, 0x276f2, 0x276e8, 0x276eb, 0x276ea, 0x276e5, 0x276e0, 0x276d0, 0x276d8, 0x276d5, 0x276d3, 0x276e4, 0x276f3, 0x276db, 0x2772f, 0x277f9, 0x2785d, 0x2785b, 0x2784c, 0x27858, 0x27860, 0x27853, 0x27850, 0x27907, 0x27908, 0x278f4, 0x278f6, 0x27904, 0x278fd, 0x27905, 0x27a28, 0x27a56, 0x27a42, 0x27a5c, 0x27a3d, 0x27a2f, 0x27a2b, 0x27a58, 0x27a61, 0x27a2c, 0x27a51, 0x27a50, 0x27a3c, 0x27a36, 0x46f6, 0x27a60, 0x27a5b, 0x27a2e, 0x27a40, 0x27a5f,
+		0x27a26, 0x27a2d, 0x27a5e, 0x27a3b, 0x27bef, 0x27bf3, 0x27bee, 0x27bed, 0x27c5a, 0x27c62, 0x27c5b, 0x27c5f, 0x27c5e, 0x27c64, 0x27ce0, 0x27ce1, 0x27ce5, 0x27da5, 0x27d9b, 0x27d9f, 0x27d9c, 0x477a, 0x27daa, 0x27da4, 0x27da3, 0x27e67, 0x27f26, 0x27f1a, 0x47b8, 0x27f1c, 0x27f15, 0x27f1b, 0x27f18, 0x27f1e, 0x27f25, 0x2f9dc, 0x2807b, 0x28075, 0x28093, 0x2808f, 0x2807c, 0x2809b, 0x28088, 0x28091, 0x2807e, 0x4801, 0x28086, 0x2825b, 0x28254, 0x28335, 0x28340, 0x28337, 0x28329, 0x28326, 0x28323, 0x28330, 0x28320, 0x28328, 0x28332, 0x2832a, 0x28331, 0x28324, 0x28341, 0x28342, 0x28343, 0x28325, 0x28338, 0x2841c, 0x2841d, 0x2841e, 0x284f6, 0x285a5, 0x28589, 0x2859d, 0x2858a, 0x28587, 0x285ab, 0x28586, 0x2859e, 0x28593, 0x285a1, 0x28588, 0x285a3, 0x2859f, 0x285a0, 0x286ff, 0x28700, 0x28702, 0x286f8, 0x286f7, 0x28701, 0x28787, 0x28789, 0x28776,
+		0x2876e, 0x28777, 0x28782, 0x28785, 0x28783, 0x28784, 0x28857, 0x2884b, 0x28856, 0x2885d, 0x28860, 0x2884d, 0x28855, 0x28854, 0x28853, 0x28850, 0x28863, 0x28862, 0x28917, 0x28916, 0x2892d, 0x289d9, 0x289bf, 0x492f, 0x289b2, 0x289ac, 0x289b0, 0x289cf, 0x289b8, 0x4931, 0x289d3, 0x289b1, 0x289af, 0x289ce, 0x289b4, 0x289b7, 0x289d5, 0x499c, 0x28d38, 0x28d28, 0x28d3f, 0x28d40, 0x28d41, 0x28d33, 0x28d2b, 0x28d2e, 0x28f11, 0x28f18, 0x28f17, 0x28f19, 0x28f16, 0x49e6, 0x28f0d, 0x28f26, 0x28f79, 0x28fd8, 0x28ff3, 0x28fd7, 0x28fcc, 0x28fcf, 0x28fcb, 0x28fd1, 0x28fce, 0x28fd4, 0x28fd5, 0x28fd3, 0x28fd6, 0x28fcd, 0x28fda, 0x28fd2, 0x28fde, 0x290cc, 0x290b8, 0x290b7, 0x290c2, 0x290c3, 0x291fa, 0x291f9, 0x2921f, 0x2921d, 0x24382, 0x292a6, 0x29290, 0x2929e, 0x29298, 0x29293, 0x29292, 0x292a5, 0x29295, 0x293a9, 0x293a8, 0x293aa, 0x293ab, 0x293ac,
+		0x2941f, 0x29422, 0x294aa, 0x29496, 0x29491, 0x294ab, 0x294a2, 0x294a4, 0x2949d, 0x29497, 0x2947d, 0x2949f, 0x2949c, 0x29499, 0x294a6, 0x29492, 0x294a7, 0x295c9, 0x295c8, 0x295c7, 0x295cb, 0x29674, 0x296c9, 0x4b38, 0x296d2, 0x296db, 0x296eb, 0x296e9, 0x296ef, 0x296ee, 0x296f9, 0x296de, 0x29816, 0x29845, 0x2987f, 0x29898, 0x2989f, 0x29886, 0x29890, 0x2989a, 0x2989d, 0x2988d, 0x2989c, 0x298a0, 0x298a7, 0x2988f, 0x29a41, 0x29a35, 0x29a37, 0x29a30, 0x29a3a, 0x29a36, 0x2fa08, 0x29aca, 0x29b12, 0x29b15, 0x29b22, 0x29b13, 0x29b18, 0x29b21, 0x29c10, 0x29c20, 0x29c2f, 0x29ca5, 0x29ca3, 0x29cb6, 0x29cab, 0x29d68, 0x29d67, 0x29d6f, 0x29d77, 0x29d64, 0x29d78, 0x4c3a, 0x29d76, 0x29d6e, 0x4cb1, 0x29fd0, 0x29fb3, 0x29fcb, 0x29fd6, 0x29fb1, 0x29fb4, 0x29fd7, 0x29fc6, 0x29fba, 0x29fd1, 0x29fd2, 0x29fb5, 0x29fc4, 0x29fcc, 0x29fbb, 0x29fb2, 0x2a25b,
+		0x2a25a, 0x2a259, 0x2a299, 0x2a294, 0x2a296, 0x2a293, 0x2a291, 0x2a28f, 0x2a298, 0x2a30c, 0x2a30a, 0x2a30e, 0x2a312, 0x2a311, 0x2a30b, 0x2a39a, 0x4d47, 0x2a3b4, 0x4d51, 0x2a41c, 0x2a420, 0x2a421, 0x2a4d3, 0x2a4d2, 0x2a538, 0x0, 0x24ccf, 0x2011d, 0x20192, 0x2018f, 0x2018c, 0x203ea, 0x204b9, 0x2056e, 0x205a0, 0x291dd, 0x20612, 0x20617, 0x2066d, 0x20805, 0x207fb, 0x207fd, 0x20806, 0x20800, 0x207ff, 0x207fe, 0x208b6, 0x20905, 0x20907, 0x20abc, 0x20abb, 0x20b8b, 0x20b87, 0x27bfb, 0x20fe7, 0x20fe6, 0x20fe2, 0x285d3, 0x20fd9, 0x20fca, 0x21012, 0x21010, 0x20fdc, 0x20fd0, 0x20f8b, 0x21013, 0x22a10, 0x20fda, 0x2f84f, 0x20ffd, 0x20fe5, 0x20fcc, 0x21039, 0x20fc7, 0x20fd8, 0x21460, 0x21464, 0x21486, 0x2145e, 0x21466, 0x2145f, 0x21477, 0x21482, 0x21496, 0x2153e, 0x21579, 0x2157a, 0x21688, 0x21689, 0x2168c, 0x243e0, 0x2167b, 0x21686, 0x21894,
+		0x21897, 0x218b7, 0x218b5, 0x218b8, 0x21893, 0x218b2, 0x218b4, 0x21899, 0x218c3, 0x2189d, 0x2189b, 0x218a3, 0x3747, 0x2199f, 0x2199e, 0x219a3, 0x219a0, 0x219a1, 0x21ac5, 0x21ac6, 0x21abe, 0x21ac4, 0x21abf, 0x21b4c, 0x21b4a, 0x21bb1, 0x21c15, 0x21c17, 0x21c10, 0x21c18, 0x21ce0, 0x21cdf, 0x21d27, 0x21f43, 0x21f48, 0x21f1d, 0x21f50, 0x21f58, 0x21f47, 0x21f54, 0x21f53, 0x21f40, 0x21f55, 0x3817, 0x21f5a, 0x22470, 0x2215b, 0x2214d, 0x22156, 0x2214f, 0x2f887, 0x22159, 0x2f888, 0x22154, 0x22153, 0x22150, 0x222b3, 0x2236e, 0x38a3, 0x2236f, 0x22393, 0x22429, 0x22425, 0x22428, 0x2246f, 0x232b8, 0x2f897, 0x224b3, 0x22579, 0x2257e, 0x2257c, 0x22583, 0x2f8ac, 0x22800, 0x22834, 0x22865, 0x22836, 0x228a7, 0x22859, 0x22858, 0x22831, 0x22862, 0x22838, 0x22873, 0x2283e, 0x22855, 0x22854, 0x22895, 0x2f8ad, 0x228a5, 0x22894, 0x2289a, 0x2289b, 0x22899,
+		0x2289f, 0x22853, 0x22a12, 0x22a0a, 0x22a09, 0x22a0c, 0x22a0b, 0x22a0e, 0x22a0d, 0x22a08, 0x22d38, 0x22d3a, 0x22d37, 0x22d3d, 0x22d2d, 0x22d82, 0x22d95, 0x22d87, 0x22d9d, 0x22db5, 0x22d81, 0x22dc7, 0x22d9b, 0x22d98, 0x22d94, 0x22d86, 0x22d90, 0x22d34, 0x22d8e, 0x22d85, 0x22da6, 0x22d96, 0x22fea, 0x22fdf, 0x232bd, 0x22ff7, 0x22feb, 0x22fe0, 0x22fe8, 0x22fe1, 0x22fe9, 0x22fee, 0x22fe5, 0x22fec, 0x23063, 0x230c6, 0x230c2, 0x230c1, 0x230c0, 0x230c3, 0x23118, 0x232ae, 0x232a3, 0x232c5, 0x232a0, 0x232b4, 0x232a5, 0x3b1e, 0x232a4, 0x232a8, 0x232a6, 0x236a8, 0x2368d, 0x23682, 0x2369e, 0x23689, 0x23683, 0x23691, 0x236a6, 0x2369a, 0x23690, 0x23685, 0x2367d, 0x2f8ec, 0x2369b, 0x236a5, 0x236f0, 0x23696, 0x23688, 0x236d6, 0x236d8, 0x23686, 0x236a4, 0x23697, 0x2391c, 0x23919, 0x2399e, 0x2399f, 0x2399d, 0x2399c, 0x23a5d, 0x23a5e, 0x23a73, 0x23a63,
+		0x23abe, 0x23ac1, 0x23abc, 0x23abf, 0x23abb, 0x23abd, 0x23b13, 0x23b14, 0x23bf8, 0x23bfa, 0x23c06, 0x23bfe, 0x23c51, 0x2400b, 0x23ff1, 0x23fa9, 0x23fd5, 0x23fdc, 0x23fcb, 0x23ff2, 0x2405f, 0x23fdb, 0x23fd6, 0x23fd9, 0x23fd1, 0x23fcf, 0x23fd8, 0x23fe0, 0x23fd4, 0x23ff3, 0x24005, 0x23fce, 0x23ff4, 0x2f912, 0x23fda, 0x2400e, 0x24006, 0x23fd7, 0x23fcd, 0x24007, 0x23fe1, 0x24008, 0x243be, 0x243ef, 0x243d9, 0x243da, 0x71d7, 0x243a0, 0x243df, 0x2439e, 0x243bf, 0x243af, 0x243a7, 0x243aa, 0x243f4, 0x243ae, 0x243b5, 0x243b8, 0x243db, 0x243c0, 0x243b7, 0x243a2, 0x243dd, 0x243a1, 0x243a5, 0x243b4, 0x243a6, 0x243ab, 0x243ac, 0x2439f, 0x243b1, 0x243fc, 0x2452e, 0x24530, 0x24599, 0x245f8, 0x245f6, 0x246f4, 0x246eb, 0x246e1, 0x246e4, 0x24875, 0x24869, 0x3e9a, 0x24899, 0x2489e, 0x24894, 0x24893, 0x24891, 0x24a19, 0x24a17, 0x24a49, 0x24a47, 0x24a48,
+		0x24a52, 0x24a40, 0x24a63, 0x24a46, 0x24a5f, 0x24b0e, 0x24b85, 0x24b87, 0x24bcb, 0x24bcc, 0x24bc9, 0x24ccb, 0x21ce7, 0x24d20, 0x24e5f, 0x24e60, 0x24e6a, 0x24e76, 0x24e73, 0x24e71, 0x24e66, 0x3fc2, 0x24e6b, 0x24e79, 0x24e7b, 0x24f3c, 0x24f9e, 0x24f9c, 0x2501c, 0x2501e, 0x25021, 0x25096, 0x250a1, 0x250b6, 0x250a0, 0x25094, 0x25097, 0x25281, 0x25286, 0x252c0, 0x2528a, 0x2f94b, 0x25285, 0x25295, 0x25249, 0x25282, 0x25284, 0x22878, 0x25290, 0x252b1, 0x253fe, 0x253fb, 0x25550, 0x25556, 0x2555a, 0x2554c, 0x40dc, 0x25557, 0x256e1, 0x256d9, 0x256db, 0x256f4, 0x25745, 0x25747, 0x25874, 0x2586b, 0x25868, 0x25869, 0x25879, 0x2f95b, 0x25880, 0x25881, 0x25861, 0x25870, 0x2586f, 0x25873, 0x2585d, 0x259fa, 0x41b3, 0x259f3, 0x25a0a, 0x25a17, 0x2f95f, 0x25ab0, 0x25c3e, 0x25c46, 0x25c4f, 0x25c44, 0x25c69, 0x25c33, 0x25c2f, 0x25c4e, 0x25c3a, 0x25c2b,
+		0x25ca9, 0x25c50, 0x25c45, 0x25c37, 0x25c25, 0x25c2c, 0x25c3f, 0x25c34, 0x25c29, 0x25c1e, 0x25c3d, 0x25eed, 0x2f966, 0x25ef3, 0x25eea, 0x25eeb, 0x260cc, 0x260ce, 0x260d0, 0x260e3, 0x260e0, 0x260d1, 0x260dc, 0x260dd, 0x260f0, 0x260db, 0x260e5, 0x260f1, 0x260c9, 0x260e8, 0x260e7, 0x260c8, 0x260de, 0x260cd, 0x260c5, 0x260c6, 0x260e4, 0x260c3, 0x262fb, 0x262fc, 0x262fe, 0x26303, 0x26300, 0x262fd, 0x26305, 0x26313, 0x263a7, 0x263b4, 0x26470, 0x26474, 0x26471, 0x26475, 0x2647d, 0x26473, 0x264c4, 0x264c3, 0x264de, 0x264dd, 0x26511, 0x2650f, 0x26510, 0x265c3, 0x265c2, 0x265ca, 0x265c1, 0x26623, 0x267b1, 0x26824, 0x26811, 0x26825, 0x2680a, 0x2681f, 0x26820, 0x26814, 0x26828, 0x26803, 0x26812, 0x2680b, 0x26817, 0x22a11, 0x26949, 0x26989, 0x26990, 0x2698f, 0x26996, 0x26995, 0x26993, 0x269ed, 0x269f0, 0x243de, 0x26a85, 0x26a77, 0x26a7a, 0x26a84,
+		0x26a79, 0x26a7d, 0x26a7b, 0x26aed, 0x26aec, 0x26df6, 0x26d9b, 0x26e9d, 0x26f11, 0x26ec4, 0x26ec8, 0x26edf, 0x26ebf, 0x26eb3, 0x26eba, 0x26f10, 0x26f06, 0x26e88, 0x26e9a, 0x26ee0, 0x26ecc, 0x26eb5, 0x26ee1, 0x26ebc, 0x26ec6, 0x26f0b, 0x26ea4, 0x26e95, 0x26ea3, 0x26ee2, 0x26ecd, 0x26ee3, 0x26eab, 0x26ecb, 0x26e8f, 0x2f9ab, 0x26ea9, 0x26f24, 0x26ee4, 0x26f12, 0x26ee5, 0x26f67, 0x26eaa, 0x26ea0, 0x26ee6, 0x26ec1, 0x26ee7, 0x26f0d, 0x26e86, 0x26eb0, 0x26e8b, 0x26ee8, 0x26ec9, 0x26f19, 0x26ec0, 0x26f0c, 0x26ee9, 0x26eea, 0x271ed, 0x271e3, 0x271e4, 0x271e8, 0x271d2, 0x271e2, 0x8666, 0x273cf, 0x273d1, 0x273c3, 0x273c9, 0x273ea, 0x273b4, 0x273dc, 0x273bd, 0x273e6, 0x273c8, 0x273ec, 0x273b2, 0x273a9, 0x273d3, 0x273c0, 0x273e9, 0x273d5, 0x45d9, 0x273c5, 0x45dd, 0x273cb, 0x273d0, 0x273d2, 0x273e4, 0x273e8, 0x273cd, 0x273b6, 0x273aa, 0x273d4,
+		0x28449, 0x273c1, 0x273dd, 0x273ce, 0x275c9, 0x275ca, 0x276fd, 0x276d9, 0x276ff, 0x27704, 0x276fa, 0x27706, 0x27715, 0x27711, 0x27707, 0x2770b, 0x277fc, 0x277fd, 0x2786d, 0x27865, 0x27914, 0x27913, 0x2790c, 0x2790b, 0x27918, 0x27922, 0x27a7d, 0x27a6f, 0x27a75, 0x27a7b, 0x27a80, 0x27a7f, 0x27a96, 0x27a6d, 0x27a6b, 0x27a86, 0x27a73, 0x27a62, 0x27a77, 0x27a81, 0x27a69, 0x27a82, 0x27a97, 0x27a84, 0x46fc, 0x27a78, 0x2f9d0, 0x27a7a, 0x27a6a, 0x27a65, 0x27a7e, 0x27a94, 0x27bc2, 0x27bc1, 0x27bf9, 0x27c71, 0x27c6b, 0x27c6d, 0x27c6f, 0x27c6e, 0x27cf2, 0x27ce8, 0x27cef, 0x27ce9, 0x27cea, 0x27ced, 0x27cf3, 0x27ce6, 0x27dc9, 0x27db4, 0x27db3, 0x27db9, 0x27dca, 0x27db1, 0x27db6, 0x27dc7, 0x27dc4, 0x27db7, 0x27e6f, 0x27f33, 0x27f32, 0x27f1f, 0x2f9d9, 0x27f2b, 0x27f30, 0x27f36, 0x27f42, 0x27f41, 0x2f9d8, 0x280a5, 0x280ab, 0x280a9, 0x280b3, 0x280a7,
+		0x280a0, 0x280d2, 0x280a8, 0x280b6, 0x280ac, 0x280ae, 0x280e6, 0x28265, 0x28347, 0x28363, 0x2834d, 0x2835f, 0x2834b, 0x28360, 0x28349, 0x28353, 0x2f9df, 0x8f3a, 0x28357, 0x2834e, 0x28352, 0x28354, 0x28420, 0x28422, 0x285c1, 0x285d7, 0x285d5, 0x285c4, 0x285d0, 0x285bb, 0x285e2, 0x285cb, 0x285b8, 0x285bf, 0x285d8, 0x285c0, 0x285ba, 0x285b4, 0x285bc, 0x285d4, 0x285ed, 0x285c2, 0x285d9, 0x285cc, 0x2872f, 0x28723, 0x28796, 0x2878d, 0x2879e, 0x28799, 0x28883, 0x4907, 0x2886a, 0x28869, 0x28875, 0x2886c, 0x28880, 0x2886e, 0x28881, 0x28877, 0x28876, 0x28873, 0x28870, 0x28884, 0x28919, 0x289f2, 0x933d, 0x289ec, 0x289eb, 0x289f6, 0x289ef, 0x289f4, 0x289ee, 0x289f3, 0x289ed, 0x28c9b, 0x28c98, 0x28c94, 0x2147a, 0x28c9a, 0x2f9f0, 0x28d4c, 0x49a8, 0x28d57, 0x28d51, 0x28d62, 0x28d52, 0x28d5c, 0x28d53, 0x2f9ef, 0x28e85, 0x28f2d, 0x28f3e, 0x28f30,
+		0x28f7d, 0x28fe6, 0x28ff6, 0x28fe4, 0x28fe9, 0x28fec, 0x28ff2, 0x28fe8, 0x28fe3, 0x28feb, 0x28ff0, 0x28fea, 0x28fe7, 0x28ffd, 0x28fe5, 0x28fee, 0x28ff5, 0x28ff9, 0x28ff1, 0x28ff4, 0x2f9f4, 0x29011, 0x290de, 0x290d8, 0x290dd, 0x290db, 0x290d3, 0x290e1, 0x291de, 0x291fc, 0x29228, 0x292b7, 0x292b8, 0x292c7, 0x292b2, 0x292a9, 0x292b9, 0x292bc, 0x292b3, 0x292d1, 0x293b0, 0x293b1, 0x293b6, 0x29404, 0x2942c, 0x294c1, 0x2949e, 0x294ba, 0x4aca, 0x294d1, 0x294d3, 0x294d4, 0x294d5, 0x294c5, 0x294b6, 0x294b2, 0x294b5, 0x294c3, 0x294b9, 0x294c6, 0x295d6, 0x295e0, 0x295db, 0x295d7, 0x295dd, 0x29715, 0x2970c, 0x2970f, 0x2970e, 0x296fe, 0x29704, 0x2970b, 0x29702, 0x296ff, 0x29708, 0x29710, 0x29717, 0x29713, 0x29706, 0x29709, 0x29824, 0x29826, 0x29825, 0x29848, 0x298b5, 0x298d2, 0x298d5, 0x298c4, 0x298af, 0x298ad, 0x298c1, 0x298c0, 0x298cc, 0x298cd,
+		0x298c3, 0x298c8, 0x298c5, 0x298ba, 0x298d0, 0x298c2, 0x298ce, 0x4b8d, 0x29a43, 0x29a42, 0x29a40, 0x29a31, 0x29acf, 0x29ace, 0x29b30, 0x29b34, 0x29b32, 0x29b43, 0x29b3c, 0x29c11, 0x29c35, 0x29c34, 0x29c37, 0x29c31, 0x29cbf, 0x29cbc, 0x29cc2, 0x29cc9, 0x29d83, 0x29d8b, 0x29da0, 0x29d8d, 0x29d8c, 0x29d9a, 0x29d8a, 0x29d91, 0x29ff1, 0x2a00f, 0x2a001, 0x2a007, 0x2a00c, 0x4cc0, 0x29fdc, 0x29fee, 0x29ff7, 0x29ff2, 0x29ff8, 0x29feb, 0x4cca, 0x29fe6, 0x29fed, 0x29fe9, 0x2a008, 0x2a000, 0x29fe5, 0x29ffc, 0x2a261, 0x2a25e, 0x2a25f, 0x4d25, 0x2a29b, 0x2a316, 0x2a315, 0x2a320, 0x2a314, 0x2a31a, 0x2a317, 0x2a39c, 0x4d4a, 0x2a3f1, 0x4d53, 0x2a3f0, 0x2a428, 0x2a424, 0x2a42a, 0x2a42b, 0x2a4d5, 0x2a504, 0x2a505, 0x2a591, 0x2a592, 0x2a6a6, 0x20109, 0x20422, 0x2041a, 0x2040e, 0x2041b, 0x20408, 0x250b3, 0x2f81c, 0x2069d, 0x20812, 0x2081e, 0x208bb,
+		0x208bf, 0x208bc, 0x20908, 0x20909, 0x20963, 0x209c8, 0x23728, 0x20b8e, 0x20b8d, 0x21043, 0x2104d, 0x2106d, 0x21042, 0x21052, 0x21051, 0x21069, 0x21050, 0x21056, 0x2106c, 0x21044, 0x21045, 0x2106b, 0x3605, 0x21068, 0x21057, 0x2122e, 0x21231, 0x2122d, 0x2148b, 0x214b2, 0x2148d, 0x214a3, 0x214a4, 0x21490, 0x21489, 0x2148e, 0x21492, 0x2157b, 0x21690, 0x2168f, 0x218e9, 0x218cd, 0x218c5, 0x218d0, 0x218c9, 0x218d1, 0x218c7, 0x218d2, 0x2185f, 0x218e1, 0x218cc, 0x218c6, 0x218e4, 0x219a9, 0x21ad3, 0x21ada, 0x21ad2, 0x21adb, 0x21b4d, 0x21b4e, 0x21c1c, 0x21ce8, 0x21f6c, 0x21f5f, 0x21f66, 0x21f64, 0x21f78, 0x21f65, 0x21f6d, 0x21f61, 0x21f7a, 0x22007, 0x22166, 0x22168, 0x22162, 0x222d3, 0x222d4, 0x222d1, 0x222dc, 0x22373, 0x22372, 0x2242d, 0x2242c, 0x2242f, 0x22472, 0x224b4, 0x22589, 0x228cd, 0x228a6, 0x228a2, 0x228a0, 0x22846, 0x228a1, 0x26857,
+		0x228a3, 0x228b1, 0x228db, 0x228f6, 0x228dc, 0x228d6, 0x22a13, 0x22d9c, 0x22d97, 0x22dd8, 0x22de4, 0x22dd4, 0x22de5, 0x22ddb, 0x22dd0, 0x22dda, 0x22dcc, 0x22ddc, 0x22ded, 0x22dd3, 0x22dd1, 0x22dce, 0x22dd9, 0x22ddd, 0x2f8c6, 0x22ee6, 0x22ee5, 0x22ee9, 0x22fff, 0x22ffe, 0x2300e, 0x2308c, 0x230ca, 0x230cb, 0x2311a, 0x232d7, 0x232cf, 0x232d0, 0x232c9, 0x232cc, 0x232d3, 0x233a4, 0x233a3, 0x23681, 0x23726, 0x236f3, 0x23727, 0x236f2, 0x236ff, 0x236f5, 0x236fc, 0x2370e, 0x3bf0, 0x236f6, 0x23700, 0x23723, 0x23705, 0x23725, 0x23708, 0x23750, 0x23925, 0x23920, 0x23923, 0x23921, 0x239a1, 0x23a69, 0x23a6c, 0x23a68, 0x23a6b, 0x23acc, 0x23acd, 0x23c10, 0x23c0d, 0x23c0a, 0x23c16, 0x23c14, 0x23c52, 0x24028, 0x2402e, 0x2402c, 0x2404d, 0x24049, 0x24031, 0x24030, 0x24033, 0x2402d, 0x24036, 0x2403e, 0x2402f, 0x24027, 0x24034, 0x2404c, 0x243fe, 0x24412,
+		0x2441f, 0x24417, 0x243f5, 0x24415, 0x243f7, 0x3e02, 0x21f7d, 0x243fa, 0x243f9, 0x2444b, 0x2459c, 0x2459d, 0x3e23, 0x246f0, 0x246f6, 0x246ef, 0x2489d, 0x2489a, 0x248a7, 0x248af, 0x248aa, 0x24a64, 0x24a86, 0x24a75, 0x24a70, 0x24a84, 0x24a6b, 0x24a85, 0x24a6c, 0x24b17, 0x24b94, 0x24b93, 0x24cd2, 0x24cd7, 0x24cd4, 0x24ea6, 0x24ea7, 0x24e9c, 0x24e8b, 0x24e8d, 0x24e98, 0x24eb9, 0x24e9b, 0x24e9d, 0x24e99, 0x24ea8, 0x24e91, 0x24e87, 0x24e9a, 0x24fa6, 0x2501f, 0x250a7, 0x250b1, 0x250b2, 0x250b7, 0x252d0, 0x252b3, 0x252b5, 0x252c4, 0x252c3, 0x252bc, 0x252b2, 0x252ba, 0x252bb, 0x252c2, 0x252cd, 0x252be, 0x252b7, 0x2538f, 0x25403, 0x25404, 0x25571, 0x25577, 0x25574, 0x2558b, 0x2557a, 0x2f951, 0x2558c, 0x25573, 0x256ff, 0x256fb, 0x256fd, 0x256f0, 0x256f3, 0x256fc, 0x256f2, 0x25892, 0x2589e, 0x258ae, 0x25896, 0x25a14, 0x25a12, 0x25a13, 0x25a16,
+		0x25a0f, 0x25ab6, 0x25abd, 0x25c8c, 0x25cae, 0x25cac, 0x25cab, 0x25c99, 0x25c92, 0x25cbb, 0x25c9e, 0x25c7e, 0x25caf, 0x25cbc, 0x25c98, 0x25f01, 0x25f09, 0x25f06, 0x2f967, 0x25f07, 0x25f08, 0x260cf, 0x2610e, 0x26132, 0x4315, 0x26112, 0x26116, 0x26117, 0x2611b, 0x26115, 0x26131, 0x26118, 0x2611a, 0x26110, 0x2610a, 0x26109, 0x2624f, 0x2f973, 0x2630f, 0x26310, 0x2f975, 0x26328, 0x26311, 0x26316, 0x26317, 0x26302, 0x263bb, 0x263ba, 0x263c3, 0x263bc, 0x2648a, 0x26484, 0x26486, 0x264e0, 0x26517, 0x26518, 0x2651e, 0x26515, 0x265d3, 0x265da, 0x265d9, 0x267e6, 0x267f4, 0x267e1, 0x26869, 0x26840, 0x26858, 0x2686c, 0x2684d, 0x26921, 0x26999, 0x269f1, 0x23f68, 0x26a86, 0x26f5b, 0x26f5c, 0x26f77, 0x26f2c, 0x2f9ad, 0x26f58, 0x26f64, 0x26f61, 0x26f48, 0x26f97, 0x26f59, 0x26f29, 0x26f62, 0x26f2e, 0x26f68, 0x26f90, 0x26f3a, 0x26f3d, 0x26f5e, 0x26f46,
+		0x26f69, 0x26f65, 0x26f3e, 0x26f49, 0x26f56, 0x26fe1, 0x26f78, 0x26f79, 0x26f66, 0x26f4a, 0x26f35, 0x26f7a, 0x26f92, 0x26f60, 0x26f36, 0x26f51, 0x26f42, 0x455d, 0x26f3f, 0x26f7b, 0x26f5d, 0x26f94, 0x26f6a, 0x2f9b5, 0x271f2, 0x273bb, 0x2741b, 0x2741a, 0x27433, 0x27417, 0x2740a, 0x27415, 0x27412, 0x27401, 0x2742d, 0x273fd, 0x27423, 0x27405, 0x27411, 0x27400, 0x2741c, 0x27435, 0x2742e, 0x27436, 0x20abf, 0x2742f, 0x2740c, 0x27409, 0x27431, 0x273fc, 0x2740f, 0x27418, 0x27402, 0x27600, 0x2771f, 0x27737, 0x465a, 0x27738, 0x2772b, 0x2772e, 0x27721, 0x27730, 0x27729, 0x27731, 0x2f9c9, 0x27701, 0x2772c, 0x27722, 0x277ff, 0x27877, 0x27867, 0x2787f, 0x2787d, 0x2787b, 0x2787e, 0x2791e, 0x2791c, 0x27921, 0x27926, 0x27927, 0x27929, 0x2792c, 0x2791d, 0x2792b, 0x27abf, 0x27aa4, 0x27aaa, 0x27aae, 0x27a9f, 0x27ad0, 0x27ab1, 0x27aad, 0x27a9b, 0x27ab2,
+		0x27aa9, 0x27ab3, 0x27ab4, 0x27aba, 0x27aa5, 0x27ab7, 0x27aac, 0x27acb, 0x27acf, 0x27bc6, 0x27c01, 0x27bff, 0x27bfd, 0x27c77, 0x27c78, 0x27c76, 0x27cf7, 0x27dcc, 0x4785, 0x27dd4, 0x27dd7, 0x27dd5, 0x27dd6, 0x27dd3, 0x27f5d, 0x27f55, 0x280e0, 0x28148, 0x280ee, 0x280db, 0x280e7, 0x280d6, 0x280e5, 0x280e1, 0x280dd, 0x280e2, 0x28270, 0x28266, 0x2826f, 0x2826e, 0x28381, 0x28369, 0x2836e, 0x2836d, 0x2836c, 0x28384, 0x28385, 0x28371, 0x28373, 0x2836a, 0x2836f, 0x2837b, 0x2856a, 0x2857c, 0x2857d, 0x28581, 0x285fa, 0x28605, 0x285eb, 0x285fb, 0x285e9, 0x285ef, 0x285fc, 0x285e7, 0x2f9e1, 0x285ee, 0x285fd, 0x28732, 0x287a7, 0x287b5, 0x287b1, 0x287b9, 0x287a8, 0x287b3, 0x2f9e6, 0x2888a, 0x28891, 0x2888d, 0x28899, 0x490b, 0x2889a, 0x2889b, 0x28892, 0x2888f, 0x288ab, 0x24cdb, 0x4939, 0x28a75, 0x28a31, 0x28a38, 0x4937, 0x28a35, 0x28a69, 0x28a3b,
+		0x28a3d, 0x28a6c, 0x28a79, 0x28a3c, 0x28a3e, 0x28c97, 0x28ca5, 0x28ca2, 0x28c9d, 0x28ca1, 0x28d68, 0x28d6f, 0x28d6d, 0x28d72, 0x28d75, 0x28d77, 0x28d79, 0x28eb5, 0x28eea, 0x28eab, 0x28f43, 0x28f41, 0x28f42, 0x29009, 0x29008, 0x29006, 0x29001, 0x29003, 0x29000, 0x29004, 0x2900a, 0x2900e, 0x2900d, 0x29007, 0x2900f, 0x29014, 0x29002, 0x29015, 0x2900c, 0x29010, 0x29005, 0x290fd, 0x290ff, 0x29104, 0x29100, 0x29109, 0x2922b, 0x2f9f7, 0x29231, 0x292db, 0x292c5, 0x292d3, 0x292ce, 0x4a6b, 0x292c9, 0x292bf, 0x292cb, 0x292c0, 0x292d0, 0x292d4, 0x293c1, 0x293b9, 0x293bb, 0x293c3, 0x293c9, 0x29407, 0x2942d, 0x294f8, 0x294e1, 0x294fa, 0x294ef, 0x294fd, 0x4acd, 0x294eb, 0x294f1, 0x294ed, 0x294fe, 0x295f8, 0x29603, 0x295ee, 0x295e8, 0x29601, 0x296ec, 0x29722, 0x29714, 0x29734, 0x2972f, 0x29739, 0x29741, 0x2973c, 0x29749, 0x29758, 0x2973a, 0x29742,
+		0x2973f, 0x29822, 0x29823, 0x2984a, 0x298dc, 0x298d9, 0x298db, 0x298e2, 0x2fa07, 0x298df, 0x298e0, 0x298d7, 0x29a4f, 0x29a46, 0x29a53, 0x29a55, 0x29a4e, 0x29a4a, 0x29a4c, 0x29a63, 0x29b51, 0x29b53, 0x29b58, 0x29b4d, 0x29b5a, 0x29b49, 0x29b5d, 0x29c12, 0x29c3c, 0x29cd1, 0x29cdf, 0x29cd6, 0x29cd8, 0x29ce0, 0x29cd9, 0x29db1, 0x29dac, 0x29daa, 0x29dee, 0x29dbd, 0x4c4d, 0x29dc3, 0x29da8, 0x29dae, 0x29dab, 0x2a01d, 0x2a027, 0x2a038, 0x2a012, 0x2a048, 0x2a02b, 0x2a016, 0x2a019, 0x2a03d, 0x2a023, 0x2a02a, 0x2a264, 0x2a2ad, 0x2a2ac, 0x2fa14, 0x2a2b1, 0x2a2af, 0x2a32c, 0x2a324, 0x2a325, 0x2a328, 0x2a3f9, 0x2a3f7, 0x2a3fd, 0x2a3fe, 0x2a439, 0x2a433, 0x2a4d7, 0x2a4d8, 0x2a4e4, 0x214a1, 0x2a50e, 0x2a53b, 0x2a544, 0x2a542, 0x2a594, 0x2a593, 0x2a5d5, 0x2a6a7, 0x20431, 0x20423, 0x20428, 0x20427, 0x204c6, 0x205a3, 0x20621, 0x2081b, 0x3510, 0x208c1,
+		0x208c3, 0x2092a, 0x20969, 0x20a27, 0x210b6, 0x210a7, 0x210a4, 0x210a6, 0x21090, 0x2109e, 0x21094, 0x210a8, 0x210a5, 0x210a2, 0x21091, 0x367b, 0x214bc, 0x214bd, 0x214b4, 0x214b0, 0x214e4, 0x21545, 0x2154b, 0x2157e, 0x2157f, 0x2157d, 0x215c3, 0x218fc, 0x218f7, 0x218f0, 0x218ed, 0x218f1, 0x218f8, 0x21ae9, 0x21ceb, 0x381d, 0x21f90, 0x21f8d, 0x21f86, 0x21f91, 0x21f8a, 0x22008, 0x22050, 0x222ea, 0x222e6, 0x222e2, 0x222e7, 0x222ed, 0x222e1, 0x22434, 0x22476, 0x22475, 0x22473, 0x224b5, 0x22590, 0x22592, 0x228e1, 0x228df, 0x228d5, 0x228f2, 0x228fe, 0x22913, 0x2292e, 0x22a19, 0x22e08, 0x64f5, 0x22e05, 0x22e09, 0x22e06, 0x22e03, 0x22dfd, 0x22dfc, 0x22e02, 0x22e42, 0x2301a, 0x23011, 0x23015, 0x23016, 0x230cc, 0x230cf, 0x230d0, 0x23122, 0x2311e, 0x23121, 0x232e5, 0x3b27, 0x23354, 0x232ef, 0x23353, 0x23351, 0x233ad, 0x23767, 0x23768, 0x237a4,
+		0x23777, 0x23789, 0x23744, 0x2378b, 0x23779, 0x2375b, 0x23743, 0x23757, 0x2374a, 0x2377c, 0x23746, 0x2377b, 0x23756, 0x239a8, 0x23a76, 0x23a72, 0x23ad6, 0x23ad8, 0x23ad1, 0x23c22, 0x23c20, 0x23c23, 0x23c1e, 0x23c6e, 0x240a3, 0x24077, 0x240a6, 0x2406d, 0x240a2, 0x2407c, 0x24084, 0x24068, 0x24074, 0x24086, 0x240a5, 0x2407b, 0x2407a, 0x24069, 0x24072, 0x24076, 0x2444a, 0x24437, 0x2442a, 0x2442d, 0x24446, 0x24428, 0x24426, 0x24442, 0x2442c, 0x24438, 0x2442b, 0x24433, 0x24445, 0x24539, 0x246f9, 0x246fa, 0x248b8, 0x248b7, 0x248bb, 0x248b9, 0x248b4, 0x24a6f, 0x24a87, 0x24a8f, 0x24aa2, 0x24aa3, 0x2f931, 0x24b9b, 0x24b9d, 0x24bce, 0x3fcf, 0x24ebd, 0x24ebf, 0x24e92, 0x3fcd, 0x24eef, 0x24ec9, 0x24fa4, 0x24fa8, 0x24faa, 0x25028, 0x25024, 0x25025, 0x25026, 0x250a9, 0x250ba, 0x250be, 0x250bc, 0x250c0, 0x252f0, 0x252df, 0x252e0, 0x252ed, 0x252db,
+		0x252fb, 0x252b9, 0x252da, 0x252eb, 0x252ec, 0x2559a, 0x2559f, 0x2559b, 0x25597, 0x255a1, 0x2570f, 0x25705, 0x25748, 0x4182, 0x258bc, 0x258ba, 0x25abf, 0x25d01, 0x25ce8, 0x25cef, 0x25ce4, 0x25ce6, 0x25d02, 0x25ceb, 0x25ce0, 0x25ced, 0x25cd9, 0x2f964, 0x25d14, 0x25cee, 0x4252, 0x25d13, 0x25cf9, 0x25cf8, 0x25f25, 0x2f969, 0x25f19, 0x25f20, 0x25f43, 0x25f3f, 0x26145, 0x2614c, 0x26149, 0x2614f, 0x26141, 0x2613e, 0x2614d, 0x26152, 0x2614a, 0x2614e, 0x26173, 0x26142, 0x26151, 0x26155, 0x26150, 0x2616c, 0x0, 0x2616a, 0x26153, 0x26168, 0x26255, 0x26256, 0x2631c, 0x2631d, 0x7f80, 0x2631e, 0x26323, 0x2631f, 0x263e1, 0x263cd, 0x263cb, 0x263cc, 0x263c8, 0x263c9, 0x2649b, 0x26494, 0x26492, 0x26496, 0x26493, 0x26495, 0x2648f, 0x2651d, 0x26522, 0x26521, 0x265e9, 0x265ef, 0x265e0, 0x265e6, 0x265e4, 0x26829, 0x2682c, 0x26876, 0x26883, 0x26878,
+		0x2683c, 0x24443, 0x2687a, 0x4451, 0x268f2, 0x2699e, 0x2699b, 0x2699a, 0x269f6, 0x269f5, 0x26aa5, 0x26a93, 0x26aa4, 0x26e82, 0x26ec7, 0x26fb7, 0x2701d, 0x26fe2, 0x26fd7, 0x26fe3, 0x26fe4, 0x26fbc, 0x26fd3, 0x455a, 0x26f5a, 0x26fd2, 0x26f2d, 0x2f9af, 0x26fc4, 0x26fd0, 0x26fe5, 0x27005, 0x27007, 0x26fe6, 0x2701b, 0x26fe7, 0x26fd8, 0x26fbe, 0x27017, 0x26fb4, 0x26fd9, 0x26fe8, 0x26fad, 0x26faf, 0x26fc8, 0x26fe9, 0x26fea, 0x271fe, 0x271fb, 0x27200, 0x27472, 0x27470, 0x27446, 0x27459, 0x2745e, 0x27448, 0x2744f, 0x27471, 0x27460, 0x2745f, 0x2746e, 0x27473, 0x2f9c0, 0x2f9bf, 0x27447, 0x2746d, 0x2746f, 0x27481, 0x2746c, 0x27478, 0x27483, 0x27449, 0x27468, 0x27474, 0x27463, 0x2746a, 0x26885, 0x27465, 0x27462, 0x274c8, 0x275d0, 0x275d4, 0x275d1, 0x27603, 0x27742, 0x27763, 0x27756, 0x2775b, 0x27755, 0x27750, 0x2772d, 0x27744, 0x27748, 0x27745,
+		0x27782, 0x4665, 0x27762, 0x27885, 0x2788d, 0x27936, 0x2792f, 0x27931, 0x27937, 0x27aa7, 0x27ad9, 0x27af0, 0x27af2, 0x27afd, 0x27ae8, 0x27aeb, 0x27aee, 0x27ae0, 0x27ae9, 0x27aed, 0x27ad6, 0x27af8, 0x27ad4, 0x27adf, 0x27ae7, 0x27ad8, 0x27ae3, 0x27aef, 0x27b0f, 0x27bca, 0x21546, 0x27c05, 0x27c0c, 0x27c0d, 0x27c7f, 0x27c80, 0x27c81, 0x27d01, 0x27d03, 0x27df2, 0x27de2, 0x27de3, 0x27dde, 0x27de9, 0x27de8, 0x27de0, 0x27e01, 0x27df5, 0x27de4, 0x8d01, 0x27e77, 0x27f74, 0x27f6f, 0x27f62, 0x27f61, 0x27f6d, 0x27f73, 0x27f6a, 0x27f69, 0x28112, 0x2812d, 0x28114, 0x2810f, 0x28129, 0x28116, 0x28103, 0x28146, 0x2815c, 0x28111, 0x28106, 0x280dc, 0x2812b, 0x2812a, 0x2812c, 0x28127, 0x2827a, 0x2839c, 0x28399, 0x28395, 0x2838b, 0x28398, 0x28396, 0x28432, 0x285a4, 0x285aa, 0x2861b, 0x48af, 0x2860d, 0x2861c, 0x2860a, 0x28620, 0x28608, 0x2861a, 0x28613,
+		0x28611, 0x2875d, 0x2875f, 0x2875e, 0x28760, 0x287bb, 0x287bc, 0x287c1, 0x287c0, 0x287c8, 0x287ce, 0x288a7, 0x288b2, 0x288b6, 0x288a5, 0x288ba, 0x288b5, 0x288ad, 0x288a4, 0x288d3, 0x288b0, 0x288b1, 0x2891d, 0x28a8d, 0x4941, 0x28a91, 0x28ab6, 0x28ab7, 0x28abd, 0x28abc, 0x28a96, 0x28a94, 0x28aa0, 0x28ca8, 0x28ca6, 0x28d84, 0x28d96, 0x28d88, 0x28d9a, 0x28ed1, 0x28ecf, 0x28f50, 0x28f51, 0x28f4e, 0x28f80, 0x28f81, 0x2901b, 0x29017, 0x29020, 0x29019, 0x2901a, 0x29021, 0x2901e, 0x29018, 0x2901d, 0x4a29, 0x2912d, 0x29124, 0x29127, 0x2912e, 0x29125, 0x2911c, 0x29119, 0x4a2a, 0x29123, 0x2911f, 0x2911a, 0x2912b, 0x2911e, 0x291e0, 0x29233, 0x292e6, 0x292fc, 0x292e5, 0x292f8, 0x292f6, 0x292ea, 0x292f2, 0x292ed, 0x292eb, 0x292f0, 0x292f1, 0x293c6, 0x293c8, 0x293ce, 0x293c5, 0x4a96, 0x293cb, 0x29513, 0x29514, 0x29507, 0x2950c, 0x2961a, 0x4b12,
+		0x29617, 0x29606, 0x29616, 0x29607, 0x29610, 0x29609, 0x29619, 0x29615, 0x2976e, 0x2973b, 0x2973e, 0x2976c, 0x29765, 0x29764, 0x29759, 0x2977c, 0x29770, 0x29779, 0x2982c, 0x29852, 0x29851, 0x2984c, 0x29900, 0x29910, 0x29913, 0x298ff, 0x298fe, 0x298ed, 0x29a5a, 0x29a58, 0x29a5c, 0x29ada, 0x29b78, 0x29b5e, 0x29b67, 0x29b64, 0x29c13, 0x29c23, 0x29c41, 0x29c3f, 0x29c40, 0x29ced, 0x29ce3, 0x29cea, 0x29cf0, 0x29ce6, 0x29ce9, 0x29cf1, 0x29cee, 0x29dd4, 0x29dd1, 0x29ddc, 0x29dec, 0x2a069, 0x2a06d, 0x2a057, 0x2a066, 0x2a0f9, 0x2a04a, 0x2a060, 0x2a056, 0x2a059, 0x2a04c, 0x2a06a, 0x2a062, 0x2a063, 0x2a070, 0x2a05f, 0x2fa0d, 0x2a064, 0x2a05d, 0x2a068, 0x2a09f, 0x2a2ba, 0x2a2b8, 0x2a2bc, 0x2a2b9, 0x2a2b4, 0x2a33b, 0x2a32d, 0x2a338, 0x2a32f, 0x2a332, 0x2a441, 0x2a4cb, 0x2a4de, 0x2a4dd, 0x2a4da, 0x2a4dc, 0x2a510, 0x2a54f, 0x2a549, 0x2a598, 0x2a596,
+		0x2a597, 0x2a5c7, 0x2019c, 0x2a5da, 0x2a5d8, 0x2a6a8, 0x2010a, 0x2019d, 0x204cb, 0x20538, 0x20539, 0x205a6, 0x20823, 0x20822, 0x20821, 0x209ce, 0x20b92, 0x20b91, 0x210ec, 0x210e0, 0x210ed, 0x21108, 0x210e5, 0x210ee, 0x210e4, 0x210eb, 0x210e3, 0x210ea, 0x2110a, 0x2f859, 0x214d1, 0x214e3, 0x214d4, 0x214d0, 0x214d9, 0x367e, 0x58e1, 0x214da, 0x214d3, 0x2154c, 0x2154d, 0x2157c, 0x21580, 0x215cc, 0x218ff, 0x21908, 0x2a508, 0x21901, 0x2f86b, 0x21900, 0x21aed, 0x21af3, 0x21aee, 0x21af1, 0x21af0, 0x21ade, 0x21b51, 0x21f82, 0x21fa9, 0x21f98, 0x21f9d, 0x21f9a, 0x21f9e, 0x21f9f, 0x21fa6, 0x21fa7, 0x22009, 0x2202f, 0x22171, 0x2216d, 0x22172, 0x222ef, 0x222f0, 0x2243b, 0x22439, 0x2243c, 0x22438, 0x24bfd, 0x2243a, 0x22478, 0x22479, 0x22477, 0x22598, 0x2259c, 0x22599, 0x2259a, 0x22911, 0x2290a, 0x228fd, 0x2290f, 0x22919, 0x22903, 0x22915, 0x2290c,
+		0x22909, 0x22912, 0x22934, 0x2292a, 0x22908, 0x2292d, 0x22928, 0x2f8b1, 0x2292c, 0x22926, 0x22933, 0x39a7, 0x22a1a, 0x22a1e, 0x22e07, 0x22e2c, 0x22e32, 0x22e28, 0x22e31, 0x22e29, 0x22e30, 0x22e2a, 0x22e44, 0x22e2e, 0x230d1, 0x23124, 0x232f7, 0x232f4, 0x232f3, 0x232f8, 0x237b5, 0x23796, 0x23798, 0x23795, 0x23791, 0x237b2, 0x2379e, 0x23759, 0x237a3, 0x2379a, 0x2379b, 0x4320, 0x26183, 0x23ada, 0x23adf, 0x23b16, 0x23c1f, 0x23c2d, 0x23c2e, 0x23c2b, 0x240b8, 0x240bb, 0x240bf, 0x240ba, 0x240d5, 0x240e3, 0x240c1, 0x240be, 0x240bd, 0x240b4, 0x240c2, 0x240a1, 0x24087, 0x240d7, 0x240ca, 0x240b5, 0x240da, 0x240d9, 0x240b3, 0x240d8, 0x24467, 0x24471, 0x24462, 0x2445c, 0x24468, 0x24452, 0x24456, 0x21109, 0x20442, 0x245a0, 0x24700, 0x246fe, 0x246ff, 0x248cb, 0x2f928, 0x248ca, 0x248a9, 0x248c8, 0x24ab4, 0x24aac, 0x24aaa, 0x24aa9, 0x24c0e, 0x24ce9,
+		0x24ced, 0x24cf2, 0x24ceb, 0x24cee, 0x24ee8, 0x24edb, 0x24ed7, 0x24ee3, 0x24ee5, 0x24eee, 0x24ed5, 0x24fb3, 0x2502d, 0x250c1, 0x250c3, 0x2530c, 0x2530e, 0x25307, 0x25317, 0x25309, 0x25316, 0x2539a, 0x2539c, 0x255b4, 0x255b7, 0x255b3, 0x2154e, 0x25713, 0x25714, 0x258e6, 0x258dc, 0x258e8, 0x258e5, 0x25a2e, 0x25a2c, 0x25a2b, 0x25acd, 0x25d3d, 0x25d32, 0x25d2d, 0x25d45, 0x25d3e, 0x25d50, 0x25d25, 0x25d53, 0x25d23, 0x25f37, 0x25f38, 0x25f47, 0x25f3d, 0x25f3e, 0x25f49, 0x25f4a, 0x25f1d, 0x7ce9, 0x261a5, 0x2618c, 0x2618d, 0x26189, 0x26196, 0x26185, 0x2618f, 0x26177, 0x2618e, 0x26182, 0x2618a, 0x26188, 0x2617b, 0x26197, 0x2617d, 0x26179, 0x26259, 0x26324, 0x2632d, 0x2632e, 0x2632b, 0x2f976, 0x263da, 0x263d8, 0x263d6, 0x26487, 0x264a0, 0x26528, 0x26525, 0x2651f, 0x265f3, 0x265f7, 0x265f6, 0x2682b, 0x2685b, 0x26848, 0x81cb, 0x2685c, 0x2686d,
+		0x2689d, 0x26899, 0x2688c, 0x26891, 0x2689b, 0x2689a, 0x2689c, 0x26895, 0x2688d, 0x26896, 0x268a5, 0x2692a, 0x269a1, 0x269a4, 0x269ad, 0x26aa9, 0x26aae, 0x26ab0, 0x2700d, 0x26f63, 0x26f71, 0x27051, 0x27054, 0x2702a, 0x27044, 0x27055, 0x27099, 0x27039, 0x2703f, 0x2703e, 0x2704f, 0x2704d, 0x27035, 0x27040, 0x27031, 0x26fd5, 0x2702b, 0x27033, 0x27041, 0x27056, 0x2704c, 0x27046, 0x2703c, 0x27045, 0x27043, 0x2703d, 0x27070, 0x27057, 0x27038, 0x27058, 0x4565, 0x27037, 0x27207, 0x27206, 0x27209, 0x274ab, 0x27490, 0x27493, 0x274bc, 0x274a9, 0x2749e, 0x274bf, 0x274aa, 0x27491, 0x274a4, 0x2749a, 0x274a7, 0x274a1, 0x2749c, 0x274a2, 0x2749b, 0x2749f, 0x27494, 0x2748f, 0x272f0, 0x27492, 0x27495, 0x274a5, 0x274a6, 0x27604, 0x2779c, 0x27779, 0x2777a, 0x2777e, 0x2777b, 0x27771, 0x27781, 0x2777f, 0x2777c, 0x2777d, 0x27775, 0x27776, 0x2788e, 0x2788f,
+		0x2793e, 0x2793f, 0x27940, 0x27941, 0x4704, 0x27b0d, 0x27b17, 0x27b10, 0x27b0e, 0x27aea, 0x27b1d, 0x27b03, 0x27b22, 0x27b04, 0x27b00, 0x27b20, 0x27b21, 0x27b23, 0x27b13, 0x27b09, 0x27b11, 0x27bcb, 0x27bce, 0x27bd0, 0x27bcc, 0x27bd4, 0x214db, 0x27c09, 0x27c0b, 0x27c85, 0x27d06, 0x27d0d, 0x4764, 0x27d14, 0x27d0f, 0x27e09, 0x27e14, 0x27e0b, 0x27dfc, 0x27e04, 0x27e0a, 0x27e00, 0x27dfd, 0x27e07, 0x27e06, 0x27e11, 0x27e79, 0x27e78, 0x27f88, 0x27f80, 0x27f8b, 0x28159, 0x28161, 0x28175, 0x4823, 0x28155, 0x2815b, 0x2815f, 0x28152, 0x28162, 0x28172, 0x2815d, 0x28168, 0x28171, 0x28165, 0x28166, 0x28167, 0x28176, 0x2814c, 0x28160, 0x28174, 0x28150, 0x2828a, 0x28281, 0x28286, 0x2827f, 0x28280, 0x283a5, 0x283a7, 0x283a8, 0x283a6, 0x283af, 0x26195, 0x283b1, 0x28435, 0x28439, 0x285c3, 0x28630, 0x2862a, 0x2862b, 0x2862d, 0x2862e, 0x2862c, 0x28623,
+		0x28621, 0x28622, 0x2876c, 0x28781, 0x2878f, 0x28780, 0x287d0, 0x287cd, 0x287d5, 0x287d4, 0x288d1, 0x288be, 0x288cb, 0x288ce, 0x288bd, 0x288d0, 0x28b04, 0x28ad5, 0x28ad0, 0x28ad3, 0x28afb, 0x28ad8, 0x28ad1, 0x28afd, 0x28ad9, 0x28ad6, 0x28ae6, 0x28af9, 0x28da1, 0x28d9d, 0x28d9e, 0x95d9, 0x28eff, 0x28f5f, 0x28f57, 0x28f60, 0x28f59, 0x2902c, 0x29025, 0x29027, 0x29030, 0x29024, 0x29026, 0x2902d, 0x2902e, 0x29029, 0x29031, 0x29145, 0x29147, 0x29152, 0x2914a, 0x29150, 0x29146, 0x2914f, 0x2914e, 0x29153, 0x29321, 0x29309, 0x2931a, 0x2931b, 0x25315, 0x29310, 0x2f9f9, 0x29314, 0x2930e, 0x29312, 0x2930b, 0x293cf, 0x293d2, 0x293d0, 0x293d4, 0x293d3, 0x293d1, 0x20b18, 0x2f9fb, 0x29409, 0x2951c, 0x29527, 0x29525, 0x2951b, 0x29529, 0x2951f, 0x29530, 0x29524, 0x29528, 0x29519, 0x2952f, 0x29624, 0x29621, 0x29625, 0x29626, 0x29627, 0x29676, 0x29766,
+		0x29775, 0x29769, 0x2977e, 0x2978f, 0x29774, 0x27210, 0x297ad, 0x2982b, 0x2982a, 0x29858, 0x29922, 0x2991d, 0x2992b, 0x2992c, 0x2992d, 0x29933, 0x2991b, 0x29927, 0x2992a, 0x29928, 0x2993b, 0x29a7e, 0x29a71, 0x29a79, 0x29a78, 0x29a70, 0x29a6d, 0x29a7d, 0x29a75, 0x29a76, 0x29ade, 0x29b66, 0x29b83, 0x29b87, 0x29b7b, 0x29b89, 0x29b86, 0x29b82, 0x29b7c, 0x29b81, 0x29c43, 0x29c45, 0x29cf7, 0x29cf9, 0x29cfa, 0x29e05, 0x29dfe, 0x29e0f, 0x29dff, 0x29dfa, 0x29e09, 0x29e20, 0x29e0c, 0x29e3c, 0x29e22, 0x29df8, 0x29e0a, 0x29e08, 0x29df7, 0x2a08e, 0x2a077, 0x2a08b, 0x2a0b4, 0x2a08a, 0x2a09a, 0x2a079, 0x2a083, 0x2a07f, 0x2a0a1, 0x2a08f, 0x2a0a3, 0x2a081, 0x2a094, 0x2a07e, 0x2a082, 0x2a090, 0x2a0a5, 0x2a0ad, 0x2a09d, 0x2a267, 0x2a269, 0x2a2cb, 0x2a2c8, 0x2a2d1, 0x2a340, 0x2a34b, 0x2a349, 0x2a346, 0x2a33e, 0x2a343, 0x2a33f, 0x2a3a5, 0x2a3a7, 0x2a44e,
+		0x2a44d, 0x2259b, 0x2a4e5, 0x2a4e1, 0x2a4e2, 0x2a516, 0x2a514, 0x2fa1b, 0x2a559, 0x2a551, 0x2a55f, 0x2a54a, 0x2a557, 0x2a558, 0x2a5ca, 0x2a5db, 0x2a5de, 0x2a5e0, 0x2a5e1, 0x2a5df, 0x2a5e2, 0x2a5e3, 0x2a692, 0x2a6bf, 0x2a6be, 0x2010b, 0x20452, 0x2044e, 0x34b9, 0x2f813, 0x205a7, 0x20826, 0x20827, 0x208c6, 0x2090b, 0x2096a, 0x20978, 0x2111a, 0x21116, 0x21119, 0x21117, 0x2111b, 0x21118, 0x21120, 0x21237, 0x214ec, 0x21581, 0x2923d, 0x2190f, 0x219ad, 0x21afb, 0x21b52, 0x21faf, 0x21fb7, 0x21fb2, 0x22178, 0x221ac, 0x22300, 0x222fe, 0x22302, 0x222fd, 0x22303, 0x22440, 0x22443, 0x22442, 0x224b7, 0x225a2, 0x22900, 0x22935, 0x22941, 0x22905, 0x20453, 0x22950, 0x2294e, 0x22953, 0x22e53, 0x22e50, 0x22e57, 0x22e5f, 0x22e55, 0x22eea, 0x23026, 0x29830, 0x2302a, 0x23028, 0x2302c, 0x2302d, 0x230d4, 0x23307, 0x23358, 0x23359, 0x237c5, 0x237cd, 0x237c7,
+		0x237e8, 0x3c4b, 0x23932, 0x2a697, 0x23ade, 0x23c32, 0x20ac8, 0x2f915, 0x240f1, 0x240f0, 0x240ec, 0x24109, 0x240f9, 0x240f5, 0x240fe, 0x24474, 0x24481, 0x2447c, 0x24475, 0x24489, 0x24482, 0x24497, 0x24486, 0x2447d, 0x24493, 0x2449c, 0x24476, 0x24480, 0x24545, 0x206a1, 0x24703, 0x248c9, 0x248cd, 0x248d0, 0x24aad, 0x24ac5, 0x24ba2, 0x24cec, 0x24cf6, 0x24cf3, 0x24ef3, 0x24efa, 0x24ef9, 0x24ef5, 0x24ef4, 0x24ef8, 0x24fb6, 0x24fb4, 0x25032, 0x250cd, 0x250c8, 0x250ce, 0x250ca, 0x2532a, 0x25321, 0x2531d, 0x255bd, 0x255be, 0x255c2, 0x40f1, 0x255c9, 0x2571f, 0x258f1, 0x258ed, 0x258f2, 0x258e0, 0x258f7, 0x25a30, 0x25a37, 0x25a31, 0x25a36, 0x25ac8, 0x25d6d, 0x25d69, 0x25d7d, 0x25d61, 0x25d70, 0x25d71, 0x25d73, 0x25d76, 0x25d75, 0x25d78, 0x25d79, 0x25d64, 0x25d6e, 0x25f51, 0x25f4f, 0x25f22, 0x261af, 0x261aa, 0x261a3, 0x2619d, 0x2619c, 0x261a1,
+		0x261b6, 0x261ac, 0x261a2, 0x261a7, 0x261b0, 0x261a9, 0x261c3, 0x26331, 0x26332, 0x26333, 0x26334, 0x26337, 0x2633c, 0x263d9, 0x263dd, 0x263de, 0x263df, 0x263e0, 0x264a5, 0x264aa, 0x264a2, 0x264a3, 0x26604, 0x26603, 0x265fe, 0x26628, 0x268af, 0x268ad, 0x268a6, 0x269ac, 0x269a5, 0x269b0, 0x269b1, 0x26a01, 0x26ab2, 0x26ad2, 0x26af1, 0x26fd1, 0x27047, 0x270c9, 0x270a7, 0x270c8, 0x27095, 0x2708e, 0x27091, 0x2707d, 0x270ee, 0x2708d, 0x2708c, 0x270b0, 0x27096, 0x27042, 0x2707c, 0x270b1, 0x270b2, 0x27084, 0x2709d, 0x270a1, 0x27098, 0x270b3, 0x27022, 0x2707b, 0x2708a, 0x270ce, 0x27080, 0x27097, 0x270b4, 0x270b5, 0x2709a, 0x2709f, 0x27093, 0x27212, 0x2720b, 0x2720e, 0x274a3, 0x274cc, 0x274dc, 0x274e1, 0x274de, 0x274d2, 0x274db, 0x274d9, 0x274d7, 0x274d4, 0x274c9, 0x274eb, 0x274da, 0x274d1, 0x27504, 0x274ca, 0x274e2, 0x275d7, 0x2778c, 0x27799,
+		0x277a2, 0x27796, 0x27794, 0x2779f, 0x4667, 0x2778e, 0x27803, 0x27894, 0x27893, 0x27944, 0x27b2f, 0x27b35, 0x27b2b, 0x27b32, 0x27b2d, 0x27b36, 0x4714, 0x27b31, 0x27b12, 0x27b33, 0x27b1f, 0x27b34, 0x27b40, 0x27b3f, 0x27b41, 0x27bd3, 0x27c89, 0x27d18, 0x27d10, 0x27e1a, 0x27e25, 0x27e1e, 0x27f92, 0x27f95, 0x27f93, 0x28184, 0x2819a, 0x28189, 0x2818d, 0x28188, 0x28191, 0x2819b, 0x2819c, 0x29548, 0x2828e, 0x28292, 0x283c5, 0x283c1, 0x283b8, 0x283be, 0x283b5, 0x283c7, 0x2843c, 0x4889, 0x285ec, 0x2863f, 0x28639, 0x28637, 0x287a1, 0x28794, 0x287a0, 0x287de, 0x287db, 0x287df, 0x287dc, 0x288d6, 0x288dc, 0x288dd, 0x288e0, 0x288e3, 0x288e1, 0x28b18, 0x28b19, 0x28b53, 0x2f9ec, 0x28b44, 0x28b0e, 0x28b0f, 0x28b47, 0x28b17, 0x28b1d, 0x28b11, 0x28cb4, 0x28cb6, 0x28db7, 0x28dbe, 0x28dc2, 0x28db4, 0x28f31, 0x28f15, 0x28f83, 0x2903b, 0x29036, 0x29042,
+		0x29050, 0x29040, 0x29034, 0x29038, 0x2903d, 0x2903e, 0x29035, 0x2903a, 0x29046, 0x29037, 0x29039, 0x29045, 0x29177, 0x2915d, 0x2916a, 0x29176, 0x2916b, 0x2916c, 0x29165, 0x29164, 0x29171, 0x2915f, 0x29172, 0x291fe, 0x291ff, 0x2923e, 0x2932b, 0x29336, 0x2932d, 0x29339, 0x2933f, 0x2933b, 0x29333, 0x29342, 0x2933a, 0x293d5, 0x293d8, 0x293d9, 0x2940d, 0x2940a, 0x29439, 0x2943a, 0x2953d, 0x29545, 0x2953a, 0x29537, 0x2953e, 0x29542, 0x29787, 0x2978c, 0x29782, 0x2976b, 0x297a0, 0x2979a, 0x29790, 0x2978e, 0x297a1, 0x297bd, 0x297b2, 0x297b5, 0x297b7, 0x297aa, 0x297a2, 0x297a5, 0x297ae, 0x297ab, 0x297bc, 0x29832, 0x2985a, 0x29964, 0x2995c, 0x2994d, 0x2993f, 0x2993e, 0x29952, 0x29958, 0x29957, 0x2995e, 0x29953, 0x29954, 0x29956, 0x28f65, 0x29a84, 0x29a85, 0x29a86, 0x29b97, 0x29ba1, 0x29ba2, 0x4c0b, 0x2fa0a, 0x29ba3, 0x29ba6, 0x4c17, 0x29c15,
+		0x29c24, 0x29c4a, 0x29c49, 0x29c48, 0x29c4b, 0x29d0e, 0x29962, 0x29d0b, 0x29d0a, 0x29d08, 0x29d06, 0x29e43, 0x29e47, 0x29e3f, 0x29e46, 0x29e50, 0x4c6d, 0x29e4b, 0x4c70, 0x29e52, 0x2a0d7, 0x2a0bf, 0x2a0d8, 0x2a0e0, 0x2a0e7, 0x2a0b8, 0x2a0d5, 0x2a0ef, 0x2a0e6, 0x2a0e4, 0x2a0d4, 0x2a0d6, 0x2a0ea, 0x4ced, 0x2a0bb, 0x2a0e9, 0x2fa10, 0x2a26d, 0x2a270, 0x2a273, 0x2a272, 0x2a2d4, 0x2a2ce, 0x2a2d5, 0x2a35a, 0x2a358, 0x2a352, 0x2a350, 0x2a355, 0x2a34e, 0x2a34d, 0x2a3cb, 0x2a3cc, 0x2a3cd, 0x2a3d1, 0x2a458, 0x2a463, 0x2a45e, 0x2a454, 0x2a45b, 0x2a4e9, 0x2a4e7, 0x2a4e8, 0x2a51d, 0x2a567, 0x2a55a, 0x2a55c, 0x2a55b, 0x2a561, 0x2a5a1, 0x4d8d, 0x2a5a4, 0x2a5e9, 0x2a5ef, 0x2a5e5, 0x2a5f5, 0x2a5eb, 0x2a5ed, 0x2a696, 0x2a695, 0x2a6b3, 0x2a6b5, 0x2a6b1, 0x34a7, 0x2044d, 0x20624, 0x2082b, 0x209cf, 0x20ac9, 0x21136, 0x21131, 0x21154, 0x2113a, 0x21138,
+		0x21239, 0x21238, 0x214f4, 0x214f3, 0x214f6, 0x214fc, 0x214f5, 0x214f1, 0x2169c, 0x21918, 0x2191a, 0x21afc, 0x21afe, 0x21b03, 0x21b53, 0x21d2b, 0x21fc6, 0x21fc1, 0x2217b, 0x22306, 0x22449, 0x224b8, 0x225a3, 0x22952, 0x2294d, 0x2295f, 0x2295e, 0x22961, 0x22a23, 0x22e8c, 0x22e6f, 0x22e75, 0x22e74, 0x22e71, 0x22e70, 0x22e6c, 0x23126, 0x23308, 0x6707, 0x237f0, 0x237ef, 0x237fb, 0x23810, 0x2380c, 0x237f6, 0x237fe, 0x23a7c, 0x23ae1, 0x23c38, 0x23c6f, 0x24118, 0x24115, 0x2411c, 0x24110, 0x24135, 0x2f917, 0x24117, 0x2411d, 0x24126, 0x24128, 0x24129, 0x2412a, 0x2411a, 0x2f916, 0x22307, 0x244ab, 0x244ac, 0x244a1, 0x244ae, 0x244a3, 0x244a7, 0x24548, 0x24604, 0x246fd, 0x3e5a, 0x24708, 0x248d2, 0x24ac6, 0x24abe, 0x24b1c, 0x24ba6, 0x24ba7, 0x24bab, 0x24c00, 0x24cfb, 0x24cfc, 0x24cf9, 0x24d01, 0x24f06, 0x24f04, 0x2f93c, 0x25034, 0x25336, 0x25332,
+		0x25342, 0x2532d, 0x25335, 0x255d8, 0x25723, 0x25720, 0x25901, 0x25900, 0x25903, 0x2a6bc, 0x25a3c, 0x25a41, 0x25a35, 0x25ac9, 0x25d8e, 0x25d9e, 0x25d99, 0x25db4, 0x25daa, 0x25d9f, 0x25d96, 0x25d9d, 0x25dc3, 0x25d74, 0x25dab, 0x42af, 0x25f63, 0x25f5b, 0x25f5a, 0x261c5, 0x261c4, 0x261cf, 0x261c8, 0x261a4, 0x261bd, 0x261d3, 0x26260, 0x2633b, 0x263e3, 0x263e7, 0x264a8, 0x264ac, 0x264a9, 0x2652a, 0x26608, 0x26609, 0x268b9, 0x26ac1, 0x26ac2, 0x26ab8, 0x270e1, 0x270eb, 0x270e5, 0x270fa, 0x270e4, 0x2710b, 0x270d7, 0x270ef, 0x270e0, 0x270ec, 0x270fb, 0x2f9b0, 0x270d3, 0x270e6, 0x270fc, 0x270e3, 0x270cf, 0x270da, 0x270dc, 0x270d2, 0x270a4, 0x456b, 0x27217, 0x27216, 0x274f2, 0x274fc, 0x27518, 0x274f6, 0x274fe, 0x274f3, 0x274f7, 0x27501, 0x274f9, 0x27506, 0x274f5, 0x27510, 0x274df, 0x27503, 0x27508, 0x275d8, 0x27605, 0x27797, 0x277b3, 0x277ae,
+		0x277af, 0x277a7, 0x277b1, 0x277a8, 0x277ac, 0x277ab, 0x27804, 0x2789a, 0x2794a, 0x27b42, 0x27b58, 0x27b4b, 0x27b45, 0x27b49, 0x27b4c, 0x27b59, 0x27b56, 0x471b, 0x27b46, 0x27b44, 0x27b5b, 0x27b69, 0x27c8e, 0x27c8f, 0x27d1e, 0x268bc, 0x27e2f, 0x27f9e, 0x27f9d, 0x27f9f, 0x27f9c, 0x281b4, 0x281ae, 0x281ab, 0x281b3, 0x281af, 0x281c2, 0x28293, 0x28295, 0x28296, 0x28297, 0x283cf, 0x283ce, 0x283cb, 0x2844b, 0x28646, 0x28643, 0x28645, 0x28651, 0x287ae, 0x287af, 0x287b0, 0x287b8, 0x287e2, 0x287e3, 0x287e6, 0x288ed, 0x288ea, 0x2893a, 0x28b59, 0x28b84, 0x28b5f, 0x28b7c, 0x28b5c, 0x28b58, 0x28b55, 0x28b5d, 0x28b7e, 0x28b80, 0x28b83, 0x28b57, 0x4963, 0x28b5e, 0x28cba, 0x28dd5, 0x28f58, 0x28f68, 0x28f67, 0x2904a, 0x2904c, 0x29052, 0x29049, 0x2904e, 0x29047, 0x2904d, 0x2904b, 0x2904f, 0x2917e, 0x29187, 0x29183, 0x29189, 0x24aca, 0x29186, 0x29188,
+		0x29247, 0x29242, 0x29249, 0x29248, 0x4a80, 0x4a84, 0x4a7f, 0x29344, 0x29351, 0x29346, 0x29347, 0x293e4, 0x2940f, 0x2943f, 0x2954b, 0x29557, 0x29552, 0x4af1, 0x29551, 0x29558, 0x2955e, 0x29553, 0x2955d, 0x2954d, 0x2963c, 0x2963f, 0x29646, 0x2963e, 0x29644, 0x29645, 0x29641, 0x29638, 0x29642, 0x29643, 0x2967a, 0x297a3, 0x297ba, 0x297c0, 0x297c4, 0x297c6, 0x297cb, 0x29861, 0x2997a, 0x29973, 0x29972, 0x29974, 0x29980, 0x29981, 0x9a47, 0x29a95, 0x29a8f, 0x29a90, 0x29a92, 0x29a94, 0x29a8b, 0x29ae6, 0x29bb2, 0x29bb8, 0x29bbd, 0x29bbe, 0x29bce, 0x29bba, 0x29c16, 0x29c26, 0x29c25, 0x29c4c, 0x29c50, 0x29c4e, 0x29c51, 0x29c52, 0x29d14, 0x29d15, 0x29d1b, 0x29e82, 0x29e99, 0x29e9a, 0x29e7d, 0x29e85, 0x29e86, 0x29e9c, 0x29e79, 0x29e7b, 0x29e80, 0x29e83, 0x29e81, 0x2a11e, 0x2a11b, 0x2a12a, 0x2a0fb, 0x2a105, 0x2a120, 0x2a111, 0x2a104, 0x2a0fd,
+		0x2a103, 0x2a110, 0x2a118, 0x2a10a, 0x2a14e, 0x2a109, 0x2a107, 0x2a11c, 0x4d1c, 0x2a277, 0x2a276, 0x2a2d8, 0x2a2d9, 0x2a361, 0x2a35c, 0x2a35e, 0x2a360, 0x2a3aa, 0x2a3d6, 0x2a3d8, 0x2a409, 0x2a408, 0x2a46b, 0x2a465, 0x2a473, 0x2a474, 0x2a4ed, 0x2a524, 0x2a525, 0x2a56a, 0x2a5a7, 0x2a5a8, 0x2a60b, 0x2a5fa, 0x2a5f9, 0x2a5ff, 0x2a604, 0x2a5f6, 0x2a605, 0x2a699, 0x2a6ab, 0x2a6bd, 0x2a6b8, 0x34a8, 0x20464, 0x2045a, 0x20572, 0x2097a, 0x20b95, 0x2115f, 0x21161, 0x2115e, 0x2115a, 0x2115c, 0x2115d, 0x2116e, 0x21157, 0x21158, 0x21502, 0x2150b, 0x21508, 0x21551, 0x21925, 0x21928, 0x21923, 0x21921, 0x21924, 0x21929, 0x21b06, 0x21b0a, 0x21fca, 0x21fcc, 0x21fcb, 0x21fcf, 0x2217f, 0x2217c, 0x221d6, 0x22309, 0x2230b, 0x22376, 0x2247d, 0x225a5, 0x2295b, 0x2295c, 0x2295d, 0x22965, 0x22e6d, 0x22e82, 0x22e83, 0x22e87, 0x22e95, 0x22e8a, 0x230d6, 0x23128,
+		0x2330d, 0x2382e, 0x2f8ee, 0x2382d, 0x23821, 0x23819, 0x2393b, 0x2393c, 0x2393a, 0x23a7e, 0x23c3b, 0x24147, 0x24139, 0x24134, 0x24136, 0x24146, 0x2413b, 0x24141, 0x24145, 0x244c0, 0x244c4, 0x244ba, 0x244bd, 0x244be, 0x245a3, 0x2470c, 0x248d9, 0x24acd, 0x24bae, 0x24cff, 0x24d24, 0x24fbb, 0x24fbc, 0x25036, 0x250d5, 0x250d3, 0x250d6, 0x2533c, 0x2533f, 0x255de, 0x255e3, 0x25727, 0x25729, 0x418b, 0x25905, 0x25907, 0x2590c, 0x25ad0, 0x25dbe, 0x25dbc, 0x25dd0, 0x25dc2, 0x25db5, 0x25dc9, 0x25f66, 0x432b, 0x261d5, 0x261e2, 0x261dc, 0x261e3, 0x261da, 0x261c2, 0x261e8, 0x263e9, 0x264af, 0x264ad, 0x264ae, 0x2660b, 0x268c1, 0x269b6, 0x269b9, 0x26ac0, 0x270a5, 0x27128, 0x27122, 0x27129, 0x27118, 0x2711f, 0x2711c, 0x27112, 0x2712a, 0x457a, 0x27121, 0x2712b, 0x27117, 0x270f0, 0x27116, 0x27123, 0x2752b, 0x27526, 0x2753d, 0x27522, 0x2753a, 0x27531,
+		0x27532, 0x27554, 0x27521, 0x27535, 0x4609, 0x2752e, 0x27530, 0x2752f, 0x27536, 0x275da, 0x275d9, 0x277bb, 0x277bc, 0x277b7, 0x277c2, 0x277bd, 0x277b2, 0x466d, 0x25344, 0x25dd1, 0x27b52, 0x27b6b, 0x27b67, 0x471f, 0x27b61, 0x27b6c, 0x27b51, 0x27b74, 0x27b77, 0x27b6f, 0x27b6d, 0x27b68, 0x2f9d1, 0x27b84, 0x27c90, 0x27c92, 0x27c93, 0x27d1f, 0x27e31, 0x27e38, 0x27e39, 0x27e37, 0x27fab, 0x281c3, 0x281c8, 0x281cb, 0x281cf, 0x28298, 0x283d4, 0x283d3, 0x283d8, 0x283d9, 0x283dd, 0x283d1, 0x283d6, 0x2843e, 0x28658, 0x28657, 0x28655, 0x287c4, 0x287e4, 0x288ee, 0x288ef, 0x288f3, 0x288f2, 0x288f0, 0x28bab, 0x28b9a, 0x28baf, 0x28b97, 0x496a, 0x496c, 0x28bbf, 0x28b94, 0x28b93, 0x28cbe, 0x28cbb, 0x28cbc, 0x28dd9, 0x28f6c, 0x29053, 0x29054, 0x2905b, 0x29058, 0x29056, 0x29057, 0x2919f, 0x29194, 0x29196, 0x29197, 0x2924a, 0x2924b, 0x29355, 0x2935a,
+		0x2935e, 0x2935f, 0x29359, 0x2935b, 0x29358, 0x29354, 0x293e8, 0x293eb, 0x293ec, 0x29413, 0x29566, 0x2956b, 0x29562, 0x29569, 0x29563, 0x2955f, 0x2954e, 0x29648, 0x2964a, 0x297e3, 0x297db, 0x297d8, 0x297d6, 0x29986, 0x29990, 0x29991, 0x29988, 0x29994, 0x29983, 0x4bb2, 0x2999c, 0x2998d, 0x29985, 0x29a98, 0x29a9a, 0x29a9c, 0x29ae7, 0x29bc5, 0x29bd0, 0x29bd1, 0x29c19, 0x29c27, 0x4c1d, 0x29c54, 0x29d2d, 0x29d22, 0x4c2d, 0x29d1f, 0x29ebd, 0x29eae, 0x29ebb, 0x29ead, 0x29ebc, 0x29eb9, 0x29eb4, 0x29ecb, 0x29eb7, 0x29eb3, 0x29eba, 0x29eb6, 0x29ecd, 0x29ebe, 0x29ec9, 0x2fa0b, 0x2a15f, 0x2a13b, 0x2a161, 0x2a15c, 0x2a18a, 0x2a15a, 0x2a14d, 0x2a146, 0x2a144, 0x2a13d, 0x2a140, 0x2a13c, 0x2a18c, 0x2a141, 0x2a14c, 0x2a13e, 0x2a14a, 0x2a27c, 0x2a27a, 0x2a365, 0x2a36e, 0x2a369, 0x2a36a, 0x2a36f, 0x2a36c, 0x2a370, 0x2a368, 0x2a36b, 0x4d45, 0x2a3ac,
+		0x2a3de, 0x2a3dd, 0x2a3dc, 0x244c5, 0x2a48c, 0x2a483, 0x2a482, 0x2a488, 0x2a485, 0x2a481, 0x2a4f5, 0x2a4ef, 0x2a4f4, 0x2a4f2, 0x2a4f6, 0x2a4f3, 0x2a4f0, 0x2a4f1, 0x2a52b, 0x2a527, 0x2a528, 0x2a56c, 0x2a5ae, 0x2a60e, 0x2a61b, 0x2a616, 0x2a61f, 0x2a622, 0x2a620, 0x2a621, 0x2a614, 0x2a613, 0x2a69d, 0x2a69c, 0x2a69e, 0x2a69f, 0x2046b, 0x208c8, 0x21178, 0x21176, 0x21170, 0x21171, 0x2150a, 0x2192c, 0x22311, 0x2247e, 0x22957, 0x22966, 0x22969, 0x22967, 0x22968, 0x22971, 0x2296f, 0x2a3ae, 0x22e8b, 0x22e96, 0x23035, 0x2303a, 0x2306b, 0x23316, 0x23843, 0x23846, 0x2383f, 0x2383b, 0x2383d, 0x239b1, 0x239b2, 0x23ae4, 0x23c40, 0x2415d, 0x24151, 0x2414d, 0x2414c, 0x2415b, 0x244d4, 0x244d2, 0x244ca, 0x244c8, 0x244d0, 0x244c9, 0x24549, 0x245a4, 0x24712, 0x2470f, 0x24711, 0x248db, 0x248dd, 0x248dc, 0x24ad4, 0x24b21, 0x24bb2, 0x24d04, 0x24d02, 0x24f11,
+		0x24f16, 0x24f10, 0x24fbe, 0x2721a, 0x2534c, 0x2534a, 0x255f2, 0x255f1, 0x2572a, 0x2572c, 0x2572f, 0x25731, 0x25911, 0x25912, 0x25a4b, 0x25ddb, 0x25dd6, 0x25ddd, 0x25de2, 0x25de4, 0x25de0, 0x25ddf, 0x25de3, 0x25f6e, 0x25f71, 0x261e9, 0x261ea, 0x26263, 0x263eb, 0x263ea, 0x268bd, 0x268bb, 0x268c6, 0x268cc, 0x268c8, 0x244cf, 0x268c9, 0x268ca, 0x268cf, 0x268d0, 0x269ba, 0x269fb, 0x26a03, 0x26ac4, 0x27149, 0x27153, 0x27136, 0x2714a, 0x27141, 0x2714e, 0x27119, 0x2714d, 0x27145, 0x2714c, 0x27147, 0x27148, 0x2714f, 0x27137, 0x27142, 0x2754a, 0x27546, 0x460a, 0x27549, 0x2754f, 0x27551, 0x2754c, 0x460c, 0x27606, 0x27951, 0x27b7a, 0x27b83, 0x27b7e, 0x27b7f, 0x27b80, 0x24bb5, 0x27c91, 0x27c94, 0x27c95, 0x27d21, 0x27d20, 0x27e3d, 0x27e40, 0x27e46, 0x27e84, 0x27fac, 0x27fad, 0x482e, 0x281da, 0x281d9, 0x283e2, 0x283e1, 0x283e3, 0x283e4, 0x283de,
+		0x283df, 0x28641, 0x28659, 0x2865c, 0x2865a, 0x287e8, 0x288f6, 0x288fc, 0x288f7, 0x288fa, 0x288f9, 0x28bc4, 0x28bbe, 0x28bd2, 0x28bbd, 0x28b95, 0x28bd4, 0x28de4, 0x29061, 0x29062, 0x29063, 0x29064, 0x29060, 0x2905c, 0x2905d, 0x2905e, 0x291a5, 0x291a6, 0x2924c, 0x29368, 0x2936e, 0x29371, 0x2936b, 0x2936f, 0x293ee, 0x293f1, 0x293f0, 0x293ef, 0x29415, 0x29414, 0x28f6e, 0x29447, 0x2957c, 0x2957a, 0x29574, 0x29576, 0x2956e, 0x29578, 0x2956d, 0x2956c, 0x2964e, 0x297d7, 0x297ea, 0x297e5, 0x29864, 0x299b3, 0x299a3, 0x299a5, 0x299a7, 0x299a2, 0x2999f, 0x299a6, 0x2999e, 0x299a8, 0x29aa9, 0x29aa6, 0x29aaa, 0x29aab, 0x29aa0, 0x29aa1, 0x29aa8, 0x29ae8, 0x29ae9, 0x29aea, 0x29be4, 0x29bdf, 0x29be0, 0x29c28, 0x29c5d, 0x29c5b, 0x29c56, 0x29c57, 0x29c5f, 0x29c62, 0x29ee1, 0x29ee3, 0x29ede, 0x29ed9, 0x29ee8, 0x29ef2, 0x29ef6, 0x29ee6, 0x29ef4, 0x29ef5,
+		0x29ee5, 0x29ee2, 0x4c8d, 0x2a196, 0x2a1aa, 0x2a197, 0x2a170, 0x2a1a1, 0x2a19d, 0x2a1a9, 0x2a16f, 0x2a17e, 0x2a194, 0x2a19a, 0x2a173, 0x2a187, 0x2a171, 0x2a177, 0x2a188, 0x2a18d, 0x2a185, 0x2a178, 0x2a1ad, 0x2a280, 0x2a281, 0x2a2e5, 0x2a2e7, 0x2a37c, 0x2a3af, 0x2a3e1, 0x2a496, 0x2a4a3, 0x2a490, 0x2a4f8, 0x2a52e, 0x2a575, 0x2a57e, 0x2a57d, 0x2a57b, 0x2a578, 0x2a5b0, 0x2a634, 0x2a636, 0x2a630, 0x2fa1d, 0x2a62e, 0x2a637, 0x20ace, 0x20b97, 0x20b98, 0x2117c, 0x2117e, 0x2117d, 0x2117f, 0x2150f, 0x216a4, 0x21931, 0x2192e, 0x2192f, 0x21932, 0x21d2c, 0x21fd4, 0x21fdc, 0x21fd8, 0x2200e, 0x22183, 0x22184, 0x22312, 0x22972, 0x2297c, 0x2297e, 0x22ea6, 0x22ea0, 0x22e9e, 0x22ea2, 0x2312a, 0x23318, 0x2384d, 0x23858, 0x2385b, 0x25914, 0x2f8f2, 0x23ae6, 0x24164, 0x24168, 0x24160, 0x24162, 0x244d7, 0x2454b, 0x248e0, 0x24b22, 0x24d05, 0x24f19, 0x24f1a,
+		0x24fc3, 0x250d8, 0x250dc, 0x2534f, 0x255f7, 0x255f4, 0x255f8, 0x25913, 0x25a50, 0x25df0, 0x25de9, 0x25def, 0x25ded, 0x25dea, 0x25df8, 0x25e05, 0x25df2, 0x25f72, 0x4331, 0x261f9, 0x261f3, 0x261f6, 0x261f2, 0x261f7, 0x26266, 0x26265, 0x26340, 0x2f977, 0x268d1, 0x268d3, 0x26a04, 0x27159, 0x27160, 0x2715b, 0x2715d, 0x2715e, 0x27169, 0x2715c, 0x27161, 0x2716a, 0x2715f, 0x2754e, 0x2755c, 0x27560, 0x27563, 0x275db, 0x277ca, 0x277c9, 0x277c8, 0x278a5, 0x278a3, 0x27b8e, 0x27b87, 0x27b89, 0x27b85, 0x27b86, 0x27b8f, 0x27b8a, 0x27b90, 0x27c98, 0x27c9b, 0x27e47, 0x27e49, 0x27e48, 0x281e5, 0x281ea, 0x281ed, 0x283f0, 0x283ef, 0x283f2, 0x283ec, 0x28440, 0x28660, 0x2865f, 0x287eb, 0x287ec, 0x28900, 0x28901, 0x28be2, 0x28bdf, 0x28be0, 0x28be1, 0x28be3, 0x28cc3, 0x28deb, 0x28dea, 0x28f61, 0x28f71, 0x2906b, 0x29068, 0x29069, 0x29067, 0x291b0, 0x291b1,
+		0x291b2, 0x29251, 0x29374, 0x2957f, 0x29584, 0x29653, 0x29654, 0x297f0, 0x297f4, 0x297f1, 0x29837, 0x299bf, 0x4bbc, 0x4bbb, 0x299bd, 0x299be, 0x299b7, 0x299c0, 0x299ba, 0x299b8, 0x299bc, 0x299bb, 0x29aeb, 0x29be7, 0x29c1d, 0x29c1c, 0x29c63, 0x2244b, 0x29d38, 0x29d36, 0x29d34, 0x29d37, 0x29f06, 0x29f1c, 0x29f02, 0x29f1d, 0x29f1e, 0x29ee0, 0x29f11, 0x29f18, 0x29f21, 0x29f20, 0x29f10, 0x2a1bd, 0x2a1ae, 0x2a1b5, 0x2a1b8, 0x2a1b9, 0x2a1be, 0x2a1c4, 0x2a1bc, 0x2a1ba, 0x2a283, 0x2a2ea, 0x2a2ec, 0x2a37e, 0x2a37b, 0x2a3e5, 0x2a4a7, 0x2a49e, 0x2a49a, 0x2a52f, 0x2a531, 0x2a583, 0x2a5b5, 0x2a646, 0x2a641, 0x2a643, 0x2a63d, 0x2a642, 0x2a63b, 0x2a647, 0x2096b, 0x209d0, 0x2118e, 0x21b11, 0x2f871, 0x2f889, 0x2244c, 0x2312b, 0x2384f, 0x2385e, 0x23863, 0x2386b, 0x2393f, 0x23ae9, 0x2416d, 0x2416b, 0x2416a, 0x29f2a, 0x244e0, 0x244dd, 0x244e1, 0x244de,
+		0x244dc, 0x2454d, 0x24716, 0x248e3, 0x24ae5, 0x24ae4, 0x24f1d, 0x2574b, 0x2a6c1, 0x25a4e, 0x25ad6, 0x25dfd, 0x25e07, 0x25dfe, 0x25e03, 0x25e0b, 0x25dff, 0x25f7a, 0x25f77, 0x261fb, 0x26343, 0x263ed, 0x269bc, 0x244df, 0x26a05, 0x26ac6, 0x26ac5, 0x27174, 0x27173, 0x27172, 0x27178, 0x27573, 0x2757a, 0x24f1c, 0x27576, 0x27575, 0x27577, 0x277cf, 0x277ce, 0x277cd, 0x278a8, 0x27b98, 0x27b92, 0x27b94, 0x27c9a, 0x27fb4, 0x281eb, 0x281f4, 0x281f3, 0x281ee, 0x281f2, 0x281f0, 0x28664, 0x28c05, 0x28bfb, 0x28bfc, 0x28df3, 0x2906c, 0x291ba, 0x29379, 0x2937e, 0x29378, 0x293f4, 0x29416, 0x29657, 0x299c8, 0x299c3, 0x299ce, 0x29ab3, 0x29aed, 0x29aee, 0x29bf1, 0x29bf8, 0x4c14, 0x29c64, 0x29c65, 0x29f35, 0x29f29, 0x29f2c, 0x29f31, 0x29f2b, 0x29f2e, 0x29f25, 0x2a1da, 0x2a1e0, 0x2a1d4, 0x2a1e1, 0x2a1dd, 0x2a3e7, 0x2a412, 0x2a4ae, 0x2a4af, 0x2a586, 0x2a585,
+		0x2a5d1, 0x2a658, 0x2a63f, 0x2a652, 0x2a64b, 0x2a653, 0x2a650, 0x2a656, 0x2a657, 0x2a6cd, 0x2a6cb, 0x20831, 0x20830, 0x209d1, 0x361c, 0x21192, 0x21190, 0x2118f, 0x21193, 0x21191, 0x3772, 0x22313, 0x2247f, 0x22eac, 0x24170, 0x244e5, 0x24b23, 0x24b24, 0x24d08, 0x24d07, 0x24f1f, 0x24f20, 0x24f21, 0x250e1, 0x25354, 0x25357, 0x25355, 0x255fa, 0x25738, 0x27186, 0x25737, 0x25a53, 0x25f7e, 0x25f7b, 0x2f971, 0x261fd, 0x261fc, 0x26346, 0x26932, 0x26ac7, 0x27171, 0x27183, 0x2716f, 0x2717e, 0x2717d, 0x27181, 0x2717c, 0x2758a, 0x2757e, 0x27580, 0x2757d, 0x2757f, 0x27582, 0x277d4, 0x277d0, 0x277d2, 0x27955, 0x27b9b, 0x27b9a, 0x27e4e, 0x281f1, 0x283f8, 0x283fd, 0x2865e, 0x28666, 0x28905, 0x28c0a, 0x28c0b, 0x28c0e, 0x28c0d, 0x28c11, 0x28c09, 0x28c10, 0x28c0c, 0x28c12, 0x28cc4, 0x28df7, 0x28df8, 0x28f6a, 0x28f6b, 0x291c0, 0x291c2, 0x29385, 0x29380,
+		0x29384, 0x29381, 0x291c4, 0x2958b, 0x2958d, 0x2958e, 0x29ab7, 0x29ab9, 0x29aef, 0x29bfb, 0x29bff, 0x29c67, 0x29c68, 0x29c69, 0x29d3f, 0x29f3c, 0x29f4c, 0x29f3d, 0x29f3e, 0x29f3f, 0x29f3b, 0x2a1ff, 0x4d0e, 0x2a1f6, 0x2a1ee, 0x2a1fc, 0x2a1f8, 0x2a201, 0x2a1fa, 0x2a288, 0x2a380, 0x2a411, 0x2a4fe, 0x2a500, 0x2a535, 0x2503c, 0x2a5bd, 0x2a5bb, 0x2a65c, 0x2a65a, 0x2a6d1, 0x2a6d0, 0x20a0b, 0x23873, 0x21939, 0x21b1a, 0x21fe3, 0x22187, 0x22377, 0x22378, 0x22eb1, 0x2386a, 0x23874, 0x23aeb, 0x2417b, 0x245a5, 0x248e4, 0x24d0b, 0x24d0e, 0x24d0c, 0x25739, 0x25e10, 0x25e11, 0x25e16, 0x25f81, 0x25f80, 0x261fe, 0x26201, 0x26200, 0x26347, 0x263ef, 0x27188, 0x2758b, 0x2758d, 0x27587, 0x27585, 0x2758f, 0x27584, 0x27588, 0x2758e, 0x2758c, 0x277d7, 0x277d6, 0x27b9f, 0x22188, 0x28203, 0x283fe, 0x287ef, 0x28909, 0x28908, 0x28c20, 0x28c24, 0x28c1f, 0x29070,
+		0x29256, 0x293f7, 0x293f8, 0x293f6, 0x29590, 0x2965e, 0x297f7, 0x299d6, 0x299d5, 0x29abb, 0x29af0, 0x29c01, 0x29c6c, 0x29d41, 0x29d42, 0x29f4f, 0x29f53, 0x29f58, 0x2a212, 0x2a204, 0x2a213, 0x2a205, 0x2a20d, 0x2a382, 0x2a381, 0x2a4b5, 0x2a4ba, 0x2a5be, 0x2a665, 0x2a663, 0x2a66e, 0x2a66a, 0x2a66c, 0x2a6c4, 0x2096c, 0x27ba5, 0x21b12, 0x2244d, 0x2331f, 0x23877, 0x23878, 0x23c44, 0x2417c, 0x244eb, 0x244ea, 0x244ec, 0x245a7, 0x24719, 0x24f23, 0x7674, 0x25601, 0x25e1c, 0x26203, 0x26348, 0x268da, 0x27191, 0x27192, 0x27596, 0x27597, 0x27591, 0x27593, 0x277d8, 0x277d5, 0x27957, 0x27958, 0x27ba2, 0x28205, 0x283fa, 0x2890c, 0x2890a, 0x28c2e, 0x28c29, 0x28c2f, 0x28cc5, 0x29072, 0x291c8, 0x2925a, 0x29259, 0x29389, 0x29388, 0x299db, 0x299d9, 0x299da, 0x29abc, 0x4bec, 0x29c06, 0x29c05, 0x29c6f, 0x29c6d, 0x29c70, 0x29f60, 0x29f5e, 0x29f63, 0x29f5a,
+		0x29f5f, 0x2a219, 0x2a21d, 0x2a21b, 0x2a222, 0x2a21c, 0x2a21e, 0x2a2f7, 0x2a384, 0x2a415, 0x2a4b8, 0x2a4c1, 0x2a501, 0x2a50a, 0x2a5d2, 0x2a675, 0x2a674, 0x2a672, 0x20ad0, 0x21b1b, 0x22010, 0x22379, 0x244ed, 0x24bb9, 0x24f24, 0x24fc5, 0x25603, 0x2634a, 0x268f7, 0x269c1, 0x269c2, 0x27194, 0x27599, 0x2759a, 0x2795a, 0x27ba8, 0x27c25, 0x27c9e, 0x28441, 0x28c32, 0x28c33, 0x291cb, 0x291ca, 0x291cc, 0x29593, 0x299e0, 0x29c71, 0x29f6b, 0x2a22b, 0x2a229, 0x2a21a, 0x2a226, 0x2a227, 0x2a22a, 0x2a2f8, 0x2503d, 0x2a676, 0x21518, 0x2387a, 0x2417f, 0x4279, 0x263f0, 0x2719a, 0x27196, 0x2759e, 0x2759d, 0x275dc, 0x277da, 0x2820b, 0x28402, 0x28442, 0x28667, 0x2938b, 0x299e2, 0x29f71, 0x2a22f, 0x2a22e, 0x2a4c6, 0x2a58e, 0x2a67b, 0x2a677, 0x2a678, 0x2a67c, 0x2387c, 0x26207, 0x27197, 0x27bd9, 0x2890d, 0x2890e, 0x299e3, 0x299e4, 0x29af3, 0x29c75, 0x2a237,
+		0x2a235, 0x2a58f, 0x2a680, 0x2a67f, 0x2a6d4, 0x22eb4, 0x2a3ec, 0x28f75, 0x24d10, 0x26269, 0x275a4, 0x277db, 0x291d1, 0x2938d, 0x29f76, 0x2a239, 0x2a684, 0x2a682, 0x20ad2, 0x244ee, 0x24d11, 0x25f84, 0x28f74, 0x2938f, 0x2938e, 0x2a23b, 0x208cb, 0x2a688, 0x2a686, 0x2335c, 0x253a4, 0x29075, 0x2a68b, 0x21519, 0x27c9f, 0x299e5, 0x2a240, 0x24d12, 0x2a2fb, 0x2a68c, 0x253a5, 0x29c77, 0x29c78, 0x2a6d6, 0x277df, 0x291d2, 0x2151a, 0x27ba9, 0x291d3, 0x2a4ca, 0x269c4, 0x278b1, 0x29664, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+		0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0,
+	},
+}
@@ -0,0 +1,88 @@
+//go:build ignore
+
+// This program generates euctw_tables.go from the EUC-TW converter of glibc iconv.
+//
+// Run it with: go run gen_euctw.go
+package main
+
+/*
+#include <iconv.h>
+#include <stdlib.h>
+
+// convert in to UTF-32LE, return the number of output bytes, -1 if in is not a complete valid character
+static int conv(iconv_t cd, char *in, size_t inLen, char *out, size_t outLen) {
+	size_t outLeft = outLen;
+	iconv(cd, NULL, NULL, NULL, NULL);
+	if (iconv(cd, &in, &inLen, &out, &outLeft) == (size_t)-1 || inLen != 0) {
+		return -1;
+	}
+	return (int)(outLen - outLeft);
+}
+*/
+import "C"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"unsafe"
+)
+
+func main() {
+	to, from := C.CString("UTF-32LE"), C.CString("EUC-TW")
+	defer C.free(unsafe.Pointer(to))
+	defer C.free(unsafe.Pointer(from))
+	cd, err := C.iconv_open(to, from)
+	if err != nil {
+		log.Fatalf("iconv_open: %v", err)
+	}
+	defer C.iconv_close(cd)
+
+	in := (*[4]byte)(C.malloc(4))
+	out := (*[16]byte)(C.malloc(16))
+	defer C.free(unsafe.Pointer(in))
+	defer C.free(unsafe.Pointer(out))
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by running \"go run gen_euctw.go\". DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package easychars")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// cnsPlanes maps CNS 11643 planes 1-7 to Unicode, indexed by plane-1 and (row-0xA1)*94+(column-0xA1), where row and column are the EUC-TW bytes.")
+	fmt.Fprintln(buf, "// 0 means the code point is not assigned.")
+	fmt.Fprintf(buf, "var cnsPlanes = [%d][94 * 94]rune{\n", cnsPlaneCount)
+	total := 0
+	for plane := 1; plane <= cnsPlaneCount; plane++ {
+		fmt.Fprintf(buf, "\t{ // plane %d\n", plane)
+		for row := 0; row < 94; row++ {
+			fmt.Fprint(buf, "\t\t")
+			for col := 0; col < 94; col++ {
+				*in = [4]byte{0x8E, byte(0xA0 + plane), byte(0xA1 + row), byte(0xA1 + col)}
+				n := C.conv(cd, (*C.char)(unsafe.Pointer(in)), 4, (*C.char)(unsafe.Pointer(out)), 16)
+				r := uint32(0)
+				if n == 4 {
+					r = binary.LittleEndian.Uint32(out[:4])
+					total++
+				}
+				fmt.Fprintf(buf, "%#x,", r)
+			}
+			fmt.Fprintln(buf)
+		}
+		fmt.Fprintln(buf, "\t},")
+	}
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format: %v", err)
+	}
+	if err := os.WriteFile("euctw_tables.go", src, 0644); err != nil {
+		log.Fatalf("write: %v", err)
+	}
+	log.Printf("%d code points", total)
+}
+
+const cnsPlaneCount = 7
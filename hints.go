@@ -0,0 +1,153 @@
+package easychars
+
+import (
+	"sort"
+	"strings"
+)
+
+// Confidence bonuses of the hints in DetectOptions, the reweighted Confidence is no more than 100.
+const (
+	// bonus of a charset listed in DetectOptions.Preferred
+	preferredBonus = 20
+	// bonus of a charset commonly used for the language of DetectOptions.Locale
+	localeBonus = 15
+	// bonus of the browser default charset of DetectOptions.TLD
+	tldBonus = 10
+)
+
+// localeCharsets are the legacy charsets commonly used for a language, keyed by lowercase language tag or primary language subtag.
+var localeCharsets = map[string][]string{
+	// Cyrillic
+	"ru": {"windows-1251", "KOI8-R", "ISO-8859-5", "IBM866", "x-mac-cyrillic"},
+	"uk": {"windows-1251", "KOI8-U", "ISO-8859-5", "IBM866"},
+	"be": {"windows-1251", "ISO-8859-5", "IBM866"},
+	"bg": {"windows-1251", "ISO-8859-5"},
+	"sr": {"windows-1251", "ISO-8859-5"},
+	"mk": {"windows-1251", "ISO-8859-5"},
+	// CJK
+	"ja":    {"Shift_JIS", "EUC-JP", "ISO-2022-JP"},
+	"zh":    {"GB18030", "GBK"},
+	"zh-cn": {"GB18030", "GBK"},
+	"zh-sg": {"GB18030", "GBK"},
+	"zh-tw": {"Big5"},
+	"zh-hk": {"Big5"},
+	"zh-mo": {"Big5"},
+	"ko":    {"EUC-KR", "ISO-2022-KR"},
+	// others
+	"el": {"ISO-8859-7", "windows-1253"},
+	"tr": {"ISO-8859-9", "windows-1254"},
+	"he": {"ISO-8859-8", "ISO-8859-8-I", "windows-1255"},
+	"ar": {"windows-1256", "ISO-8859-6"},
+	"fa": {"windows-1256"},
+	"th": {"windows-874"},
+	"vi": {"windows-1258"},
+	"cs": {"ISO-8859-2", "windows-1250"},
+	"hu": {"ISO-8859-2", "windows-1250"},
+	"pl": {"ISO-8859-2", "windows-1250"},
+	"ro": {"ISO-8859-2", "windows-1250"},
+	"sk": {"ISO-8859-2", "windows-1250"},
+	"sl": {"ISO-8859-2", "windows-1250"},
+	"hr": {"ISO-8859-2", "windows-1250"},
+	"lt": {"windows-1257", "ISO-8859-13"},
+	"lv": {"windows-1257", "ISO-8859-13"},
+	"et": {"windows-1257", "ISO-8859-13"},
+}
+
+// tldCharsets are the default charsets browsers use for documents without declaration, keyed by top-level domain.
+// They are modeled on the per-TLD fallback of Firefox.
+var tldCharsets = map[string]string{
+	"ru": "windows-1251", "su": "windows-1251", "ua": "windows-1251", "by": "windows-1251",
+	"bg": "windows-1251", "kz": "windows-1251", "rs": "windows-1251", "mk": "windows-1251",
+	"jp": "Shift_JIS",
+	"cn": "GB18030",
+	"tw": "Big5", "hk": "Big5", "mo": "Big5",
+	"kr": "EUC-KR",
+	"gr": "ISO-8859-7",
+	"tr": "windows-1254",
+	"il": "windows-1255",
+	"sa": "windows-1256", "ae": "windows-1256", "eg": "windows-1256", "ir": "windows-1256",
+	"iq": "windows-1256", "jo": "windows-1256", "kw": "windows-1256", "sy": "windows-1256",
+	"th": "windows-874",
+	"vn": "windows-1258",
+	"cz": "windows-1250", "hu": "windows-1250", "pl": "windows-1250", "sk": "windows-1250",
+	"si": "windows-1250", "hr": "windows-1250", "ro": "windows-1250",
+	"lt": "windows-1257", "lv": "windows-1257", "ee": "windows-1257",
+}
+
+// reweight raises the Confidence of results by the hints in opts, and sorts them by Confidence in descending order again.
+//
+// A Result gets preferredBonus if its charset is in opts.Preferred, localeBonus if it's commonly used
+// for the language of opts.Locale, and tldBonus if it's the browser default of opts.TLD.
+// The bonuses add up, and Confidence is no more than 100.
+func reweight(results []*Result, opts *DetectOptions) []*Result {
+	locale := localeHint(opts.Locale)
+	tld, hasTLD := tldCharsets[tldHint(opts.TLD)]
+	if len(opts.Preferred) == 0 && len(locale) == 0 && !hasTLD {
+		return results
+	}
+	for _, res := range results {
+		bonus := 0
+		if containsCharset(opts.Preferred, res.Charset) {
+			bonus += preferredBonus
+		}
+		if containsCharset(locale, res.Charset) {
+			bonus += localeBonus
+		}
+		if hasTLD && SameCharset(tld, res.Charset) {
+			bonus += tldBonus
+		}
+		res.Confidence += bonus
+		if res.Confidence > 100 {
+			res.Confidence = 100
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Confidence > results[j].Confidence })
+	return results
+}
+
+// filterAllowed returns the Results whose charset is in allowed, Results from BOM are always kept.
+func filterAllowed(results []*Result, allowed []string) []*Result {
+	filtered := results[:0]
+	for _, res := range results {
+		if res.Source == SourceBOM || containsCharset(allowed, res.Charset) {
+			filtered = append(filtered, res)
+		}
+	}
+	return filtered
+}
+
+// localeHint returns the charsets of a locale such as "ru-RU", "zh_TW" or "ja_JP.UTF-8", nil if the language is unknown.
+func localeHint(locale string) []string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	locale = strings.ReplaceAll(locale, "_", "-")
+	if charsets, ok := localeCharsets[locale]; ok {
+		return charsets
+	}
+	if i := strings.IndexByte(locale, '-'); i >= 0 {
+		return localeCharsets[locale[:i]]
+	}
+	return nil
+}
+
+// tldHint returns the top-level domain of a TLD or host name such as ".ru" or "www.example.co.jp".
+func tldHint(tld string) string {
+	tld = strings.ToLower(strings.TrimSpace(tld))
+	tld = strings.TrimSuffix(tld, ".")
+	if i := strings.LastIndexByte(tld, '.'); i >= 0 {
+		tld = tld[i+1:]
+	}
+	return tld
+}
+
+// Check whether charsets contains a name of charset
+func containsCharset(charsets []string, charset string) bool {
+	for _, c := range charsets {
+		if SameCharset(c, charset) {
+			return true
+		}
+	}
+	return false
+}
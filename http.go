@@ -0,0 +1,64 @@
+package easychars
+
+import (
+	"io"
+	"mime"
+	"net/http"
+)
+
+// DecodeHTTPResponse returns a reader which converts the body of resp to UTF-8, see DecodeWithContentType.
+//
+// The caller is still responsible for closing resp.Body.
+func DecodeHTTPResponse(resp *http.Response) (io.Reader, *Result, error) {
+	return DecodeWithContentType(resp.Body, resp.Header.Get("Content-Type"))
+}
+
+// DecodeWithContentType returns a reader which converts r to UTF-8, with contentType as the value of its Content-Type header.
+//
+// The charset is decided in the order of WHATWG encoding sniffing, reference: https://html.spec.whatwg.org/multipage/parsing.html#encoding-sniffing-algorithm
+//
+//  1. a byte order mark at the beginning of r
+//  2. the charset parameter of contentType, if it's a valid charset name
+//  3. the charset declared by XML declaration or HTML <meta> in the head of r
+//  4. DetectEncoding
+//
+// The Result tells the Source of the charset.
+func DecodeWithContentType(r io.Reader, contentType string) (io.Reader, *Result, error) {
+	sample, content, err := readSample(r, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	res := detectBOM(sample)
+	if res == nil {
+		res = detectContentType(contentType)
+	}
+	if res == nil {
+		res, err = DetectEncodingWithOptions(content, &DetectOptions{Declaration: DeclarationOverride})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return newUtf8Reader(r, sample, res), res, nil
+}
+
+// detectContentType returns the Result of the charset parameter in contentType, such as "text/html; charset=gbk",
+// nil if there is no valid charset parameter.
+func detectContentType(contentType string) *Result {
+	label := ""
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		label = params["charset"]
+	} else {
+		// be tolerant of malformed headers, as browsers are
+		label, _ = extractCharsetFromContent(contentType)
+	}
+	if label == "" {
+		return nil
+	}
+	e, err := GetEncodingFromCharsetName(label)
+	if err != nil {
+		return nil
+	}
+	result := newResult(preferredName(e, label), "", 100)
+	result.Source = SourceContentType
+	return result
+}
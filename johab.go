@@ -0,0 +1,344 @@
+package easychars
+
+import (
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/transform"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Johab is the Korean encoding of KS C 5601-1992 annex 3, used by old DOS and early Windows programs.
+//
+// A Hangul code is 1 bit set, then 5 bits each of initial, medial and final jamo, so all 11172 composed syllables
+// are encoded: 0x84-0xD3 0x41-0x7E, 0x81-0xFE.
+// The symbols and Hanja of KS X 1001 are encoded two rows per lead byte: 0xD9-0xDE, 0xE0-0xF9 0x31-0x7E, 0x91-0xFE.
+//
+// Reference: https://en.wikipedia.org/wiki/KS_X_1001#Johab
+var Johab encoding.Encoding = johab{}
+
+type johab struct{}
+
+func (johab) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: johabDecoder{}}
+}
+
+func (johab) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: johabEncoder{}}
+}
+
+func (johab) String() string {
+	return "Johab"
+}
+
+// Johab jamo index of 5 bits code, -1 if the code is not used. Index 0 is fill (no jamo), then jamo in Unicode order.
+var (
+	johabInitials = [32]int8{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1}
+	johabMedials  = [32]int8{-1, -1, 0, 1, 2, 3, 4, 5, -1, -1, 6, 7, 8, 9, 10, 11, -1, -1, 12, 13, 14, 15, 16, 17, -1, -1, 18, 19, 20, 21, -1, -1}
+	johabFinals   = [32]int8{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, -1, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, -1, -1}
+)
+
+// Hangul Compatibility Jamo of a single initial or final consonant, indexed by jamo index - 1
+var (
+	johabInitialJamo = [19]rune{
+		0x3131, 0x3132, 0x3134, 0x3137, 0x3138, 0x3139, 0x3141, 0x3142, 0x3143, 0x3145,
+		0x3146, 0x3147, 0x3148, 0x3149, 0x314A, 0x314B, 0x314C, 0x314D, 0x314E,
+	}
+	johabFinalJamo = [27]rune{
+		0x3131, 0x3132, 0x3133, 0x3134, 0x3135, 0x3136, 0x3137, 0x3139, 0x313A, 0x313B,
+		0x313C, 0x313D, 0x313E, 0x313F, 0x3140, 0x3141, 0x3142, 0x3144, 0x3145, 0x3146,
+		0x3147, 0x3148, 0x314A, 0x314B, 0x314C, 0x314D, 0x314E,
+	}
+)
+
+// commonHangul are the most frequently used Hangul syllables in Korean text, about half of the syllables in a document.
+const commonHangul = "이다는의에하고을가지한서로기리사어니도를자나있수시들대해으일인정게아라것적그여만주보요원부전국상과습우장면제"
+
+// Guess whether content is encoded by Johab
+//
+// Most 2 bytes codes of EUC-KR, CP949 and Big5 are also valid in Johab, but they decode to Hangul syllables of rare jamo
+// combinations, or to no character at all, and EUC-KR never uses the trail bytes 0x41-0xA0 for Hangul.
+// So content must be valid under Johab rule, except a lead byte cut at the end, but not UTF-8,
+// almost all of its codes must be assigned, at least a fifth of the Hangul codes must have a trail byte below 0xA1,
+// and at least a fifth of the Hangul syllables must be commonly used ones.
+//
+// return: the Result of Johab, nil if content doesn't look like Johab
+func probeJohab(content []byte) *Result {
+	content, ok := validSample(isValidJohab, content)
+	if !ok || IsValidUTF8(content) {
+		return nil
+	}
+	var codes, assigned, hangul, lowTrail, syllables, common int
+	for i := 0; i < len(content); i++ {
+		c0 := content[i]
+		if c0 < utf8.RuneSelf {
+			continue
+		}
+		c1 := content[i+1]
+		codes++
+		i++
+		if c0 > 0xD3 {
+			if row, col, ok := johabToKSX1001(c0, c1); ok && ksxRune(row, col) != utf8.RuneError {
+				assigned++
+			}
+			continue
+		}
+		hangul++
+		if c1 < 0xA1 {
+			lowTrail++
+		}
+		r := johabHangul(uint16(c0)<<8 | uint16(c1))
+		if r == utf8.RuneError {
+			continue
+		}
+		assigned++
+		if r >= 0xAC00 && r <= 0xD7A3 {
+			syllables++
+			if strings.ContainsRune(commonHangul, r) {
+				common++
+			}
+		}
+	}
+	if codes < 10 || assigned*100 < codes*98 || lowTrail*5 < hangul || common*5 < syllables {
+		return nil
+	}
+	return newResult("Johab", "ko", 100*assigned/codes)
+}
+
+// johabHangul returns the rune of Johab Hangul code, utf8.RuneError if the combination of jamo is not valid.
+//
+// A code with all jamo is a composed syllable, a code with only one jamo is a compatibility jamo,
+// and a code with none is U+3000 ideographic space.
+func johabHangul(code uint16) rune {
+	l := johabInitials[code>>10&0x1F]
+	v := johabMedials[code>>5&0x1F]
+	t := johabFinals[code&0x1F]
+	switch {
+	case l < 0 || v < 0 || t < 0:
+		return utf8.RuneError
+	case l > 0 && v > 0:
+		return 0xAC00 + rune((int(l-1)*21+int(v-1))*28+int(t))
+	case l > 0 && v == 0 && t == 0:
+		return johabInitialJamo[l-1]
+	case l == 0 && v > 0 && t == 0:
+		return 0x314F + rune(v-1)
+	case l == 0 && v == 0 && t > 0:
+		return johabFinalJamo[t-1]
+	case l == 0 && v == 0 && t == 0:
+		return 0x3000
+	}
+	return utf8.RuneError
+}
+
+// johabToKSX1001 returns the KS X 1001 row and column (0x21-0x7E) of a Johab symbol or Hanja code, false if it's not one.
+func johabToKSX1001(c0, c1 byte) (row, col byte, ok bool) {
+	switch {
+	case c0 >= 0xD9 && c0 <= 0xDE:
+		row = 0x21 + (c0-0xD9)*2
+	case c0 >= 0xE0 && c0 <= 0xF9:
+		row = 0x4A + (c0-0xE0)*2
+	default:
+		return 0, 0, false
+	}
+	switch {
+	case c1 >= 0x31 && c1 <= 0x7E:
+		col = c1 - 0x31 + 0x21
+	case c1 >= 0x91 && c1 <= 0xA0:
+		col = c1 - 0x91 + 0x6F
+	case c1 >= 0xA1 && c1 <= 0xFE:
+		row, col = row+1, c1-0x80
+	default:
+		return 0, 0, false
+	}
+	// Hangul Compatibility Jamo in row 0x24 are encoded in the Hangul area
+	if row == 0x24 && col <= 0x53 {
+		return 0, 0, false
+	}
+	return row, col, true
+}
+
+// ksx1001ToJohab is the reverse of johabToKSX1001.
+func ksx1001ToJohab(row, col byte) (c0, c1 byte, ok bool) {
+	var base byte // the first row of the area
+	switch {
+	case row >= 0x21 && row <= 0x2C:
+		base, c0 = 0x21, 0xD9
+	case row >= 0x4A && row <= 0x7D:
+		base, c0 = 0x4A, 0xE0
+	default:
+		return 0, 0, false
+	}
+	if row == 0x24 && col <= 0x53 {
+		return 0, 0, false
+	}
+	c0 += (row - base) / 2
+	switch {
+	case (row-base)%2 == 1:
+		c1 = col + 0x80
+	case col <= 0x6E:
+		c1 = col - 0x21 + 0x31
+	default:
+		c1 = col - 0x6F + 0x91
+	}
+	return c0, c1, true
+}
+
+type johabDecoder struct {
+	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
+	transform.NopResetter
+}
+
+func (johabDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	r, size := rune(0), 0
+loop:
+	for ; nSrc < len(src); nSrc += size {
+		c0 := src[nSrc]
+		if c0 < utf8.RuneSelf {
+			r, size = rune(c0), 1
+		} else if c0 < 0x84 || c0 > 0xF9 || c0 >= 0xD4 && c0 <= 0xD8 || c0 == 0xDF {
+			r, size = utf8.RuneError, 1
+		} else if nSrc+2 > len(src) {
+			if !atEOF {
+				err = transform.ErrShortSrc
+				break loop
+			}
+			r, size = utf8.RuneError, 1
+		} else if c1 := src[nSrc+1]; c0 <= 0xD3 {
+			if c1 < 0x41 || c1 == 0x7F || c1 == 0x80 || c1 == 0xFF {
+				r, size = utf8.RuneError, 1
+			} else {
+				r, size = johabHangul(uint16(c0)<<8|uint16(c1)), 2
+			}
+		} else if row, col, ok := johabToKSX1001(c0, c1); ok {
+			r, size = ksxRune(row, col), 2
+		} else {
+			r, size = utf8.RuneError, 1
+		}
+
+		if nDst+utf8.RuneLen(r) > len(dst) {
+			err = transform.ErrShortDst
+			break loop
+		}
+		nDst += utf8.EncodeRune(dst[nDst:], r)
+	}
+	return
+}
+
+var (
+	ksxOnce sync.Once
+	// ksxTable maps KS X 1001 to Unicode, indexed by (row-0x21)*94+(column-0x21), 0 means the code point is not assigned
+	ksxTable []rune
+	// ksxReverse maps a rune to row<<8 | column of KS X 1001
+	ksxReverse map[rune]uint16
+)
+
+// buildKSXTables builds ksxTable and ksxReverse from the EUC-KR decoder of golang.org/x/text, whose 0xA1-0xFE 0xA1-0xFE codes are KS X 1001.
+func buildKSXTables() {
+	ksxTable = make([]rune, 94*94)
+	ksxReverse = make(map[rune]uint16, 8*1024)
+	decoder := korean.EUCKR.NewDecoder()
+	var buf [utf8.UTFMax]byte
+	for i := range ksxTable {
+		row, col := byte(0x21+i/94), byte(0x21+i%94)
+		decoder.Reset()
+		n, _, err := decoder.Transform(buf[:], []byte{row | 0x80, col | 0x80}, true)
+		if r, _ := utf8.DecodeRune(buf[:n]); err == nil && r != utf8.RuneError {
+			ksxTable[i] = r
+			ksxReverse[r] = uint16(row)<<8 | uint16(col)
+		}
+	}
+}
+
+// ksxRune returns the rune of KS X 1001 at row and column, utf8.RuneError if it's not assigned.
+func ksxRune(row, col byte) rune {
+	ksxOnce.Do(buildKSXTables)
+	if r := ksxTable[int(row-0x21)*94+int(col-0x21)]; r != 0 {
+		return r
+	}
+	return utf8.RuneError
+}
+
+type johabEncoder struct {
+	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
+	transform.NopResetter
+}
+
+func (johabEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	r, size := rune(0), 0
+	for ; nSrc < len(src); nSrc += size {
+		r = rune(src[nSrc])
+		if r < utf8.RuneSelf {
+			size = 1
+			if nDst >= len(dst) {
+				err = transform.ErrShortDst
+				break
+			}
+			dst[nDst] = byte(r)
+			nDst++
+			continue
+		}
+
+		r, size = utf8.DecodeRune(src[nSrc:])
+		if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+			err = transform.ErrShortSrc
+			break
+		}
+		c0, c1, ok := encodeJohab(r)
+		if !ok {
+			err = errUnsupportedRune
+			break
+		}
+		if nDst+2 > len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		dst[nDst], dst[nDst+1] = c0, c1
+		nDst += 2
+	}
+	return
+}
+
+// encodeJohab returns the Johab code of r, false if r is not in Johab.
+func encodeJohab(r rune) (c0, c1 byte, ok bool) {
+	var code uint16
+	switch {
+	case r >= 0xAC00 && r <= 0xD7A3:
+		s := int(r - 0xAC00)
+		code = 0x8000 | johabCode(&johabInitials, s/(21*28)+1)<<10 | johabCode(&johabMedials, s/28%21+1)<<5 | johabCode(&johabFinals, s%28)
+	case r >= 0x3131 && r <= 0x314E:
+		for i, j := range johabInitialJamo {
+			if j == r {
+				code = 0x8000 | johabCode(&johabInitials, i+1)<<10 | 2<<5 | 1
+			}
+		}
+		for i, j := range johabFinalJamo {
+			if code == 0 && j == r {
+				code = 0x8000 | 1<<10 | 2<<5 | johabCode(&johabFinals, i+1)
+			}
+		}
+	case r >= 0x314F && r <= 0x3163:
+		code = 0x8000 | 1<<10 | johabCode(&johabMedials, int(r-0x314F)+1)<<5 | 1
+	}
+	if code != 0 {
+		return byte(code >> 8), byte(code), true
+	}
+
+	// symbols and Hanja of KS X 1001
+	ksxOnce.Do(buildKSXTables)
+	ksx, ok := ksxReverse[r]
+	if !ok {
+		return 0, 0, false
+	}
+	return ksx1001ToJohab(byte(ksx>>8), byte(ksx))
+}
+
+// johabCode returns the 5 bits code of jamo index in table.
+func johabCode(table *[32]int8, index int) uint16 {
+	for code, i := range table {
+		if int(i) == index {
+			return uint16(code)
+		}
+	}
+	return 0
+}
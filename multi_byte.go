@@ -1,12 +1,16 @@
 package easychars
 
+import "bytes"
+
 // Check whether content is valid under GBK rule, referce: https://zh.wikipedia.org/wiki/GBK
 func isValidGBK(content []byte) bool {
 	nByte := 1 // the number of bytes that current character use, max 2 bytes in GBK
-	for _, b := range content {
+	for i := 0; i < len(content); i++ {
+		b := content[i]
 		switch nByte {
 		case 1:
-			if b <= 0x7F { // character is ascii
+			if b <= 0x7F { // character is ascii, skip the run of them
+				i += asciiPrefixLen(content[i:]) - 1
 				continue
 			}
 			if b >= 0x81 && b <= 0xFE { // may be a GBK encoded character, depending on second byte
@@ -27,10 +31,12 @@ func isValidGBK(content []byte) bool {
 // Check whether content is valid under GB18030 rule, referce: https://zh.wikipedia.org/wiki/GB_18030
 func isValidGB18030(content []byte) bool {
 	nByte := 1 // the number of bytes that current character use, max 4 bytes in GB18030
-	for _, b := range content {
+	for i := 0; i < len(content); i++ {
+		b := content[i]
 		switch nByte {
 		case 1:
-			if b <= 0x7F { // character is ascii
+			if b <= 0x7F { // character is ascii, skip the run of them
+				i += asciiPrefixLen(content[i:]) - 1
 				continue
 			}
 			if b >= 0x81 && b <= 0xFE { // may be a GB18030 encoded character, depending on second byte
@@ -71,10 +77,12 @@ func isValidGB18030(content []byte) bool {
 // Check whether content is valid under Big5 rule, referce: https://zh.wikipedia.org/wiki/Big5
 func isValidBig5(content []byte) bool {
 	nByte := 1 // Big5 use ascii && 2 byte encoded character
-	for _, b := range content {
+	for i := 0; i < len(content); i++ {
+		b := content[i]
 		switch nByte {
 		case 1:
-			if b <= 0x7F {
+			if b <= 0x7F { // skip the run of ascii
+				i += asciiPrefixLen(content[i:]) - 1
 				continue
 			}
 			if b >= 0x81 && b <= 0xFE {
@@ -91,3 +99,289 @@ func isValidBig5(content []byte) bool {
 	}
 	return nByte == 1
 }
+
+// Check whether content is valid under EUC-TW rule, referce: https://en.wikipedia.org/wiki/Extended_Unix_Code#EUC-TW
+func isValidEUCTW(content []byte) bool {
+	nByte := 1 // EUC-TW use ascii, 2 byte encoded character of CNS 11643 plane 1 and 4 byte encoded character starting with SS2 0x8E
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0xA1 && b <= 0xFE { // 2 byte encoded character
+				nByte = 2
+			} else if b == 0x8E { // 4 byte encoded character, depending on the plane byte
+				nByte = 4
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b < 0xA1 || b > 0xFE {
+				return false
+			}
+		case 4:
+			if b < 0xA1 || b > 0xB0 { // plane 1 - 16
+				return false
+			}
+			nByte = 3
+		case 3: // the last 2 bytes of 4 byte encoded character are same as 2 byte encoded character
+			if b < 0xA1 || b > 0xFE {
+				return false
+			}
+			nByte = 2
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under Johab rule, referce: https://en.wikipedia.org/wiki/KS_X_1001#Johab
+func isValidJohab(content []byte) bool {
+	nByte := 1      // Johab use ascii && 2 byte encoded character
+	hangul := false // whether current 2 byte encoded character is in Hangul area
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0x84 && b <= 0xD3 { // Hangul
+				nByte, hangul = 2, true
+			} else if b >= 0xD8 && b <= 0xDE || b >= 0xE0 && b <= 0xF9 { // symbols, user-defined characters and Hanja
+				nByte, hangul = 2, false
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if hangul && !(b >= 0x41 && b <= 0x7E || b >= 0x81 && b <= 0xFE) {
+				return false
+			}
+			if !hangul && !(b >= 0x31 && b <= 0x7E || b >= 0x91 && b <= 0xFE) {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under Shift_JIS rule, referce: https://en.wikipedia.org/wiki/Shift_JIS
+func isValidShiftJIS(content []byte) bool {
+	nByte := 1 // Shift_JIS use ascii, 1 byte half-width katakana && 2 byte encoded character
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F || b >= 0xA1 && b <= 0xDF { // ascii or half-width katakana
+				continue
+			}
+			if b >= 0x81 && b <= 0x9F || b >= 0xE0 && b <= 0xEF { // JIS X 0208, depending on second byte
+				nByte = 2
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b < 0x40 || b > 0xFC || b == 0x7F {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under CP932 (Windows-31J) rule, referce: https://en.wikipedia.org/wiki/Code_page_932_(Microsoft_Windows)
+func isValidCP932(content []byte) bool {
+	nByte := 1 // CP932 is Shift_JIS with NEC, IBM extensions and user-defined characters in lead byte 0xF0 - 0xFC
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F || b >= 0xA1 && b <= 0xDF {
+				continue
+			}
+			if b >= 0x81 && b <= 0x9F || b >= 0xE0 && b <= 0xFC {
+				nByte = 2
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b < 0x40 || b > 0xFC || b == 0x7F {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under EUC-JP rule, referce: https://en.wikipedia.org/wiki/Extended_Unix_Code#EUC-JP
+func isValidEUCJP(content []byte) bool {
+	nByte := 1    // EUC-JP use ascii, 2 byte JIS X 0208, 2 byte half-width katakana starting with SS2 0x8E and 3 byte JIS X 0212 starting with SS3 0x8F
+	kana := false // whether current character is half-width katakana
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0xA1 && b <= 0xFE { // JIS X 0208
+				nByte, kana = 2, false
+			} else if b == 0x8E { // half-width katakana
+				nByte, kana = 2, true
+			} else if b == 0x8F { // JIS X 0212
+				nByte = 3
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if kana && (b < 0xA1 || b > 0xDF) || b < 0xA1 || b > 0xFE {
+				return false
+			}
+		case 3:
+			if b < 0xA1 || b > 0xFE {
+				return false
+			}
+			nByte, kana = 2, false
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under EUC-KR rule, referce: https://en.wikipedia.org/wiki/Extended_Unix_Code#EUC-KR
+func isValidEUCKR(content []byte) bool {
+	nByte := 1 // EUC-KR use ascii && 2 byte encoded character of KS X 1001
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0xA1 && b <= 0xFE {
+				nByte = 2
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b < 0xA1 || b > 0xFE {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under CP949 (Unified Hangul Code) rule, referce: https://en.wikipedia.org/wiki/Unified_Hangul_Code
+func isValidCP949(content []byte) bool {
+	nByte := 1        // CP949 is EUC-KR with the other 8822 Hangul syllables in lead byte 0x81 - 0xC6
+	extended := false // whether the lead byte may start an extended Hangul syllable
+	for _, b := range content {
+		switch nByte {
+		case 1:
+			if b <= 0x7F {
+				continue
+			}
+			if b >= 0x81 && b <= 0xFE {
+				nByte, extended = 2, b <= 0xC6
+			} else {
+				return false
+			}
+		case 2:
+			nByte = 1
+			if b >= 0xA1 && b <= 0xFE {
+				continue
+			}
+			if !extended || !(b >= 0x41 && b <= 0x5A || b >= 0x61 && b <= 0x7A || b >= 0x81 && b <= 0xA0) {
+				return false
+			}
+		}
+	}
+	return nByte == 1
+}
+
+// Check whether content is valid under ISO-2022-JP rule, referce: https://www.rfc-editor.org/rfc/rfc1468
+//
+// Besides the escape sequences of RFC 1468, ESC ( I for half-width katakana and ESC $ ( D for JIS X 0212 are accepted,
+// same as the decoder of golang.org/x/text. A line feed ends a 2 byte character set.
+func isValidISO2022JP(content []byte) bool {
+	const (
+		ascii    = iota // ASCII or JIS X 0201 Roman, 1 byte
+		katakana        // JIS X 0201 Katakana, 1 byte 0x21 - 0x5F
+		kanji           // JIS X 0208 or JIS X 0212, 2 byte 0x21 - 0x7E
+	)
+	state := ascii
+	for i := 0; i < len(content); i++ {
+		b := content[i]
+		if b >= 0x80 { // ISO-2022-JP is 7 bit
+			return false
+		}
+		if b == 0x1B { // escape sequence
+			switch rest := content[i+1:]; {
+			case bytes.HasPrefix(rest, []byte("(B")), bytes.HasPrefix(rest, []byte("(J")):
+				state, i = ascii, i+2
+			case bytes.HasPrefix(rest, []byte("(I")):
+				state, i = katakana, i+2
+			case bytes.HasPrefix(rest, []byte("$@")), bytes.HasPrefix(rest, []byte("$B")):
+				state, i = kanji, i+2
+			case bytes.HasPrefix(rest, []byte("$(D")):
+				state, i = kanji, i+3
+			default:
+				return false
+			}
+			continue
+		}
+		switch state {
+		case katakana:
+			if b == '\n' {
+				state = ascii
+			} else if b < 0x21 || b > 0x5F {
+				return false
+			}
+		case kanji:
+			if b == '\n' {
+				state = ascii
+				continue
+			}
+			if i+1 >= len(content) || b < 0x21 || b > 0x7E || content[i+1] < 0x21 || content[i+1] > 0x7E {
+				return false
+			}
+			i++
+		}
+	}
+	return true
+}
+
+// Check whether content is valid under ISO-2022-KR rule, referce: https://www.rfc-editor.org/rfc/rfc1557
+//
+// KS X 1001 must be designated to G1 by ESC $ ) C before the first SO, then SO shifts to 2 byte KS X 1001 characters
+// and SI shifts back to ASCII. A space or line break may appear between 2 byte characters.
+func isValidISO2022KR(content []byte) bool {
+	designated := false // whether ESC $ ) C has appeared
+	shifted := false    // whether in SO state, in which 2 byte characters are KS X 1001
+	for i := 0; i < len(content); i++ {
+		b := content[i]
+		switch {
+		case b >= 0x80: // ISO-2022-KR is 7 bit
+			return false
+		case b == 0x1B:
+			if !bytes.HasPrefix(content[i+1:], []byte("$)C")) {
+				return false
+			}
+			designated, i = true, i+3
+		case b == 0x0E: // SO
+			if !designated {
+				return false
+			}
+			shifted = true
+		case b == 0x0F: // SI
+			shifted = false
+		case shifted && b > 0x20:
+			if i+1 >= len(content) || b > 0x7E || content[i+1] < 0x21 || content[i+1] > 0x7E {
+				return false
+			}
+			i++
+		}
+	}
+	return true
+}
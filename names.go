@@ -0,0 +1,156 @@
+package easychars
+
+import (
+	"sort"
+	"strings"
+)
+
+// whatwgLabels are the labels of the WHATWG Encoding Standard, one encoding per line,
+// reference: https://encoding.spec.whatwg.org/#names-and-labels
+const whatwgLabels = `
+unicode-1-1-utf-8 unicode11utf8 unicode20utf8 utf-8 utf8 x-unicode20utf8
+866 cp866 csibm866 ibm866
+csisolatin2 iso-8859-2 iso-ir-101 iso8859-2 iso88592 iso_8859-2 iso_8859-2:1987 l2 latin2
+csisolatin3 iso-8859-3 iso-ir-109 iso8859-3 iso88593 iso_8859-3 iso_8859-3:1988 l3 latin3
+csisolatin4 iso-8859-4 iso-ir-110 iso8859-4 iso88594 iso_8859-4 iso_8859-4:1988 l4 latin4
+csisolatincyrillic cyrillic iso-8859-5 iso-ir-144 iso8859-5 iso88595 iso_8859-5 iso_8859-5:1988
+arabic asmo-708 csiso88596e csiso88596i csisolatinarabic ecma-114 iso-8859-6 iso-8859-6-e iso-8859-6-i iso-ir-127 iso8859-6 iso88596 iso_8859-6 iso_8859-6:1987
+csisolatingreek ecma-118 elot_928 greek greek8 iso-8859-7 iso-ir-126 iso8859-7 iso88597 iso_8859-7 iso_8859-7:1987 sun_eu_greek
+csiso88598e csisolatinhebrew hebrew iso-8859-8 iso-8859-8-e iso-ir-138 iso8859-8 iso88598 iso_8859-8 iso_8859-8:1988 visual
+csiso88598i iso-8859-8-i logical
+csisolatin6 iso-8859-10 iso-ir-157 iso8859-10 iso885910 l6 latin6
+iso-8859-13 iso8859-13 iso885913
+iso-8859-14 iso8859-14 iso885914
+csisolatin9 iso-8859-15 iso8859-15 iso885915 iso_8859-15 l9
+iso-8859-16
+cskoi8r koi koi8 koi8-r koi8_r
+koi8-ru koi8-u
+csmacintosh mac macintosh x-mac-roman
+dos-874 iso-8859-11 iso8859-11 iso885911 tis-620 windows-874
+cp1250 windows-1250 x-cp1250
+cp1251 windows-1251 x-cp1251
+ansi_x3.4-1968 ascii cp1252 cp819 csisolatin1 ibm819 iso-8859-1 iso-ir-100 iso8859-1 iso88591 iso_8859-1 iso_8859-1:1987 l1 latin1 us-ascii windows-1252 x-cp1252
+cp1253 windows-1253 x-cp1253
+cp1254 csisolatin5 iso-8859-9 iso-ir-148 iso8859-9 iso88599 iso_8859-9 iso_8859-9:1989 l5 latin5 windows-1254 x-cp1254
+cp1255 windows-1255 x-cp1255
+cp1256 windows-1256 x-cp1256
+cp1257 windows-1257 x-cp1257
+cp1258 windows-1258 x-cp1258
+x-mac-cyrillic x-mac-ukrainian
+chinese csgb2312 csiso58gb231280 gb2312 gb_2312 gb_2312-80 gbk iso-ir-58 x-gbk
+gb18030
+big5 big5-hkscs cn-big5 csbig5 x-x-big5
+cseucpkdfmtjapanese euc-jp x-euc-jp
+csiso2022jp iso-2022-jp
+csshiftjis ms932 ms_kanji shift-jis shift_jis sjis windows-31j x-sjis
+cseuckr csksc56011987 euc-kr iso-ir-149 korean ks_c_5601-1987 ks_c_5601-1989 ksc5601 ksc_5601 windows-949
+csiso2022kr hz-gb-2312 iso-2022-cn iso-2022-cn-ext iso-2022-kr replacement
+unicodefffe utf-16be
+csunicode iso-10646-ucs-2 ucs-2 unicode unicodefeff utf-16 utf-16le
+x-user-defined
+`
+
+// whatwgAliases returns the WHATWG labels of the encoding which name (case insensitive) is a label of, nil if it's not a label.
+func whatwgAliases(name string) []string {
+	name = strings.ToLower(name)
+	for _, line := range strings.Split(whatwgLabels, "\n") {
+		labels := strings.Fields(line)
+		for _, label := range labels {
+			if label == name {
+				return labels
+			}
+		}
+	}
+	return nil
+}
+
+// unsupportedNames maps the names of charsets which golang.org/x/text can't decode to IANA names.
+// They are reported by saintfish/chardet, or mapped to the replacement encoding by WHATWG, so that they are told apart.
+var unsupportedNames = map[string]string{
+	"ibm420_ltr":      "IBM420",
+	"ibm420_rtl":      "IBM420",
+	"ibm424_ltr":      "IBM424",
+	"ibm424_rtl":      "IBM424",
+	"iso-2022-kr":     "ISO-2022-KR",
+	"csiso2022kr":     "ISO-2022-KR",
+	"iso-2022-cn":     "ISO-2022-CN",
+	"iso-2022-cn-ext": "ISO-2022-CN-EXT",
+	"hz-gb-2312":      "HZ-GB-2312",
+}
+
+// CanonicalName returns the name used in declarations and headers of the charset of label (case insensitive),
+// e.g. "GB18030" for "GB-18030" and "UTF-32LE" for "utf32le". It's the preferred MIME name, or the IANA name.
+//
+// The names registered by RegisterEncoding, WHATWG labels, IANA names and aliases
+// and the names reported by saintfish/chardet are reconciled.
+// The charsets golang.org/x/text can't decode, such as "ISO-2022-KR" and "IBM424_rtl", have their IANA names too.
+// Labels of the same encoding.Encoding have the same canonical name, e.g. "ISO-8859-1" is "windows-1252" as WHATWG defines.
+//
+// It will return an *InvalidNameError if label is not valid.
+func CanonicalName(label string) (string, error) {
+	if iana, ok := unsupportedNames[strings.ToLower(strings.TrimSpace(label))]; ok {
+		if canonical, _, ok := lookupRegistered(iana); ok {
+			return canonical, nil
+		}
+		return iana, nil
+	}
+	e, err := GetEncodingFromCharsetName(label)
+	if err != nil {
+		return "", err
+	}
+	return preferredName(e, label), nil
+}
+
+// Aliases returns the known names of the charset of name, nil if name is not valid.
+//
+// The canonical name comes first, followed by the other names in lowercase and sorted.
+// Known names are the registered names, the WHATWG labels and the names reported by saintfish/chardet,
+// so an IANA alias which is none of them is not listed.
+func Aliases(name string) []string {
+	canonical, err := CanonicalName(name)
+	if err != nil {
+		return nil
+	}
+	aliases := []string{canonical}
+	for _, label := range knownLabels() {
+		if strings.EqualFold(label, canonical) {
+			continue
+		}
+		if c, err := CanonicalName(label); err == nil && c == canonical {
+			aliases = append(aliases, label)
+		}
+	}
+	sort.Strings(aliases[1:])
+	return aliases
+}
+
+// SameCharset reports whether a and b are names of the same charset, such as "GB-18030" and "gb18030".
+func SameCharset(a, b string) bool {
+	if strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b)) {
+		return true
+	}
+	ca, err := CanonicalName(a)
+	if err != nil {
+		return false
+	}
+	cb, err := CanonicalName(b)
+	return err == nil && ca == cb
+}
+
+// knownLabels returns the distinct known names in lowercase, see Aliases.
+func knownLabels() []string {
+	labels := strings.Fields(whatwgLabels)
+	labels = append(labels, registeredNames()...)
+	for name := range unsupportedNames {
+		labels = append(labels, name)
+	}
+	seen := make(map[string]bool, len(labels))
+	distinct := labels[:0]
+	for _, label := range labels {
+		if !seen[label] {
+			seen[label] = true
+			distinct = append(distinct, label)
+		}
+	}
+	return distinct
+}
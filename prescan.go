@@ -0,0 +1,304 @@
+package easychars
+
+import (
+	"bytes"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"strings"
+)
+
+// prescanWindow is the number of bytes searched for a charset declaration, same as WHATWG encoding sniffing.
+const prescanWindow = 1024
+
+// detectDeclaration looks for the charset declared by the XML declaration or the HTML <meta> element in the head of content.
+//
+// The XML declaration is only recognized at the very beginning of content, reference: https://www.w3.org/TR/xml/#NT-EncodingDecl
+//
+// <meta> is found by the WHATWG prescan algorithm,
+// reference: https://html.spec.whatwg.org/multipage/parsing.html#prescan-a-byte-stream-to-determine-its-encoding
+//
+// return: the Result of declared charset with Source SourceDeclaration, nil if there is no valid declaration
+func detectDeclaration(content []byte) *Result {
+	if len(content) > prescanWindow {
+		content = content[:prescanWindow]
+	}
+	charset, ok := "", false
+	if label, found := xmlDeclaredLabel(content); found {
+		charset, ok = resolveDeclaredLabel(label)
+	}
+	if !ok {
+		charset, ok = prescanMeta(content)
+	}
+	if !ok {
+		return nil
+	}
+	result := newResult(charset, "", 0)
+	result.Source = SourceDeclaration
+	return result
+}
+
+// resolveDeclaredLabel returns the charset name of a declared label, false if the label is not valid.
+//
+// As WHATWG says, a document declaring UTF-16 in ASCII compatible bytes is actually UTF-8,
+// and x-user-defined is windows-1252.
+func resolveDeclaredLabel(label string) (charset string, ok bool) {
+	e, err := GetEncodingFromCharsetName(label)
+	if err != nil {
+		return "", false
+	}
+	charset = preferredName(e, label)
+	switch {
+	case strings.HasPrefix(strings.ToUpper(charset), "UTF-16"):
+		charset = "UTF-8"
+	case strings.EqualFold(charset, "x-user-defined"):
+		charset = "windows-1252"
+	}
+	return charset, true
+}
+
+// preferredName returns the name of e used in declarations and headers, e.g. "EUC-JP" instead of "Extended_UNIX_Code_Packed_Format_for_Japanese".
+// It's the canonical name if label is registered, or the preferred MIME name, or the IANA name, or label itself if e has no name.
+func preferredName(e encoding.Encoding, label string) string {
+	if canonical, _, ok := lookupRegistered(label); ok {
+		return canonical
+	}
+	name, err := ianaindex.MIME.Name(e)
+	if err != nil || name == "" {
+		name, err = getCharsetNameFromEncoding(e)
+	}
+	if err != nil {
+		name = strings.TrimSpace(label)
+	}
+	return name
+}
+
+// xmlDeclaredLabel returns the encoding label in the XML declaration <?xml version="1.0" encoding="..."?> at the beginning of content.
+func xmlDeclaredLabel(content []byte) (label string, found bool) {
+	if !bytes.HasPrefix(content, []byte("<?xml")) || len(content) < 6 || !isSpace(content[5]) {
+		return
+	}
+	end := bytes.Index(content, []byte("?>"))
+	if end < 0 {
+		return
+	}
+	decl := content[5:end]
+	i := bytes.Index(decl, []byte("encoding"))
+	if i < 0 {
+		return
+	}
+	decl = bytes.TrimLeft(decl[i+len("encoding"):], "\t\n\r ")
+	if len(decl) == 0 || decl[0] != '=' {
+		return
+	}
+	decl = bytes.TrimLeft(decl[1:], "\t\n\r ")
+	if len(decl) == 0 || decl[0] != '"' && decl[0] != '\'' {
+		return
+	}
+	j := bytes.IndexByte(decl[1:], decl[0])
+	if j < 0 {
+		return
+	}
+	return string(decl[1 : j+1]), true
+}
+
+// prescanMeta looks for the charset declared by <meta charset> or <meta http-equiv="Content-Type" content="...; charset=...">
+// with the WHATWG prescan algorithm, skipping comments and other tags.
+func prescanMeta(content []byte) (charset string, ok bool) {
+	for pos := 0; pos < len(content); pos++ {
+		rest := content[pos:]
+		switch {
+		case bytes.HasPrefix(rest, []byte("<!--")):
+			// the "--" of "-->" may be the one of "<!--"
+			end := bytes.Index(rest[2:], []byte("-->"))
+			if end < 0 {
+				return
+			}
+			pos += 2 + end + 2
+
+		case len(rest) > 5 && hasPrefixFold(rest, "<meta") && (isSpace(rest[5]) || rest[5] == '/'):
+			pos += 5
+			if charset, ok = prescanMetaAttributes(content, &pos); ok {
+				return
+			}
+
+		case len(rest) > 1 && isASCIILetter(rest[1]) && rest[0] == '<',
+			len(rest) > 2 && isASCIILetter(rest[2]) && rest[0] == '<' && rest[1] == '/':
+			// skip the tag name and its attributes
+			for pos < len(content) && !isSpace(content[pos]) && content[pos] != '>' {
+				pos++
+			}
+			for {
+				if _, _, found := getAttribute(content, &pos); !found {
+					break
+				}
+			}
+
+		case bytes.HasPrefix(rest, []byte("<!")), bytes.HasPrefix(rest, []byte("</")), bytes.HasPrefix(rest, []byte("<?")):
+			end := bytes.IndexByte(rest, '>')
+			if end < 0 {
+				return
+			}
+			pos += end
+		}
+	}
+	return
+}
+
+// prescanMetaAttributes reads the attributes of a <meta> element from pos, and returns the charset it declares.
+func prescanMetaAttributes(content []byte, pos *int) (charset string, ok bool) {
+	seen := map[string]bool{}
+	gotPragma := false
+	needPragma := 0 // 0: null, 1: true, 2: false
+	label, hasLabel := "", false
+	for {
+		name, value, found := getAttribute(content, pos)
+		if !found {
+			break
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		switch name {
+		case "http-equiv":
+			if value == "content-type" {
+				gotPragma = true
+			}
+		case "content":
+			if hasLabel {
+				continue
+			}
+			if l, found := extractCharsetFromContent(value); found {
+				label, hasLabel = l, true
+				needPragma = 1
+			}
+		case "charset":
+			label, hasLabel = value, true
+			needPragma = 2
+		}
+	}
+	if needPragma == 0 || needPragma == 1 && !gotPragma || !hasLabel {
+		return
+	}
+	return resolveDeclaredLabel(label)
+}
+
+// getAttribute reads an attribute of a tag from pos with the WHATWG "get an attribute" algorithm.
+// Name and value are lowercased.
+//
+// return: found is false if there are no more attributes in the tag
+func getAttribute(content []byte, pos *int) (name string, value string, found bool) {
+	p := *pos
+	defer func() { *pos = p }()
+	for p < len(content) && (isSpace(content[p]) || content[p] == '/') {
+		p++
+	}
+	if p >= len(content) || content[p] == '>' {
+		return
+	}
+
+	var n, v []byte
+	// attribute name
+	for ; ; p++ {
+		if p >= len(content) {
+			return
+		}
+		c := content[p]
+		if c == '=' && len(n) > 0 {
+			p++
+			break
+		}
+		if isSpace(c) {
+			for p < len(content) && isSpace(content[p]) {
+				p++
+			}
+			if p >= len(content) || content[p] != '=' {
+				return string(n), "", true
+			}
+			p++
+			break
+		}
+		if c == '/' || c == '>' {
+			return string(n), "", true
+		}
+		n = append(n, toASCIILower(c))
+	}
+
+	// attribute value
+	for p < len(content) && isSpace(content[p]) {
+		p++
+	}
+	if p >= len(content) {
+		return
+	}
+	if q := content[p]; q == '"' || q == '\'' {
+		for p++; ; p++ {
+			if p >= len(content) {
+				return
+			}
+			if content[p] == q {
+				p++
+				return string(n), string(v), true
+			}
+			v = append(v, toASCIILower(content[p]))
+		}
+	}
+	if content[p] == '>' {
+		return string(n), "", true
+	}
+	for ; p < len(content) && !isSpace(content[p]) && content[p] != '>'; p++ {
+		v = append(v, toASCIILower(content[p]))
+	}
+	return string(n), string(v), true
+}
+
+// extractCharsetFromContent returns the charset in the content attribute of <meta>, such as "text/html; charset=gbk",
+// with the WHATWG "extract a character encoding from a meta element" algorithm.
+func extractCharsetFromContent(s string) (label string, found bool) {
+	for {
+		i := strings.Index(strings.ToLower(s), "charset")
+		if i < 0 {
+			return
+		}
+		s = strings.TrimLeft(s[i+len("charset"):], "\t\n\f\r ")
+		if len(s) > 0 && s[0] == '=' {
+			s = strings.TrimLeft(s[1:], "\t\n\f\r ")
+			break
+		}
+	}
+	if len(s) == 0 {
+		return
+	}
+	if q := s[0]; q == '"' || q == '\'' {
+		j := strings.IndexByte(s[1:], q)
+		if j < 0 {
+			return
+		}
+		return s[1 : j+1], true
+	}
+	if j := strings.IndexAny(s, "\t\n\f\r ;"); j >= 0 {
+		s = s[:j]
+	}
+	return s, true
+}
+
+// Check whether c is ASCII whitespace of HTML: TAB, LF, FF, CR or SPACE
+func isSpace(c byte) bool {
+	return c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func toASCIILower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// Check whether s starts with ASCII prefix, case insensitive
+func hasPrefixFold(s []byte, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(string(s[:len(prefix)]), prefix)
+}
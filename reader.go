@@ -0,0 +1,223 @@
+package easychars
+
+import (
+	"bytes"
+	"golang.org/x/text/transform"
+	"io"
+)
+
+// DefaultSampleSize is the number of bytes DetectReader reads from the head of a stream by default.
+const DefaultSampleSize = 64 * 1024
+
+// SampleOptions controls how much of a stream is read for detection.
+//
+// Sizes are rounded down to a multiple of 4, so that UTF-16 and UTF-32 code units are not split between windows.
+type SampleOptions struct {
+	// Number of bytes read from the head of the stream, DefaultSampleSize if not positive.
+	HeadSize int
+	// Number of bytes read from the middle of the stream, 0 to disable.
+	// Only used when the reader is an io.ReaderAt whose size is known.
+	MiddleSize int
+	// Number of bytes read from the tail of the stream, 0 to disable.
+	// Only used when the reader is an io.ReaderAt whose size is known.
+	TailSize int
+}
+
+// DetectReader detects the charset of r by reading only a sample of it, instead of the whole stream.
+//
+// The head window is read from r, and returned as sample so that the caller can replay it,
+// e.g. io.MultiReader(bytes.NewReader(sample), r). If r is also an io.ReaderAt and its size is known
+// (it has a Size() int64 method or is an io.Seeker), the middle and tail windows are read by ReadAt,
+// which doesn't move the position of r. The stream starts at the current position of r if it's an io.Seeker,
+// otherwise at offset 0 of ReadAt.
+//
+// The Result is the same as DetectEncoding gives for the sampled bytes.
+// When windows are joined, they are cut at line boundaries, so that no character is split at the joins.
+// If the head window has no line boundary, the middle and tail windows are not used.
+func DetectReader(r io.Reader, opts *SampleOptions) (result *Result, sample []byte, err error) {
+	sample, content, err := readSample(r, opts)
+	if err != nil {
+		return
+	}
+	result, err = DetectEncoding(content)
+	return
+}
+
+// readSample reads the head window from r as sample, and content is sample followed by the middle and tail windows if any.
+func readSample(r io.Reader, opts *SampleOptions) (sample []byte, content []byte, err error) {
+	if opts == nil {
+		opts = &SampleOptions{}
+	}
+	headSize := opts.HeadSize &^ 0x3
+	if headSize <= 0 {
+		headSize = DefaultSampleSize
+	}
+	start := readerOffset(r)
+	sample = make([]byte, headSize)
+	n, err := io.ReadFull(r, sample)
+	sample = sample[:n]
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	content = sample
+	// the stream may be longer than the head window, which must be cut at a line to be joined to other windows
+	if end := lastLineEnd(sample); n == headSize && end > 0 {
+		if windows := readWindows(r, start, start+int64(n), opts); len(windows) > 0 {
+			content = make([]byte, 0, end+len(windows))
+			content = append(content, sample[:end]...)
+			content = append(content, windows...)
+		}
+	}
+	return
+}
+
+// readWindows reads the middle and tail windows of r, which starts at offset start, after offset headEnd.
+// It returns nil if r is not an io.ReaderAt with known size.
+func readWindows(r io.Reader, start, headEnd int64, opts *SampleOptions) (windows []byte) {
+	ra, ok := r.(io.ReaderAt)
+	if !ok || opts.MiddleSize <= 0 && opts.TailSize <= 0 {
+		return
+	}
+	size, ok := readerSize(r)
+	if !ok {
+		return
+	}
+	middleSize := int64(opts.MiddleSize &^ 0x3)
+	tailSize := int64(opts.TailSize &^ 0x3)
+	next := headEnd // windows never overlap
+	// offsets are aligned to the start of the stream
+	for _, w := range []struct{ off, size int64 }{
+		{start + ((size-start-middleSize)/2)&^0x3, middleSize},
+		{start + (size-start-tailSize)&^0x3, tailSize},
+	} {
+		if w.off < next {
+			w.size -= next - w.off
+			w.off = next
+		}
+		if w.size <= 0 {
+			continue
+		}
+		buf := make([]byte, w.size)
+		n, _ := ra.ReadAt(buf, w.off)
+		buf = buf[:n&^0x3]
+		next = w.off + int64(len(buf))
+		// start at a line, and end at a line unless the window reaches the end of stream
+		first, end := firstLineStart(buf), len(buf)
+		if next < size {
+			end = lastLineEnd(buf)
+		}
+		if first < 0 || end < first {
+			continue
+		}
+		windows = append(windows, buf[first:end]...)
+	}
+	return
+}
+
+// firstLineStart returns the first offset in w which starts a line, -1 if there is none. See isLineBoundary.
+func firstLineStart(w []byte) int {
+	for i := 0; i <= len(w); i += 4 {
+		if isLineBoundary(w, i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastLineEnd returns the last offset in w which ends a line, -1 if there is none. See isLineBoundary.
+func lastLineEnd(w []byte) int {
+	for i := len(w) &^ 0x3; i >= 0; i -= 4 {
+		if isLineBoundary(w, i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isLineBoundary reports whether offset i of w is right after a line feed, in ASCII compatible charsets, UTF-16 or UTF-32.
+// Only a multiple of 4 is a boundary, so that UTF-16 and UTF-32 code units are kept aligned.
+func isLineBoundary(w []byte, i int) bool {
+	if i%4 != 0 || i == 0 {
+		return false
+	}
+	// the zero bytes of the line feed in UTF-16LE and UTF-32LE
+	j := i - 1
+	for j > i-4 && w[j] == 0 {
+		j--
+	}
+	return w[j] == '\n'
+}
+
+// readerOffset returns the current position of r if it's an io.Seeker, 0 otherwise.
+func readerOffset(r io.Reader) int64 {
+	if s, ok := r.(io.Seeker); ok {
+		if off, err := s.Seek(0, io.SeekCurrent); err == nil {
+			return off
+		}
+	}
+	return 0
+}
+
+// readerSize reports the total size of r, false if it can't be known without reading r.
+func readerSize(r io.Reader) (size int64, ok bool) {
+	switch s := r.(type) {
+	case interface{ Size() int64 }:
+		return s.Size(), true
+	case io.Seeker:
+		cur, err := s.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, false
+		}
+		size, err = s.Seek(0, io.SeekEnd)
+		if _, err2 := s.Seek(cur, io.SeekStart); err != nil || err2 != nil {
+			return 0, false
+		}
+		return size, true
+	}
+	return 0, false
+}
+
+// NewReader detects the charset of r and returns a reader which converts the whole stream to UTF-8.
+//
+// The head of r (DefaultSampleSize bytes) is buffered for detection and replayed by the returned reader,
+// without the byte order mark if any. The content is converted by Result.Decoder,
+// which handles multi-byte characters split between reads. If the charset is not Convertible,
+// the returned reader gives the original bytes.
+func NewReader(r io.Reader) (io.Reader, *Result, error) {
+	res, sample, err := DetectReader(r, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newUtf8Reader(r, sample, res), res, nil
+}
+
+// newUtf8Reader returns a reader which replays sample and then reads the rest of r, both converted to UTF-8 by res.Decoder.
+func newUtf8Reader(r io.Reader, sample []byte, res *Result) io.Reader {
+	if !res.Convertible {
+		return io.MultiReader(bytes.NewReader(sample), r)
+	}
+	// BOM is not part of the text, drop it before converting
+	stream := io.MultiReader(bytes.NewReader(sample[res.BOMLength:]), r)
+	return transform.NewReader(stream, res.Decoder)
+}
+
+// validSample returns content without a character of at most 3 bytes cut at the end, which is valid by validate,
+// because a sample of DetectReader may end in the middle of a character. It returns false if content is not valid.
+func validSample(validate func(content []byte) bool, content []byte) ([]byte, bool) {
+	for cut := 0; cut <= 3 && cut <= len(content); cut++ {
+		if validate(content[:len(content)-cut]) {
+			return content[:len(content)-cut], true
+		}
+	}
+	return nil, false
+}
+
+// isValidSample checks whether content is valid by validate, ignoring a character of at most 3 bytes cut at the end, see validSample.
+func isValidSample(validate func(content []byte) bool, content []byte) bool {
+	_, ok := validSample(validate, content)
+	return ok
+}
@@ -0,0 +1,156 @@
+package easychars
+
+import (
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode/utf32"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// registeredEncoding is a charset of the registry.
+type registeredEncoding struct {
+	canonical string
+	e         encoding.Encoding
+}
+
+// registry holds the charsets consulted before htmlindex and ianaindex.
+var registry = struct {
+	sync.RWMutex
+	// keyed by lowercase canonical name and aliases
+	names map[string]*registeredEncoding
+	// in registration order, so that the first registered name of an encoding.Encoding is its name
+	encodings []*registeredEncoding
+}{names: map[string]*registeredEncoding{}}
+
+func init() {
+	// charsets and names which htmlindex and ianaindex don't know
+	utf32LE := utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM)
+	utf32BE := utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM)
+	// only gb18030 is valid name in htmlindex and ianaindex
+	RegisterEncoding("GB18030", []string{"gb-18030", "gb_18030", "gb 18030"}, simplifiedchinese.GB18030)
+	// Microsoft code pages, which are decoded by Shift_JIS and EUC-KR of htmlindex
+	RegisterEncoding("Shift_JIS", []string{"cp932", "ms_932", "windows-932"}, japanese.ShiftJIS)
+	RegisterEncoding("EUC-KR", []string{"cp949", "ms949", "ms_949", "uhc"}, korean.EUCKR)
+	// UTF-32 is not listed in ianaindex and html encodings
+	RegisterEncoding("UTF-32LE", []string{"utf-32-le", "utf_32_le", "utf-32_le", "utf_32-le", "utf32le", "utf32-le", "utf_32le", "utf32_le"}, utf32LE)
+	RegisterEncoding("UTF-32BE", []string{"utf-32-be", "utf_32_be", "utf-32_be", "utf_32-be", "utf32be", "utf32-be", "utf_32be", "utf32_be"}, utf32BE)
+	// EUC-TW and Johab are not supported by golang.org/x/text, use this package's own encodings
+	RegisterEncoding("EUC-TW", []string{"euc_tw", "euctw", "x-euc-tw", "cns11643"}, EUCTW)
+	RegisterEncoding("Johab", []string{"x-johab", "cp1361", "ms1361", "windows-1361", "ksc5601-1992", "ks_c_5601-1992"}, Johab)
+	// single-byte charsets of this package, instead of the charmaps of golang.org/x/text
+	for _, c := range []*SingleByteCharset{
+		ISO8859_2, ISO8859_5, ISO8859_6, ISO8859_7,
+		Windows1250, Windows1251, Windows1252, Windows1254, Windows1255, Windows1256,
+		KOI8R, IBM866, MacCyrillic, MacRoman,
+	} {
+		registerSingleByte(c, whatwgAliases(c.String()))
+	}
+	// IBM855 is not in WHATWG
+	registerSingleByte(IBM855, []string{"cp855", "855", "csibm855"})
+	// WHATWG labels TIS-620 as windows-874, only the names of TIS-620 itself are taken
+	registerSingleByte(TIS620, []string{"tis620", "cstis620"})
+}
+
+// registerSingleByte registers c under its name and aliases, with c.IsValid as the Validator of its name.
+func registerSingleByte(c *SingleByteCharset, aliases []string) {
+	RegisterEncoding(c.String(), aliases, c)
+	RegisterValidator(c.String(), c.IsValid)
+}
+
+// RegisterEncoding registers e as the charset named canonical, also known as aliases.
+// Names are case insensitive, and replace the built-in or registered ones.
+// A nil e removes canonical and aliases.
+//
+// The registry is consulted before htmlindex and ianaindex, by GetEncodingFromCharsetName and the functions taking charset names,
+// by DetectAll to find the Decoder of a detected charset, and by CanonicalName, which returns canonical for all the names.
+// If the same e is registered under several canonical names, the first registered one is the name of e.
+//
+// It's safe to call RegisterEncoding concurrently with detection and conversion.
+func RegisterEncoding(canonical string, aliases []string, e encoding.Encoding) {
+	canonical = strings.TrimSpace(canonical)
+	names := make([]string, 0, len(aliases)+1)
+	for _, name := range append([]string{canonical}, aliases...) {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if e == nil {
+		for _, name := range names {
+			delete(registry.names, name)
+		}
+	} else {
+		var entry *registeredEncoding
+		for _, r := range registry.encodings {
+			if strings.EqualFold(r.canonical, canonical) {
+				entry = r
+				break
+			}
+		}
+		if entry == nil {
+			entry = &registeredEncoding{}
+			registry.encodings = append(registry.encodings, entry)
+		}
+		entry.canonical, entry.e = canonical, e
+		for _, name := range names {
+			registry.names[name] = entry
+		}
+	}
+
+	// drop the charsets which have no names left
+	referenced := make(map[*registeredEncoding]bool, len(registry.encodings))
+	for _, r := range registry.names {
+		referenced[r] = true
+	}
+	encodings := registry.encodings[:0]
+	for _, r := range registry.encodings {
+		if referenced[r] {
+			encodings = append(encodings, r)
+		}
+	}
+	registry.encodings = encodings
+}
+
+// lookupRegistered returns the registered charset of name (case insensitive).
+func lookupRegistered(name string) (canonical string, e encoding.Encoding, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	r, ok := registry.names[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return "", nil, false
+	}
+	return r.canonical, r.e, true
+}
+
+// registeredName returns the canonical name e is first registered under.
+func registeredName(e encoding.Encoding) (string, bool) {
+	// comparing interfaces holding the same uncomparable type panics
+	if e == nil || !reflect.TypeOf(e).Comparable() {
+		return "", false
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, r := range registry.encodings {
+		if reflect.TypeOf(r.e) == reflect.TypeOf(e) && r.e == e {
+			return r.canonical, true
+		}
+	}
+	return "", false
+}
+
+// registeredNames returns the lowercase names of the registry.
+func registeredNames() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.names))
+	for name := range registry.names {
+		names = append(names, name)
+	}
+	return names
+}
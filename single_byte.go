@@ -1,85 +1,188 @@
 package easychars
 
 import (
+	"golang.org/x/text/encoding"
 	"golang.org/x/text/transform"
+	"sync"
+	"unicode/utf8"
 )
 
-type iso_8859_1_Decoder struct {
+// SingleByteCharset is a charset which encodes every character in a single byte, defined by a 256-entry table.
+//
+// It's an encoding.Encoding. A byte mapped to utf8.RuneError is undefined in the charset,
+// it's decoded to U+FFFD and makes content invalid.
+type SingleByteCharset struct {
+	name  string
+	table [256]rune
+	// UTF-8 of every byte, so that decoding doesn't encode runes again and again
+	utf8Bytes [256][utf8.UTFMax]byte
+	utf8Size  [256]uint8
+	valid     [256]bool
+
+	reverseOnce sync.Once
+	reverse     map[rune]byte
+}
+
+// NewSingleByteCharset returns a SingleByteCharset named name, which maps byte b to table[b].
+func NewSingleByteCharset(name string, table [256]rune) *SingleByteCharset {
+	c := &SingleByteCharset{name: name, table: table}
+	for b, r := range table {
+		c.utf8Size[b] = uint8(utf8.EncodeRune(c.utf8Bytes[b][:], r))
+		c.valid[b] = r != utf8.RuneError
+	}
+	return c
+}
+
+// newASCIICharset returns a SingleByteCharset which is ASCII in 0x00 - 0x7F, and maps 0x80 + i to high[i].
+func newASCIICharset(name string, high [128]rune) *SingleByteCharset {
+	var table [256]rune
+	for b := range table {
+		if b < utf8.RuneSelf {
+			table[b] = rune(b)
+		} else {
+			table[b] = high[b-utf8.RuneSelf]
+		}
+	}
+	return NewSingleByteCharset(name, table)
+}
+
+func (c *SingleByteCharset) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{Transformer: singleByteDecoder{charset: c}}
+}
+
+func (c *SingleByteCharset) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{Transformer: singleByteEncoder{charset: c}}
+}
+
+func (c *SingleByteCharset) String() string {
+	return c.name
+}
+
+// DecodeByte returns the rune of b, utf8.RuneError if b is undefined.
+func (c *SingleByteCharset) DecodeByte(b byte) rune {
+	return c.table[b]
+}
+
+// EncodeRune returns the byte of r, false if the charset can't represent r.
+func (c *SingleByteCharset) EncodeRune(r rune) (b byte, ok bool) {
+	if r < utf8.RuneSelf && c.table[r] == r {
+		return byte(r), true
+	}
+	c.reverseOnce.Do(c.buildReverse)
+	b, ok = c.reverse[r]
+	return
+}
+
+// ValidMask returns whether every byte is defined in the charset.
+func (c *SingleByteCharset) ValidMask() [256]bool {
+	return c.valid
+}
+
+// IsValid reports whether every byte of content is defined in the charset. It can be registered by RegisterValidator.
+func (c *SingleByteCharset) IsValid(content []byte) bool {
+	for _, b := range content {
+		if !c.valid[b] {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *SingleByteCharset) buildReverse() {
+	c.reverse = make(map[rune]byte, len(c.table))
+	// the lowest byte wins if a rune is mapped more than once
+	for b := len(c.table) - 1; b >= 0; b-- {
+		if c.valid[b] {
+			c.reverse[c.table[b]] = byte(b)
+		}
+	}
+}
+
+// appendUtf8 appends content decoded by c to dst. It's the fast path of ToUtf8WithDecoder,
+// which sizes the output first, so that it allocates at most once whatever the length of content.
+func (c *SingleByteCharset) appendUtf8(dst, content []byte) []byte {
+	n := 0
+	for _, b := range content {
+		n += int(c.utf8Size[b])
+	}
+	dst = growBytes(dst, n)
+	out := dst[len(dst) : len(dst)+n]
+	i := 0
+	for _, b := range content {
+		if size := c.utf8Size[b]; size == 1 {
+			out[i] = c.utf8Bytes[b][0]
+			i++
+		} else {
+			i += copy(out[i:], c.utf8Bytes[b][:size])
+		}
+	}
+	return dst[:len(dst)+n]
+}
+
+// singleByteCharsetOf returns the SingleByteCharset d decodes, nil if d is not a decoder of a SingleByteCharset.
+func singleByteCharsetOf(d Decoder) *SingleByteCharset {
+	if wrapped, ok := d.(*encoding.Decoder); ok {
+		d = wrapped.Transformer
+	}
+	if sd, ok := d.(singleByteDecoder); ok {
+		return sd.charset
+	}
+	return nil
+}
+
+type singleByteDecoder struct {
 	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
 	transform.NopResetter
+	charset *SingleByteCharset
 }
 
-func (d iso_8859_1_Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
-	for _, b := range src {
-		if b <= 0x7F {
-			if nDst+1 > len(dst) {
-				err = transform.ErrShortDst
-				return
-			}
-			dst[nDst] = b
-			nDst += 1
+func (d singleByteDecoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	c := d.charset
+	for ; nSrc < len(src); nSrc++ {
+		b := src[nSrc]
+		size := int(c.utf8Size[b])
+		if nDst+size > len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		if size == 1 {
+			dst[nDst] = c.utf8Bytes[b][0]
 		} else {
-			if nDst+2 > len(dst) {
-				err = transform.ErrShortDst
-				return
-			}
-			dst[nDst] = 0xC0 | b>>6
-			dst[nDst+1] = 0x80 | (b & 0x3F)
-			nDst += 2
+			copy(dst[nDst:], c.utf8Bytes[b][:size])
 		}
-		nSrc += 1
+		nDst += size
 	}
 	return
 }
 
-type windows_1251_Decoder struct {
+type singleByteEncoder struct {
 	// transform.NopResetter can be embedded by implementations of Transformer to add a nop Reset method.
 	transform.NopResetter
+	charset *SingleByteCharset
 }
 
-// convert Windows-1251(byte no less than 0X80 and not equal to 0X98(unused in Windows-1251)) code to correspond Unicode
-var charMap_windows_1251 = [128]rune{
-	0x0402, 0x0403, 0x0082, 0x0453, 0x0084, 0x0085, 0x0086, 0x0087,
-	0x20AC, 0x0089, 0x0409, 0x008B, 0x040A, 0x040C, 0x040B, 0x040F,
-	0x0452, 0x0091, 0x0092, 0x0093, 0x0094, 0x0095, 0x0096, 0x0097,
-	0x0000, 0x0099, 0x0459, 0x009B, 0x045A, 0x045C, 0x045B, 0x045F,
-	0x00A0, 0x040E, 0x045E, 0x0408, 0x00A4, 0x0490, 0x00A6, 0x00A7,
-	0x0401, 0x00A9, 0x0404, 0x00AB, 0x00AC, 0x00AD, 0x00AE, 0x0407,
-	0x00B0, 0x00B1, 0x0406, 0x0456, 0x0491, 0x00B5, 0x00B6, 0x00B7,
-	0x0451, 0x2116, 0x0454, 0x00BB, 0x0458, 0x0405, 0x0455, 0x0457,
-	0x0410, 0x0411, 0x0412, 0x0413, 0x0414, 0x0415, 0x0416, 0x0417,
-	0x0418, 0x0419, 0x041A, 0x041B, 0x041C, 0x041D, 0x041E, 0x041F,
-	0x0420, 0x0421, 0x0422, 0x0423, 0x0424, 0x0425, 0x0426, 0x0427,
-	0x0428, 0x0429, 0x042A, 0x042B, 0x042C, 0x042D, 0x042E, 0x042F,
-	0x0430, 0x0431, 0x0432, 0x0433, 0x0434, 0x0435, 0x0436, 0x0437,
-	0x0438, 0x0439, 0x043A, 0x043B, 0x043C, 0x043D, 0x043E, 0x043F,
-	0x0440, 0x0441, 0x0442, 0x0443, 0x0444, 0x0445, 0x0446, 0x0447,
-	0x0448, 0x0449, 0x044A, 0x044B, 0x044C, 0x044D, 0x044E, 0x044F,
-}
-
-func (d windows_1251_Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
-	var r rune
-	for _, b := range src {
-		if b <= 0x7F {
-			if nDst+1 > len(dst) {
-				err = transform.ErrShortDst
-				return
-			}
-			dst[nDst] = b
-			nDst += 1
-		} else {
-			r = charMap_windows_1251[b-0x80]
-			utf8codes := unicodeRuneToUtf8(r)
-			if nDst+len(utf8codes) > len(dst) {
-				err = transform.ErrShortDst
-				return
+func (e singleByteEncoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	r, size := rune(0), 0
+	for ; nSrc < len(src); nSrc += size {
+		r, size = rune(src[nSrc]), 1
+		if r >= utf8.RuneSelf {
+			r, size = utf8.DecodeRune(src[nSrc:])
+			if size == 1 && !atEOF && !utf8.FullRune(src[nSrc:]) {
+				err = transform.ErrShortSrc
+				break
 			}
-			for i, c := range utf8codes {
-				dst[nDst+i] = c
-			}
-			nDst += len(utf8codes)
 		}
-		nSrc += 1
+		b, ok := e.charset.EncodeRune(r)
+		if !ok {
+			err = errUnsupportedRune
+			break
+		}
+		if nDst >= len(dst) {
+			err = transform.ErrShortDst
+			break
+		}
+		dst[nDst] = b
+		nDst++
 	}
 	return
 }
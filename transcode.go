@@ -0,0 +1,185 @@
+package easychars
+
+import (
+	"fmt"
+	"golang.org/x/text/transform"
+	"io"
+	"unicode/utf8"
+)
+
+// TranscodeOptions controls how Transcode handles content which can't be converted exactly.
+type TranscodeOptions struct {
+	// How invalid sequences of the source charset are handled, ErrorReplace by default.
+	// The U+FFFD written by ErrorReplace must be representable by the target charset, or it's unmappable.
+	Invalid ErrorMode
+	// How characters which the target charset can't represent are handled, nil fails with an *UnmappableError.
+	Unsupported UnsupportedPolicy
+}
+
+// UnmappableError is returned by Transcode when the target charset can't represent a character of content.
+type UnmappableError struct {
+	// Byte offset of the character in content, which is encoded by the source charset.
+	Offset int
+	// Bytes of the character in content.
+	Bytes []byte
+	// The character.
+	Rune rune
+}
+
+func (e *UnmappableError) Error() string {
+	return fmt.Sprintf("easychars: rune %U of % x at offset %d not supported by target encoding", e.Rune, e.Bytes, e.Offset)
+}
+
+// Transcode converts content from the charset of name from to the charset of name to, through UTF-8.
+//
+// Both names are resolved by GetEncodingFromCharsetName, an *InvalidNameError is returned if one is not valid.
+// The errors of unmappable characters and invalid sequences tell their offsets in content.
+// A nil opts is the same as a zero TranscodeOptions.
+func Transcode(content []byte, from, to string, opts *TranscodeOptions) ([]byte, error) {
+	t, err := newTranscoder(from, to, opts)
+	if err != nil {
+		return nil, err
+	}
+	transcoded, _, err := transform.Bytes(t, content)
+	if err != nil {
+		return nil, err
+	}
+	return transcoded, nil
+}
+
+// NewTranscodingReader returns a reader which converts r from the charset of name from to the charset of name to,
+// see Transcode for the names, opts and errors.
+func NewTranscodingReader(r io.Reader, from, to string, opts *TranscodeOptions) (io.Reader, error) {
+	t, err := newTranscoder(from, to, opts)
+	if err != nil {
+		return nil, err
+	}
+	return transform.NewReader(r, t), nil
+}
+
+// transcoder is a transform.Transformer which decodes the source one character at a time and encodes it at once,
+// so that unmappable characters are located in the source.
+type transcoder struct {
+	// name of the source charset
+	from    string
+	decoder transform.Transformer
+	encoder transform.Transformer
+	mode    ErrorMode
+	policy  UnsupportedPolicy
+	// U+FFFD encoded in the source charset, see encodedReplacementChar
+	fffd []byte
+	// number of source bytes consumed since Reset
+	offset int
+	// encoded bytes which dst had no room for
+	pending []byte
+	// whether the encoder is flushed at EOF
+	flushed bool
+	decoded [64]byte
+	encoded []byte
+}
+
+func newTranscoder(from, to string, opts *TranscodeOptions) (*transcoder, error) {
+	src, err := GetEncodingFromCharsetName(from)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := GetEncodingFromCharsetName(to)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &TranscodeOptions{}
+	}
+	decoder := src.NewDecoder()
+	return &transcoder{
+		from:    from,
+		decoder: decoder,
+		encoder: dst.NewEncoder(),
+		mode:    opts.Invalid,
+		policy:  opts.Unsupported,
+		fffd:    encodedReplacementChar(decoder),
+	}, nil
+}
+
+func (t *transcoder) Reset() {
+	t.decoder.Reset()
+	t.encoder.Reset()
+	t.offset = 0
+	t.pending = t.pending[:0]
+	t.flushed = false
+}
+
+func (t *transcoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	n := copy(dst, t.pending)
+	nDst, t.pending = n, t.pending[n:]
+	for len(t.pending) == 0 {
+		if nSrc == len(src) {
+			if !atEOF || t.flushed {
+				return nDst, nSrc, nil
+			}
+			// stateful encoders like ISO-2022-JP switch back to ASCII at the end
+			t.flushed = true
+			encoded, err := t.encode(nil, true)
+			if err != nil {
+				return nDst, nSrc, err
+			}
+			n := copy(dst[nDst:], encoded)
+			nDst, t.pending = nDst+n, append(t.pending, encoded[n:]...)
+			continue
+		}
+
+		out, size, err := decodeOne(t.decoder, t.decoded[:], src[nSrc:], atEOF)
+		if err == transform.ErrShortSrc && !atEOF {
+			return nDst, nSrc, err
+		}
+		if err != nil {
+			return nDst, nSrc, &DecodeError{Charset: t.from, Offset: t.offset, Err: err}
+		}
+		char := src[nSrc : nSrc+size]
+		if out, err = applyErrorMode(out, char, t.offset, replacements(out, char, t.fffd), t.mode); err != nil {
+			return nDst, nSrc, err
+		}
+		encoded, err := t.encode(out, false)
+		if err != nil {
+			if unsupported, ok := err.(*UnsupportedRuneError); ok {
+				err = &UnmappableError{Offset: t.offset, Bytes: append([]byte(nil), char...), Rune: unsupported.Rune}
+			}
+			return nDst, nSrc, err
+		}
+		n := copy(dst[nDst:], encoded)
+		nDst, t.pending = nDst+n, append(t.pending, encoded[n:]...)
+		nSrc += size
+		t.offset += size
+	}
+	// the rest of the character is written by the next call
+	return nDst, nSrc, transform.ErrShortDst
+}
+
+// encode encodes the UTF-8 out of a single source character, replacing the unsupported runes by t.policy.
+// The returned slice is valid until the next call.
+func (t *transcoder) encode(out []byte, atEOF bool) ([]byte, error) {
+	encoded := t.encoded[:0]
+	for {
+		if size := len(encoded) + maxEncodedLen(len(out)); cap(encoded) < size {
+			encoded = append(make([]byte, 0, size), encoded...)
+		}
+		nDst, nSrc, err := t.encoder.Transform(encoded[len(encoded):cap(encoded)], out, atEOF)
+		encoded, out = encoded[:len(encoded)+nDst], out[nSrc:]
+		t.encoded = encoded
+		if err == nil {
+			return encoded, nil
+		}
+		if _, ok := err.(interface{ Replacement() byte }); !ok {
+			return nil, err
+		}
+		r, size := utf8.DecodeRune(out)
+		if t.policy == nil {
+			return nil, &UnsupportedRuneError{Rune: r}
+		}
+		replacement, err := encodeReplacement(t.encoder, t.policy(r))
+		if err != nil {
+			return nil, &UnsupportedRuneError{Rune: r}
+		}
+		encoded, out = append(encoded, replacement...), out[size:]
+	}
+}
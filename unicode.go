@@ -1,12 +1,42 @@
 package easychars
 
 import (
+	"bytes"
 	"unicode/utf8"
 )
 
+// Byte order marks of Unicode charsets, reference: https://en.wikipedia.org/wiki/Byte_order_mark
+//
+// UTF-32LE BOM starts with UTF-16LE BOM, so UTF-32 must be checked before UTF-16.
+var boms = []struct {
+	charset string
+	bom     []byte
+}{
+	{"UTF-32BE", []byte{0x00, 0x00, 0xFE, 0xFF}},
+	{"UTF-32LE", []byte{0xFF, 0xFE, 0x00, 0x00}},
+	{"UTF-8", []byte{0xEF, 0xBB, 0xBF}},
+	{"UTF-16BE", []byte{0xFE, 0xFF}},
+	{"UTF-16LE", []byte{0xFF, 0xFE}},
+}
+
+// Check whether content starts with a Unicode byte order mark
+//
+// return: the Result of correspond charset with Confidence 100, BOMLength and Source set, nil if there is no BOM
+func detectBOM(content []byte) *Result {
+	for _, b := range boms {
+		if bytes.HasPrefix(content, b.bom) {
+			result := newResult(b.charset, "", 100)
+			result.BOMLength = len(b.bom)
+			result.Source = SourceBOM
+			return result
+		}
+	}
+	return nil
+}
+
 // Check whether content is valid under UTF-8 rule
 func IsValidUTF8(content []byte) bool {
-	return utf8.Valid(content)
+	return utf8.Valid(content[asciiPrefixLen(content):])
 }
 
 // Check whether content is valid under UTF-16 rule, reference: https://zh.wikipedia.org/wiki/UTF-16
@@ -45,6 +75,12 @@ func isValidUTF16BE(content []byte) bool {
 	// https://en.wikipedia.org/wiki/UTF-16
 	is_surrogate_pairs := false
 	for i := 0; i < len(content); i += 2 {
+		if !is_surrogate_pairs && i&0x7 == 0 {
+			// skip the code units out of the surrogate range, 4 at a time
+			if i += utf16PrefixLen(content[i:], true); i == len(content) {
+				break
+			}
+		}
 		c := uint16(content[i])<<8 ^ uint16(content[i+1])
 		switch is_surrogate_pairs {
 		case true:
@@ -87,6 +123,12 @@ func isValidUTF16LE(content []byte) bool {
 	// https://en.wikipedia.org/wiki/UTF-16
 	is_surrogate_pairs := false
 	for i := 0; i < len(content); i += 2 {
+		if !is_surrogate_pairs && i&0x7 == 0 {
+			// skip the code units out of the surrogate range, 4 at a time
+			if i += utf16PrefixLen(content[i:], false); i == len(content) {
+				break
+			}
+		}
 		c := uint16(content[i+1])<<8 ^ uint16(content[i])
 		switch is_surrogate_pairs {
 		case true:
@@ -103,64 +145,126 @@ func isValidUTF16LE(content []byte) bool {
 	return !is_surrogate_pairs
 }
 
-// Convert unicode to utf-8 encode []byte
-func unicodeRuneToUtf8(unicode rune) (utf8codes []byte) {
-	if unicode <= 0x7F {
-		utf8codes = append(utf8codes, byte(unicode))
-		return
-	} else if unicode <= 0x7FF {
-		var c1 byte = 192
-		var c2 byte = 128
-		for k := 0; k < 11; k++ {
-			if k < 6 {
-				c2 |= byte((unicode % 64) & (1 << k))
-			} else {
-				c1 |= byte((unicode >> 6) & (1 << (k - 6)))
-			}
+// Check whether content is valid under UTF-32BE rule, reference: https://en.wikipedia.org/wiki/UTF-32
+//
+// Every 4 bytes must be a code point no more than U+10FFFF and not in the surrogate range U+D800 - U+DFFF.
+func IsValidUTF32BE(content []byte) bool {
+	if len(content) == 0 || len(content)&0x3 != 0 {
+		return false
+	}
+	for i := 0; i < len(content); i += 4 {
+		c := uint32(content[i])<<24 | uint32(content[i+1])<<16 | uint32(content[i+2])<<8 | uint32(content[i+3])
+		if !isValidCodePoint(c) {
+			return false
 		}
-		utf8codes = append(utf8codes, c1)
-		utf8codes = append(utf8codes, c2)
-		return
-	} else if unicode <= 0xFFFF {
-		var c1 byte = 224
-		var c2 byte = 128
-		var c3 byte = 128
-		for k := 0; k < 16; k++ {
-			if k < 6 {
-				c3 |= byte((unicode % 64) & (1 << k))
-			} else if k < 12 {
-				c2 |= byte((unicode >> 6) & (1 << (k - 6)))
-			} else {
-				c1 |= byte((unicode >> 12) & (1 << (k - 12)))
-			}
+	}
+	return true
+}
+
+// Check whether content is valid under UTF-32LE rule, reference: https://en.wikipedia.org/wiki/UTF-32
+//
+// Every 4 bytes must be a code point no more than U+10FFFF and not in the surrogate range U+D800 - U+DFFF.
+func IsValidUTF32LE(content []byte) bool {
+	if len(content) == 0 || len(content)&0x3 != 0 {
+		return false
+	}
+	for i := 0; i < len(content); i += 4 {
+		c := uint32(content[i+3])<<24 | uint32(content[i+2])<<16 | uint32(content[i+1])<<8 | uint32(content[i])
+		if !isValidCodePoint(c) {
+			return false
 		}
-		utf8codes = append(utf8codes, c1)
-		utf8codes = append(utf8codes, c2)
-		utf8codes = append(utf8codes, c3)
-		return
-	} else if unicode <= 0x10FFFF { // last unicode point which can be represented by utf-8
-		var c1 byte = 240
-		var c2 byte = 128
-		var c3 byte = 128
-		var c4 byte = 128
-		for k := 0; k < 21; k++ {
-			if k < 6 {
-				c4 |= byte((unicode % 64) & (1 << k))
-			} else if k < 12 {
-				c3 |= byte((unicode >> 6) & (1 << (k - 6)))
-			} else if k < 18 {
-				c2 |= byte((unicode >> 12) & (1 << (k - 12)))
-			} else {
-				c1 |= byte((unicode >> 18) & (1 << (k - 18)))
-			}
+	}
+	return true
+}
+
+// Check whether c is a Unicode scalar value, which can be encoded by UTF-8 and UTF-32
+func isValidCodePoint(c uint32) bool {
+	return c <= 0x10FFFF && (c < 0xD800 || c > 0xDFFF)
+}
+
+// Guess whether content without BOM is encoded by UTF-16BE or UTF-16LE
+//
+// Most text contains ASCII characters, which have a zero high byte in UTF-16.
+// So zero bytes are counted by code unit: a unit with only the high byte zero
+// is a vote for the endianness, a unit with only the low byte zero is a vote against it,
+// and a unit with both bytes zero (U+0000, seldom in text but common in UTF-32) is a vote against UTF-16.
+// The endianness must also pass isValidUTF16BE/isValidUTF16LE, which checks surrogate pairs.
+//
+// return: the Result of UTF-16BE or UTF-16LE, nil if content doesn't look like UTF-16
+func probeUTF16(content []byte) *Result {
+	units := len(content) / 2
+	if units < 2 || len(content)&0x1 != 0 {
+		return nil
+	}
+	var evenZero, oddZero, nulUnits int // evenZero/oddZero: units whose only zero byte is at even/odd offset
+	for i := 0; i < len(content); i += 2 {
+		switch {
+		case content[i] == 0 && content[i+1] == 0:
+			nulUnits++
+		case content[i] == 0:
+			evenZero++
+		case content[i+1] == 0:
+			oddZero++
+		}
+	}
+
+	BE := isValidUTF16BE(content)
+	LE := isValidUTF16LE(content)
+	charset, votes := "", 0
+	switch {
+	case evenZero > oddZero && BE: // high byte first
+		charset, votes = "UTF-16BE", evenZero-oddZero
+	case oddZero > evenZero && LE: // low byte first
+		charset, votes = "UTF-16LE", oddZero-evenZero
+	default:
+		return nil
+	}
+	confidence := 100 * (votes - nulUnits) / units
+	if BE != LE {
+		// surrogate pairs are only valid under one endianness
+		confidence += 20
+	}
+	if confidence <= 0 {
+		return nil
+	}
+	if confidence > 100 {
+		confidence = 100
+	}
+	return newResult(charset, "", confidence)
+}
+
+// Guess whether content without BOM is encoded by UTF-32BE or UTF-32LE
+//
+// A code point is no more than U+10FFFF, so the highest byte of every UTF-32 code unit is zero
+// and the next one almost always is. The endianness is picked by the position of these zero bytes,
+// then content must pass IsValidUTF32BE/IsValidUTF32LE. The lowest byte is seldom zero in text,
+// so the Confidence drops with the number of units whose lowest byte is zero.
+//
+// return: the Result of UTF-32BE or UTF-32LE, nil if content doesn't look like UTF-32
+func probeUTF32(content []byte) *Result {
+	units := len(content) / 4
+	if units == 0 || len(content)&0x3 != 0 {
+		return nil
+	}
+	var zeros [4]int // number of zero bytes at each offset of the code units
+	for i, b := range content {
+		if b == 0 {
+			zeros[i&0x3]++
 		}
-		utf8codes = append(utf8codes, c1)
-		utf8codes = append(utf8codes, c2)
-		utf8codes = append(utf8codes, c3)
-		utf8codes = append(utf8codes, c4)
-		return
-	} else {
-		// can't be represented by utf-8
-		return
 	}
+
+	charset, lowZeros := "", 0
+	switch {
+	case zeros[0]+zeros[1] > zeros[2]+zeros[3] && IsValidUTF32BE(content):
+		charset, lowZeros = "UTF-32BE", zeros[3]
+	case zeros[0]+zeros[1] < zeros[2]+zeros[3] && IsValidUTF32LE(content):
+		charset, lowZeros = "UTF-32LE", zeros[0]
+	default:
+		return nil
+	}
+	confidence := 100 * (units - lowZeros) / units
+	if confidence <= 0 {
+		return nil
+	}
+	return newResult(charset, "", confidence)
 }
@@ -0,0 +1,185 @@
+package easychars
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Validator reports whether content is valid under the byte structure of a charset.
+//
+// A Validator is used to re-rank detected charsets, so it should reject content which is impossible for the charset,
+// but it needn't tell whether the content is meaningful text.
+type Validator func(content []byte) bool
+
+// validators are the registered Validators, keyed by lowercase charset name.
+var validators = struct {
+	sync.RWMutex
+	m map[string]Validator
+}{m: map[string]Validator{
+	"utf-8":       IsValidUTF8,
+	"utf8":        IsValidUTF8,
+	"utf-16":      func(content []byte) bool { isUTF16, _, _ := isValidUTF16(content); return isUTF16 },
+	"utf-16be":    isValidUTF16BE,
+	"utf-16le":    isValidUTF16LE,
+	"utf-32be":    IsValidUTF32BE,
+	"utf-32le":    IsValidUTF32LE,
+	"gbk":         isValidGBK,
+	"gb2312":      isValidGBK,
+	"gb18030":     isValidGB18030,
+	"gb-18030":    isValidGB18030,
+	"gb 18030":    isValidGB18030,
+	"big5":        isValidBig5,
+	"euc-tw":      isValidEUCTW,
+	"johab":       isValidJohab,
+	"shift_jis":   isValidShiftJIS,
+	"cp932":       isValidCP932,
+	"windows-31j": isValidCP932,
+	"euc-jp":      isValidEUCJP,
+	"euc-kr":      isValidEUCKR,
+	"cp949":       isValidCP949,
+	"windows-949": isValidCP949,
+	"iso-2022-jp": isValidISO2022JP,
+	"iso-2022-kr": isValidISO2022KR,
+}}
+
+// RegisterValidator registers v as the Validator of charset (case insensitive), replacing the built-in or registered one.
+// A nil v removes the Validator of charset, so that the charset is never rejected.
+//
+// It's safe to call RegisterValidator concurrently with detection.
+func RegisterValidator(charset string, v Validator) {
+	name := strings.ToLower(strings.TrimSpace(charset))
+	validators.Lock()
+	defer validators.Unlock()
+	if v == nil {
+		delete(validators.m, name)
+		return
+	}
+	validators.m[name] = v
+}
+
+// LookupValidator returns the Validator of charset (case insensitive), false if there is none.
+//
+// The charset name itself is looked up first, then its CanonicalName, e.g. "csGB2312" is validated as "GBK",
+// so Validators should be registered under canonical names to cover all the aliases.
+func LookupValidator(charset string) (Validator, bool) {
+	name := strings.ToLower(strings.TrimSpace(charset))
+	validators.RLock()
+	v, ok := validators.m[name]
+	validators.RUnlock()
+	if ok {
+		return v, true
+	}
+	canonical, err := CanonicalName(name)
+	if err != nil {
+		return nil, false
+	}
+	validators.RLock()
+	defer validators.RUnlock()
+	v, ok = validators.m[strings.ToLower(canonical)]
+	return v, ok
+}
+
+// structuralSupersets are the Microsoft code pages extending the charsets reported by chardet.
+// The decoder of a charset in golang.org/x/text also decodes its superset, as WHATWG defines.
+var structuralSupersets = map[string]string{
+	"shift_jis": "CP932",
+	"euc-kr":    "CP949",
+}
+
+// checkStructure checks the statistical Results against the Validators of their charsets,
+// and sorts them by Confidence in descending order again.
+//
+// A Result whose charset is impossible for content is downgraded to Confidence 1,
+// unless content is valid under the superset of the charset, then the Result is renamed to the superset.
+// A character cut at the end of content is ignored, because content may be a sample of longer text.
+func checkStructure(results []*Result, content []byte) []*Result {
+	for _, res := range results {
+		valid, superset := validateCharset(res.Charset, content, true)
+		switch {
+		case superset != "":
+			res.Charset = superset
+		case !valid:
+			res.Confidence = 1
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Confidence > results[j].Confidence })
+	return results
+}
+
+// validateCharset checks whether content is valid under the Validator of charset, true if there is no Validator.
+// If content is only valid under the superset of charset, valid is true and superset is its name.
+// If sample is true, content is a sample of longer text, and a character cut at the end of it is ignored.
+func validateCharset(charset string, content []byte, sample bool) (valid bool, superset string) {
+	validate, ok := LookupValidator(charset)
+	if !ok || isValidContent(validate, content, sample) {
+		return true, ""
+	}
+	if superset, ok := structuralSupersets[strings.ToLower(charset)]; ok {
+		if validate, ok := LookupValidator(superset); ok && isValidContent(validate, content, sample) {
+			return true, superset
+		}
+	}
+	return false, ""
+}
+
+// isValidContent checks whether content is valid by validate, see isValidSample if sample is true.
+func isValidContent(validate Validator, content []byte, sample bool) bool {
+	if sample {
+		return isValidSample(validate, content)
+	}
+	return validate(content)
+}
+
+// detectValid returns the highest ranked Result of detectAll that content is valid under, see selectValid.
+// The Conflict of the declared charset, if any, is recorded on the returned Result.
+func detectValid(content []byte, opts *DetectOptions, sample bool) (*Result, error) {
+	results, conflict, err := detectAll(content, opts)
+	if err != nil {
+		return nil, err
+	}
+	var first *Result
+	if len(results) > 0 {
+		first = results[0]
+	}
+	res, err := selectValid(results, content, opts, sample)
+	if err != nil {
+		return nil, err
+	}
+	conflict.resolve(res, first)
+	return res, nil
+}
+
+// selectValid returns the highest ranked Result that content is valid under, ErrNotDetected if there is none.
+//
+// The Results are re-sorted so that the ones content is invalid under come last, in their original order.
+// Results from BOM and Content-Type, and the declared one if opts.Declaration is DeclarationOverride, are trusted without validation.
+// If sample is true, content is a sample of longer text, and a character cut at the end of it is ignored.
+func selectValid(results []*Result, content []byte, opts *DetectOptions, sample bool) (*Result, error) {
+	valid := make(map[*Result]bool, len(results))
+	for _, res := range results {
+		valid[res] = isTrusted(res, opts) || isValidResult(res, content, sample)
+	}
+	sort.SliceStable(results, func(i, j int) bool { return valid[results[i]] && !valid[results[j]] })
+	if len(results) == 0 || !valid[results[0]] {
+		return nil, ErrNotDetected
+	}
+	return results[0], nil
+}
+
+// isTrusted reports whether the charset of res is decided by the document, instead of guessed.
+func isTrusted(res *Result, opts *DetectOptions) bool {
+	switch res.Source {
+	case SourceBOM, SourceContentType:
+		return true
+	case SourceDeclaration:
+		return opts != nil && opts.Declaration == DeclarationOverride
+	}
+	return false
+}
+
+// isValidResult checks whether content without BOM is valid under the charset of res or its superset.
+func isValidResult(res *Result, content []byte, sample bool) bool {
+	valid, _ := validateCharset(res.Charset, content[res.BOMLength:], sample)
+	return valid
+}